@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/clems4ever/go-graphkb/internal/database"
+	"github.com/clems4ever/go-graphkb/internal/importers"
 	"github.com/clems4ever/go-graphkb/internal/knowledge"
 	"github.com/clems4ever/go-graphkb/internal/server"
 	"github.com/spf13/cobra"
@@ -20,6 +22,12 @@ var Database *database.MariaDB
 // ConfigPath string
 var ConfigPath string
 
+// ConfirmQuery runs the query even if it exceeds the cost budget
+var ConfirmQuery bool
+
+// QueryTimeout is the number of seconds the query can run, the query_timeout option is used when not set
+var QueryTimeout int
+
 func main() {
 	// Display the code line where log.Fatal appeared for troubleshooting
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
@@ -60,6 +68,9 @@ func main() {
 		Args: cobra.ExactArgs(1),
 	}
 
+	queryCmd.Flags().BoolVar(&ConfirmQuery, "confirm", false, "Run the query even if it exceeds the cost budget")
+	queryCmd.Flags().IntVar(&QueryTimeout, "timeout", 0, "Number of seconds the query can run, capped by the query_max_timeout option")
+
 	rootCmd.PersistentFlags().StringVar(&ConfigPath, "config", "config.yml", "Provide the path to the configuration file (required)")
 
 	cobra.OnInitialize(onInit)
@@ -123,7 +134,7 @@ func listen(cmd *cobra.Command, args []string) {
 
 	listenInterface := viper.GetString("server_listen")
 
-	server.StartServer(listenInterface, Database, Database, Database, Database, eventBus)
+	server.StartServer(listenInterface, Database, Database, Database, Database, listener, eventBus)
 
 	close(eventBus)
 }
@@ -139,20 +150,48 @@ func read(cmd *cobra.Command, args []string) {
 }
 
 func queryFunc(cmd *cobra.Command, args []string) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	timeout := knowledge.QueryTimeout(time.Duration(QueryTimeout)*time.Second,
+		time.Duration(viper.GetInt("query_timeout"))*time.Second,
+		time.Duration(viper.GetInt("query_max_timeout"))*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	q := knowledge.NewQuerier(Database, Database)
-
-	r, err := q.Query(ctx, args[0])
+	q.MaxHops = viper.GetInt("query_max_hops")
+	q.CostBudget = viper.GetInt64("query_cost_budget")
+	q.CostConfirmed = ConfirmQuery
+	q.SchemaLoader = importers.NewSchemaLoader(Database, Database)
+	q.StrictValidation = viper.GetBool("query_strict_validation")
+	q.Procedures = knowledge.NewProcedureRegistry()
+	q.Procedures.RegisterBuiltins(Database, Database, Database)
+	q.Updater = knowledge.NewGraphUpdater(Database, Database)
+	q.AllowUpdates = viper.GetBool("query_allow_updates")
+
+	r, err := q.Query(ctx, args[0], nil)
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer r.Cursor.Close()
+
+	for _, w := range r.Warnings {
+		fmt.Printf("[WARNING] %s\n", w.Message)
+	}
+
+	if r.Plan != nil {
+		plan, err := json.MarshalIndent(r.Plan, "", "  ")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(plan))
+	}
+	if r.Mode == knowledge.ExplainMode {
+		return
+	}
 
 	resultsCount := 0
 	for r.Cursor.HasMore() {
 		var m interface{}
-		err := r.Cursor.Read(context.Background(), &m)
+		err := r.Cursor.Read(ctx, &m)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -166,7 +205,16 @@ func queryFunc(cmd *cobra.Command, args []string) {
 		resultsCount++
 	}
 
-	totalTime := r.Statistics.Parsing + r.Statistics.Execution
+	totalTime := r.Statistics.Parsing + r.Statistics.Translation + r.Statistics.Execution
 
 	fmt.Printf("%d results found in %fms\n", resultsCount, float64(totalTime.Microseconds())/1000.0)
+
+	if r.Mode == knowledge.ProfileMode {
+		fmt.Printf("parsing: %fms\ntranslation: %fms\nexecution: %fms\nfetching: %fms\nrows: %d\n",
+			float64(r.Statistics.Parsing.Microseconds())/1000.0,
+			float64(r.Statistics.Translation.Microseconds())/1000.0,
+			float64(r.Statistics.Execution.Microseconds())/1000.0,
+			float64(r.Statistics.Fetching.Microseconds())/1000.0,
+			r.Statistics.RowsCount)
+	}
 }
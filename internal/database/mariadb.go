@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -127,6 +128,30 @@ CREATE TABLE IF NOT EXISTS importers (
 		return err
 	}
 	defer q.Close()
+
+	// Create the table storing the number of assets and relations of each type
+	q, err = m.db.QueryContext(context.Background(), `
+CREATE TABLE IF NOT EXISTS graph_statistics (
+	kind ENUM('asset', 'relation') NOT NULL,
+	type VARCHAR(64) NOT NULL,
+	count BIGINT NOT NULL,
+
+	CONSTRAINT pk_statistics PRIMARY KEY (kind, type)
+)`)
+	if err != nil {
+		return err
+	}
+	defer q.Close()
+
+	// The statistics of a graph imported before the table existed are computed once.
+	var statisticsCount int64
+	row := m.db.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM graph_statistics")
+	if err := row.Scan(&statisticsCount); err != nil {
+		return err
+	}
+	if statisticsCount == 0 {
+		return m.updateStatistics()
+	}
 	return nil
 }
 
@@ -411,15 +436,97 @@ func (m *MariaDB) UpdateGraph(source string, bulk *knowledge.GraphUpdatesBulk) e
 		assetsCount,
 		relCount,
 		time.Since(nowRelationInsert).Seconds())
+
+	if err := m.updateTypesStatistics(bulk.Types()); err != nil {
+		return fmt.Errorf("Unable to update statistics: %v", err)
+	}
 	return nil
 }
 
+// updateTypesStatistics count the assets and relations of the given types, the counts of the other types being
+// left untouched
+func (m *MariaDB) updateTypesStatistics(assetTypes []string, relationTypes []string) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	kinds := []struct {
+		Kind  string
+		Table string
+		Types []string
+	}{
+		{"asset", "assets", assetTypes},
+		{"relation", "relations", relationTypes},
+	}
+	for _, k := range kinds {
+		if len(k.Types) == 0 {
+			continue
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(k.Types)), ", ")
+		args := []interface{}{k.Kind}
+		for _, t := range k.Types {
+			args = append(args, t)
+		}
+
+		queries := []string{
+			fmt.Sprintf("DELETE FROM graph_statistics WHERE kind = ? AND type IN (%s)", placeholders),
+			fmt.Sprintf("INSERT INTO graph_statistics (kind, type, count) SELECT ?, type, COUNT(*) FROM %s WHERE type IN (%s) GROUP BY type",
+				k.Table, placeholders),
+		}
+		for _, q := range queries {
+			if _, err := tx.ExecContext(context.Background(), q, args...); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// updateStatistics count the assets and relations of each type, it is only run when the database is initialized
+func (m *MariaDB) updateStatistics() error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queries := []string{
+		"DELETE FROM graph_statistics",
+		"INSERT INTO graph_statistics (kind, type, count) SELECT 'asset', type, COUNT(*) FROM assets GROUP BY type",
+		"INSERT INTO graph_statistics (kind, type, count) SELECT 'relation', type, COUNT(*) FROM relations GROUP BY type",
+	}
+	for _, q := range queries {
+		if _, err := tx.ExecContext(context.Background(), q); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 // ReadGraph read source subgraph
 func (m *MariaDB) ReadGraph(source string, graph *knowledge.Graph) error {
 	fmt.Printf("Start reading graph of source %s\n", source)
 
 	now := time.Now()
-	// Select all assets for which there is an observed relation from the source
+	if err := m.readSourceRelations(source, graph); err != nil {
+		return err
+	}
+	// The assets created manually may have no relation, the graphs of the importers are left unchanged.
+	if source == knowledge.ManualSource {
+		if err := m.readSourceAssets(source, graph); err != nil {
+			return err
+		}
+	}
+
+	elapsed := time.Since(now)
+	fmt.Printf("Read graph of source %s in %fs\n", source, elapsed.Seconds())
+	return nil
+}
+
+// readSourceRelations read the relations of the source and their assets into the graph
+func (m *MariaDB) readSourceRelations(source string, graph *knowledge.Graph) error {
 	rows, err := m.db.QueryContext(context.Background(), `
 SELECT a.type, a.value, b.type, b.value, r.type FROM relations r
 INNER JOIN assets a ON a.id=r.from_id
@@ -430,6 +537,7 @@ WHERE r.source = ? AND r.type <> 'observed' AND (a.type <> 'source' AND b.type <
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
 
 	for rows.Next() {
 		var FromType, ToType, FromKey, ToKey, Type string
@@ -448,9 +556,38 @@ WHERE r.source = ? AND r.type <> 'observed' AND (a.type <> 'source' AND b.type <
 		to := graph.AddAsset(toAsset.Type, toAsset.Key)
 		graph.AddRelation(from, schema.RelationKeyType(Type), to)
 	}
+	// A partial graph would make the next update remove the relations which have not been read.
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Unable to read relations of source %s: %v", source, err)
+	}
+	return nil
+}
 
-	elapsed := time.Since(now)
-	fmt.Printf("Read graph of source %s in %fs\n", source, elapsed.Seconds())
+// readSourceAssets read the assets observed by the source into the graph so that the assets without relation are
+// read too. Only the manual source reads them.
+func (m *MariaDB) readSourceAssets(source string, graph *knowledge.Graph) error {
+	rows, err := m.db.QueryContext(context.Background(), `
+SELECT a.type, a.value FROM relations r
+INNER JOIN assets s ON s.id=r.from_id
+INNER JOIN assets a ON a.id=r.to_id
+WHERE r.source = ? AND r.type = 'observed' AND s.type = 'source' AND s.value = ?
+	`, source, source)
+
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var Type, Key string
+		if err := rows.Scan(&Type, &Key); err != nil {
+			return err
+		}
+		graph.AddAsset(schema.AssetType(Type), Key)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("Unable to read assets of source %s: %v", source, err)
+	}
 	return nil
 }
 
@@ -476,6 +613,13 @@ func (m *MariaDB) FlushAll() error {
 			return err
 		}
 	}
+
+	_, err = m.db.ExecContext(context.Background(), "DROP TABLE graph_statistics")
+	if err != nil {
+		if !isUnknownTableError(err) {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -491,6 +635,55 @@ func (m *MariaDB) CountAssets() (int64, error) {
 	return count, nil
 }
 
+// ReadStatistics read the number of assets and relations of each type in db.
+func (m *MariaDB) ReadStatistics() (*knowledge.GraphStatistics, error) {
+	rows, err := m.db.QueryContext(context.Background(), "SELECT kind, type, count FROM graph_statistics")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statistics := &knowledge.GraphStatistics{
+		AssetsCount:    make(map[string]int64),
+		RelationsCount: make(map[string]int64),
+	}
+	for rows.Next() {
+		var kind, statisticsType string
+		var count int64
+		if err := rows.Scan(&kind, &statisticsType, &count); err != nil {
+			return nil, err
+		}
+		if kind == "asset" {
+			statistics.AssetsCount[statisticsType] = count
+		} else {
+			statistics.RelationsCount[statisticsType] = count
+		}
+	}
+	return statistics, rows.Err()
+}
+
+// ReadSourcesStatistics return the number of assets observed by each source and the number of relations it created
+func (m *MariaDB) ReadSourcesStatistics(ctx context.Context) (map[string]knowledge.SourceStatistics, error) {
+	rows, err := m.db.QueryContext(ctx, `
+SELECT source, COUNT(DISTINCT CASE WHEN type = 'observed' THEN to_id END), SUM(type <> 'observed')
+FROM relations GROUP BY source`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	statistics := make(map[string]knowledge.SourceStatistics)
+	for rows.Next() {
+		var source string
+		var s knowledge.SourceStatistics
+		if err := rows.Scan(&source, &s.AssetsCount, &s.RelationsCount); err != nil {
+			return nil, err
+		}
+		statistics[source] = s
+	}
+	return statistics, rows.Err()
+}
+
 // CountRelations count the total number of relations in db.
 func (m *MariaDB) CountRelations() (int64, error) {
 	var count int64
@@ -517,10 +710,23 @@ func (m *MariaDB) Query(ctx context.Context, sql knowledge.SQLTranslation) (*kno
 		// Query can take 35 seconds max before being aborted...
 		sql.Query = fmt.Sprintf("SET STATEMENT max_statement_time=%f FOR %s", time.Until(deadline).Seconds()+5, sql.Query)
 	}
-	fmt.Println(sql.Query)
+	// The query is run on a dedicated connection so that it can be killed when the context is canceled.
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	rows, err := m.db.QueryContext(ctx, sql.Query)
+	var connectionID int64
+	if err := conn.QueryRowContext(ctx, "SELECT CONNECTION_ID()").Scan(&connectionID); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("Unable to read connection id: %v", err)
+	}
+	stopKiller := m.killQueryOnCancel(ctx, connectionID)
+
+	rows, err := conn.QueryContext(ctx, sql.Query, sql.Args...)
 	if err != nil {
+		stopKiller()
+		conn.Close()
 		return nil, err
 	}
 
@@ -528,11 +734,48 @@ func (m *MariaDB) Query(ctx context.Context, sql knowledge.SQLTranslation) (*kno
 	res.Cursor = &MariaDBCursor{
 		Rows:        rows,
 		Projections: sql.ProjectionTypes,
+		conn:        conn,
+		stopKiller:  stopKiller,
 	}
 	res.Projections = sql.ProjectionTypes
 	return res, nil
 }
 
+// killQueryOnCancel kill the query run by the connection when the context is canceled. Closing the client side of
+// the connection is not enough since MariaDB keeps running the query until it sends results. The returned
+// function stops watching the context, it must be called before the connection is released.
+func (m *MariaDB) killQueryOnCancel(ctx context.Context, connectionID int64) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			_, err := m.db.ExecContext(context.Background(), fmt.Sprintf("KILL QUERY %d", connectionID))
+			if err != nil {
+				log.Printf("Unable to kill query of connection %d: %v", connectionID, err)
+			}
+		case <-done:
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// Explain return the execution plan of the query computed by MariaDB in JSON without running the query
+func (m *MariaDB) Explain(ctx context.Context, sql knowledge.SQLTranslation) (string, error) {
+	var plan string
+	row := m.db.QueryRowContext(ctx, "EXPLAIN FORMAT=JSON "+sql.Query, sql.Args...)
+	if err := row.Scan(&plan); err != nil {
+		return "", fmt.Errorf("Unable to explain query: %v", err)
+	}
+	return plan, nil
+}
+
 func (m *MariaDB) SaveSuccessfulQuery(ctx context.Context, cypher, sql string, duration time.Duration) error {
 	_, err := m.db.ExecContext(ctx, "INSERT INTO query_history (id, timestamp, query_cypher, query_sql, status, execution_time_ms) VALUES (NULL, CURRENT_TIMESTAMP(), ?, ?, 'SUCCESS', ?)",
 		cypher, sql, duration)
@@ -613,6 +856,10 @@ type MariaDBCursor struct {
 	*sql.Rows
 
 	Projections []knowledge.Projection
+
+	// conn is the connection dedicated to the query, it is released when the cursor is closed
+	conn       *sql.Conn
+	stopKiller func()
 }
 
 // HasMore tells whether there are more data to retrieve from the cursor
@@ -651,7 +898,7 @@ func (mc *MariaDBCursor) Read(ctx context.Context, doc interface{}) error {
 		if ok {
 			err = q.Put(string(b))
 		} else {
-			err = q.Put(b)
+			err = q.Put(v)
 		}
 		if err != nil {
 			return err
@@ -667,6 +914,11 @@ func (mc *MariaDBCursor) Read(ctx context.Context, doc interface{}) error {
 			if err != nil {
 				return nil
 			}
+			// The asset is NULL when it belongs to an optional pattern which did not match
+			if items[0] == nil {
+				output[i] = nil
+				continue
+			}
 			a := knowledge.AssetWithID{
 				ID: fmt.Sprintf("%v", reflect.ValueOf(items[0])),
 				Asset: knowledge.Asset{
@@ -680,6 +932,11 @@ func (mc *MariaDBCursor) Read(ctx context.Context, doc interface{}) error {
 			if err != nil {
 				return nil
 			}
+			// The relation is NULL when it belongs to an optional pattern which did not match
+			if items[0] == nil {
+				output[i] = nil
+				continue
+			}
 			r := knowledge.RelationWithID{
 				From: fmt.Sprintf("%v", reflect.ValueOf(items[0])),
 				To:   fmt.Sprintf("%v", reflect.ValueOf(items[1])),
@@ -692,6 +949,41 @@ func (mc *MariaDBCursor) Read(ctx context.Context, doc interface{}) error {
 				return nil
 			}
 			output[i] = items[0]
+		case knowledge.PathExprType:
+			items, err := q.Get(1)
+			if err != nil {
+				return nil
+			}
+			if items[0] == nil {
+				output[i] = nil
+				continue
+			}
+			p := knowledge.PathWithID{}
+			if err := json.Unmarshal([]byte(fmt.Sprintf("%v", reflect.ValueOf(items[0]))), &p); err != nil {
+				return err
+			}
+			output[i] = p
+		case knowledge.ListExprType:
+			items, err := q.Get(1)
+			if err != nil {
+				return nil
+			}
+			if items[0] == nil {
+				output[i] = nil
+				continue
+			}
+			var list []interface{}
+			if err := json.Unmarshal([]byte(fmt.Sprintf("%v", reflect.ValueOf(items[0]))), &list); err != nil {
+				return err
+			}
+			// Aggregating with JSON_ARRAYAGG keeps the NULL values while collect() skips them.
+			l := make([]interface{}, 0, len(list))
+			for _, e := range list {
+				if e != nil {
+					l = append(l, e)
+				}
+			}
+			output[i] = l
 		}
 	}
 	val.Elem().Set(reflect.ValueOf(output))
@@ -700,5 +992,13 @@ func (mc *MariaDBCursor) Read(ctx context.Context, doc interface{}) error {
 
 // Close the cursor
 func (mc *MariaDBCursor) Close() error {
-	return mc.Rows.Close()
+	err := mc.Rows.Close()
+	if mc.conn != nil {
+		mc.stopKiller()
+		if cerr := mc.conn.Close(); err == nil {
+			err = cerr
+		}
+		mc.conn = nil
+	}
+	return err
 }
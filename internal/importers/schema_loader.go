@@ -0,0 +1,44 @@
+package importers
+
+import (
+	"context"
+
+	"github.com/clems4ever/go-graphkb/internal/knowledge"
+	"github.com/clems4ever/go-graphkb/internal/schema"
+)
+
+// SchemaLoader load the schema graph merging the schemas of all the registered importers and of the assets and
+// relations created by the queries
+type SchemaLoader struct {
+	registry  Registry
+	persistor schema.Persistor
+}
+
+// NewSchemaLoader create a schema loader
+func NewSchemaLoader(registry Registry, persistor schema.Persistor) *SchemaLoader {
+	return &SchemaLoader{registry: registry, persistor: persistor}
+}
+
+// LoadSchemaGraph load the schemas of the importers and of the manual source and merge them
+func (sl *SchemaLoader) LoadSchemaGraph(ctx context.Context) (schema.SchemaGraph, error) {
+	sg := schema.NewSchemaGraph()
+
+	importers, err := sl.registry.ListImporters(ctx)
+	if err != nil {
+		return sg, err
+	}
+
+	sources := []string{knowledge.ManualSource}
+	for name := range importers {
+		sources = append(sources, name)
+	}
+
+	for _, name := range sources {
+		g, err := sl.persistor.LoadSchema(ctx, name)
+		if err != nil {
+			return sg, err
+		}
+		sg.Merge(g)
+	}
+	return sg, nil
+}
@@ -101,6 +101,16 @@ func (g *Graph) HasRelation(relation Relation) bool {
 	return g.relations.Contains(relation)
 }
 
+// RemoveAsset remove the asset from the graph, its relations are kept
+func (g *Graph) RemoveAsset(asset Asset) {
+	g.assets.Remove(asset)
+}
+
+// RemoveRelation remove the relation from the graph
+func (g *Graph) RemoveRelation(relation Relation) {
+	g.relations.Remove(relation)
+}
+
 // Merge merge other graph into the current graph
 func (g *Graph) Merge(other *Graph) {
 	for a := range other.assets.Iter() {
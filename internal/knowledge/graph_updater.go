@@ -3,6 +3,7 @@ package knowledge
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/clems4ever/go-graphkb/internal/schema"
 )
@@ -14,15 +15,26 @@ type SourceSubGraphUpdates struct {
 	Source  string
 }
 
+// GraphUpdatesCount is the number of assets and relations upserted and removed by a bulk of updates
+type GraphUpdatesCount struct {
+	AssetUpserts     int
+	AssetRemovals    int
+	RelationUpserts  int
+	RelationRemovals int
+}
+
 // SourceListener represents the source listener waiting for source events
 type GraphUpdater struct {
 	graphDB         GraphDB
 	schemaPersistor schema.Persistor
+
+	// mutex applies the updates one after the other
+	mutex sync.Mutex
 }
 
 // NewGraphUpdater create a new instance of graph updater
 func NewGraphUpdater(graphDB GraphDB, schemaPersistor schema.Persistor) *GraphUpdater {
-	return &GraphUpdater{graphDB, schemaPersistor}
+	return &GraphUpdater{graphDB: graphDB, schemaPersistor: schemaPersistor}
 }
 
 // Augment the graph of the user with "observed" relation from the source to the each asset
@@ -79,6 +91,12 @@ func (sl *GraphUpdater) updateSchema(source string, sg *schema.SchemaGraph) erro
 }
 
 func (sl *GraphUpdater) doUpdate(updates SourceSubGraphUpdates) error {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+	return sl.applyUpdates(updates)
+}
+
+func (sl *GraphUpdater) applyUpdates(updates SourceSubGraphUpdates) error {
 	if err := sl.updateSchema(updates.Source, &updates.Schema); err != nil {
 		return err
 	}
@@ -99,6 +117,43 @@ func (sl *GraphUpdater) doUpdate(updates SourceSubGraphUpdates) error {
 	return nil
 }
 
+// UpdateSourceGraph read the graph of the source, let update modify it and apply the differences like the updates
+// sent by the importers. The schema of the source is the schema of the modified graph. No other update is applied
+// in the meantime so that the graph read is the one being modified.
+func (sl *GraphUpdater) UpdateSourceGraph(source string, update func(graph *Graph) error) (GraphUpdatesCount, error) {
+	sl.mutex.Lock()
+	defer sl.mutex.Unlock()
+
+	previousGraph := NewGraph()
+	if err := sl.graphDB.ReadGraph(source, previousGraph); err != nil {
+		return GraphUpdatesCount{}, fmt.Errorf("Unable to read graph of source %s: %v", source, err)
+	}
+
+	graph := previousGraph.Copy()
+	if err := update(graph); err != nil {
+		return GraphUpdatesCount{}, err
+	}
+
+	bulk := GenerateGraphUpdatesBulk(previousGraph, graph)
+	// The observed relations are added to the bulk when it is applied, they are not counted.
+	count := GraphUpdatesCount{
+		AssetUpserts:     len(bulk.GetAssetUpserts()),
+		AssetRemovals:    len(bulk.GetAssetRemovals()),
+		RelationUpserts:  len(bulk.GetRelationUpserts()),
+		RelationRemovals: len(bulk.GetRelationRemovals()),
+	}
+	if count == (GraphUpdatesCount{}) {
+		return count, nil
+	}
+
+	err := sl.applyUpdates(SourceSubGraphUpdates{
+		Updates: *bulk,
+		Schema:  graph.ExtractSchema(),
+		Source:  source,
+	})
+	return count, err
+}
+
 // Listen events coming from the event bus
 func (sl *GraphUpdater) Listen(updatesC chan SourceSubGraphUpdates) chan struct{} {
 	closeC := make(chan struct{})
@@ -2,6 +2,7 @@ package knowledge
 
 import (
 	"encoding/json"
+	"sort"
 
 	mapset "github.com/deckarep/golang-set"
 )
@@ -135,6 +136,35 @@ func (gub *GraphUpdatesBulk) RemoveRelations(relations ...Relation) {
 	}
 }
 
+// Types return the sorted types of the assets and the relations the bulk may add or remove. The assets removed
+// with the relations are the ends of the removed relations.
+func (gub *GraphUpdatesBulk) Types() ([]string, []string) {
+	assetTypes := mapset.NewSet()
+	relationTypes := mapset.NewSet()
+	for _, assets := range [][]Asset{gub.GetAssetUpserts(), gub.GetAssetRemovals()} {
+		for _, a := range assets {
+			assetTypes.Add(string(a.Type))
+		}
+	}
+	for _, relations := range [][]Relation{gub.GetRelationUpserts(), gub.GetRelationRemovals()} {
+		for _, r := range relations {
+			assetTypes.Add(string(r.From.Type))
+			assetTypes.Add(string(r.To.Type))
+			relationTypes.Add(string(r.Type))
+		}
+	}
+
+	toSlice := func(set mapset.Set) []string {
+		types := []string{}
+		for t := range set.Iter() {
+			types = append(types, t.(string))
+		}
+		sort.Strings(types)
+		return types
+	}
+	return toSlice(assetTypes), toSlice(relationTypes)
+}
+
 func (gub *GraphUpdatesBulk) MarshalJSON() ([]byte, error) {
 	j := &GraphUpdatesBulkJSON{}
 	j.AssetUpserts = gub.GetAssetUpserts()
@@ -133,6 +133,27 @@ func (s *SourceUpdatesSuite) TestShouldGenerateBulkForMixedAdditionsAndRemovals(
 	s.Assert().ElementsMatch(bulk.GetRelationRemovals(), []Relation{r})
 }
 
+func (s *SourceUpdatesSuite) TestShouldListTypesOfBulk() {
+	g := NewGraph()
+	ip := g.AddAsset("ip", "127.0.0.1")
+	host := g.AddAsset("host", "web-01")
+	rel := g.AddRelation(ip, "resolves", host)
+
+	bulk := NewGraphUpdatesBulk()
+	bulk.UpsertAsset(Asset(ip))
+	bulk.RemoveRelation(rel)
+	assetTypes, relationTypes := bulk.Types()
+	s.Assert().Equal([]string{"host", "ip"}, assetTypes)
+	s.Assert().Equal([]string{"resolves"}, relationTypes)
+
+	// The types of the assets removed without relation are counted again too.
+	bulk = NewGraphUpdatesBulk()
+	bulk.RemoveAsset(Asset(host))
+	assetTypes, relationTypes = bulk.Types()
+	s.Assert().Equal([]string{"host"}, assetTypes)
+	s.Assert().Equal([]string{}, relationTypes)
+}
+
 func TestGraphUpdatesSuite(t *testing.T) {
 	suite.Run(t, new(SourceUpdatesSuite))
 }
@@ -25,8 +25,26 @@ type GraphDB interface {
 
 	CountAssets() (int64, error)
 	CountRelations() (int64, error)
+	// ReadStatistics return the number of assets and relations of each type, they are updated with the graph
+	ReadStatistics() (*GraphStatistics, error)
+	// ReadSourcesStatistics return the number of assets observed by each source and the number of relations it created
+	ReadSourcesStatistics(ctx context.Context) (map[string]SourceStatistics, error)
 
 	Query(ctx context.Context, query SQLTranslation) (*GraphQueryResult, error)
+	// Explain return the execution plan of the query in JSON without running it
+	Explain(ctx context.Context, query SQLTranslation) (string, error)
+}
+
+// GraphStatistics are the number of assets and relations of each type, they are used to plan the queries
+type GraphStatistics struct {
+	AssetsCount    map[string]int64 `json:"assets_count"`
+	RelationsCount map[string]int64 `json:"relations_count"`
+}
+
+// SourceStatistics are the number of assets observed by a source and the number of relations it created
+type SourceStatistics struct {
+	AssetsCount    int64 `json:"assets_count"`
+	RelationsCount int64 `json:"relations_count"`
 }
 
 // Cursor is a cursor over the results
@@ -55,3 +73,13 @@ type RelationWithID struct {
 func (r RelationWithID) String() string {
 	return fmt.Sprintf("Relation{id:%s, from:%s, to:%s, type:%s", r.ID, r.From, r.To, r.Type)
 }
+
+// PathWithID is a path made of assets and of the relations binding them
+type PathWithID struct {
+	Assets    []AssetWithID    `json:"assets"`
+	Relations []RelationWithID `json:"relations"`
+}
+
+func (p PathWithID) String() string {
+	return fmt.Sprintf("Path{assets:%v, relations:%v}", p.Assets, p.Relations)
+}
@@ -2,6 +2,7 @@ package knowledge
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -12,20 +13,65 @@ import (
 type Querier struct {
 	GraphDB    GraphDB
 	historizer history.Historizer
+
+	// MaxHops is the maximum number of hops a variable-length relationship can traverse.
+	// DefaultMaxHops is used when not set.
+	MaxHops int
+	// CostBudget is the maximum estimated number of rows of the cartesian product of the disconnected patterns
+	// matched by a query. DefaultCostBudget is used when not set, a negative budget disables the check.
+	CostBudget int64
+	// CostConfirmed runs the queries exceeding the cost budget anyway, it is set once the user confirmed the query
+	CostConfirmed bool
+	// SchemaLoader provides the schema the queries are validated against, they are not validated when not set
+	SchemaLoader SchemaLoader
+	// StrictValidation rejects the queries not matching the schema instead of returning warnings
+	StrictValidation bool
+	// PageSize is the maximum number of rows returned, the results are not paginated when not set
+	PageSize int
+	// PageToken is the token of the page of results to return, it comes from the result of the previous page
+	PageToken string
+	// Updater applies the assets and relations created and deleted by the updating clauses under the manual source
+	Updater *GraphUpdater
+	// AllowUpdates allows the queries with CREATE, MERGE and DELETE clauses, they are reserved to the administrators
+	AllowUpdates bool
+	// Procedures are the procedures the CALL clauses can call
+	Procedures *ProcedureRegistry
 }
 
 type QuerierResult struct {
 	Cursor      Cursor
 	Projections []Projection
 	Statistics  Statistics
+
+	// Mode is the mode requested by the EXPLAIN or PROFILE prefix of the query
+	Mode QueryMode
+	// Plan describes how the query is run, it is only set when the query is explained or profiled
+	Plan *QueryPlan
+	// Warnings are the parts of the query not matching the schema
+	Warnings []QueryWarning
+
+	// pages reads the page of results when they are paginated
+	pages *pageCursor
+}
+
+// NextPage return the token of the next page of results once the rows of the page have been read. It is empty
+// when the results are not paginated or when the page is the last one.
+func (qr *QuerierResult) NextPage() (string, error) {
+	if qr.pages == nil {
+		return "", nil
+	}
+	return qr.pages.NextPage()
 }
 
 func NewQuerier(db GraphDB, historizer history.Historizer) *Querier {
 	return &Querier{GraphDB: db, historizer: historizer}
 }
 
-func (q *Querier) Query(ctx context.Context, queryString string) (*QuerierResult, error) {
-	qr, sql, err := q.queryInternal(ctx, queryString)
+// Query execute a cypher query. The parameters are the values of the placeholders ($name) of the query.
+// A query prefixed by EXPLAIN is not run, only its plan is returned. A query prefixed by PROFILE is run
+// and its plan is returned, the results are counted and the time spent reading them is measured.
+func (q *Querier) Query(ctx context.Context, queryString string, parameters map[string]interface{}) (*QuerierResult, error) {
+	qr, sql, err := q.queryInternal(ctx, queryString, parameters)
 	if err != nil {
 		saveErr := q.historizer.SaveFailedQuery(ctx, queryString, sql, err)
 		if saveErr != nil {
@@ -41,12 +87,14 @@ func (q *Querier) Query(ctx context.Context, queryString string) (*QuerierResult
 	return qr, nil
 }
 
-func (q *Querier) queryInternal(ctx context.Context, cypherQuery string) (*QuerierResult, string, error) {
+func (q *Querier) queryInternal(ctx context.Context, cypherQuery string, parameters map[string]interface{}) (*QuerierResult, string, error) {
 	s := Statistics{}
 
 	var err error
 	var queryCypher *query.QueryCypher
 
+	mode, cypherQuery := parseQueryMode(cypherQuery)
+
 	s.Parsing = MeasureDuration(func() {
 		queryCypher, err = query.TransformCypher(cypherQuery)
 	})
@@ -55,11 +103,79 @@ func (q *Querier) queryInternal(ctx context.Context, cypherQuery string) (*Queri
 		return nil, "", err
 	}
 
-	translation, err := NewSQLQueryTranslator().Translate(queryCypher)
+	if len(queryCypher.UpdatingClauses) > 0 {
+		return q.update(ctx, mode, queryCypher, parameters, s)
+	}
+
+	translator := NewSQLQueryTranslator()
+	if q.MaxHops > 0 {
+		translator.MaxHops = q.MaxHops
+	}
+	translator.Parameters = parameters
+	translator.CallResult, err = q.callProcedure(ctx, mode, queryCypher, parameters)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fingerprint, err := queryFingerprint(cypherQuery, parameters)
+	if err != nil {
+		return nil, "", err
+	}
+	translator.Page, err = q.page(fingerprint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	statistics, err := q.GraphDB.ReadStatistics()
+	if err != nil {
+		return nil, "", fmt.Errorf("Unable to read graph statistics: %v", err)
+	}
+	translator.Statistics = statistics
+
+	var translation *SQLTranslation
+	s.Translation = MeasureDuration(func() {
+		translation, err = translator.Translate(queryCypher)
+	})
 	if err != nil {
 		return nil, "", err
 	}
 
+	warnings, err := q.validate(ctx, translator.Graphs)
+	if err != nil {
+		return nil, translation.Query, err
+	}
+
+	if mode != ExplainMode && !q.CostConfirmed {
+		if err := q.checkCost(translator.Graphs, statistics); err != nil {
+			return nil, translation.Query, err
+		}
+	}
+
+	var plan *QueryPlan
+	if mode != RunMode {
+		databasePlan, err := q.GraphDB.Explain(ctx, *translation)
+		if err != nil {
+			return nil, translation.Query, err
+		}
+		plan = &QueryPlan{
+			Translation:  *translation,
+			QueryGraph:   translator.QueryGraph.Summarize(),
+			DatabasePlan: json.RawMessage(databasePlan),
+		}
+	}
+
+	if mode == ExplainMode {
+		result := &QuerierResult{
+			Cursor:      &emptyCursor{},
+			Projections: translation.ProjectionTypes,
+			Statistics:  s,
+			Mode:        mode,
+			Plan:        plan,
+			Warnings:    warnings,
+		}
+		return result, translation.Query, nil
+	}
+
 	var res *GraphQueryResult
 	s.Execution = MeasureDuration(func() {
 		res, err = q.GraphDB.Query(ctx, *translation)
@@ -75,13 +191,83 @@ func (q *Querier) queryInternal(ctx context.Context, cypherQuery string) (*Queri
 		Cursor:      res.Cursor,
 		Projections: res.Projections,
 		Statistics:  s,
+		Mode:        mode,
+		Plan:        plan,
+		Warnings:    warnings,
+	}
+	if mode == ProfileMode {
+		result.Cursor = &profilingCursor{Cursor: res.Cursor, statistics: &result.Statistics}
+	}
+	if translator.Page != nil {
+		result.pages = &pageCursor{
+			Cursor:      result.Cursor,
+			page:        *translator.Page,
+			fingerprint: fingerprint,
+			keys:        translator.pageKeys,
+		}
+		result.Cursor = result.pages
 	}
 	return result, translation.Query, nil
 }
 
+// page return the page of results requested by the page token or the first page when there is no token. The page
+// size overrides the one of the token when set.
+func (q *Querier) page(fingerprint string) (*QueryPage, error) {
+	if q.PageToken == "" {
+		if q.PageSize <= 0 {
+			return nil, nil
+		}
+		return &QueryPage{Size: q.PageSize}, nil
+	}
+
+	page, err := decodePageToken(q.PageToken, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if q.PageSize > 0 {
+		page.Size = q.PageSize
+	}
+	return page, nil
+}
+
+// validate check the query graphs against the schema, the warnings are returned as an error in strict mode
+func (q *Querier) validate(ctx context.Context, graphs []QueryGraph) ([]QueryWarning, error) {
+	if q.SchemaLoader == nil {
+		return nil, nil
+	}
+
+	sg, err := q.SchemaLoader.LoadSchemaGraph(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load schema: %v", err)
+	}
+
+	warnings := validateQueryGraphs(graphs, sg)
+	if q.StrictValidation && len(warnings) > 0 {
+		return nil, &QueryValidationError{Warnings: warnings}
+	}
+	return warnings, nil
+}
+
+// checkCost reject the queries whose disconnected patterns are estimated to produce more rows than the cost budget
+func (q *Querier) checkCost(graphs []QueryGraph, statistics *GraphStatistics) error {
+	budget := q.CostBudget
+	if budget == 0 {
+		budget = DefaultCostBudget
+	}
+	if budget < 0 {
+		return nil
+	}
+	return checkQueryCost(graphs, statistics, budget)
+}
+
 type Statistics struct {
-	Parsing   time.Duration
-	Execution time.Duration
+	Parsing     time.Duration
+	Translation time.Duration
+	Execution   time.Duration
+	// Fetching is the time spent reading the results, it is only measured when the query is profiled
+	Fetching time.Duration
+	// RowsCount is the number of results read, they are only counted when the query is profiled
+	RowsCount int
 }
 
 func MeasureDuration(Func func()) time.Duration {
@@ -0,0 +1,449 @@
+package knowledge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/clems4ever/go-graphkb/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubGraphDB is a graph database returning the given rows to any query
+type stubGraphDB struct {
+	rows           [][]interface{}
+	queries        []SQLTranslation
+	assetsCount    map[string]int64
+	relationsCount map[string]int64
+	// graph is the graph read for any source
+	graph *Graph
+	// updates are the bulks the graph has been updated with
+	updates []*GraphUpdatesBulk
+	// sourcesStatistics are the statistics of each source
+	sourcesStatistics map[string]SourceStatistics
+}
+
+func (s *stubGraphDB) Close() error                   { return nil }
+func (s *stubGraphDB) InitializeSchema() error        { return nil }
+func (s *stubGraphDB) FlushAll() error                { return nil }
+func (s *stubGraphDB) CountAssets() (int64, error)    { return 0, nil }
+func (s *stubGraphDB) CountRelations() (int64, error) { return 0, nil }
+
+func (s *stubGraphDB) UpdateGraph(source string, bulk *GraphUpdatesBulk) error {
+	s.updates = append(s.updates, bulk)
+	return nil
+}
+
+func (s *stubGraphDB) ReadGraph(source string, graph *Graph) error {
+	if s.graph != nil {
+		graph.Merge(s.graph)
+	}
+	return nil
+}
+
+func (s *stubGraphDB) ReadStatistics() (*GraphStatistics, error) {
+	return &GraphStatistics{AssetsCount: s.assetsCount, RelationsCount: s.relationsCount}, nil
+}
+
+func (s *stubGraphDB) ReadSourcesStatistics(ctx context.Context) (map[string]SourceStatistics, error) {
+	return s.sourcesStatistics, nil
+}
+
+func (s *stubGraphDB) Query(ctx context.Context, query SQLTranslation) (*GraphQueryResult, error) {
+	s.queries = append(s.queries, query)
+	return &GraphQueryResult{
+		Cursor:      &stubCursor{rows: s.rows},
+		Projections: query.ProjectionTypes,
+	}, nil
+}
+
+func (s *stubGraphDB) Explain(ctx context.Context, query SQLTranslation) (string, error) {
+	return `{"query_block": {"select_id": 1}}`, nil
+}
+
+type stubCursor struct {
+	rows [][]interface{}
+}
+
+func (sc *stubCursor) HasMore() bool { return len(sc.rows) > 0 }
+
+func (sc *stubCursor) Read(ctx context.Context, doc interface{}) error {
+	*(doc.(*interface{})) = sc.rows[0]
+	sc.rows = sc.rows[1:]
+	return nil
+}
+
+func (sc *stubCursor) Close() error { return nil }
+
+// stubSchemaLoader is a schema loader returning the given schema
+type stubSchemaLoader struct {
+	schema schema.SchemaGraph
+}
+
+func (ssl *stubSchemaLoader) LoadSchemaGraph(ctx context.Context) (schema.SchemaGraph, error) {
+	return ssl.schema, nil
+}
+
+// stubSchemaPersistor is a schema persistor keeping the schemas in memory
+type stubSchemaPersistor struct {
+	schemas map[string]schema.SchemaGraph
+}
+
+func (ssp *stubSchemaPersistor) SaveSchema(ctx context.Context, sourceName string, sg schema.SchemaGraph) error {
+	ssp.schemas[sourceName] = sg
+	return nil
+}
+
+func (ssp *stubSchemaPersistor) LoadSchema(ctx context.Context, sourceName string) (schema.SchemaGraph, error) {
+	if sg, ok := ssp.schemas[sourceName]; ok {
+		return sg, nil
+	}
+	return schema.NewSchemaGraph(), nil
+}
+
+// stubHistorizer is a historizer dropping the queries
+type stubHistorizer struct{}
+
+func (sh *stubHistorizer) SaveSuccessfulQuery(ctx context.Context, cypher, sql string, duration time.Duration) error {
+	return nil
+}
+
+func (sh *stubHistorizer) SaveFailedQuery(ctx context.Context, cypher, sql string, err error) error {
+	return nil
+}
+
+func TestShouldParseQueryMode(t *testing.T) {
+	cases := []struct {
+		Query    string
+		Mode     QueryMode
+		Stripped string
+	}{
+		{"MATCH (n) RETURN n", RunMode, "MATCH (n) RETURN n"},
+		{"EXPLAIN MATCH (n) RETURN n", ExplainMode, "MATCH (n) RETURN n"},
+		{"  explain\nMATCH (n) RETURN n", ExplainMode, "MATCH (n) RETURN n"},
+		{"PROFILE MATCH (n) RETURN n", ProfileMode, "MATCH (n) RETURN n"},
+		{"MATCH (explain) RETURN explain", RunMode, "MATCH (explain) RETURN explain"},
+	}
+
+	for _, c := range cases {
+		mode, stripped := parseQueryMode(c.Query)
+		assert.Equal(t, c.Mode, mode, c.Query)
+		assert.Equal(t, c.Stripped, stripped, c.Query)
+	}
+}
+
+func TestShouldExplainQueryWithoutRunningIt(t *testing.T) {
+	db := &stubGraphDB{}
+	querier := NewQuerier(db, &stubHistorizer{})
+
+	res, err := querier.Query(context.Background(), "EXPLAIN MATCH (h:host)-[r:owned_by]->(u) RETURN h", nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, db.queries)
+	assert.Equal(t, ExplainMode, res.Mode)
+	assert.False(t, res.Cursor.HasMore())
+	assert.Equal(t, []Projection{{Alias: "h", ExpressionType: NodeExprType}}, res.Projections)
+
+	require.NotNil(t, res.Plan)
+	assert.Contains(t, res.Plan.Translation.Query, "SELECT a0.id, a0.value, a0.type FROM assets a0")
+	assert.Equal(t, []interface{}{"owned_by", "host"}, res.Plan.Translation.Args)
+	assert.JSONEq(t, `{"query_block": {"select_id": 1}}`, string(res.Plan.DatabasePlan))
+
+	assert.Equal(t, QueryGraphSummary{
+		Nodes: []QueryNodeSummary{
+			{Variable: "h", Labels: []string{"host"}},
+			{Variable: "u"},
+		},
+		Relations: []QueryRelationSummary{
+			{Variable: "r", Labels: []string{"owned_by"}, LeftIdx: 0, RightIdx: 1, Direction: "right"},
+		},
+		Variables: []string{"h", "r", "u"},
+	}, res.Plan.QueryGraph)
+}
+
+func TestShouldProfileQuery(t *testing.T) {
+	db := &stubGraphDB{rows: [][]interface{}{{"web-01"}, {"web-02"}}}
+	querier := NewQuerier(db, &stubHistorizer{})
+
+	res, err := querier.Query(context.Background(), "PROFILE MATCH (h:host) RETURN h.value", nil)
+	require.NoError(t, err)
+	require.Len(t, db.queries, 1)
+	assert.Equal(t, ProfileMode, res.Mode)
+	require.NotNil(t, res.Plan)
+
+	for res.Cursor.HasMore() {
+		var d interface{}
+		require.NoError(t, res.Cursor.Read(context.Background(), &d))
+	}
+	assert.Equal(t, 2, res.Statistics.RowsCount)
+}
+
+func TestShouldNotProfileQueryByDefault(t *testing.T) {
+	db := &stubGraphDB{rows: [][]interface{}{{"web-01"}}}
+	querier := NewQuerier(db, &stubHistorizer{})
+
+	res, err := querier.Query(context.Background(), "MATCH (h:host) RETURN h.value", nil)
+	require.NoError(t, err)
+	assert.Equal(t, RunMode, res.Mode)
+	assert.Nil(t, res.Plan)
+}
+
+func TestShouldRejectExpensiveCartesianProduct(t *testing.T) {
+	db := &stubGraphDB{assetsCount: map[string]int64{"host": 5000, "user": 3000}}
+	querier := NewQuerier(db, &stubHistorizer{})
+
+	_, err := querier.Query(context.Background(), "MATCH (h:host), (u:user) RETURN h, u", nil)
+	require.Error(t, err)
+	assert.Empty(t, db.queries)
+
+	costErr, ok := err.(*QueryCostError)
+	require.True(t, ok)
+	assert.Equal(t, float64(15000000), costErr.EstimatedCost)
+	assert.Equal(t, int64(DefaultCostBudget), costErr.Budget)
+	assert.Equal(t, []QueryComponentCost{
+		{Variables: []string{"h"}, EstimatedRows: 5000},
+		{Variables: []string{"u"}, EstimatedRows: 3000},
+	}, costErr.Components)
+}
+
+func TestShouldRunExpensiveCartesianProductOnceConfirmed(t *testing.T) {
+	db := &stubGraphDB{assetsCount: map[string]int64{"host": 5000, "user": 3000}}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.CostConfirmed = true
+
+	_, err := querier.Query(context.Background(), "MATCH (h:host), (u:user) RETURN h, u", nil)
+	require.NoError(t, err)
+	assert.Len(t, db.queries, 1)
+}
+
+func TestShouldRunCartesianProductWithinBudget(t *testing.T) {
+	cases := []struct {
+		Query  string
+		Budget int64
+	}{
+		// The product is within the budget.
+		{"MATCH (h:host), (u:user) RETURN h, u", 20000000},
+		// The check is disabled.
+		{"MATCH (h:host), (u:user) RETURN h, u", -1},
+		// The patterns are connected.
+		{"MATCH (h:host)-[:owned_by]->(u:user), (h)--(u2:user) RETURN h, u, u2", 1},
+		// The node constrained by its properties is expected to match few assets.
+		{"MATCH (h:host {value: 'web-01'}), (u:user) RETURN h, u", 5000},
+		// The nodes restricted by an equality on their value in the WHERE clause are expected to match few assets.
+		{"MATCH (h:host), (u:user) WHERE h.value = 'web-01' AND 'alice' = u.value RETURN h, u", 1},
+		// The nodes bound to the intermediate result are connected through the row they come from.
+		{"MATCH (h:host)-[r]->(u:user) WITH h, u MATCH (h), (u) RETURN h, u", 1},
+	}
+
+	for _, c := range cases {
+		db := &stubGraphDB{assetsCount: map[string]int64{"host": 5000, "user": 3000}}
+		querier := NewQuerier(db, &stubHistorizer{})
+		querier.CostBudget = c.Budget
+
+		_, err := querier.Query(context.Background(), c.Query, nil)
+		assert.NoError(t, err, c.Query)
+	}
+}
+
+func TestShouldCheckCostOfEveryPartOfTheQuery(t *testing.T) {
+	db := &stubGraphDB{assetsCount: map[string]int64{"host": 5000, "user": 3000}}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.CostBudget = 1000
+
+	_, err := querier.Query(context.Background(), "MATCH (h:host), (u) WITH h, count(u) AS c RETURN h, c", nil)
+	costErr, ok := err.(*QueryCostError)
+	require.True(t, ok)
+	assert.Equal(t, float64(40000000), costErr.EstimatedCost)
+
+	_, err = querier.Query(context.Background(), "MATCH (h:host) RETURN h UNION MATCH (h:host), (u:user) RETURN h", nil)
+	_, ok = err.(*QueryCostError)
+	assert.True(t, ok)
+}
+
+func TestShouldEstimateFanOutOfRelations(t *testing.T) {
+	db := &stubGraphDB{
+		assetsCount:    map[string]int64{"host": 1, "process": 100000, "user": 3000},
+		relationsCount: map[string]int64{"runs": 100000, "owned_by": 1},
+	}
+	querier := NewQuerier(db, &stubHistorizer{})
+
+	// The single host runs all the processes.
+	_, err := querier.Query(context.Background(),
+		"MATCH (h:host {value: 'web-01'})-[:runs]->(p:process), (u:user) RETURN h, p, u", nil)
+	costErr, ok := err.(*QueryCostError)
+	require.True(t, ok)
+	assert.Equal(t, float64(300000000), costErr.EstimatedCost)
+	assert.Equal(t, []QueryComponentCost{
+		{Variables: []string{"h", "p"}, EstimatedRows: 100000},
+		{Variables: []string{"u"}, EstimatedRows: 3000},
+	}, costErr.Components)
+
+	// A host has a single owner.
+	_, err = querier.Query(context.Background(),
+		"MATCH (h:host {value: 'web-01'})-[:owned_by]->(o:user), (u:user) RETURN h, o, u", nil)
+	assert.NoError(t, err)
+}
+
+func TestShouldNotEstimateNodesSelectiveFromOtherPredicates(t *testing.T) {
+	cases := []string{
+		"MATCH (h:host), (u:user) WHERE h.value = 'a' OR u.value = 'b' RETURN h, u",
+		"MATCH (h:host), (u:user) WHERE h.value <> 'a' AND u.value <> 'b' RETURN h, u",
+		"MATCH (h:host), (u:user) WHERE h.value = u.value RETURN h, u",
+	}
+
+	for _, c := range cases {
+		db := &stubGraphDB{assetsCount: map[string]int64{"host": 5000, "user": 3000}}
+		querier := NewQuerier(db, &stubHistorizer{})
+
+		_, err := querier.Query(context.Background(), c, nil)
+		_, ok := err.(*QueryCostError)
+		assert.True(t, ok, c)
+	}
+}
+
+func newValidatingQuerier() *Querier {
+	sg := schema.NewSchemaGraph()
+	host := sg.AddAsset("host")
+	user := sg.AddAsset("user")
+	ip := sg.AddAsset("ip")
+	sg.AddRelation(host, "owned_by", user)
+	sg.AddRelation(ip, "resolves", host)
+
+	querier := NewQuerier(&stubGraphDB{}, &stubHistorizer{})
+	querier.SchemaLoader = &stubSchemaLoader{schema: sg}
+	return querier
+}
+
+func TestShouldValidateQueryAgainstSchema(t *testing.T) {
+	cases := []struct {
+		Query    string
+		Warnings []QueryWarning
+	}{
+		{"MATCH (h:host)-[:owned_by]->(u:user) RETURN h", nil},
+		{"MATCH (h:host)<-[:owned_by]-(u:user) RETURN h", []QueryWarning{
+			{
+				Message:     "The schema has no relation (:user)-[:owned_by]->(:host), did you mean (:host)-[:owned_by]->(:user)?",
+				Suggestions: []string{"(:host)-[:owned_by]->(:user)"},
+			},
+		}},
+		{"MATCH (h:host)--(u:user) RETURN h", nil},
+		{"MATCH (h:host)-[*1..3]-(u:user) RETURN h", nil},
+		{"MATCH (h:hots) RETURN h", []QueryWarning{
+			{Message: "Unknown asset type 'hots', did you mean 'host'?", Suggestions: []string{"host"}},
+		}},
+		{"MATCH (h:host)-[:owned]->(u) RETURN h", []QueryWarning{
+			{Message: "Unknown relation type 'owned', did you mean 'owned_by'?", Suggestions: []string{"owned_by"}},
+		}},
+		{"MATCH (h:database) RETURN h", []QueryWarning{
+			{Message: "Unknown asset type 'database'"},
+		}},
+		{"MATCH (i:ip)-->(u:user) RETURN i", []QueryWarning{
+			{Message: "The schema has no relation (:ip)-[]->(:user)"},
+		}},
+		// The warning is raised once even if the node is bound again by the next part.
+		{"MATCH (h:hots) WITH h MATCH (h) RETURN h", []QueryWarning{
+			{Message: "Unknown asset type 'hots', did you mean 'host'?", Suggestions: []string{"host"}},
+		}},
+	}
+
+	for _, c := range cases {
+		res, err := newValidatingQuerier().Query(context.Background(), c.Query, nil)
+		require.NoError(t, err, c.Query)
+		assert.Equal(t, c.Warnings, res.Warnings, c.Query)
+	}
+}
+
+func TestShouldRejectQueryNotMatchingSchemaInStrictMode(t *testing.T) {
+	querier := newValidatingQuerier()
+	querier.StrictValidation = true
+
+	_, err := querier.Query(context.Background(), "MATCH (h:hots) RETURN h", nil)
+	require.Error(t, err)
+	assert.Equal(t, "Query does not match the schema: Unknown asset type 'hots', did you mean 'host'?", err.Error())
+
+	_, err = querier.Query(context.Background(), "MATCH (h:host) RETURN h", nil)
+	assert.NoError(t, err)
+}
+
+// readAll read the rows of the cursor
+func readAll(t *testing.T, cursor Cursor) []interface{} {
+	rows := []interface{}{}
+	for cursor.HasMore() {
+		var d interface{}
+		require.NoError(t, cursor.Read(context.Background(), &d))
+		rows = append(rows, d)
+	}
+	return rows
+}
+
+func TestShouldPaginateResultsByKeyset(t *testing.T) {
+	host := func(id string) []interface{} {
+		return []interface{}{AssetWithID{ID: id, Asset: Asset{Type: "host", Key: "web-" + id}}}
+	}
+	db := &stubGraphDB{rows: [][]interface{}{host("3"), host("5"), host("5"), host("8")}}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.PageSize = 3
+
+	res, err := querier.Query(context.Background(), "MATCH (h:host) RETURN h", nil)
+	require.NoError(t, err)
+	assert.Len(t, readAll(t, res.Cursor), 3)
+	assert.Contains(t, db.queries[0].Query, "ORDER BY a0.id\nLIMIT 4")
+
+	nextPage, err := res.NextPage()
+	require.NoError(t, err)
+	require.NotEmpty(t, nextPage)
+
+	// The rows with the id of the last row which have already been returned are skipped.
+	db.rows = [][]interface{}{host("5"), host("8")}
+	querier.PageToken = nextPage
+	res, err = querier.Query(context.Background(), "MATCH (h:host) RETURN h", nil)
+	require.NoError(t, err)
+	assert.Len(t, readAll(t, res.Cursor), 2)
+	assert.Contains(t, db.queries[1].Query, "a0.id >= ?")
+	assert.Contains(t, db.queries[1].Query, "OFFSET 2")
+	assert.Equal(t, []interface{}{"host", int64(5)}, db.queries[1].Args)
+
+	nextPage, err = res.NextPage()
+	require.NoError(t, err)
+	assert.Empty(t, nextPage)
+}
+
+func TestShouldPaginateResultsByOffset(t *testing.T) {
+	db := &stubGraphDB{rows: [][]interface{}{{"web-01"}, {"web-02"}, {"web-03"}}}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.PageSize = 2
+
+	res, err := querier.Query(context.Background(), "MATCH (h:host) RETURN h.value", nil)
+	require.NoError(t, err)
+	assert.Len(t, readAll(t, res.Cursor), 2)
+
+	nextPage, err := res.NextPage()
+	require.NoError(t, err)
+	require.NotEmpty(t, nextPage)
+
+	querier.PageToken = nextPage
+	_, err = querier.Query(context.Background(), "MATCH (h:host) RETURN h.value", nil)
+	require.NoError(t, err)
+	assert.Contains(t, db.queries[1].Query, "ORDER BY a0.value\nLIMIT 3\nOFFSET 2")
+}
+
+func TestShouldRejectPageTokenOfAnotherQuery(t *testing.T) {
+	db := &stubGraphDB{rows: [][]interface{}{{"web-01"}, {"web-02"}}}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.PageSize = 1
+
+	res, err := querier.Query(context.Background(), "MATCH (h:host) RETURN h.value", nil)
+	require.NoError(t, err)
+	readAll(t, res.Cursor)
+	nextPage, err := res.NextPage()
+	require.NoError(t, err)
+
+	querier.PageToken = nextPage
+	_, err = querier.Query(context.Background(), "MATCH (h:user) RETURN h.value", nil)
+	assert.EqualError(t, err, "Page token does not match the query")
+
+	querier.PageToken = "garbage"
+	_, err = querier.Query(context.Background(), "MATCH (h:host) RETURN h.value", nil)
+	assert.EqualError(t, err, "Invalid page token")
+}
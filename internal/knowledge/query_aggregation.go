@@ -0,0 +1,150 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// aggregationFunctions are the aggregation functions of Cypher and the SQL functions they are translated into
+var aggregationFunctions = map[string]string{
+	"COUNT":   "COUNT",
+	"MIN":     "MIN",
+	"MAX":     "MAX",
+	"SUM":     "SUM",
+	"AVG":     "AVG",
+	"COLLECT": "JSON_ARRAYAGG",
+}
+
+// isAggregationFunction return whether the function (in upper case) is an aggregation function
+func isAggregationFunction(name string) bool {
+	_, ok := aggregationFunctions[name]
+	return ok
+}
+
+// SQLAggregation represent the aggregation of an expression
+type SQLAggregation struct {
+	// Function is the name of the Cypher function in upper case
+	Function string
+	Distinct bool
+	// Arguments are the columns of the aggregated expression, the columns of the node or the relation
+	// when the expression is a variable. There is no argument for count(*).
+	Arguments    []string
+	ArgumentType ExpressionType
+}
+
+// newSQLAggregation create the aggregation computed by the function on the given arguments
+func newSQLAggregation(name string, distinct bool, arguments []FunctionArgument) (*SQLAggregation, error) {
+	aggregation := SQLAggregation{Function: name, Distinct: distinct, ArgumentType: PropertyExprType}
+	if len(arguments) == 0 {
+		return &aggregation, nil
+	}
+	if len(arguments) > 1 {
+		return nil, fmt.Errorf("Function %s expects exactly one argument", name)
+	}
+
+	aggregation.ArgumentType = arguments[0].Type
+	if arguments[0].Columns != nil {
+		aggregation.Arguments = arguments[0].Columns
+	} else {
+		aggregation.Arguments = []string{arguments[0].SQL}
+	}
+	return &aggregation, nil
+}
+
+// ReturnType return the type of the result of the aggregation
+func (a SQLAggregation) ReturnType() ExpressionType {
+	if a.Function == "COLLECT" {
+		return ListExprType
+	}
+	return PropertyExprType
+}
+
+// Build the SQL aggregating the given arguments. The arguments replace the ones of the aggregation so that
+// the aggregation can be computed on the columns of a derived table.
+func (a SQLAggregation) Build(arguments []string) (string, error) {
+	fn := aggregationFunctions[a.Function]
+	distinct := ""
+	if a.Distinct {
+		distinct = "DISTINCT "
+	}
+
+	if len(arguments) == 0 {
+		if a.Function != "COUNT" || a.Distinct {
+			return "", fmt.Errorf("Function %s expects exactly one argument", a.Function)
+		}
+		return "COUNT(*)", nil
+	}
+
+	argument := arguments[0]
+	switch a.ArgumentType {
+	case NodeExprType, EdgeExprType:
+		switch a.Function {
+		case "COUNT":
+		case "COLLECT":
+			// NULL values are skipped by the cursor, the node or the relation is NULL when not matched by an optional pattern.
+			var object string
+			if a.ArgumentType == NodeExprType {
+				object = fmt.Sprintf("JSON_OBJECT('_id', CAST(%s AS CHAR), 'type', %s, 'key', %s)",
+					arguments[0], arguments[2], arguments[1])
+			} else {
+				object = fmt.Sprintf("JSON_OBJECT('_id', CAST(%s AS CHAR), 'from_id', CAST(%s AS CHAR), 'to_id', CAST(%s AS CHAR), 'type', %s)",
+					arguments[0], arguments[1], arguments[2], arguments[3])
+			}
+			argument = fmt.Sprintf("IF(%s IS NULL, NULL, %s)", arguments[0], object)
+		default:
+			return "", fmt.Errorf("Function %s cannot be applied to a node or a relationship", a.Function)
+		}
+	case PathExprType, ListExprType:
+		if a.Function != "COUNT" && a.Function != "COLLECT" {
+			return "", fmt.Errorf("Function %s cannot be applied to a path or a list", a.Function)
+		}
+	}
+	return fmt.Sprintf("%s(%s%s)", fn, distinct, argument), nil
+}
+
+// buildAggregation build the aggregation of a projection made of an aggregation function invocation only.
+// It returns false when the projection is not an aggregation.
+func (sqt *SQLQueryTranslator) buildAggregation(e *query.QueryExpression) (*SQLAggregation, bool, error) {
+	atom, ok := expressionAtom(e)
+	if !ok || atom.FunctionInvocation == nil {
+		return nil, false, nil
+	}
+	fn := strings.ToUpper(atom.FunctionInvocation.FunctionName)
+	if !isAggregationFunction(fn) {
+		return nil, false, nil
+	}
+
+	arguments := []FunctionArgument{}
+	for i := range atom.FunctionInvocation.Expressions {
+		e := &atom.FunctionInvocation.Expressions[i]
+		projectionVisitor := ProjectionVisitor{QueryGraph: &sqt.QueryGraph}
+		if err := projectionVisitor.ParseExpression(e); err != nil {
+			return nil, false, err
+		}
+		if projectionVisitor.Aggregation {
+			return nil, false, fmt.Errorf("Aggregation functions cannot be nested")
+		}
+
+		sql, err := NewExpressionBuilder(&sqt.QueryGraph, sqt.bindings).Build(e)
+		if err != nil {
+			return nil, false, err
+		}
+
+		argument := FunctionArgument{SQL: sql, Type: projectionVisitor.ExpressionType}
+		switch projectionVisitor.ExpressionType {
+		case NodeExprType:
+			argument.Columns = nodeColumns(fmt.Sprintf("a%d", projectionVisitor.TypeAndIndex.Index))
+		case EdgeExprType:
+			argument.Columns = relationColumns(fmt.Sprintf("r%d", projectionVisitor.TypeAndIndex.Index))
+		}
+		arguments = append(arguments, argument)
+	}
+
+	aggregation, err := newSQLAggregation(fn, atom.FunctionInvocation.Distinct, arguments)
+	if err != nil {
+		return nil, false, err
+	}
+	return aggregation, true, nil
+}
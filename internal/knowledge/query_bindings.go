@@ -0,0 +1,134 @@
+package knowledge
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var bindingMarkerRegexp = regexp.MustCompile(`\?(\d+)`)
+
+// SQLBindings collects the values bound to the placeholders of a SQL query. While the query is being built,
+// values are referenced by markers because fragments of the query can be duplicated or reordered, for instance
+// in the branches of an UNION. The markers are replaced by placeholders once the query is complete.
+type SQLBindings struct {
+	// Parameters are the values of the parameters of the Cypher query
+	Parameters map[string]interface{}
+
+	values  []interface{}
+	indices map[interface{}]int
+}
+
+// NewSQLBindings create bindings with the values of the parameters of the Cypher query
+func NewSQLBindings(parameters map[string]interface{}) *SQLBindings {
+	return &SQLBindings{
+		Parameters: parameters,
+		values:     []interface{}{},
+		indices:    make(map[interface{}]int),
+	}
+}
+
+// Bind a value and return the marker referencing it in the query
+func (b *SQLBindings) Bind(value interface{}) string {
+	// Scalar values are bound only once so that identical expressions produce identical SQL.
+	switch value.(type) {
+	case string, int64, float64, bool:
+		if idx, ok := b.indices[value]; ok {
+			return fmt.Sprintf("?%d", idx)
+		}
+		b.indices[value] = len(b.values)
+	}
+	b.values = append(b.values, value)
+	return fmt.Sprintf("?%d", len(b.values)-1)
+}
+
+// BindParameter bind the value of a parameter of the Cypher query and return the marker referencing it
+func (b *SQLBindings) BindParameter(name string) (string, error) {
+	value, ok := b.Parameters[name]
+	if !ok {
+		return "", fmt.Errorf("Parameter $%s is not provided", name)
+	}
+
+	switch v := value.(type) {
+	case int:
+		value = int64(v)
+	case string, int64, float64, bool, nil:
+	default:
+		return "", fmt.Errorf("Parameter $%s must be a string, a number, a boolean or null", name)
+	}
+	return b.Bind(value), nil
+}
+
+// IsListParameter return whether the value of a parameter of the Cypher query is a list
+func (b *SQLBindings) IsListParameter(name string) bool {
+	_, ok := b.Parameters[name].([]interface{})
+	return ok
+}
+
+// BindListParameter bind each element of a list parameter of the Cypher query and return the markers referencing them
+func (b *SQLBindings) BindListParameter(name string) ([]string, error) {
+	values, ok := b.Parameters[name].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Parameter $%s must be a list", name)
+	}
+
+	markers := []string{}
+	for _, value := range values {
+		switch v := value.(type) {
+		case int:
+			value = int64(v)
+		case string, int64, float64, bool, nil:
+		default:
+			return nil, fmt.Errorf("Elements of parameter $%s must be strings, numbers, booleans or null", name)
+		}
+		markers = append(markers, b.Bind(value))
+	}
+	return markers, nil
+}
+
+// IsMapParameter return whether the value of a parameter of the Cypher query is a map
+func (b *SQLBindings) IsMapParameter(name string) bool {
+	_, ok := b.Parameters[name].(map[string]interface{})
+	return ok
+}
+
+// BindMapParameter bind each value of a map parameter of the Cypher query and return the markers referencing
+// them indexed by key
+func (b *SQLBindings) BindMapParameter(name string) (map[string]string, error) {
+	values, ok := b.Parameters[name].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Parameter $%s must be a map", name)
+	}
+
+	markers := make(map[string]string)
+	for key, value := range values {
+		switch v := value.(type) {
+		case int:
+			value = int64(v)
+		case string, int64, float64, bool, nil:
+		default:
+			return nil, fmt.Errorf("Values of parameter $%s must be strings, numbers, booleans or null", name)
+		}
+		markers[key] = b.Bind(value)
+	}
+	return markers, nil
+}
+
+// BindLikePattern bind the pattern of a LIKE expression. The wildcards of the value are escaped
+// so that they match literally.
+func (b *SQLBindings) BindLikePattern(prefix, value, suffix string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+	return b.Bind(prefix + escaped + suffix)
+}
+
+// Resolve replace the markers of the query by placeholders and return the arguments to bind to them in order
+func (b *SQLBindings) Resolve(query string) (string, []interface{}) {
+	var args []interface{}
+	resolved := bindingMarkerRegexp.ReplaceAllStringFunc(query, func(marker string) string {
+		idx, _ := strconv.Atoi(marker[1:])
+		args = append(args, b.values[idx])
+		return "?"
+	})
+	return resolved, args
+}
@@ -0,0 +1,85 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// translateCall translate the rows of the procedure called by the CALL clause into a common table expression.
+// The yielded fields are bound to variables like the variables projected by a WITH clause so that the rest of
+// the query is built on top of them.
+func (sqt *SQLQueryTranslator) translateCall(call *query.QueryCall) error {
+	if sqt.union {
+		return fmt.Errorf("CALL clauses cannot be combined with UNION clauses")
+	}
+	result := sqt.CallResult
+	if result == nil || result.Procedure != call.Procedure {
+		return fmt.Errorf("Procedure %s has not been called", call.Procedure)
+	}
+
+	items := call.YieldItems
+	if len(items) == 0 {
+		for _, f := range result.Fields {
+			items = append(items, query.QueryYieldItem{Field: f, Variable: f})
+		}
+	}
+
+	stage := &queryStage{
+		Name:         fmt.Sprintf("w%d", len(sqt.ctes)),
+		ColumnsCount: len(items),
+		Where:        call.Where,
+	}
+	indices := []int{}
+	for _, item := range items {
+		idx := -1
+		for i, f := range result.Fields {
+			if f == item.Field {
+				idx = i
+			}
+		}
+		if idx < 0 {
+			return fmt.Errorf("Procedure %s has no field %s", result.Procedure, item.Field)
+		}
+		indices = append(indices, idx)
+		stage.Variables = append(stage.Variables, stageVariable{Name: item.Variable, ExpressionType: PropertyExprType})
+	}
+
+	selects := []string{}
+	for _, row := range result.Rows {
+		values := []string{}
+		for _, idx := range indices {
+			values = append(values, sqt.bindings.Bind(row[idx]))
+		}
+		selects = append(selects, fmt.Sprintf("SELECT %s", strings.Join(values, ", ")))
+	}
+	// The table has no row when the procedure returned none.
+	if len(selects) == 0 {
+		nulls := make([]string, len(items))
+		for i := range nulls {
+			nulls[i] = "NULL"
+		}
+		selects = append(selects, fmt.Sprintf("SELECT %s LIMIT 0", strings.Join(nulls, ", ")))
+	}
+
+	columns := make([]string, stage.ColumnsCount)
+	for i := range columns {
+		columns[i] = fmt.Sprintf("v%d", i)
+	}
+	sqt.ctes = append(sqt.ctes, fmt.Sprintf("%s (%s) AS (\n%s)", stage.Name, strings.Join(columns, ", "),
+		strings.Join(selects, "\nUNION ALL\n")))
+	sqt.stage = stage
+	return nil
+}
+
+// projectCall return a copy of the query returning the fields yielded by the procedure in place of a standalone
+// CALL clause, which has no RETURN clause
+func (sqt *SQLQueryTranslator) projectCall(q *query.QuerySinglePartQuery) *query.QuerySinglePartQuery {
+	projected := *q
+	for _, v := range sqt.stage.Variables {
+		projected.ProjectionBody.ProjectionItems = append(projected.ProjectionBody.ProjectionItems,
+			query.QueryProjectionItem{Expression: variableExpression(v.Name), Alias: v.Name})
+	}
+	return &projected
+}
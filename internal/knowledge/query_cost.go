@@ -0,0 +1,283 @@
+package knowledge
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// DefaultCostBudget is the maximum estimated number of rows of the cartesian product of the disconnected
+// patterns matched by a query
+const DefaultCostBudget = 10000000
+
+// QueryComponentCost is the estimated number of rows matched by a connected component of a query graph
+type QueryComponentCost struct {
+	// Variables are the names of the nodes and relations of the component
+	Variables     []string `json:"variables"`
+	EstimatedRows int64    `json:"estimated_rows"`
+}
+
+// QueryCostError is returned when a query combines disconnected patterns into a cartesian product whose
+// estimated number of rows exceeds the cost budget. The query can still be run once confirmed.
+type QueryCostError struct {
+	EstimatedCost float64              `json:"estimated_cost"`
+	Budget        int64                `json:"budget"`
+	Components    []QueryComponentCost `json:"components"`
+}
+
+func (e *QueryCostError) Error() string {
+	components := []string{}
+	for _, c := range e.Components {
+		components = append(components, fmt.Sprintf("(%s) ~%d rows", strings.Join(c.Variables, ", "), c.EstimatedRows))
+	}
+	return fmt.Sprintf("Query matches %d disconnected patterns %s whose cartesian product is estimated to %.0f rows, "+
+		"above the budget of %d rows. Connect the patterns with a relationship or confirm the query to run it anyway",
+		len(e.Components), strings.Join(components, " x "), e.EstimatedCost, e.Budget)
+}
+
+// ConnectedComponents return the indices of the nodes of each connected component of the query graph. The nodes
+// bound to the intermediate result of a WITH clause are connected through the row they come from.
+func (qg *QueryGraph) ConnectedComponents() [][]int {
+	parents := make([]int, len(qg.Nodes))
+	for i := range parents {
+		parents[i] = i
+	}
+	find := func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+	union := func(i, j int) {
+		parents[find(i)] = find(j)
+	}
+
+	staged := -1
+	for i, n := range qg.Nodes {
+		if !n.Staged {
+			continue
+		}
+		if staged >= 0 {
+			union(i, staged)
+		}
+		staged = i
+	}
+	for _, r := range qg.Relations {
+		union(r.LeftIdx, r.RightIdx)
+	}
+
+	components := [][]int{}
+	componentsIdx := make(map[int]int)
+	for i := range qg.Nodes {
+		root := find(i)
+		idx, ok := componentsIdx[root]
+		if !ok {
+			idx = len(components)
+			componentsIdx[root] = idx
+			components = append(components, []int{})
+		}
+		components[idx] = append(components[idx], i)
+	}
+	return components
+}
+
+// selectivePredicate is an equality of the WHERE clause restricting the value of a node like h.value = 'web-01'
+type selectivePredicate struct {
+	NodeIdx   int
+	Predicate query.QueryExpression
+}
+
+// whereSelectivePredicates return the equalities of the WHERE clause between the value of a node and a literal or a
+// parameter like h.value = 'web-01', among the predicates combined by AND
+func whereSelectivePredicates(qg *QueryGraph, where *query.QueryExpression) []selectivePredicate {
+	if len(where.OrExpression.XorExpressions) != 1 || len(where.OrExpression.XorExpressions[0].AndExpressions) != 1 {
+		return nil
+	}
+
+	// nodeValue return the index of the node whose value is the operand, if any
+	nodeValue := func(operand *query.QueryPropertyOrLabelsExpression) (int, bool) {
+		if operand.Atom.Variable == nil || len(operand.Labels) > 0 ||
+			len(operand.PropertyKeys) != 1 || operand.PropertyKeys[0] != "value" {
+			return -1, false
+		}
+		typeAndIndex, err := qg.FindVariable(*operand.Atom.Variable)
+		if err != nil || typeAndIndex.Type != NodeType {
+			return -1, false
+		}
+		return typeAndIndex.Index, true
+	}
+	// isConstant tells whether the operand is a literal or a parameter
+	isConstant := func(operand *query.QueryPropertyOrLabelsExpression) bool {
+		return len(operand.PropertyKeys) == 0 && len(operand.Labels) == 0 &&
+			(operand.Atom.Literal != nil || operand.Atom.Parameter != nil)
+	}
+
+	predicates := []selectivePredicate{}
+	// predicate wrap the comparison into an expression of its own
+	predicate := func(idx int, notExpr query.QueryNotExpression) selectivePredicate {
+		return selectivePredicate{NodeIdx: idx, Predicate: query.QueryExpression{
+			OrExpression: query.QueryOrExpression{XorExpressions: []query.QueryXorExpression{{
+				AndExpressions: []query.QueryAndExpression{{NotExpressions: []query.QueryNotExpression{notExpr}}},
+			}}},
+		}}
+	}
+	for _, notExpr := range where.OrExpression.XorExpressions[0].AndExpressions[0].NotExpressions {
+		comparison := notExpr.ComparisonExpression
+		if notExpr.Not || len(comparison.PartialComparisonExpressions) != 1 ||
+			comparison.PartialComparisonExpressions[0].ComparisonOperator != query.Equal {
+			continue
+		}
+		left, ok := addOrSubtractOperand(&comparison.AddOrSubtractExpression)
+		if !ok {
+			continue
+		}
+		right, ok := addOrSubtractOperand(&comparison.PartialComparisonExpressions[0].AddOrSubtractExpression)
+		if !ok {
+			continue
+		}
+		if idx, ok := nodeValue(left); ok && isConstant(right) {
+			predicates = append(predicates, predicate(idx, notExpr))
+		} else if idx, ok := nodeValue(right); ok && isConstant(left) {
+			predicates = append(predicates, predicate(idx, notExpr))
+		}
+	}
+	return predicates
+}
+
+// estimateNodeRows estimate the number of assets matched by a node from the number of assets of each type. The
+// nodes constrained by their properties, by an equality of the WHERE clause or bound to an intermediate result are
+// expected to match few assets.
+func estimateNodeRows(node QueryNode, assetsCount map[string]int64) int64 {
+	if node.isConstrained() {
+		return 1
+	}
+	return labelsAssetsCount(node, assetsCount)
+}
+
+// labelsAssetsCount return the number of assets having one of the labels of the node, or all the assets when the
+// node has no label
+func labelsAssetsCount(node QueryNode, assetsCount map[string]int64) int64 {
+	var count int64
+	if len(node.Labels) == 0 {
+		for _, c := range assetsCount {
+			count += c
+		}
+		return count
+	}
+	for _, label := range node.Labels {
+		count += assetsCount[label]
+	}
+	return count
+}
+
+// estimateComponentRows estimate the number of rows matched by a connected component of the query graph. The
+// component is walked from its most selective node and each relation multiplies the rows by its fan-out, the
+// average number of relations of its type per asset of the node it is walked from. A relation cannot match more
+// rows per row than the node it leads to matches assets.
+func estimateComponentRows(qg *QueryGraph, nodesIdx []int, statistics *GraphStatistics) int64 {
+	seed := nodesIdx[0]
+	for _, idx := range nodesIdx {
+		if estimateNodeRows(qg.Nodes[idx], statistics.AssetsCount) <
+			estimateNodeRows(qg.Nodes[seed], statistics.AssetsCount) {
+			seed = idx
+		}
+	}
+
+	rows := float64(estimateNodeRows(qg.Nodes[seed], statistics.AssetsCount))
+	visited := map[int]bool{seed: true}
+	queue := []int{seed}
+	for len(queue) > 0 {
+		idx := queue[0]
+		queue = queue[1:]
+		// The nodes bound to the intermediate result come from the same row.
+		if qg.Nodes[idx].Staged {
+			for i, n := range qg.Nodes {
+				if n.Staged && !visited[i] {
+					visited[i] = true
+					queue = append(queue, i)
+				}
+			}
+		}
+		for _, r := range qg.Relations {
+			next := -1
+			if r.LeftIdx == idx {
+				next = r.RightIdx
+			} else if r.RightIdx == idx {
+				next = r.LeftIdx
+			}
+			if next < 0 || visited[next] {
+				continue
+			}
+			visited[next] = true
+			queue = append(queue, next)
+
+			assets := labelsAssetsCount(qg.Nodes[idx], statistics.AssetsCount)
+			if assets < 1 {
+				assets = 1
+			}
+			fanOut := float64(estimateRelationRows(r, statistics.RelationsCount)) / float64(assets)
+			if nextRows := float64(estimateNodeRows(qg.Nodes[next], statistics.AssetsCount)); nextRows < fanOut {
+				fanOut = nextRows
+			}
+			rows *= fanOut
+		}
+	}
+
+	if rows > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(math.Ceil(rows))
+}
+
+// estimateQueryGraphCost estimate the number of rows of the cartesian product of the connected components of the
+// query graph
+func estimateQueryGraphCost(qg *QueryGraph, statistics *GraphStatistics) (float64, []QueryComponentCost) {
+	components := qg.ConnectedComponents()
+	componentsIdx := make(map[int]int)
+	costs := make([]QueryComponentCost, len(components))
+
+	cost := 1.0
+	for i, nodesIdx := range components {
+		costs[i].Variables = []string{}
+		for _, idx := range nodesIdx {
+			componentsIdx[idx] = i
+		}
+		costs[i].EstimatedRows = estimateComponentRows(qg, nodesIdx, statistics)
+		cost *= float64(costs[i].EstimatedRows)
+	}
+
+	for name, typeAndIndex := range qg.VariablesIndex {
+		switch typeAndIndex.Type {
+		case NodeType:
+			i := componentsIdx[typeAndIndex.Index]
+			costs[i].Variables = append(costs[i].Variables, name)
+		case RelationType:
+			i := componentsIdx[qg.Relations[typeAndIndex.Index].LeftIdx]
+			costs[i].Variables = append(costs[i].Variables, name)
+		}
+	}
+	for i := range costs {
+		sort.Strings(costs[i].Variables)
+	}
+	return cost, costs
+}
+
+// checkQueryCost check the cartesian products of disconnected patterns of the query graphs are estimated to
+// produce at most budget rows. The query graphs matching a single connected component are not checked.
+func checkQueryCost(graphs []QueryGraph, statistics *GraphStatistics, budget int64) error {
+	for i := range graphs {
+		cost, components := estimateQueryGraphCost(&graphs[i], statistics)
+		if len(components) > 1 && cost > float64(budget) {
+			return &QueryCostError{
+				EstimatedCost: cost,
+				Budget:        budget,
+				Components:    components,
+			}
+		}
+	}
+	return nil
+}
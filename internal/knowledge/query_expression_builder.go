@@ -13,9 +13,10 @@ type ExpressionBuilder struct {
 	visitor *SQLExpressionVisitor
 }
 
-func NewExpressionBuilder(queryGraph *QueryGraph) *ExpressionBuilder {
+func NewExpressionBuilder(queryGraph *QueryGraph, bindings *SQLBindings) *ExpressionBuilder {
 	visitor := SQLExpressionVisitor{}
 	visitor.queryGraph = queryGraph
+	visitor.bindings = bindings
 	return &ExpressionBuilder{
 		QueryGraph: queryGraph,
 		parser:     NewExpressionParser(&visitor),
@@ -23,235 +24,708 @@ func NewExpressionBuilder(queryGraph *QueryGraph) *ExpressionBuilder {
 	}
 }
 
-func (eb *ExpressionBuilder) Build(q *query.QueryExpression) (string, error) {
+// BuildSQLExpression build the abstract syntax tree of the SQL expression
+func (eb *ExpressionBuilder) BuildSQLExpression(q *query.QueryExpression) (*SQLExpression, error) {
+	eb.visitor.stack = nil
+	eb.visitor.frames = nil
+
 	err := eb.parser.ParseExpression(q)
+	if err != nil {
+		return nil, err
+	}
+	if len(eb.visitor.stack) != 1 || len(eb.visitor.frames) != 0 {
+		return nil, fmt.Errorf("Unable to build expression")
+	}
+	return eb.visitor.stack[0], nil
+}
+
+func (eb *ExpressionBuilder) Build(q *query.QueryExpression) (string, error) {
+	expression, err := eb.BuildSQLExpression(q)
+	if err != nil {
+		return "", err
+	}
+	return expression.String(), nil
+}
+
+// BuildConstraint build the expression so that it can be combined with other constraints by AND
+func (eb *ExpressionBuilder) BuildConstraint(q *query.QueryExpression) (string, error) {
+	expression, err := eb.BuildSQLExpression(q)
 	if err != nil {
 		return "", err
 	}
-	return eb.visitor.expression, nil
+	return expression.Within(AndPrecedence), nil
 }
 
+// SQLExpressionVisitor build the abstract syntax tree of a SQL expression. Every expression visited pushes
+// its tree on a stack, operators replace their operands on the stack by the tree combining them.
 type SQLExpressionVisitor struct {
 	ExpressionVisitorBase
 
 	queryGraph *QueryGraph
+	bindings   *SQLBindings
 
-	propertiesPath []string
+	stack []*SQLExpression
+	// frames are the expressions whose operands are being visited, like a chain of comparisons or the
+	// arguments of a function invocation
+	frames []expressionFrame
+}
+
+// expressionFrame is an expression whose operands are being visited
+type expressionFrame struct {
+	// mark is the size of the stack when the frame has been entered, the operands are pushed above it
+	mark int
+	// operators are the operators visited in between the operands
+	operators []interface{}
+}
 
-	variableName   *string
-	stringLiteral  *string
-	integerLiteral *int64
-	doubleLiteral  *float64
-	boolLiteral    *bool
+// inOperator is the IN operator of a string, list or null operator expression
+type inOperator struct{}
 
-	parenthesizedExpression string
+// nullOperator is the IS NULL or IS NOT NULL operator of a string, list or null operator expression
+type nullOperator struct {
+	not bool
+}
+
+func (sev *SQLExpressionVisitor) push(e *SQLExpression) {
+	sev.stack = append(sev.stack, e)
+}
 
-	functionInvocation string
+func (sev *SQLExpressionVisitor) pop() (*SQLExpression, error) {
+	if len(sev.stack) == 0 {
+		return nil, fmt.Errorf("Expression is missing an operand")
+	}
+	e := sev.stack[len(sev.stack)-1]
+	sev.stack = sev.stack[:len(sev.stack)-1]
+	return e, nil
+}
 
-	propertyLabelsExpression string
+func (sev *SQLExpressionVisitor) pushFrame() {
+	sev.frames = append(sev.frames, expressionFrame{mark: len(sev.stack)})
+}
 
-	comparisonExpression string
-	comparisonOperator   query.ComparisonOperator
+func (sev *SQLExpressionVisitor) currentFrame() *expressionFrame {
+	return &sev.frames[len(sev.frames)-1]
+}
 
-	stringExpression string
-	stringOperator   query.StringOperator
+// popFrame end visiting the operands of the current frame and return them with the operators visited in between
+func (sev *SQLExpressionVisitor) popFrame() ([]*SQLExpression, []interface{}) {
+	frame := sev.frames[len(sev.frames)-1]
+	sev.frames = sev.frames[:len(sev.frames)-1]
 
-	notExpressions []string
-	andExpressions []string
-	orExpression   string
-	expression     string
+	operands := make([]*SQLExpression, len(sev.stack)-frame.mark)
+	copy(operands, sev.stack[frame.mark:])
+	sev.stack = sev.stack[:frame.mark]
+	return operands, frame.operators
 }
 
-func (sev *SQLExpressionVisitor) OnVariable(name string) error {
-	sev.variableName = new(string)
-	*sev.variableName = name
+func (sev *SQLExpressionVisitor) OnExitPropertyOrLabelsExpression(e query.QueryPropertyOrLabelsExpression) error {
+	if e.Atom.Variable == nil {
+		return nil
+	}
+	name := *e.Atom.Variable
+
+	typeAndIndex, err := sev.queryGraph.FindVariable(name)
+	if err != nil {
+		return err
+	}
+
+	if len(e.Labels) > 0 {
+		return sev.buildLabelsPredicate(name, typeAndIndex, e)
+	}
+
+	if typeAndIndex.Type == ValueType {
+		if len(e.PropertyKeys) > 0 {
+			return fmt.Errorf("Variable '%s' is not a node or a relationship and has no property", name)
+		}
+		value := sev.queryGraph.Values[typeAndIndex.Index]
+		sev.push(NewSQLAtom(value.Expression, value.ExpressionType))
+		return nil
+	}
+
+	if typeAndIndex.Type == PathType {
+		if len(e.PropertyKeys) > 0 {
+			return fmt.Errorf("Variable '%s' is a path and has no property", name)
+		}
+		path := sev.queryGraph.Paths[typeAndIndex.Index]
+		atom := NewSQLAtom(buildPathProjection(sev.queryGraph, path), PathExprType)
+		atom.Path = buildPathSQL(sev.queryGraph, path)
+		sev.push(atom)
+		return nil
+	}
+
+	var alias string
+	var properties []string
+	var columns []string
+	var expressionType ExpressionType
+	switch typeAndIndex.Type {
+	case NodeType:
+		alias = fmt.Sprintf("a%d", typeAndIndex.Index)
+		properties = []string{"id", "value", "type"}
+		columns = nodeColumns(alias)
+		expressionType = NodeExprType
+	case RelationType:
+		if sev.queryGraph.Relations[typeAndIndex.Index].VariableLength {
+			return fmt.Errorf("Variable-length relationship '%s' cannot be used in an expression", name)
+		}
+		alias = fmt.Sprintf("r%d", typeAndIndex.Index)
+		properties = []string{"from_id", "to_id", "type"}
+		columns = relationColumns(alias)
+		expressionType = EdgeExprType
+	}
+
+	if len(e.PropertyKeys) > 0 {
+		atom := NewSQLAtom(fmt.Sprintf("%s.%s", alias, strings.Join(e.PropertyKeys, ".")), PropertyExprType)
+		if len(e.PropertyKeys) == 1 && (e.PropertyKeys[0] == "value" || e.PropertyKeys[0] == "type") {
+			atom.ValueKind = StringValueKind
+		}
+		sev.push(atom)
+		return nil
+	}
+
+	projection := []string{}
+	for _, p := range properties {
+		projection = append(projection, fmt.Sprintf("%s.%s", alias, p))
+	}
+	atom := NewSQLAtom(strings.Join(projection, ", "), expressionType)
+	atom.Columns = columns
+	sev.push(atom)
+	return nil
+}
+
+// buildLabelsPredicate build the predicate testing whether the node has one of the labels like h:host
+func (sev *SQLExpressionVisitor) buildLabelsPredicate(name string, typeAndIndex TypeAndIndex,
+	e query.QueryPropertyOrLabelsExpression) error {
+	if typeAndIndex.Type != NodeType {
+		return fmt.Errorf("Variable '%s' is not a node, it cannot be tested against labels", name)
+	}
+	if len(e.PropertyKeys) > 0 {
+		return fmt.Errorf("Labels cannot be tested on the property of variable '%s'", name)
+	}
+
+	alias := fmt.Sprintf("a%d", typeAndIndex.Index)
+	predicates := []*SQLExpression{}
+	for _, label := range e.Labels {
+		predicates = append(predicates, NewSQLInfixExpression("=", ComparisonPrecedence,
+			NewSQLAtom(fmt.Sprintf("%s.type", alias), PropertyExprType),
+			NewSQLAtom(sev.bindings.Bind(label), PropertyExprType)))
+	}
+	if len(predicates) == 1 {
+		sev.push(predicates[0])
+		return nil
+	}
+	sev.push(NewSQLInfixExpression("OR", OrPrecedence, predicates...))
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnStringLiteral(value string) error {
-	sev.stringLiteral = new(string)
-	*sev.stringLiteral = value
+	atom := NewSQLAtom(sev.bindings.Bind(value), PropertyExprType)
+	atom.StringValue = &value
+	atom.ValueKind = StringValueKind
+	sev.push(atom)
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnIntegerLiteral(value int64) error {
-	sev.integerLiteral = new(int64)
-	*sev.integerLiteral = value
+	atom := NewSQLAtom(sev.bindings.Bind(value), PropertyExprType)
+	atom.ValueKind = IntegerValueKind
+	sev.push(atom)
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnDoubleLiteral(value float64) error {
-	sev.doubleLiteral = new(float64)
-	*sev.doubleLiteral = value
+	atom := NewSQLAtom(sev.bindings.Bind(value), PropertyExprType)
+	atom.ValueKind = FloatValueKind
+	sev.push(atom)
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnBooleanLiteral(value bool) error {
-	sev.boolLiteral = new(bool)
-	*sev.boolLiteral = value
+	sev.push(NewSQLAtom(sev.bindings.Bind(value), PropertyExprType))
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnVariablePropertiesPath(propertiesPath []string) error {
-	sev.propertiesPath = propertiesPath
+func (sev *SQLExpressionVisitor) OnNullLiteral() error {
+	sev.push(NewSQLAtom("NULL", PropertyExprType))
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnEnterListLiteral() error {
+	sev.pushFrame()
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnExitListLiteral() error {
+	elements, _ := sev.popFrame()
+	sev.push(newSQLList(elements))
+	return nil
+}
+
+// newSQLList create a list made of the given elements
+func newSQLList(elements []*SQLExpression) *SQLExpression {
+	sqlElements := []string{}
+	for _, e := range elements {
+		sqlElements = append(sqlElements, e.String())
+	}
+	list := NewSQLAtom(fmt.Sprintf("JSON_ARRAY(%s)", strings.Join(sqlElements, ", ")), ListExprType)
+	list.Elements = elements
+	return list
+}
+
+func (sev *SQLExpressionVisitor) OnParameter(name string) error {
+	if sev.bindings.IsListParameter(name) {
+		markers, err := sev.bindings.BindListParameter(name)
+		if err != nil {
+			return err
+		}
+		elements := []*SQLExpression{}
+		for _, m := range markers {
+			elements = append(elements, NewSQLAtom(m, PropertyExprType))
+		}
+		sev.push(newSQLList(elements))
+		return nil
+	}
+
+	marker, err := sev.bindings.BindParameter(name)
+	if err != nil {
+		return err
+	}
+	atom := NewSQLAtom(marker, PropertyExprType)
+	switch value := sev.bindings.Parameters[name].(type) {
+	case string:
+		atom.StringValue = &value
+		atom.ValueKind = StringValueKind
+	case int, int32, int64:
+		atom.ValueKind = IntegerValueKind
+	case float32, float64:
+		atom.ValueKind = FloatValueKind
+	}
+	sev.push(atom)
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnRelationshipsPattern(pattern query.QueryPatternElement) error {
+	predicate, err := buildPatternPredicate(sev.queryGraph, sev.bindings, pattern)
+	if err != nil {
+		return err
+	}
+	sev.push(predicate)
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnExitParenthesizedExpression() error {
-	sev.parenthesizedExpression = sev.expression
-	sev.expression = ""
+	e, err := sev.pop()
+	if err != nil {
+		return err
+	}
+	sev.push(NewSQLParenthesizedExpression(e))
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnExitPropertyOrLabelsExpression(e query.QueryPropertyOrLabelsExpression) error {
-	if sev.variableName != nil {
-		var properties []string
-		typeAndIndex, err := sev.queryGraph.FindVariable(*sev.variableName)
+func (sev *SQLExpressionVisitor) OnEnterFunctionInvocation(name string, distinct bool) error {
+	sev.pushFrame()
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnExitFunctionInvocation(name string, distinct bool) error {
+	operands, _ := sev.popFrame()
+	arguments := []FunctionArgument{}
+	for _, o := range operands {
+		arguments = append(arguments, FunctionArgument{SQL: o.String(), Type: o.Type, Columns: o.Columns,
+			PatternPredicate: o.PatternPredicate, Path: o.Path})
+	}
+
+	var functionInvocation string
+	var functionInvocationType ExpressionType
+	var err error
+	if isAggregationFunction(name) {
+		var aggregation *SQLAggregation
+		aggregation, err = newSQLAggregation(name, distinct, arguments)
 		if err != nil {
 			return err
 		}
+		functionInvocation, err = aggregation.Build(aggregation.Arguments)
+		functionInvocationType = aggregation.ReturnType()
+	} else {
+		functionInvocation, functionInvocationType, err = buildScalarFunction(name, distinct, arguments)
+	}
+	if err != nil {
+		return err
+	}
+	atom := NewSQLAtom(functionInvocation, functionInvocationType)
+	atom.ValueKind = functionValueKinds[name]
+	sev.push(atom)
+	return nil
+}
+
+// functionValueKinds are the kinds of the values returned by the functions, when they are known
+var functionValueKinds = map[string]ValueKind{
+	"ID":      IntegerValueKind,
+	"TYPE":    StringValueKind,
+	"TOLOWER": StringValueKind,
+	"SIZE":    IntegerValueKind,
+	"LENGTH":  IntegerValueKind,
+	"COUNT":   IntegerValueKind,
+	"AVG":     FloatValueKind,
+}
+
+func (sev *SQLExpressionVisitor) OnEnterStringListNullOperatorExpression(e query.QueryStringListNullOperatorExpression) error {
+	sev.pushFrame()
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnStringOperator(operator query.StringOperator) error {
+	frame := sev.currentFrame()
+	frame.operators = append(frame.operators, operator)
+	return nil
+}
 
-		alias := ""
-		switch typeAndIndex.Type {
-		case NodeType:
-			alias = "a"
-			properties = []string{"id", "value", "type"}
-		case RelationType:
-			alias = "r"
-			properties = []string{"from_id", "to_id", "type"}
+func (sev *SQLExpressionVisitor) OnInOperator() error {
+	frame := sev.currentFrame()
+	frame.operators = append(frame.operators, inOperator{})
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnNullOperator(not bool) error {
+	frame := sev.currentFrame()
+	frame.operators = append(frame.operators, nullOperator{not: not})
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnExitStringListNullOperatorExpression(e query.QueryStringListNullOperatorExpression) error {
+	operands, operators := sev.popFrame()
+	if len(operands) == 0 {
+		return fmt.Errorf("Unable to build string, list or null operator expression")
+	}
+
+	// The operators are applied from left to right, the binary ones consuming the next operand.
+	expression := operands[0]
+	next := 1
+	for _, o := range operators {
+		var err error
+		switch operator := o.(type) {
+		case query.StringOperator:
+			if next >= len(operands) {
+				return fmt.Errorf("String operator is missing an operand")
+			}
+			expression, err = sev.buildStringOperator(operator, expression, operands[next])
+			next++
+		case inOperator:
+			if next >= len(operands) {
+				return fmt.Errorf("IN operator is missing an operand")
+			}
+			expression, err = buildInOperator(expression, operands[next])
+			next++
+		case nullOperator:
+			expression = buildNullOperator(operator.not, expression)
 		}
-		alias += fmt.Sprintf("%d", typeAndIndex.Index)
-		if len(sev.propertiesPath) > 0 {
-			properties = []string{strings.Join(sev.propertiesPath, ".")}
+		if err != nil {
+			return err
 		}
+	}
+	sev.push(expression)
+	return nil
+}
+
+// buildStringOperator build a string operator into a LIKE expression
+func (sev *SQLExpressionVisitor) buildStringOperator(operator query.StringOperator, left, right *SQLExpression) (*SQLExpression, error) {
+	// The pattern is bound once the operator is known so that its wildcards can be escaped.
+	if right.StringValue == nil {
+		return nil, fmt.Errorf("Operand of string operator must be a string")
+	}
+
+	pattern := ""
+	switch operator {
+	case query.ContainsOperator:
+		pattern = sev.bindings.BindLikePattern("%", *right.StringValue, "%")
+	case query.EndsWithOperator:
+		pattern = sev.bindings.BindLikePattern("%", *right.StringValue, "")
+	case query.StartsWithOperator:
+		pattern = sev.bindings.BindLikePattern("", *right.StringValue, "%")
+	}
+	return NewSQLInfixExpression("LIKE", ComparisonPrecedence, left, NewSQLAtom(pattern, PropertyExprType)), nil
+}
+
+// buildInOperator build the IN operator checking whether the left operand is an element of the list
+func buildInOperator(left, right *SQLExpression) (*SQLExpression, error) {
+	if left.Type == NodeExprType || left.Type == EdgeExprType {
+		return nil, fmt.Errorf("A node or a relationship cannot be the left operand of IN")
+	}
+	if right.Type != ListExprType {
+		return nil, fmt.Errorf("The right operand of IN must be a list")
+	}
 
-		projection := []string{}
-		for _, p := range properties {
-			projection = append(projection, fmt.Sprintf("%s.%s", alias, p))
+	if right.Elements != nil {
+		if len(right.Elements) == 0 {
+			return NewSQLAtom("FALSE", PropertyExprType), nil
 		}
+		elements := []string{}
+		for _, e := range right.Elements {
+			elements = append(elements, e.String())
+		}
+		list := NewSQLAtom(fmt.Sprintf("(%s)", strings.Join(elements, ", ")), ListExprType)
+		return NewSQLInfixExpression("IN", ComparisonPrecedence, left, list), nil
+	}
+	return NewSQLAtom(fmt.Sprintf("JSON_CONTAINS(%s, JSON_ARRAY(%s))", right, left), PropertyExprType), nil
+}
+
+// buildNullOperator build the IS NULL or IS NOT NULL operator
+func buildNullOperator(not bool, operand *SQLExpression) *SQLExpression {
+	// A node or a relation is null when it is not matched by an optional pattern.
+	operand = scalarOperand(operand)
+	if not {
+		return NewSQLPostfixExpression("IS NOT NULL", ComparisonPrecedence, operand)
+	}
+	return NewSQLPostfixExpression("IS NULL", ComparisonPrecedence, operand)
+}
+
+// scalarOperand return the identifier of the node or the relation when the operand is one, the operand otherwise
+func scalarOperand(operand *SQLExpression) *SQLExpression {
+	if operand.Columns != nil {
+		return NewSQLAtom(operand.Columns[0], PropertyExprType)
+	}
+	return operand
+}
+
+func (sev *SQLExpressionVisitor) OnExitUnaryExpression(negation bool) error {
+	if !negation {
+		return nil
+	}
+	operand, err := sev.pop()
+	if err != nil {
+		return err
+	}
+	if err := checkArithmeticOperands(operand); err != nil {
+		return err
+	}
+	minus := NewSQLPrefixExpression("-", UnaryPrecedence, operand)
+	minus.ValueKind = operand.ValueKind
+	sev.push(minus)
+	return nil
+}
 
-		sev.propertyLabelsExpression = strings.Join(projection, ", ")
-		sev.variableName = nil
-		sev.propertiesPath = nil
-	} else if sev.stringLiteral != nil {
-		sev.propertyLabelsExpression = fmt.Sprintf("'%s'", *sev.stringLiteral)
-		sev.stringLiteral = nil
-	} else if sev.integerLiteral != nil {
-		sev.propertyLabelsExpression = fmt.Sprintf("%d", *sev.integerLiteral)
-		sev.integerLiteral = nil
-	} else if sev.doubleLiteral != nil {
-		sev.propertyLabelsExpression = fmt.Sprintf("%f", *sev.doubleLiteral)
-		sev.doubleLiteral = nil
-	} else if sev.boolLiteral != nil {
-		value := "false"
-		if *sev.boolLiteral {
-			value = "true"
+// checkArithmeticOperands check that the operands can be given to an arithmetic operator
+func checkArithmeticOperands(operands ...*SQLExpression) error {
+	for _, o := range operands {
+		switch o.Type {
+		case NodeExprType, EdgeExprType, PathExprType:
+			return fmt.Errorf("A node, a relationship or a path cannot be the operand of an arithmetic operator")
+		case ListExprType:
+			return fmt.Errorf("A list cannot be the operand of an arithmetic operator")
 		}
-		sev.propertyLabelsExpression = value
-		sev.boolLiteral = nil
-	} else if sev.functionInvocation != "" {
-		sev.propertyLabelsExpression = sev.functionInvocation
-		sev.functionInvocation = ""
-	} else if sev.parenthesizedExpression != "" {
-		sev.propertyLabelsExpression = fmt.Sprintf("(%s)", sev.parenthesizedExpression)
-		sev.parenthesizedExpression = ""
 	}
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnExitFunctionInvocation(name string) error {
-	sev.functionInvocation = fmt.Sprintf("%s(%s)", name, sev.expression)
-	sev.expression = ""
+// popArithmeticOperands pop the two operands of an arithmetic operator. Since an operator is visited after its
+// operands, they are on top of the stack.
+func (sev *SQLExpressionVisitor) popArithmeticOperands() (*SQLExpression, *SQLExpression, error) {
+	right, err := sev.pop()
+	if err != nil {
+		return nil, nil, err
+	}
+	left, err := sev.pop()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := checkArithmeticOperands(left, right); err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+// numericValueKind return the kind of the result of an arithmetic operator given the kinds of its operands
+func numericValueKind(left, right *SQLExpression) ValueKind {
+	switch {
+	case left.ValueKind == IntegerValueKind && right.ValueKind == IntegerValueKind:
+		return IntegerValueKind
+	case left.ValueKind == FloatValueKind || right.ValueKind == FloatValueKind:
+		return FloatValueKind
+	}
+	return UnknownValueKind
+}
+
+func (sev *SQLExpressionVisitor) OnPowerOfOperator() error {
+	left, right, err := sev.popArithmeticOperands()
+	if err != nil {
+		return err
+	}
+	power := NewSQLAtom(fmt.Sprintf("POWER(%s, %s)", left, right), PropertyExprType)
+	power.ValueKind = FloatValueKind
+	sev.push(power)
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnMultiplyDivideModuloOperator(operator query.MultiplyDivideModuloOperator) error {
+	operatorStr := ""
+	switch operator {
+	case query.Multiply:
+		operatorStr = "*"
+	case query.Divide:
+		operatorStr = "/"
+	case query.Modulo:
+		operatorStr = "%"
+	default:
+		return fmt.Errorf("Unknown multiplicative operator")
+	}
+	left, right, err := sev.popArithmeticOperands()
+	if err != nil {
+		return err
+	}
+	kind := numericValueKind(left, right)
+	// The division of two integers is an integer in Cypher while / always divides decimals in SQL.
+	if operatorStr == "/" && left.ValueKind == IntegerValueKind && right.ValueKind == IntegerValueKind {
+		operatorStr = "DIV"
+	} else if operatorStr == "/" {
+		kind = FloatValueKind
+	}
+	expression := NewSQLInfixExpression(operatorStr, MultiplicativePrecedence, left, right)
+	expression.ValueKind = kind
+	sev.push(expression)
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnExitStringListNullOperatorExpression(e query.QueryStringListNullOperatorExpression) error {
-	if sev.stringExpression != "" {
-		expression := sev.propertyLabelsExpression[1 : len(sev.propertyLabelsExpression)-1]
-
-		switch sev.stringOperator {
-		case query.ContainsOperator:
-			sev.stringExpression = fmt.Sprintf("%s LIKE '%%%s%%'", sev.stringExpression, expression)
-		case query.EndsWithOperator:
-			sev.stringExpression = fmt.Sprintf("%s LIKE '%%%s'", sev.stringExpression, expression)
-		case query.StartsWithOperator:
-			sev.stringExpression = fmt.Sprintf("%s LIKE '%s%%'", sev.stringExpression, expression)
-		}
-	} else {
-		sev.stringExpression = sev.propertyLabelsExpression
+func (sev *SQLExpressionVisitor) OnAddOrSubtractOperator(operator query.AddOrSubtractOperator) error {
+	operatorStr := ""
+	switch operator {
+	case query.Add:
+		operatorStr = "+"
+	case query.Subtract:
+		operatorStr = "-"
+	default:
+		return fmt.Errorf("Unknown additive operator")
+	}
+	left, right, err := sev.popArithmeticOperands()
+	if err != nil {
+		return err
+	}
+	// Adding a string concatenates in Cypher while + is always numeric in SQL.
+	if operator == query.Add && (left.ValueKind == StringValueKind || right.ValueKind == StringValueKind) {
+		concatenation := NewSQLAtom(fmt.Sprintf("CONCAT(%s, %s)", left, right), PropertyExprType)
+		concatenation.ValueKind = StringValueKind
+		sev.push(concatenation)
+		return nil
 	}
-	sev.propertyLabelsExpression = ""
+	expression := NewSQLInfixExpression(operatorStr, AdditivePrecedence, left, right)
+	expression.ValueKind = numericValueKind(left, right)
+	sev.push(expression)
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnStringOperator(operator query.StringOperator) error {
-	sev.stringOperator = operator
-	sev.stringExpression = sev.propertyLabelsExpression
-	sev.propertyLabelsExpression = ""
+func (sev *SQLExpressionVisitor) OnEnterComparisonExpression() error {
+	sev.pushFrame()
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnComparisonOperator(operator query.ComparisonOperator) error {
+	frame := sev.currentFrame()
+	frame.operators = append(frame.operators, operator)
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnExitComparisonExpression() error {
-	if sev.comparisonExpression != "" {
-		operatorStr := ""
-		switch sev.comparisonOperator {
-		case query.Equal:
-			operatorStr = "="
-		case query.NotEqual:
-			operatorStr = "<>"
-		case query.Less:
-			operatorStr = "<"
-		case query.LessOrEqual:
-			operatorStr = "<="
-		case query.Greater:
-			operatorStr = ">"
-		case query.GreaterOrEqual:
-			operatorStr = ">="
-		}
+	operands, operators := sev.popFrame()
+	if len(operands) != len(operators)+1 {
+		return fmt.Errorf("Unable to build comparison expression")
+	}
+	if len(operators) == 0 {
+		sev.push(operands[0])
+		return nil
+	}
 
-		sev.comparisonExpression = fmt.Sprintf("%s %s %s", sev.comparisonExpression,
-			operatorStr, sev.stringExpression)
+	// A chain of comparisons like a < b < c is true when every comparison is true.
+	comparisons := []*SQLExpression{}
+	for i, o := range operators {
+		comparison, err := buildComparison(o.(query.ComparisonOperator), operands[i], operands[i+1])
+		if err != nil {
+			return err
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	if len(comparisons) == 1 {
+		sev.push(comparisons[0])
 	} else {
-		sev.comparisonExpression = sev.stringExpression
+		sev.push(NewSQLInfixExpression("AND", AndPrecedence, comparisons...))
 	}
-	sev.stringExpression = ""
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnComparisonOperator(operator query.ComparisonOperator) error {
-	sev.comparisonOperator = operator
-	sev.comparisonExpression = sev.stringExpression
-	sev.stringExpression = ""
-	return nil
+// buildComparison build the comparison of two operands, nodes and relationships are compared by identifier
+func buildComparison(operator query.ComparisonOperator, left, right *SQLExpression) (*SQLExpression, error) {
+	left, right = scalarOperand(left), scalarOperand(right)
+
+	operatorStr := ""
+	switch operator {
+	case query.Equal:
+		operatorStr = "="
+	case query.NotEqual:
+		operatorStr = "<>"
+	case query.Less:
+		operatorStr = "<"
+	case query.LessOrEqual:
+		operatorStr = "<="
+	case query.Greater:
+		operatorStr = ">"
+	case query.GreaterOrEqual:
+		operatorStr = ">="
+	case query.RegexMatch:
+		// The regular expression must match the whole string and is case sensitive as in Cypher.
+		operatorStr = "REGEXP"
+		right = NewSQLAtom(fmt.Sprintf("CONCAT('(?-i)^(?:', %s, ')$')", right), PropertyExprType)
+	default:
+		return nil, fmt.Errorf("Unknown comparison operator")
+	}
+	return NewSQLInfixExpression(operatorStr, ComparisonPrecedence, left, right), nil
 }
 
 func (sev *SQLExpressionVisitor) OnExitNotExpression(not bool) error {
-	expr := sev.comparisonExpression
-	if not {
-		expr = "NOT " + expr
+	if !not {
+		return nil
 	}
-	sev.notExpressions = append(sev.notExpressions, expr)
-	sev.comparisonExpression = ""
+	operand, err := sev.pop()
+	if err != nil {
+		return err
+	}
+	sev.push(NewSQLPrefixExpression("NOT", NotPrecedence, operand))
+	return nil
+}
+
+func (sev *SQLExpressionVisitor) OnEnterAndExpression() error {
+	sev.pushFrame()
 	return nil
 }
 
 func (sev *SQLExpressionVisitor) OnExitAndExpression() error {
-	if sev.andExpressions == nil {
-		sev.andExpressions = []string{}
-	}
-	sev.andExpressions = append(sev.andExpressions, strings.Join(sev.notExpressions, " AND "))
-	sev.notExpressions = nil
+	return sev.buildLogicalOperator("AND", AndPrecedence)
+}
+
+func (sev *SQLExpressionVisitor) OnEnterXorExpression() error {
+	sev.pushFrame()
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnExitOrExpression() error {
-	sev.orExpression = strings.Join(sev.andExpressions, " OR ")
-	sev.andExpressions = nil
+func (sev *SQLExpressionVisitor) OnExitXorExpression() error {
+	return sev.buildLogicalOperator("XOR", XorPrecedence)
+}
+
+func (sev *SQLExpressionVisitor) OnEnterOrExpression() error {
+	sev.pushFrame()
 	return nil
 }
 
-func (sev *SQLExpressionVisitor) OnExitExpression() error {
-	sev.expression = sev.orExpression
-	sev.orExpression = ""
+func (sev *SQLExpressionVisitor) OnExitOrExpression() error {
+	return sev.buildLogicalOperator("OR", OrPrecedence)
+}
+
+// buildLogicalOperator combine the operands of the current frame with the logical operator
+func (sev *SQLExpressionVisitor) buildLogicalOperator(operator string, precedence SQLPrecedence) error {
+	operands, _ := sev.popFrame()
+	switch len(operands) {
+	case 0:
+		return fmt.Errorf("%s operator is missing an operand", operator)
+	case 1:
+		sev.push(operands[0])
+	default:
+		sev.push(NewSQLInfixExpression(operator, precedence, operands...))
+	}
 	return nil
 }
@@ -3,7 +3,6 @@ package knowledge
 import (
 	"testing"
 
-	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/clems4ever/go-graphkb/internal/parser"
 	"github.com/clems4ever/go-graphkb/internal/query"
 	"github.com/stretchr/testify/assert"
@@ -11,10 +10,7 @@ import (
 )
 
 func CypherToExpr(cypher string) query.QueryExpression {
-	is := antlr.NewInputStream(cypher)
-	lexer := parser.NewCypherLexer(is)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
-	p := parser.NewCypherParser(stream)
+	p := parser.NewCypherParser(query.NewTokenStream(cypher))
 
 	l := query.NewCypherVisitor()
 	queryExpression := l.Visit(p.OC_Expression())
@@ -24,6 +20,7 @@ func CypherToExpr(cypher string) query.QueryExpression {
 type ExpressionTestCase struct {
 	Cypher   string
 	SQL      string
+	Args     []interface{}
 	Selected bool
 }
 
@@ -41,7 +38,9 @@ func TestShouldBuildExpression(t *testing.T) {
 		}
 		t.Run(tc.Cypher, func(t *testing.T) {
 			qg := NewQueryGraph()
-			ep := NewExpressionBuilder(&qg)
+			bindings := NewSQLBindings(map[string]interface{}{
+				"name": "abc", "count": 2, "names": []interface{}{"abc", "def"}})
+			ep := NewExpressionBuilder(&qg, bindings)
 
 			_, _, err := qg.PushNode(query.QueryNodePattern{
 				Variable: "a",
@@ -64,7 +63,10 @@ func TestShouldBuildExpression(t *testing.T) {
 
 			sql, err := ep.Build(&expr)
 			require.NoError(t, err)
+
+			sql, args := bindings.Resolve(sql)
 			assert.Equal(t, tc.SQL, sql)
+			assert.Equal(t, tc.Args, args)
 		})
 	}
 }
@@ -92,20 +94,69 @@ var testCases = []ExpressionTestCase{
 	},
 	ExpressionTestCase{
 		Cypher: "a.value CONTAINS 'abc'",
-		SQL:    "a0.value LIKE '%abc%'",
+		SQL:    "a0.value LIKE ?",
+		Args:   []interface{}{"%abc%"},
 	},
 	ExpressionTestCase{
 		Cypher: "a.value STARTS WITH 'abc'",
-		SQL:    "a0.value LIKE 'abc%'",
+		SQL:    "a0.value LIKE ?",
+		Args:   []interface{}{"abc%"},
 	},
 	ExpressionTestCase{
 		Cypher: "a.value ENDS WITH 'abc'",
-		SQL:    "a0.value LIKE '%abc'",
+		SQL:    "a0.value LIKE ?",
+		Args:   []interface{}{"%abc"},
 	},
 	ExpressionTestCase{
 		Cypher: "COUNT(a.value)",
 		SQL:    "COUNT(a0.value)",
 	},
+	ExpressionTestCase{
+		Cypher: "count(DISTINCT a.value)",
+		SQL:    "COUNT(DISTINCT a0.value)",
+	},
+	ExpressionTestCase{
+		Cypher: "count(r)",
+		SQL:    "COUNT(r0.id)",
+	},
+	ExpressionTestCase{
+		Cypher: "collect(a.value)",
+		SQL:    "JSON_ARRAYAGG(a0.value)",
+	},
+	ExpressionTestCase{
+		Cypher: "max(a.value)",
+		SQL:    "MAX(a0.value)",
+	},
+	ExpressionTestCase{
+		Cypher: "id(a)",
+		SQL:    "a0.id",
+	},
+	ExpressionTestCase{
+		Cypher: "type(r)",
+		SQL:    "r0.type",
+	},
+	ExpressionTestCase{
+		Cypher: "labels(a)",
+		SQL:    "JSON_ARRAY(a0.type)",
+	},
+	ExpressionTestCase{
+		Cypher: "size(a.value)",
+		SQL:    "CHAR_LENGTH(a0.value)",
+	},
+	ExpressionTestCase{
+		Cypher: "size(collect(a.value))",
+		SQL:    "JSON_LENGTH(JSON_ARRAYAGG(a0.value))",
+	},
+	ExpressionTestCase{
+		Cypher: "coalesce(a.value, b.value, 'none')",
+		SQL:    "COALESCE(a0.value, a1.value, ?)",
+		Args:   []interface{}{"none"},
+	},
+	ExpressionTestCase{
+		Cypher: "toLower(a.value) = toLower('ABC')",
+		SQL:    "LOWER(a0.value) = LOWER(?)",
+		Args:   []interface{}{"ABC"},
+	},
 	ExpressionTestCase{
 		Cypher: "a.value < b.value",
 		SQL:    "a0.value < a1.value",
@@ -120,7 +171,8 @@ var testCases = []ExpressionTestCase{
 	},
 	ExpressionTestCase{
 		Cypher: "a.value = 'abc'",
-		SQL:    "a0.value = 'abc'",
+		SQL:    "a0.value = ?",
+		Args:   []interface{}{"abc"},
 	},
 	ExpressionTestCase{
 		Cypher: "a",
@@ -136,30 +188,248 @@ var testCases = []ExpressionTestCase{
 	},
 	ExpressionTestCase{
 		Cypher: "'abc'",
-		SQL:    "'abc'",
+		SQL:    "?",
+		Args:   []interface{}{"abc"},
 	},
 	ExpressionTestCase{
 		Cypher: "2",
-		SQL:    "2",
+		SQL:    "?",
+		Args:   []interface{}{int64(2)},
 	},
 	ExpressionTestCase{
 		Cypher: "2.5",
-		SQL:    "2.500000",
+		SQL:    "?",
+		Args:   []interface{}{2.5},
 	},
 	ExpressionTestCase{
 		Cypher: "true",
-		SQL:    "true",
+		SQL:    "?",
+		Args:   []interface{}{true},
 	},
 	ExpressionTestCase{
 		Cypher: "false",
-		SQL:    "false",
+		SQL:    "?",
+		Args:   []interface{}{false},
 	},
 	ExpressionTestCase{
 		Cypher: "TRUE",
-		SQL:    "true",
+		SQL:    "?",
+		Args:   []interface{}{true},
 	},
 	ExpressionTestCase{
 		Cypher: "FALSE",
-		SQL:    "false",
+		SQL:    "?",
+		Args:   []interface{}{false},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value CONTAINS '50%_off'",
+		SQL:    "a0.value LIKE ?",
+		Args:   []interface{}{"%50\\%\\_off%"},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value ENDS WITH 'C:\\\\dir_1' OR a.value STARTS WITH '100%'",
+		SQL:    "a0.value LIKE ? OR a0.value LIKE ?",
+		Args:   []interface{}{`%C:\\dir\_1`, `100\%%`},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value = 'it\\'s' OR a.value = \"it's\"",
+		SQL:    "a0.value = ? OR a0.value = ?",
+		Args:   []interface{}{"it's", "it's"},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value = $name AND b.value > $count",
+		SQL:    "a0.value = ? AND a1.value > ?",
+		Args:   []interface{}{"abc", int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value IN ['abc', 'def']",
+		SQL:    "a0.value IN (?, ?)",
+		Args:   []interface{}{"abc", "def"},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value IN []",
+		SQL:    "FALSE",
+	},
+	ExpressionTestCase{
+		Cypher: "a.value IN $names AND NOT b.value IN $names",
+		SQL:    "a0.value IN (?, ?) AND NOT a1.value IN (?, ?)",
+		Args:   []interface{}{"abc", "def", "abc", "def"},
+	},
+	ExpressionTestCase{
+		Cypher: "'abc' IN labels(a)",
+		SQL:    "JSON_CONTAINS(JSON_ARRAY(a0.type), JSON_ARRAY(?))",
+		Args:   []interface{}{"abc"},
+	},
+	ExpressionTestCase{
+		Cypher: "[1, a.value]",
+		SQL:    "JSON_ARRAY(?, a0.value)",
+		Args:   []interface{}{int64(1)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value IS NULL OR b.value IS NOT NULL",
+		SQL:    "a0.value IS NULL OR a1.value IS NOT NULL",
+	},
+	ExpressionTestCase{
+		Cypher: "a IS NULL",
+		SQL:    "a0.id IS NULL",
+	},
+	ExpressionTestCase{
+		Cypher: "a.value = NULL",
+		SQL:    "a0.value = NULL",
+	},
+	ExpressionTestCase{
+		Cypher: "a.value + 1",
+		SQL:    "CONCAT(a0.value, ?)",
+		Args:   []interface{}{int64(1)},
 	},
+	ExpressionTestCase{
+		Cypher: "'x' + $count + a.value",
+		SQL:    "CONCAT(CONCAT(?, ?), a0.value)",
+		Args:   []interface{}{"x", int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "$count + 1",
+		SQL:    "? + ?",
+		Args:   []interface{}{int64(2), int64(1)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value - b.value - 2",
+		SQL:    "a0.value - a1.value - ?",
+		Args:   []interface{}{int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value - (b.value - 2)",
+		SQL:    "a0.value - (a1.value - ?)",
+		Args:   []interface{}{int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value * 2 / 3 % 4",
+		SQL:    "a0.value * ? / ? % ?",
+		Args:   []interface{}{int64(2), int64(3), int64(4)},
+	},
+	ExpressionTestCase{
+		Cypher: "7 / 2",
+		SQL:    "? DIV ?",
+		Args:   []interface{}{int64(7), int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "size(a.value) * 2 / 3 % 4",
+		SQL:    "CHAR_LENGTH(a0.value) * ? DIV ? % ?",
+		Args:   []interface{}{int64(2), int64(3), int64(4)},
+	},
+	ExpressionTestCase{
+		Cypher: "7.0 / 2",
+		SQL:    "? / ?",
+		Args:   []interface{}{7.0, int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "size(a.value) + size(b.value) * 2",
+		SQL:    "CHAR_LENGTH(a0.value) + CHAR_LENGTH(a1.value) * ?",
+		Args:   []interface{}{int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "(size(a.value) + size(b.value)) * 2",
+		SQL:    "(CHAR_LENGTH(a0.value) + CHAR_LENGTH(a1.value)) * ?",
+		Args:   []interface{}{int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value % 2 = 0",
+		SQL:    "a0.value % ? = ?",
+		Args:   []interface{}{int64(2), int64(0)},
+	},
+	ExpressionTestCase{
+		Cypher: "2 ^ 3 ^ a.value",
+		SQL:    "POWER(POWER(?, ?), a0.value)",
+		Args:   []interface{}{int64(2), int64(3)},
+	},
+	ExpressionTestCase{
+		Cypher: "-a.value * 2",
+		SQL:    "-a0.value * ?",
+		Args:   []interface{}{int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "- -a.value",
+		SQL:    "a0.value",
+	},
+	ExpressionTestCase{
+		Cypher: "a.value - -2",
+		SQL:    "a0.value - -?",
+		Args:   []interface{}{int64(2)},
+	},
+	ExpressionTestCase{
+		Cypher: "-(size(a.value) + 1)",
+		SQL:    "-(CHAR_LENGTH(a0.value) + ?)",
+		Args:   []interface{}{int64(1)},
+	},
+	ExpressionTestCase{
+		Cypher: "size(a.value) + 1 > 3",
+		SQL:    "CHAR_LENGTH(a0.value) + ? > ?",
+		Args:   []interface{}{int64(1), int64(3)},
+	},
+	ExpressionTestCase{
+		Cypher: "a.value XOR b.value",
+		SQL:    "a0.value XOR a1.value",
+	},
+	ExpressionTestCase{
+		Cypher: "a.value = 1 OR a.value = 2 XOR b.value = 3 AND NOT b.value = 4",
+		SQL:    "a0.value = ? OR a0.value = ? XOR a1.value = ? AND NOT a1.value = ?",
+		Args:   []interface{}{int64(1), int64(2), int64(3), int64(4)},
+	},
+	ExpressionTestCase{
+		Cypher: "NOT (a.value OR b.value) AND a.value",
+		SQL:    "NOT (a0.value OR a1.value) AND a0.value",
+	},
+	ExpressionTestCase{
+		Cypher: "(a.value OR b.value) AND (a.value XOR (b.value AND NOT (a.value OR b.value)))",
+		SQL:    "(a0.value OR a1.value) AND (a0.value XOR (a1.value AND NOT (a0.value OR a1.value)))",
+	},
+	ExpressionTestCase{
+		Cypher: "NOT (NOT (a.value = 1 AND (b.value = 2 OR NOT b.value = 3)))",
+		SQL:    "NOT (NOT (a0.value = ? AND (a1.value = ? OR NOT a1.value = ?)))",
+		Args:   []interface{}{int64(1), int64(2), int64(3)},
+	},
+	ExpressionTestCase{
+		Cypher: "size(coalesce(a.value, 'x')) > 1 AND (a.value IS NULL XOR b.value IN ['x', 'y'])",
+		SQL:    "CHAR_LENGTH(COALESCE(a0.value, ?)) > ? AND (a0.value IS NULL XOR a1.value IN (?, ?))",
+		Args:   []interface{}{"x", int64(1), "x", "y"},
+	},
+	ExpressionTestCase{
+		Cypher: "coalesce(a.value = 1 OR b.value = 2, false)",
+		SQL:    "COALESCE(a0.value = ? OR a1.value = ?, ?)",
+		Args:   []interface{}{int64(1), int64(2), false},
+	},
+	ExpressionTestCase{
+		Cypher: "size(a.value) + size(b.value) IS NULL",
+		SQL:    "CHAR_LENGTH(a0.value) + (CHAR_LENGTH(a1.value) IS NULL)",
+	},
+	ExpressionTestCase{
+		Cypher: "1 < a.value <= 3",
+		SQL:    "? < a0.value AND a0.value <= ?",
+		Args:   []interface{}{int64(1), int64(3)},
+	},
+	ExpressionTestCase{
+		Cypher: "a = b",
+		SQL:    "a0.id = a1.id",
+	},
+	ExpressionTestCase{
+		Cypher: "a.value =~ 'ab.*'",
+		SQL:    "a0.value REGEXP CONCAT('(?-i)^(?:', ?, ')$')",
+		Args:   []interface{}{"ab.*"},
+	},
+}
+
+func TestShouldFailBuildingArithmeticOnNodes(t *testing.T) {
+	qg := NewQueryGraph()
+	_, _, err := qg.PushNode(query.QueryNodePattern{Variable: "a"})
+	require.NoError(t, err)
+	_, _, err = qg.PushNode(query.QueryNodePattern{Variable: "b"})
+	require.NoError(t, err)
+
+	for _, cypher := range []string{"a + 1", "1 - b", "-a", "a.value * [1, 2]"} {
+		t.Run(cypher, func(t *testing.T) {
+			expr := CypherToExpr(cypher)
+			_, err := NewExpressionBuilder(&qg, NewSQLBindings(nil)).Build(&expr)
+			require.Error(t, err)
+		})
+	}
 }
@@ -13,6 +13,8 @@ const (
 	NodeExprType     ExpressionType = iota
 	EdgeExprType     ExpressionType = iota
 	PropertyExprType ExpressionType = iota
+	PathExprType     ExpressionType = iota
+	ListExprType     ExpressionType = iota
 )
 
 type ExpressionVisitor interface {
@@ -29,20 +31,31 @@ type ExpressionVisitor interface {
 	OnDoubleLiteral(value float64) error
 	OnIntegerLiteral(value int64) error
 	OnBooleanLiteral(value bool) error
+	OnNullLiteral() error
 
-	OnEnterFunctionInvocation(name string) error
-	OnExitFunctionInvocation(name string) error
+	OnEnterListLiteral() error
+	OnExitListLiteral() error
+
+	OnParameter(name string) error
+
+	OnRelationshipsPattern(pattern query.QueryPatternElement) error
+
+	OnEnterFunctionInvocation(name string, distinct bool) error
+	OnExitFunctionInvocation(name string, distinct bool) error
 
 	OnEnterParenthesizedExpression() error
 	OnExitParenthesizedExpression() error
 
 	OnStringOperator(operator query.StringOperator) error
+	OnInOperator() error
+	OnNullOperator(not bool) error
 
-	OnEnterUnaryExpression() error
-	OnExitUnaryExpression() error
+	OnEnterUnaryExpression(negation bool) error
+	OnExitUnaryExpression(negation bool) error
 
 	OnEnterPowerOfExpression() error
 	OnExitPowerOfExpression() error
+	OnPowerOfOperator() error
 
 	OnEnterMultipleDivideModuloExpression() error
 	OnExitMultipleDivideModuloExpression() error
@@ -120,10 +133,37 @@ func (ep *ExpressionParser) ParsePropertyOrLabelsExpression(q *query.QueryProper
 			if err != nil {
 				return err
 			}
+		} else if q.Atom.Literal.Null {
+			err := ep.visitor.OnNullLiteral()
+			if err != nil {
+				return err
+			}
+		} else if q.Atom.Literal.List != nil {
+			err := ep.visitor.OnEnterListLiteral()
+			if err != nil {
+				return err
+			}
+			for i := range q.Atom.Literal.List {
+				if err := ep.ParseExpression(&q.Atom.Literal.List[i]); err != nil {
+					return err
+				}
+			}
+			err = ep.visitor.OnExitListLiteral()
+			if err != nil {
+				return err
+			}
+		} else {
+			return fmt.Errorf("Map literals are not supported")
+		}
+	} else if q.Atom.Parameter != nil {
+		err := ep.visitor.OnParameter(*q.Atom.Parameter)
+		if err != nil {
+			return err
 		}
 	} else if q.Atom.FunctionInvocation != nil {
 		fnName := strings.ToUpper(q.Atom.FunctionInvocation.FunctionName)
-		err := ep.visitor.OnEnterFunctionInvocation(fnName)
+		distinct := q.Atom.FunctionInvocation.Distinct
+		err := ep.visitor.OnEnterFunctionInvocation(fnName, distinct)
 		if err != nil {
 			return err
 		}
@@ -134,7 +174,12 @@ func (ep *ExpressionParser) ParsePropertyOrLabelsExpression(q *query.QueryProper
 				return err
 			}
 		}
-		err = ep.visitor.OnExitFunctionInvocation(fnName)
+		err = ep.visitor.OnExitFunctionInvocation(fnName, distinct)
+		if err != nil {
+			return err
+		}
+	} else if q.Atom.RelationshipsPattern != nil {
+		err := ep.visitor.OnRelationshipsPattern(*q.Atom.RelationshipsPattern)
 		if err != nil {
 			return err
 		}
@@ -177,11 +222,12 @@ func (ep *ExpressionParser) ParseStringListNullOperatorExpression(q *query.Query
 	for i := range q.StringOperatorExpression {
 		stringExpression := q.StringOperatorExpression[i]
 
-		if stringExpression.PropertyOrLabelsExpression.Atom.Literal == nil {
-			return fmt.Errorf("Expression must be a literal to be used with string operator")
+		atom := stringExpression.PropertyOrLabelsExpression.Atom
+		if atom.Literal == nil && atom.Parameter == nil {
+			return fmt.Errorf("Expression must be a literal or a parameter to be used with string operator")
 		}
 
-		if stringExpression.PropertyOrLabelsExpression.Atom.Literal.String == nil {
+		if atom.Literal != nil && atom.Literal.String == nil {
 			return fmt.Errorf("Expression must be a string literal to be used with string operator")
 		}
 
@@ -196,6 +242,31 @@ func (ep *ExpressionParser) ParseStringListNullOperatorExpression(q *query.Query
 		}
 	}
 
+	for i := range q.ListOperatorExpression {
+		listExpression := q.ListOperatorExpression[i]
+
+		if listExpression.Operator != query.InOperator {
+			return fmt.Errorf("List indexing and slicing are not supported")
+		}
+
+		err := ep.visitor.OnInOperator()
+		if err != nil {
+			return err
+		}
+
+		err = ep.ParsePropertyOrLabelsExpression(&listExpression.PropertyOrLabelsExpression)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, nullExpression := range q.NullOperatorExpression {
+		err := ep.visitor.OnNullOperator(nullExpression.Not)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = ep.visitor.OnExitStringListNullOperatorExpression(*q)
 	if err != nil {
 		return err
@@ -205,7 +276,17 @@ func (ep *ExpressionParser) ParseStringListNullOperatorExpression(q *query.Query
 }
 
 func (ep *ExpressionParser) ParseUnaryAddOrSubtractExpression(q *query.QueryUnaryAddOrSubtractExpression) error {
-	err := ep.ParseStringListNullOperatorExpression(&q.StringListNullOperatorExpression)
+	err := ep.visitor.OnEnterUnaryExpression(q.Negation)
+	if err != nil {
+		return err
+	}
+
+	err = ep.ParseStringListNullOperatorExpression(&q.StringListNullOperatorExpression)
+	if err != nil {
+		return err
+	}
+
+	err = ep.visitor.OnExitUnaryExpression(q.Negation)
 	if err != nil {
 		return err
 	}
@@ -223,6 +304,14 @@ func (ep *ExpressionParser) ParsePowerOfExpression(q *query.QueryPowerOfExpressi
 		if err != nil {
 			return err
 		}
+
+		// Like the other arithmetic operators, the operator is visited after both of its operands.
+		if i > 0 {
+			err = ep.visitor.OnPowerOfOperator()
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	err = ep.visitor.OnExitPowerOfExpression()
@@ -425,17 +514,31 @@ func (evb *ExpressionVisitorBase) OnStringLiteral(value string) error
 func (evb *ExpressionVisitorBase) OnDoubleLiteral(value float64) error                    { return nil }
 func (evb *ExpressionVisitorBase) OnIntegerLiteral(value int64) error                     { return nil }
 func (evb *ExpressionVisitorBase) OnBooleanLiteral(value bool) error                      { return nil }
-func (evb *ExpressionVisitorBase) OnEnterFunctionInvocation(name string) error            { return nil }
-func (evb *ExpressionVisitorBase) OnExitFunctionInvocation(name string) error             { return nil }
-func (evb *ExpressionVisitorBase) OnEnterParenthesizedExpression() error                  { return nil }
-func (evb *ExpressionVisitorBase) OnExitParenthesizedExpression() error                   { return nil }
-func (evb *ExpressionVisitorBase) OnStringOperator(operator query.StringOperator) error   { return nil }
-func (evb *ExpressionVisitorBase) OnEnterUnaryExpression() error                          { return nil }
-func (evb *ExpressionVisitorBase) OnExitUnaryExpression() error                           { return nil }
-func (evb *ExpressionVisitorBase) OnEnterPowerOfExpression() error                        { return nil }
-func (evb *ExpressionVisitorBase) OnExitPowerOfExpression() error                         { return nil }
-func (evb *ExpressionVisitorBase) OnEnterMultipleDivideModuloExpression() error           { return nil }
-func (evb *ExpressionVisitorBase) OnExitMultipleDivideModuloExpression() error            { return nil }
+func (evb *ExpressionVisitorBase) OnNullLiteral() error                                   { return nil }
+func (evb *ExpressionVisitorBase) OnEnterListLiteral() error                              { return nil }
+func (evb *ExpressionVisitorBase) OnExitListLiteral() error                               { return nil }
+func (evb *ExpressionVisitorBase) OnParameter(name string) error                          { return nil }
+func (evb *ExpressionVisitorBase) OnRelationshipsPattern(pattern query.QueryPatternElement) error {
+	return nil
+}
+func (evb *ExpressionVisitorBase) OnEnterFunctionInvocation(name string, distinct bool) error {
+	return nil
+}
+func (evb *ExpressionVisitorBase) OnExitFunctionInvocation(name string, distinct bool) error {
+	return nil
+}
+func (evb *ExpressionVisitorBase) OnEnterParenthesizedExpression() error                { return nil }
+func (evb *ExpressionVisitorBase) OnExitParenthesizedExpression() error                 { return nil }
+func (evb *ExpressionVisitorBase) OnStringOperator(operator query.StringOperator) error { return nil }
+func (evb *ExpressionVisitorBase) OnInOperator() error                                  { return nil }
+func (evb *ExpressionVisitorBase) OnNullOperator(not bool) error                        { return nil }
+func (evb *ExpressionVisitorBase) OnEnterUnaryExpression(negation bool) error           { return nil }
+func (evb *ExpressionVisitorBase) OnExitUnaryExpression(negation bool) error            { return nil }
+func (evb *ExpressionVisitorBase) OnEnterPowerOfExpression() error                      { return nil }
+func (evb *ExpressionVisitorBase) OnExitPowerOfExpression() error                       { return nil }
+func (evb *ExpressionVisitorBase) OnPowerOfOperator() error                             { return nil }
+func (evb *ExpressionVisitorBase) OnEnterMultipleDivideModuloExpression() error         { return nil }
+func (evb *ExpressionVisitorBase) OnExitMultipleDivideModuloExpression() error          { return nil }
 func (evb *ExpressionVisitorBase) OnMultiplyDivideModuloOperator(operator query.MultiplyDivideModuloOperator) error {
 	return nil
 }
@@ -459,3 +562,43 @@ func (evb *ExpressionVisitorBase) OnEnterOrExpression() error          { return
 func (evb *ExpressionVisitorBase) OnExitOrExpression() error           { return nil }
 func (evb *ExpressionVisitorBase) OnEnterExpression() error            { return nil }
 func (evb *ExpressionVisitorBase) OnExitExpression() error             { return nil }
+
+// expressionAtom return the atom of the expression if the expression is only made of an atom without
+// any operator or property lookup.
+func expressionAtom(e *query.QueryExpression) (*query.QueryAtom, bool) {
+	if len(e.OrExpression.XorExpressions) != 1 {
+		return nil, false
+	}
+	xorExpr := e.OrExpression.XorExpressions[0]
+	if len(xorExpr.AndExpressions) != 1 || len(xorExpr.AndExpressions[0].NotExpressions) != 1 {
+		return nil, false
+	}
+	notExpr := xorExpr.AndExpressions[0].NotExpressions[0]
+	if notExpr.Not || len(notExpr.ComparisonExpression.PartialComparisonExpressions) > 0 {
+		return nil, false
+	}
+	operand, ok := addOrSubtractOperand(&notExpr.ComparisonExpression.AddOrSubtractExpression)
+	if !ok || len(operand.PropertyKeys) > 0 || len(operand.Labels) > 0 {
+		return nil, false
+	}
+	return &operand.Atom, true
+}
+
+// addOrSubtractOperand return the operand of the expression if the expression is only made of an atom and its
+// property lookups or labels, without any operator.
+func addOrSubtractOperand(addExpr *query.QueryAddOrSubtractExpression) (*query.QueryPropertyOrLabelsExpression, bool) {
+	if len(addExpr.PartialAddOrSubtractExpression) > 0 ||
+		len(addExpr.MultipleDivideModuloExpression.PartialMultipleDivideModuloExpressions) > 0 {
+		return nil, false
+	}
+	unaryExprs := addExpr.MultipleDivideModuloExpression.PowerOfExpression.QueryUnaryAddOrSubtractExpressions
+	if len(unaryExprs) != 1 || unaryExprs[0].Negation {
+		return nil, false
+	}
+	slnoExpr := unaryExprs[0].StringListNullOperatorExpression
+	if len(slnoExpr.StringOperatorExpression) > 0 || len(slnoExpr.ListOperatorExpression) > 0 ||
+		len(slnoExpr.NullOperatorExpression) > 0 {
+		return nil, false
+	}
+	return &slnoExpr.PropertyOrLabelsExpression, true
+}
@@ -0,0 +1,210 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FunctionArgument is an argument given to a function
+type FunctionArgument struct {
+	SQL  string
+	Type ExpressionType
+	// Columns are the columns of the node or the relation given as argument
+	Columns []string
+	// PatternPredicate tells whether the argument is a pattern used as a predicate like (a)-->(b)
+	PatternPredicate bool
+	// Path is the SQL of the parts of the path given as argument when it is matched by the query. It is nil when
+	// the path comes from a previous part of the query, only its JSON document is known then.
+	Path *PathSQL
+}
+
+// ScalarFunction describe how a scalar function of Cypher is checked and translated into SQL
+type ScalarFunction struct {
+	MinArguments int
+	// MaxArguments is -1 when the number of arguments is not bounded
+	MaxArguments int
+	// ArgumentTypes are the types accepted for all the arguments
+	ArgumentTypes []ExpressionType
+	ReturnType    ExpressionType
+
+	Build func(arguments []FunctionArgument) string
+}
+
+// nodeColumns return the columns of a node used by functions
+func nodeColumns(alias string) []string {
+	return []string{alias + ".id", alias + ".value", alias + ".type"}
+}
+
+// relationColumns return the columns of a relation used by functions
+func relationColumns(alias string) []string {
+	return []string{alias + ".id", alias + ".from_id", alias + ".to_id", alias + ".type"}
+}
+
+// scalarFunctions are the scalar functions of Cypher indexed by their name in upper case
+var scalarFunctions = map[string]ScalarFunction{
+	"ID": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{NodeExprType, EdgeExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			return arguments[0].Columns[0]
+		},
+	},
+	"TYPE": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{EdgeExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			return arguments[0].Columns[3]
+		},
+	},
+	"LABELS": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{NodeExprType},
+		ReturnType:    ListExprType,
+		Build: func(arguments []FunctionArgument) string {
+			// An asset has a single type.
+			return fmt.Sprintf("JSON_ARRAY(%s)", arguments[0].Columns[2])
+		},
+	},
+	"TOLOWER": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{PropertyExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			return fmt.Sprintf("LOWER(%s)", arguments[0].SQL)
+		},
+	},
+	"SIZE": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{PropertyExprType, ListExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			if arguments[0].Type == ListExprType {
+				return fmt.Sprintf("JSON_LENGTH(%s)", arguments[0].SQL)
+			}
+			return fmt.Sprintf("CHAR_LENGTH(%s)", arguments[0].SQL)
+		},
+	},
+	"NODES": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{PathExprType},
+		ReturnType:    ListExprType,
+		Build: func(arguments []FunctionArgument) string {
+			if arguments[0].Path != nil {
+				return arguments[0].Path.Nodes
+			}
+			return fmt.Sprintf("JSON_EXTRACT(%s, '$.assets')", arguments[0].SQL)
+		},
+	},
+	"RELATIONSHIPS": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{PathExprType},
+		ReturnType:    ListExprType,
+		Build: func(arguments []FunctionArgument) string {
+			if arguments[0].Path != nil {
+				return arguments[0].Path.Relations
+			}
+			return fmt.Sprintf("JSON_EXTRACT(%s, '$.relations')", arguments[0].SQL)
+		},
+	},
+	"LENGTH": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{PathExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			// The length of a path is its number of relations.
+			if arguments[0].Path != nil {
+				return arguments[0].Path.Length
+			}
+			return fmt.Sprintf("JSON_LENGTH(%s, '$.relations')", arguments[0].SQL)
+		},
+	},
+	"EXISTS": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  1,
+		ArgumentTypes: []ExpressionType{PropertyExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			// The pattern predicates are already translated into EXISTS subqueries.
+			if arguments[0].PatternPredicate {
+				return arguments[0].SQL
+			}
+			return fmt.Sprintf("%s IS NOT NULL", arguments[0].SQL)
+		},
+	},
+	"COALESCE": ScalarFunction{
+		MinArguments:  1,
+		MaxArguments:  -1,
+		ArgumentTypes: []ExpressionType{PropertyExprType},
+		ReturnType:    PropertyExprType,
+		Build: func(arguments []FunctionArgument) string {
+			sqlArguments := []string{}
+			for _, a := range arguments {
+				sqlArguments = append(sqlArguments, a.SQL)
+			}
+			return fmt.Sprintf("COALESCE(%s)", strings.Join(sqlArguments, ", "))
+		},
+	},
+}
+
+// expressionTypeDescription describe an expression type in error messages
+func expressionTypeDescription(expressionType ExpressionType) string {
+	switch expressionType {
+	case NodeExprType:
+		return "a node"
+	case EdgeExprType:
+		return "a relationship"
+	case PathExprType:
+		return "a path"
+	case ListExprType:
+		return "a list"
+	}
+	return "a value"
+}
+
+// buildScalarFunction check the arguments given to a scalar function and build its SQL form
+func buildScalarFunction(name string, distinct bool, arguments []FunctionArgument) (string, ExpressionType, error) {
+	fn, ok := scalarFunctions[name]
+	if !ok {
+		return "", PropertyExprType, fmt.Errorf("Unknown function %s", name)
+	}
+	if distinct {
+		return "", PropertyExprType, fmt.Errorf("DISTINCT cannot be used with function %s", name)
+	}
+
+	if fn.MinArguments == fn.MaxArguments && len(arguments) != fn.MinArguments {
+		if fn.MinArguments == 1 {
+			return "", PropertyExprType, fmt.Errorf("Function %s expects exactly one argument", name)
+		}
+		return "", PropertyExprType, fmt.Errorf("Function %s expects exactly %d arguments", name, fn.MinArguments)
+	}
+	if len(arguments) < fn.MinArguments {
+		return "", PropertyExprType, fmt.Errorf("Function %s expects at least %d argument(s)", name, fn.MinArguments)
+	}
+	if fn.MaxArguments >= 0 && len(arguments) > fn.MaxArguments {
+		return "", PropertyExprType, fmt.Errorf("Function %s expects at most %d argument(s)", name, fn.MaxArguments)
+	}
+
+	for i, a := range arguments {
+		accepted := false
+		descriptions := []string{}
+		for _, t := range fn.ArgumentTypes {
+			accepted = accepted || a.Type == t
+			descriptions = append(descriptions, expressionTypeDescription(t))
+		}
+		if !accepted {
+			return "", PropertyExprType, fmt.Errorf("Argument %d of function %s must be %s", i+1, name,
+				strings.Join(descriptions, " or "))
+		}
+	}
+	return fn.Build(arguments), fn.ReturnType, nil
+}
@@ -15,6 +15,19 @@ type QueryNode struct {
 	Labels []string
 	// Constraint expressions
 	Constraints AndOrExpression
+	// OptionalGroup is the index of the OPTIONAL MATCH introducing the node, 0 if the node is required
+	OptionalGroup int
+	// Staged tells whether the node is bound to the intermediate result of a WITH clause
+	Staged bool
+	// WhereConstraints are the equalities of the WHERE clause restricting the value of the node to a literal or a
+	// parameter like h.value = 'web-01', they only reference the node
+	WhereConstraints []AndOrExpression
+}
+
+// isConstrained tells whether the node is expected to match few assets, being constrained by its properties, by
+// an equality of the WHERE clause or bound to an intermediate result
+func (n QueryNode) isConstrained() bool {
+	return n.Staged || len(n.WhereConstraints) > 0 || len(n.Constraints.Children) > 0
 }
 
 type RelationDirection int
@@ -26,10 +39,15 @@ const (
 	Right RelationDirection = iota
 	// There is a relation but we don't know in which direction
 	Either RelationDirection = iota
-	// There is a relation in both directions
+	// There are arrows on both sides of the relation, like in openCypher it is matched in any direction
 	Both RelationDirection = iota
 )
 
+// Undirected tells whether the relation can be matched in any direction
+func (d RelationDirection) Undirected() bool {
+	return d == Either || d == Both
+}
+
 // QueryRelation represent a relation and its constraints
 type QueryRelation struct {
 	Labels []string
@@ -39,6 +57,25 @@ type QueryRelation struct {
 	LeftIdx   int
 	RightIdx  int
 	Direction RelationDirection
+
+	// VariableLength is true when the relation matches paths of MinHops to MaxHops relations
+	VariableLength bool
+	// MinHops is at least 1 since zero-length paths are not supported
+	MinHops int
+	// MaxHops is 0 when the maximum number of hops is not bounded by the query
+	MaxHops int
+	// ShortestPath tells whether only the shortest paths of the variable-length relation are matched
+	ShortestPath ShortestPathMode
+	// OptionalGroup is the index of the OPTIONAL MATCH introducing the relation, 0 if the relation is required
+	OptionalGroup int
+	// WalkFromRight tells whether the paths of the variable-length relation are walked from its right node
+	WalkFromRight bool
+}
+
+// QueryValue represent a value bound to a variable like a column of the intermediate results of a WITH clause
+type QueryValue struct {
+	Expression     string
+	ExpressionType ExpressionType
 }
 
 type VariableType int
@@ -46,6 +83,8 @@ type VariableType int
 const (
 	NodeType     VariableType = iota
 	RelationType VariableType = iota
+	ValueType    VariableType = iota
+	PathType     VariableType = iota
 )
 
 type TypeAndIndex struct {
@@ -56,14 +95,21 @@ type TypeAndIndex struct {
 type QueryGraph struct {
 	Nodes     []QueryNode
 	Relations []QueryRelation
+	Values    []QueryValue
+	Paths     []QueryPath
 
 	VariablesIndex map[string]TypeAndIndex
+
+	// OptionalGroupsCount is the number of OPTIONAL MATCH pushed in the graph
+	OptionalGroupsCount int
 }
 
 func NewQueryGraph() QueryGraph {
 	return QueryGraph{
 		Nodes:          []QueryNode{},
 		Relations:      []QueryRelation{},
+		Values:         []QueryValue{},
+		Paths:          []QueryPath{},
 		VariablesIndex: make(map[string]TypeAndIndex),
 	}
 }
@@ -104,10 +150,12 @@ func (qg *QueryGraph) PushNode(q query.QueryNodePattern) (*QueryNode, int, error
 func (qg *QueryGraph) PushRelation(q query.QueryRelationshipPattern, leftIdx, rightIdx int) (*QueryRelation, int, error) {
 	var varName string
 	var labels []string
+	var hopsRange *query.QueryRangeLiteral
 
 	if q.RelationshipDetail != nil {
 		varName = q.RelationshipDetail.Variable
 		labels = q.RelationshipDetail.Labels
+		hopsRange = q.RelationshipDetail.Range
 	}
 
 	// If pattern comes with a variable name, search in the index if it does not already exist
@@ -153,6 +201,24 @@ func (qg *QueryGraph) PushRelation(q query.QueryRelationshipPattern, leftIdx, ri
 		RightIdx:  rightIdx,
 		Direction: direction,
 	}
+
+	if hopsRange != nil {
+		qr.VariableLength = true
+		qr.MinHops = 1
+		if hopsRange.Min != nil {
+			qr.MinHops = int(*hopsRange.Min)
+		}
+		if hopsRange.Max != nil {
+			qr.MaxHops = int(*hopsRange.Max)
+		}
+
+		if qr.MinHops < 1 {
+			return nil, -1, fmt.Errorf("Zero-length paths are not supported, the minimum number of hops of a variable-length relationship must be at least 1")
+		}
+		if hopsRange.Max != nil && qr.MaxHops < qr.MinHops {
+			return nil, -1, fmt.Errorf("Maximum number of hops of a variable-length relationship must be greater than the minimum")
+		}
+	}
 	newIdx := len(qg.Relations)
 
 	qg.Relations = append(qg.Relations, qr)
@@ -166,6 +232,35 @@ func (qg *QueryGraph) PushRelation(q query.QueryRelationshipPattern, leftIdx, ri
 	return &qr, newIdx, nil
 }
 
+// PushValue bind a value to a variable
+func (qg *QueryGraph) PushValue(name string, value QueryValue) (int, error) {
+	if _, ok := qg.VariablesIndex[name]; ok {
+		return -1, fmt.Errorf("Variable '%s' is already defined", name)
+	}
+
+	newIdx := len(qg.Values)
+	qg.Values = append(qg.Values, value)
+	qg.VariablesIndex[name] = TypeAndIndex{
+		Type:  ValueType,
+		Index: newIdx,
+	}
+	return newIdx, nil
+}
+
+// PushOptionalGroup mark the nodes and relations pushed from the given indices as optional. They form a group
+// which is either entirely matched or not matched at all. The index of the group is returned.
+func (qg *QueryGraph) PushOptionalGroup(firstNodeIdx, firstRelationIdx int) int {
+	qg.OptionalGroupsCount++
+	group := qg.OptionalGroupsCount
+	for i := firstNodeIdx; i < len(qg.Nodes); i++ {
+		qg.Nodes[i].OptionalGroup = group
+	}
+	for i := firstRelationIdx; i < len(qg.Relations); i++ {
+		qg.Relations[i].OptionalGroup = group
+	}
+	return group
+}
+
 func (qg *QueryGraph) FindVariable(name string) (TypeAndIndex, error) {
 	v, ok := qg.VariablesIndex[name]
 	if !ok {
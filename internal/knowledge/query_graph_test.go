@@ -307,6 +307,94 @@ func (s *QueryGraphSuite) TestCannotPushARelationBoundToUnexistingNode() {
 	s.Require().EqualError(err, "Cannot push relation bound to an unexisting node")
 }
 
+func (s *QueryGraphSuite) TestShouldPushVariableLengthRelation() {
+	g := NewQueryGraph()
+	_, _, err := g.PushNode(query.QueryNodePattern{})
+	s.Require().NoError(err)
+
+	min := int64(2)
+	pattern0 := CreateRelationship("var1", []string{"t1"})
+	pattern0.RelationshipDetail.Range = &query.QueryRangeLiteral{Min: &min}
+
+	n0, _, err := g.PushRelation(pattern0, 0, 0)
+	s.Require().NoError(err)
+
+	q0 := QueryRelation{Labels: []string{"t1"}, Direction: Either, VariableLength: true, MinHops: 2}
+	s.Assert().Equal(&q0, n0)
+}
+
+func (s *QueryGraphSuite) TestCannotPushVariableLengthRelationWithoutHop() {
+	g := NewQueryGraph()
+	_, _, err := g.PushNode(query.QueryNodePattern{})
+	s.Require().NoError(err)
+
+	min := int64(0)
+	pattern0 := CreateRelationship("var1", []string{"t1"})
+	pattern0.RelationshipDetail.Range = &query.QueryRangeLiteral{Min: &min}
+
+	_, _, err = g.PushRelation(pattern0, 0, 0)
+	s.Require().EqualError(err, "Zero-length paths are not supported, the minimum number of hops of a variable-length relationship must be at least 1")
+}
+
+func (s *QueryGraphSuite) TestShouldPushOptionalGroup() {
+	g := NewQueryGraph()
+	_, idx0, err := g.PushNode(query.QueryNodePattern{Variable: "h", Labels: []string{"host"}})
+	s.Require().NoError(err)
+
+	_, idx1, err := g.PushNode(query.QueryNodePattern{Variable: "o", Labels: []string{"owner"}})
+	s.Require().NoError(err)
+
+	_, _, err = g.PushRelation(query.QueryRelationshipPattern{RightArrow: true}, idx0, idx1)
+	s.Require().NoError(err)
+
+	group := g.PushOptionalGroup(1, 0)
+	s.Assert().Equal(1, group)
+	s.Assert().Equal(0, g.Nodes[0].OptionalGroup)
+	s.Assert().Equal(1, g.Nodes[1].OptionalGroup)
+	s.Assert().Equal(1, g.Relations[0].OptionalGroup)
+
+	s.Assert().Equal(2, g.PushOptionalGroup(2, 1))
+	s.Assert().Equal(2, g.OptionalGroupsCount)
+}
+
+func (s *QueryGraphSuite) TestShouldPushValue() {
+	g := NewQueryGraph()
+	idx, err := g.PushValue("c", QueryValue{Expression: "w0.v1", ExpressionType: PropertyExprType})
+	s.Require().NoError(err)
+	s.Assert().Equal(0, idx)
+
+	typeAndIndex, err := g.FindVariable("c")
+	s.Require().NoError(err)
+	s.Assert().Equal(TypeAndIndex{Type: ValueType, Index: 0}, typeAndIndex)
+
+	_, err = g.PushValue("c", QueryValue{Expression: "w0.v2", ExpressionType: PropertyExprType})
+	s.Assert().EqualError(err, "Variable 'c' is already defined")
+}
+
+func (s *QueryGraphSuite) TestShouldPushPath() {
+	g := NewQueryGraph()
+	_, idx0, err := g.PushNode(query.QueryNodePattern{Variable: "u", Labels: []string{"user"}})
+	s.Require().NoError(err)
+
+	_, idx1, err := g.PushNode(query.QueryNodePattern{Variable: "g", Labels: []string{"group"}})
+	s.Require().NoError(err)
+
+	_, relationIdx, err := g.PushRelation(query.QueryRelationshipPattern{RightArrow: true}, idx0, idx1)
+	s.Require().NoError(err)
+
+	idx, err := g.PushPath("p", QueryPath{NodesIdx: []int{idx0, idx1}, RelationsIdx: []int{relationIdx}})
+	s.Require().NoError(err)
+	s.Assert().Equal(0, idx)
+	s.Assert().True(g.IsRelationInPath(relationIdx))
+
+	typeAndIndex, err := g.FindVariable("p")
+	s.Require().NoError(err)
+	s.Assert().Equal(TypeAndIndex{Type: PathType, Index: 0}, typeAndIndex)
+
+	_, err = g.PushPath("u", QueryPath{NodesIdx: []int{idx0}})
+	s.Assert().EqualError(err, "Variable 'u' is already defined")
+}
+
 func TestShouldRunQueryGraphSuite(t *testing.T) {
 	suite.Run(t, new(QueryGraphSuite))
 }
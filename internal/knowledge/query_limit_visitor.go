@@ -1,15 +1,26 @@
 package knowledge
 
-import "github.com/clems4ever/go-graphkb/internal/query"
+import (
+	"fmt"
+	"math"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
 
 type QueryLimitVisitor struct {
 	ExpressionVisitorBase
 
+	// Parameters are the values of the parameters of the query
+	Parameters map[string]interface{}
+
 	Limit int64
 }
 
-// ParseExpression return whether the expression require aggregation
+// ParseExpression parse the number of rows of a LIMIT clause
 func (qlv *QueryLimitVisitor) ParseExpression(q *query.QueryExpression) error {
+	if !isCountExpression(q) {
+		return fmt.Errorf("LIMIT must be an integer literal or a parameter")
+	}
 	err := NewExpressionParser(qlv).ParseExpression(q)
 	if err != nil {
 		return err
@@ -21,3 +32,49 @@ func (qlv *QueryLimitVisitor) OnIntegerLiteral(value int64) error {
 	qlv.Limit = value
 	return nil
 }
+
+func (qlv *QueryLimitVisitor) OnParameter(name string) error {
+	value, err := countParameter(qlv.Parameters, name, "LIMIT")
+	if err != nil {
+		return err
+	}
+	qlv.Limit = value
+	return nil
+}
+
+// isCountExpression return whether the expression of a LIMIT or SKIP clause is an integer literal or a parameter
+func isCountExpression(q *query.QueryExpression) bool {
+	atom, ok := expressionAtom(q)
+	if !ok {
+		return false
+	}
+	return atom.Parameter != nil || (atom.Literal != nil && atom.Literal.Integer != nil)
+}
+
+// countParameter return the value of a parameter given to a LIMIT or SKIP clause, it must be a non-negative integer
+func countParameter(parameters map[string]interface{}, name string, clause string) (int64, error) {
+	value, ok := parameters[name]
+	if !ok {
+		return 0, fmt.Errorf("Parameter $%s is not provided", name)
+	}
+
+	var count int64
+	switch v := value.(type) {
+	case int:
+		count = int64(v)
+	case int64:
+		count = v
+	case float64:
+		// The numbers decoded from JSON are floats.
+		if v != math.Trunc(v) {
+			return 0, fmt.Errorf("Parameter $%s of %s must be a non-negative integer", name, clause)
+		}
+		count = int64(v)
+	default:
+		return 0, fmt.Errorf("Parameter $%s of %s must be a non-negative integer", name, clause)
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("Parameter $%s of %s must be a non-negative integer", name, clause)
+	}
+	return count, nil
+}
@@ -0,0 +1,34 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// buildFromTables build the tables of the FROM clause. The tables of the optional groups are left joined
+// to the required tables with their constraints as join condition so that the rows are kept with NULL
+// values when the optional patterns do not match.
+func buildFromTables(groupsTables [][]string, groupsConstraints []AndOrExpression) ([]string, error) {
+	if len(groupsTables) == 1 {
+		return groupsTables[0], nil
+	}
+
+	// When there is no required pattern, a single row is produced to join the optional patterns to.
+	requiredTables := "(SELECT 1) d"
+	if len(groupsTables[0]) > 0 {
+		requiredTables = fmt.Sprintf("(%s)", strings.Join(groupsTables[0], ", "))
+	}
+
+	from := requiredTables
+	for group := 1; group < len(groupsTables); group++ {
+		condition, err := BuildAndOrExpression(groupsConstraints[group])
+		if err != nil {
+			return nil, err
+		}
+		if condition == "" {
+			condition = "TRUE"
+		}
+		from += fmt.Sprintf("\nLEFT JOIN (%s) ON %s", strings.Join(groupsTables[group], ", "), condition)
+	}
+	return []string{from}, nil
+}
@@ -0,0 +1,160 @@
+package knowledge
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// SortItem represent one item of the ORDER BY clause of the SQL query
+type SortItem struct {
+	// Expressions to sort on when the query is a single SELECT
+	Expressions []string
+	// Positions (starting at 1) of the columns to sort on when the query is a compound one
+	// (UNION or aggregation over a derived table) because the expressions are not visible anymore.
+	Positions  []int
+	Descending bool
+}
+
+// projectionColumns split a projection into the SQL expressions of its columns
+func projectionColumns(projection string, expressionType ExpressionType) []string {
+	if expressionType == NodeExprType || expressionType == EdgeExprType {
+		return strings.Split(projection, ", ")
+	}
+	return []string{projection}
+}
+
+// buildSortItems translate the ORDER BY clause of the projection body into sort items. Expressions
+// computing a projection or one of the columns of a projected node or relation are sorted by the
+// position of that column. Other expressions are appended to the projections as hidden columns so
+// that they can be referenced in compound queries. Those columns are ignored by the cursors since
+// they only read the columns required by the projection types. Hidden columns are not allowed when
+// the query is combined with other queries since the columns of the queries must line up.
+func (sqt *SQLQueryTranslator) buildSortItems(body query.QueryProjectionBody,
+	projections []string, projectionTypes []Projection, aggregation bool, union bool) ([]SortItem, []string, error) {
+	columns := make([][]string, 0)
+	positions := make([]int, 0)
+	columnsCount := 0
+	for i := range projections {
+		c := projectionColumns(projections[i], projectionTypes[i].ExpressionType)
+		columns = append(columns, c)
+		positions = append(positions, columnsCount+1)
+		columnsCount += len(c)
+	}
+
+	sortItems := make([]SortItem, 0)
+	for _, o := range body.Order {
+		projectionIdx := -1
+
+		// The sort item might refer to a projection by its alias.
+		if atom, ok := expressionAtom(&o.Expression); ok && atom.Variable != nil {
+			for i := range projectionTypes {
+				if projectionTypes[i].Alias == *atom.Variable {
+					projectionIdx = i
+					break
+				}
+			}
+		}
+
+		sortItem := SortItem{Descending: o.Descending}
+		if projectionIdx == -1 {
+			projectionVisitor := ProjectionVisitor{QueryGraph: &sqt.QueryGraph}
+			if err := projectionVisitor.ParseExpression(&o.Expression); err != nil {
+				return nil, nil, err
+			}
+
+			expression, err := NewExpressionBuilder(&sqt.QueryGraph, sqt.bindings).Build(&o.Expression)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for i := range projections {
+				if projections[i] == expression {
+					projectionIdx = i
+					break
+				}
+			}
+
+			// The sort item might refer to a property which is one of the columns of a projected node or relation.
+			if projectionIdx == -1 {
+				if column, ok := findProjectedColumn(columns, positions, expression); ok {
+					sortItem.Expressions = []string{expression}
+					sortItem.Positions = []int{column}
+					sortItems = append(sortItems, sortItem)
+					continue
+				}
+			}
+
+			if projectionIdx == -1 {
+				// The aggregation would be computed over all the rows since the query has no GROUP BY.
+				if projectionVisitor.Aggregation {
+					return nil, nil, fmt.Errorf("ORDER BY aggregation %s must be projected", expression)
+				}
+				if body.Distinct || aggregation {
+					return nil, nil, fmt.Errorf("ORDER BY expression %s must be projected when using DISTINCT or aggregation", expression)
+				}
+				if union {
+					return nil, nil, fmt.Errorf("ORDER BY expression %s must be projected in a query combined by UNION", expression)
+				}
+
+				sortItem.Expressions = projectionColumns(expression, projectionVisitor.ExpressionType)
+				for i := range sortItem.Expressions {
+					sortItem.Positions = append(sortItem.Positions, columnsCount+i+1)
+				}
+				columnsCount += len(sortItem.Expressions)
+				projections = append(projections, sortItem.Expressions...)
+			}
+		}
+
+		if projectionIdx != -1 {
+			sortItem.Expressions = columns[projectionIdx]
+			for i := range columns[projectionIdx] {
+				sortItem.Positions = append(sortItem.Positions, positions[projectionIdx]+i)
+			}
+		}
+		sortItems = append(sortItems, sortItem)
+	}
+	return sortItems, projections, nil
+}
+
+// findProjectedColumn return the position of the projected column computed by the expression
+func findProjectedColumn(columns [][]string, positions []int, expression string) (int, bool) {
+	for i := range columns {
+		for j := range columns[i] {
+			if columns[i][j] == expression {
+				return positions[i] + j, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// buildOrderBy build the ORDER BY clause either with the expressions or with the positions of the columns
+func buildOrderBy(sortItems []SortItem, usePositions bool) string {
+	if len(sortItems) == 0 {
+		return ""
+	}
+
+	direction := func(descending bool) string {
+		if descending {
+			return " DESC"
+		}
+		return ""
+	}
+
+	orderBy := []string{}
+	for _, s := range sortItems {
+		if usePositions {
+			for _, p := range s.Positions {
+				orderBy = append(orderBy, strconv.Itoa(p)+direction(s.Descending))
+			}
+		} else {
+			for _, e := range s.Expressions {
+				orderBy = append(orderBy, e+direction(s.Descending))
+			}
+		}
+	}
+	return fmt.Sprintf("\nORDER BY %s", strings.Join(orderBy, ", "))
+}
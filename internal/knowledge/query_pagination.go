@@ -0,0 +1,309 @@
+package knowledge
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// ErrInvalidPageToken is returned when the page token cannot be decoded
+var ErrInvalidPageToken = fmt.Errorf("Invalid page token")
+
+// ErrPageTokenMismatch is returned when the page token comes from another query
+var ErrPageTokenMismatch = fmt.Errorf("Page token does not match the query")
+
+// QueryPage locates a page of the results of a query
+type QueryPage struct {
+	// Size is the maximum number of rows of the page
+	Size int `json:"s"`
+	// After are the ids of the projected assets of the last row of the previous page when the rows are
+	// paginated by keyset, the page starts at the first row whose ids are not lower
+	After []int64 `json:"a,omitempty"`
+	// Offset is the number of rows to skip from the start of the page. When paginating by keyset, those are
+	// the rows with the same ids as the last row of the previous page which have already been returned.
+	Offset int `json:"o,omitempty"`
+}
+
+// pageToken is the position of the next page of results of a query
+type pageToken struct {
+	// Fingerprint identifies the query and its parameters
+	Fingerprint string `json:"f"`
+	QueryPage
+}
+
+// queryFingerprint identify the query and the values of its parameters so that the token of a page can only be
+// used to read the results of the query it comes from
+func queryFingerprint(cypherQuery string, parameters map[string]interface{}) (string, error) {
+	// The keys of the maps are sorted by the encoder, the fingerprint is therefore stable.
+	p, err := json.Marshal(parameters)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(strings.TrimSpace(cypherQuery)))
+	h.Write([]byte{0})
+	h.Write(p)
+	return hex.EncodeToString(h.Sum(nil))[:16], nil
+}
+
+// encodePageToken encode the position of a page into an opaque token
+func encodePageToken(token pageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// decodePageToken decode the position of the page of the token and check it comes from the query
+func decodePageToken(encoded string, fingerprint string) (*QueryPage, error) {
+	b, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	token := pageToken{}
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	if token.Fingerprint != fingerprint {
+		return nil, ErrPageTokenMismatch
+	}
+	if token.Size <= 0 || token.Offset < 0 {
+		return nil, ErrInvalidPageToken
+	}
+	return &token.QueryPage, nil
+}
+
+// keysetProjections return the indices of the projections whose ids locate the pages by keyset. The rows are
+// paginated this way when every projection is a required node so that the rows sharing the same ids are
+// identical. Nil is returned when the rows must be paginated by offset.
+func (sqt *SQLQueryTranslator) keysetProjections(body query.QueryProjectionBody, aggregation bool) []int {
+	if len(body.Order) > 0 || body.Limit != nil || body.Skip != nil || aggregation {
+		return nil
+	}
+
+	keys := []int{}
+	for i, p := range body.ProjectionItems {
+		atom, ok := expressionAtom(&p.Expression)
+		if !ok || atom.Variable == nil {
+			return nil
+		}
+		typeAndIndex, err := sqt.QueryGraph.FindVariable(*atom.Variable)
+		if err != nil || typeAndIndex.Type != NodeType ||
+			sqt.QueryGraph.Nodes[typeAndIndex.Index].OptionalGroup > 0 {
+			return nil
+		}
+		keys = append(keys, i)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return keys
+}
+
+// paginate restrict the results of the final part of the query to the requested page. The rows are sorted by
+// the ids of the projected assets and the page starts after the ids of the last row of the previous page when
+// possible, so that any page costs as much as the first one. Otherwise the rows are sorted by all their columns
+// unless the query sorts them and the page starts at its offset. One more row than the size of the page is
+// fetched to tell whether there is a next page.
+func (sqt *SQLQueryTranslator) paginate(body query.QueryProjectionBody, projections []string,
+	projectionTypes []Projection, aggregation bool, orderBy []SortItem, limit int, offset int,
+	constraints *AndOrExpression) ([]SortItem, int, int, error) {
+	columns := make([][]string, 0)
+	positions := make([]int, 0)
+	columnsCount := 0
+	for i := range projectionTypes {
+		c := projectionColumns(projections[i], projectionTypes[i].ExpressionType)
+		columns = append(columns, c)
+		positions = append(positions, columnsCount+1)
+		columnsCount += len(c)
+	}
+
+	keys := sqt.keysetProjections(body, aggregation)
+	if keys != nil {
+		keyColumns := []string{}
+		orderBy = []SortItem{}
+		for _, k := range keys {
+			// The first column of a projected asset is its id.
+			keyColumns = append(keyColumns, columns[k][0])
+			orderBy = append(orderBy, SortItem{Expressions: columns[k][:1], Positions: positions[k : k+1]})
+		}
+
+		if len(sqt.Page.After) > 0 {
+			if len(sqt.Page.After) != len(keys) {
+				return nil, 0, 0, ErrPageTokenMismatch
+			}
+			values := []string{}
+			for _, id := range sqt.Page.After {
+				values = append(values, sqt.bindings.Bind(id))
+			}
+			expression := fmt.Sprintf("%s >= %s", keyColumns[0], values[0])
+			if len(keys) > 1 {
+				expression = fmt.Sprintf("(%s) >= (%s)", strings.Join(keyColumns, ", "), strings.Join(values, ", "))
+			}
+			constraints.Children = append(constraints.Children, AndOrExpression{Expression: expression})
+		}
+		sqt.pageKeys = keys
+		return orderBy, sqt.Page.Size + 1, sqt.Page.Offset, nil
+	}
+
+	if len(sqt.Page.After) > 0 {
+		return nil, 0, 0, ErrPageTokenMismatch
+	}
+
+	// The rows must be sorted the same way every time the query is run for the offsets to be consistent.
+	if len(orderBy) == 0 {
+		for i := range columns {
+			sortItem := SortItem{Expressions: columns[i]}
+			for j := range columns[i] {
+				sortItem.Positions = append(sortItem.Positions, positions[i]+j)
+			}
+			orderBy = append(orderBy, sortItem)
+		}
+	}
+
+	pageLimit := sqt.Page.Size + 1
+	// The pages are taken from the rows selected by the SKIP and LIMIT clauses of the query.
+	if limit > 0 {
+		remaining := limit - sqt.Page.Offset
+		if remaining <= 0 {
+			return nil, 0, 0, ErrPageTokenMismatch
+		}
+		if remaining < pageLimit {
+			pageLimit = remaining
+		}
+	}
+	return orderBy, pageLimit, offset + sqt.Page.Offset, nil
+}
+
+// paginateUnions restrict the results of queries combined by UNION clauses to the requested page, the rows are
+// sorted by all their columns and the page starts at its offset
+func (sqt *SQLQueryTranslator) paginateUnions(translation *SQLTranslation) error {
+	if len(sqt.Page.After) > 0 {
+		return ErrPageTokenMismatch
+	}
+
+	columnsCount := 0
+	for _, p := range translation.ProjectionTypes {
+		// The nodes and relations are projected with three columns.
+		if p.ExpressionType == NodeExprType || p.ExpressionType == EdgeExprType {
+			columnsCount += 3
+		} else {
+			columnsCount++
+		}
+	}
+	orderBy := []string{}
+	for i := 1; i <= columnsCount; i++ {
+		orderBy = append(orderBy, strconv.Itoa(i))
+	}
+
+	translation.Query += fmt.Sprintf("\nORDER BY %s\nLIMIT %d", strings.Join(orderBy, ", "), sqt.Page.Size+1)
+	if sqt.Page.Offset > 0 {
+		translation.Query += fmt.Sprintf("\nOFFSET %d", sqt.Page.Offset)
+	}
+	return nil
+}
+
+// pageCursor read the rows of a page of results and compute the position of the next page
+type pageCursor struct {
+	Cursor
+
+	page        QueryPage
+	fingerprint string
+	// keys are the indices of the projected assets locating the pages by keyset, nil when paginating by offset
+	keys []int
+
+	rowsCount   int
+	hasNextPage bool
+	// lastKeys are the ids of the assets of the last row read
+	lastKeys []int64
+	// tiesCount is the number of trailing rows read with the same ids as the last one
+	tiesCount int
+}
+
+// HasMore tells whether there are more rows in the page. The row following the page is only probed to tell
+// whether there is a next page.
+func (pc *pageCursor) HasMore() bool {
+	if pc.rowsCount >= pc.page.Size {
+		pc.hasNextPage = pc.hasNextPage || pc.Cursor.HasMore()
+		return false
+	}
+	return pc.Cursor.HasMore()
+}
+
+func (pc *pageCursor) Read(ctx context.Context, doc interface{}) error {
+	if err := pc.Cursor.Read(ctx, doc); err != nil {
+		return err
+	}
+	pc.rowsCount++
+	if pc.keys == nil {
+		return nil
+	}
+
+	row, ok := (*(doc.(*interface{}))).([]interface{})
+	if !ok {
+		return fmt.Errorf("Unable to read the ids of the row")
+	}
+	keys := []int64{}
+	for _, k := range pc.keys {
+		asset, ok := row[k].(AssetWithID)
+		if !ok {
+			return fmt.Errorf("Unable to read the id of column %d", k)
+		}
+		id, err := strconv.ParseInt(asset.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("Unable to read the id of column %d: %v", k, err)
+		}
+		keys = append(keys, id)
+	}
+
+	if equalKeys(keys, pc.lastKeys) {
+		pc.tiesCount++
+	} else {
+		pc.tiesCount = 1
+	}
+	pc.lastKeys = keys
+	return nil
+}
+
+// NextPage return the token of the next page of results once the rows of the page have been read, it is empty
+// when this page is the last one
+func (pc *pageCursor) NextPage() (string, error) {
+	if !pc.hasNextPage {
+		return "", nil
+	}
+
+	next := pageToken{
+		Fingerprint: pc.fingerprint,
+		QueryPage:   QueryPage{Size: pc.page.Size, Offset: pc.page.Offset + pc.rowsCount},
+	}
+	if pc.keys != nil {
+		next.After = pc.lastKeys
+		next.Offset = pc.tiesCount
+		// All the rows of the page have the same ids as the last row of the previous page.
+		if pc.tiesCount == pc.rowsCount && equalKeys(pc.lastKeys, pc.page.After) {
+			next.Offset += pc.page.Offset
+		}
+	}
+	return encodePageToken(next)
+}
+
+// equalKeys tells whether the ids of two rows are the same
+func equalKeys(keys1 []int64, keys2 []int64) bool {
+	if len(keys1) != len(keys2) {
+		return false
+	}
+	for i := range keys1 {
+		if keys1[i] != keys2[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryPath represent a path bound to a variable by a named pattern like p = (a)-->(b)
+type QueryPath struct {
+	// NodesIdx are the indices of the nodes of the pattern in the order of the pattern
+	NodesIdx []int
+	// RelationsIdx are the indices of the relations of the pattern in the order of the pattern
+	RelationsIdx []int
+}
+
+// PushPath bind the path matched by a pattern to a variable
+func (qg *QueryGraph) PushPath(name string, path QueryPath) (int, error) {
+	if _, ok := qg.VariablesIndex[name]; ok {
+		return -1, fmt.Errorf("Variable '%s' is already defined", name)
+	}
+
+	newIdx := len(qg.Paths)
+	qg.Paths = append(qg.Paths, path)
+	qg.VariablesIndex[name] = TypeAndIndex{
+		Type:  PathType,
+		Index: newIdx,
+	}
+	return newIdx, nil
+}
+
+// IsRelationInPath tells whether the relation is part of a path bound to a variable
+func (qg *QueryGraph) IsRelationInPath(relationIdx int) bool {
+	for _, p := range qg.Paths {
+		for _, idx := range p.RelationsIdx {
+			if idx == relationIdx {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PathSQL is the SQL of the parts of a path matched by the query, the functions on paths build their result from
+// the parts rather than from the JSON document representing the path
+type PathSQL struct {
+	// Nodes is the JSON array of the assets of the path
+	Nodes string
+	// Relations is the JSON array of the relations of the path
+	Relations string
+	// Length is the number of relations of the path
+	Length string
+}
+
+// pathSegments return the JSON objects of the assets and of the relations of a path. The variable-length
+// relations contribute the comma-separated objects of the assets and relations they traversed, the end node of the
+// relation being the last of the traversed assets.
+func pathSegments(qg *QueryGraph, path QueryPath) ([]string, []string) {
+	assets := []string{assetJSONObject(fmt.Sprintf("a%d", path.NodesIdx[0]))}
+	relations := []string{}
+	for i, relationIdx := range path.RelationsIdx {
+		alias := fmt.Sprintf("r%d", relationIdx)
+		if qg.Relations[relationIdx].VariableLength {
+			assets = append(assets, fmt.Sprintf("%s.path_assets", alias))
+			relations = append(relations, fmt.Sprintf("%s.path_relations", alias))
+			continue
+		}
+		assets = append(assets, assetJSONObject(fmt.Sprintf("a%d", path.NodesIdx[i+1])))
+		relations = append(relations, relationJSONObject(alias))
+	}
+	return assets, relations
+}
+
+// buildPathProjection build the JSON document representing a path
+func buildPathProjection(qg *QueryGraph, path QueryPath) string {
+	assets, relations := pathSegments(qg, path)
+	if len(relations) == 0 {
+		return fmt.Sprintf("CONCAT('{\"assets\":[', %s, '],\"relations\":[]}')", assets[0])
+	}
+	return fmt.Sprintf("CONCAT('{\"assets\":[', %s, '],\"relations\":[', %s, ']}')",
+		strings.Join(assets, ", ',', "), strings.Join(relations, ", ',', "))
+}
+
+// buildPathSQL build the SQL of the parts of a path
+func buildPathSQL(qg *QueryGraph, path QueryPath) *PathSQL {
+	assets, relations := pathSegments(qg, path)
+
+	fixedHops := 0
+	depths := []string{}
+	for _, relationIdx := range path.RelationsIdx {
+		if qg.Relations[relationIdx].VariableLength {
+			depths = append(depths, fmt.Sprintf("r%d.depth", relationIdx))
+		} else {
+			fixedHops++
+		}
+	}
+
+	length := fmt.Sprintf("%d", fixedHops)
+	if len(depths) > 0 {
+		if fixedHops > 0 {
+			depths = append([]string{length}, depths...)
+		}
+		length = strings.Join(depths, " + ")
+		if len(depths) > 1 {
+			length = fmt.Sprintf("(%s)", length)
+		}
+	}
+
+	return &PathSQL{
+		Nodes:     buildJSONArray(qg, path, assets),
+		Relations: buildJSONArray(qg, path, relations),
+		Length:    length,
+	}
+}
+
+// buildJSONArray build the JSON array of the objects of a path. The segments of the variable-length relations
+// being lists of objects already, the array is concatenated when the path has such relations.
+func buildJSONArray(qg *QueryGraph, path QueryPath, objects []string) string {
+	for _, relationIdx := range path.RelationsIdx {
+		if qg.Relations[relationIdx].VariableLength {
+			return fmt.Sprintf("CONCAT('[', %s, ']')", strings.Join(objects, ", ',', "))
+		}
+	}
+	return fmt.Sprintf("JSON_ARRAY(%s)", strings.Join(objects, ", "))
+}
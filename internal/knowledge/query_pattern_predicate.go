@@ -0,0 +1,158 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// isUndirectedRelationship tells whether the relationship can be matched in any direction
+func isUndirectedRelationship(r query.QueryRelationshipPattern) bool {
+	return r.LeftArrow == r.RightArrow
+}
+
+// buildPatternPredicate build the correlated subquery checking whether a pattern used as a predicate like
+// (h)-[:owned_by]->() matches. The variables of the pattern must be bound by the enclosing query. The anonymous
+// nodes without any constraint are not joined with the assets, the ends of the relations are enough.
+func buildPatternPredicate(queryGraph *QueryGraph, bindings *SQLBindings, pattern query.QueryPatternElement) (*SQLExpression, error) {
+	nodes := []query.QueryNodePattern{pattern.QueryNodePattern}
+	for _, c := range pattern.QueryPatternElementChains {
+		nodes = append(nodes, c.QueryNodePattern)
+	}
+
+	tables := []string{}
+	constraints := AndOrExpression{And: true}
+	// ids are the columns holding the ids of the nodes, empty until the node is bound to a column
+	ids := make([]string, len(nodes))
+
+	for i, n := range nodes {
+		undirected := (i > 0 && isUndirectedRelationship(pattern.QueryPatternElementChains[i-1].RelationshipPattern)) ||
+			(i < len(pattern.QueryPatternElementChains) && isUndirectedRelationship(pattern.QueryPatternElementChains[i].RelationshipPattern))
+
+		var alias string
+		if n.Variable != "" {
+			typeAndIndex, err := queryGraph.FindVariable(n.Variable)
+			if err != nil {
+				return nil, fmt.Errorf("Pattern predicates cannot introduce new variable '%s'", n.Variable)
+			}
+			if typeAndIndex.Type != NodeType {
+				return nil, fmt.Errorf("Variable '%s' is not a node", n.Variable)
+			}
+			alias = fmt.Sprintf("a%d", typeAndIndex.Index)
+		} else if len(n.Labels) > 0 || n.Properties != nil || undirected {
+			alias = fmt.Sprintf("pa%d", i)
+			tables = append(tables, fmt.Sprintf("assets %s", alias))
+		} else {
+			continue
+		}
+		ids[i] = fmt.Sprintf("%s.id", alias)
+
+		typesConstraints := AndOrExpression{And: false}
+		for _, label := range n.Labels {
+			typesConstraints.Children = append(typesConstraints.Children, AndOrExpression{
+				Expression: fmt.Sprintf("%s.type = %s", alias, bindings.Bind(label)),
+			})
+		}
+		if len(typesConstraints.Children) > 0 {
+			constraints.Children = append(constraints.Children, typesConstraints)
+		}
+		if n.Properties != nil {
+			propertiesConstraints, err := buildPropertiesConstraints(queryGraph, bindings, alias, "node",
+				nodeProperties, n.Properties)
+			if err != nil {
+				return nil, err
+			}
+			constraints.Children = append(constraints.Children, propertiesConstraints...)
+		}
+	}
+
+	// bindEnd bind the end of a relation to a node, the first relation reaching an unbound node binds it
+	bindEnd := func(nodeIdx int, column string) AndOrExpression {
+		if ids[nodeIdx] == "" {
+			ids[nodeIdx] = column
+			return AndOrExpression{}
+		}
+		return AndOrExpression{Expression: fmt.Sprintf("%s = %s", column, ids[nodeIdx])}
+	}
+
+	for i, c := range pattern.QueryPatternElementChains {
+		alias := fmt.Sprintf("pr%d", i)
+		tables = append(tables, fmt.Sprintf("relations %s", alias))
+
+		if detail := c.RelationshipPattern.RelationshipDetail; detail != nil {
+			if detail.Range != nil {
+				return nil, fmt.Errorf("Variable-length relationships are not supported in pattern predicates")
+			}
+			if detail.Variable != "" {
+				typeAndIndex, err := queryGraph.FindVariable(detail.Variable)
+				if err != nil {
+					return nil, fmt.Errorf("Pattern predicates cannot introduce new variable '%s'", detail.Variable)
+				}
+				if typeAndIndex.Type != RelationType || queryGraph.Relations[typeAndIndex.Index].VariableLength {
+					return nil, fmt.Errorf("Variable '%s' is not a relationship", detail.Variable)
+				}
+				constraints.Children = append(constraints.Children, AndOrExpression{
+					Expression: fmt.Sprintf("%s.id = r%d.id", alias, typeAndIndex.Index),
+				})
+			}
+
+			typesConstraints := AndOrExpression{And: false}
+			for _, label := range detail.Labels {
+				typesConstraints.Children = append(typesConstraints.Children, AndOrExpression{
+					Expression: fmt.Sprintf("%s.type = %s", alias, bindings.Bind(label)),
+				})
+			}
+			if len(typesConstraints.Children) > 0 {
+				constraints.Children = append(constraints.Children, typesConstraints)
+			}
+			if detail.Properties != nil {
+				propertiesConstraints, err := buildPropertiesConstraints(queryGraph, bindings, alias, "relationship",
+					relationProperties, detail.Properties)
+				if err != nil {
+					return nil, err
+				}
+				constraints.Children = append(constraints.Children, propertiesConstraints...)
+			}
+		}
+
+		// The nodes around an undirected relation are always bound since they are joined with the assets.
+		if isUndirectedRelationship(c.RelationshipPattern) {
+			constraints.Children = append(constraints.Children, AndOrExpression{
+				And: false,
+				Children: []AndOrExpression{
+					AndOrExpression{And: true, Children: []AndOrExpression{
+						bindEnd(i, fmt.Sprintf("%s.from_id", alias)),
+						bindEnd(i+1, fmt.Sprintf("%s.to_id", alias)),
+					}},
+					AndOrExpression{And: true, Children: []AndOrExpression{
+						bindEnd(i, fmt.Sprintf("%s.to_id", alias)),
+						bindEnd(i+1, fmt.Sprintf("%s.from_id", alias)),
+					}},
+				},
+			})
+			continue
+		}
+
+		fromIdx, toIdx := i, i+1
+		if c.RelationshipPattern.LeftArrow {
+			fromIdx, toIdx = i+1, i
+		}
+		constraints.Children = append(constraints.Children,
+			bindEnd(fromIdx, fmt.Sprintf("%s.from_id", alias)),
+			bindEnd(toIdx, fmt.Sprintf("%s.to_id", alias)))
+	}
+
+	where, err := BuildAndOrExpression(constraints)
+	if err != nil {
+		return nil, err
+	}
+	subquery := fmt.Sprintf("SELECT 1 FROM %s", strings.Join(tables, ", "))
+	if where != "" {
+		subquery += fmt.Sprintf(" WHERE %s", where)
+	}
+
+	predicate := NewSQLAtom(fmt.Sprintf("EXISTS (%s)", subquery), PropertyExprType)
+	predicate.PatternPredicate = true
+	return predicate, nil
+}
@@ -0,0 +1,143 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// QueryMode tells whether a query is run, explained or profiled
+type QueryMode int
+
+const (
+	// RunMode runs the query
+	RunMode QueryMode = iota
+	// ExplainMode describes how the query would be run without running it
+	ExplainMode QueryMode = iota
+	// ProfileMode runs the query and measures the time spent in each phase
+	ProfileMode QueryMode = iota
+)
+
+var queryModePrefixRegexp = regexp.MustCompile(`(?i)^\s*(EXPLAIN|PROFILE)\s+`)
+
+// parseQueryMode return the mode requested by the EXPLAIN or PROFILE prefix of the query and the query without it
+func parseQueryMode(queryString string) (QueryMode, string) {
+	match := queryModePrefixRegexp.FindStringSubmatch(queryString)
+	if match == nil {
+		return RunMode, queryString
+	}
+	mode := ExplainMode
+	if strings.ToUpper(match[1]) == "PROFILE" {
+		mode = ProfileMode
+	}
+	return mode, queryString[len(match[0]):]
+}
+
+// QueryPlan describes how a cypher query is run against the database
+type QueryPlan struct {
+	Translation SQLTranslation `json:"translation"`
+	// QueryGraph is the summary of the graph matched by the last part of the query
+	QueryGraph QueryGraphSummary `json:"query_graph"`
+	// DatabasePlan is the execution plan of the SQL query computed by the database
+	DatabasePlan json.RawMessage `json:"database_plan"`
+}
+
+// QueryNodeSummary describes a node of the query graph
+type QueryNodeSummary struct {
+	Variable      string   `json:"variable,omitempty"`
+	Labels        []string `json:"labels"`
+	OptionalGroup int      `json:"optional_group"`
+}
+
+// QueryRelationSummary describes a relation of the query graph, the nodes being referenced by index
+type QueryRelationSummary struct {
+	Variable       string   `json:"variable,omitempty"`
+	Labels         []string `json:"labels"`
+	LeftIdx        int      `json:"left"`
+	RightIdx       int      `json:"right"`
+	Direction      string   `json:"direction"`
+	VariableLength bool     `json:"variable_length"`
+	MinHops        int      `json:"min_hops,omitempty"`
+	MaxHops        int      `json:"max_hops,omitempty"`
+	OptionalGroup  int      `json:"optional_group"`
+}
+
+// QueryGraphSummary describes the nodes and relations of a query graph
+type QueryGraphSummary struct {
+	Nodes     []QueryNodeSummary     `json:"nodes"`
+	Relations []QueryRelationSummary `json:"relations"`
+	// Variables are the names of the variables bound in the graph
+	Variables []string `json:"variables"`
+}
+
+var relationDirectionNames = map[RelationDirection]string{
+	Left:   "left",
+	Right:  "right",
+	Either: "either",
+	Both:   "both",
+}
+
+// Summarize describe the nodes and relations of the query graph
+func (qg *QueryGraph) Summarize() QueryGraphSummary {
+	summary := QueryGraphSummary{
+		Nodes:     []QueryNodeSummary{},
+		Relations: []QueryRelationSummary{},
+		Variables: []string{},
+	}
+	for _, n := range qg.Nodes {
+		summary.Nodes = append(summary.Nodes, QueryNodeSummary{
+			Labels:        n.Labels,
+			OptionalGroup: n.OptionalGroup,
+		})
+	}
+	for _, r := range qg.Relations {
+		summary.Relations = append(summary.Relations, QueryRelationSummary{
+			Labels:         r.Labels,
+			LeftIdx:        r.LeftIdx,
+			RightIdx:       r.RightIdx,
+			Direction:      relationDirectionNames[r.Direction],
+			VariableLength: r.VariableLength,
+			MinHops:        r.MinHops,
+			MaxHops:        r.MaxHops,
+			OptionalGroup:  r.OptionalGroup,
+		})
+	}
+	for name, typeAndIndex := range qg.VariablesIndex {
+		switch typeAndIndex.Type {
+		case NodeType:
+			summary.Nodes[typeAndIndex.Index].Variable = name
+		case RelationType:
+			summary.Relations[typeAndIndex.Index].Variable = name
+		}
+		summary.Variables = append(summary.Variables, name)
+	}
+	sort.Strings(summary.Variables)
+	return summary
+}
+
+// emptyCursor is the cursor of an explained query, there is no result since the query is not run
+type emptyCursor struct{}
+
+func (ec *emptyCursor) HasMore() bool                                   { return false }
+func (ec *emptyCursor) Read(ctx context.Context, doc interface{}) error { return nil }
+func (ec *emptyCursor) Close() error                                    { return nil }
+
+// profilingCursor measure the time spent reading the results of a profiled query and count them
+type profilingCursor struct {
+	Cursor
+
+	statistics *Statistics
+}
+
+func (pc *profilingCursor) Read(ctx context.Context, doc interface{}) error {
+	var err error
+	pc.statistics.Fetching += MeasureDuration(func() {
+		err = pc.Cursor.Read(ctx, doc)
+	})
+	if err == nil {
+		pc.statistics.RowsCount++
+	}
+	return err
+}
@@ -0,0 +1,222 @@
+package knowledge
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/utils"
+)
+
+// tableAliasRegexp matches the aliases of the assets, relations and intermediate results referenced by a constraint
+var tableAliasRegexp = regexp.MustCompile(`\b([ar]\d+|w\d+)\.`)
+
+// joinConstraint is a constraint of the query and the aliases of the tables it references
+type joinConstraint struct {
+	Expression AndOrExpression
+	Aliases    []string
+}
+
+// tableAlias return the alias of a table of the FROM clause like "assets a0" or "w0"
+func tableAlias(table string) string {
+	fields := strings.Fields(table)
+	return fields[len(fields)-1]
+}
+
+// flattenConstraints split the conjunction into the constraints it is made of
+func flattenConstraints(tree AndOrExpression) ([]joinConstraint, error) {
+	if tree.Expression == "" && (tree.And || len(tree.Children) == 1) {
+		constraints := []joinConstraint{}
+		for _, c := range tree.Children {
+			children, err := flattenConstraints(c)
+			if err != nil {
+				return nil, err
+			}
+			constraints = append(constraints, children...)
+		}
+		return constraints, nil
+	}
+
+	expression, err := BuildAndOrExpression(tree)
+	if err != nil {
+		return nil, err
+	}
+	if expression == "" {
+		return nil, nil
+	}
+	aliases := []string{}
+	for _, match := range tableAliasRegexp.FindAllStringSubmatch(expression, -1) {
+		if !utils.IsStringInSlice(match[1], aliases) {
+			aliases = append(aliases, match[1])
+		}
+	}
+	return []joinConstraint{{Expression: tree, Aliases: aliases}}, nil
+}
+
+// estimateRelationRows estimate the number of relations matched by a relation from the number of relations of each type
+func estimateRelationRows(relation QueryRelation, relationsCount map[string]int64) int64 {
+	var count int64
+	if len(relation.Labels) == 0 {
+		for _, c := range relationsCount {
+			count += c
+		}
+		return count
+	}
+	for _, label := range relation.Labels {
+		count += relationsCount[label]
+	}
+	return count
+}
+
+// estimateTableRows estimate the number of rows of a table of the FROM clause. The intermediate results are expected
+// to be the smallest tables since they are already filtered.
+func estimateTableRows(qg *QueryGraph, statistics *GraphStatistics, alias string) int64 {
+	var idx int
+	if _, err := fmt.Sscanf(alias, "a%d", &idx); err == nil && idx < len(qg.Nodes) {
+		return estimateNodeRows(qg.Nodes[idx], statistics.AssetsCount)
+	}
+	if _, err := fmt.Sscanf(alias, "r%d", &idx); err == nil && idx < len(qg.Relations) {
+		return estimateRelationRows(qg.Relations[idx], statistics.RelationsCount)
+	}
+	return 0
+}
+
+// joinNeighbors return the aliases of the tables each table is joined to by the query graph: the relations are
+// joined to their nodes and the nodes bound to the intermediate result are joined to it.
+func joinNeighbors(qg *QueryGraph, aliases []string) map[string][]string {
+	neighbors := make(map[string][]string)
+	link := func(alias1, alias2 string) {
+		neighbors[alias1] = append(neighbors[alias1], alias2)
+		neighbors[alias2] = append(neighbors[alias2], alias1)
+	}
+	for i, r := range qg.Relations {
+		link(fmt.Sprintf("r%d", i), fmt.Sprintf("a%d", r.LeftIdx))
+		link(fmt.Sprintf("r%d", i), fmt.Sprintf("a%d", r.RightIdx))
+	}
+	for _, alias := range aliases {
+		if !strings.HasPrefix(alias, "w") {
+			continue
+		}
+		for i, n := range qg.Nodes {
+			if n.Staged {
+				link(alias, fmt.Sprintf("a%d", i))
+			}
+		}
+	}
+	return neighbors
+}
+
+// planJoinOrder order the tables so that the join starts from the most constrained node or intermediate result
+// and then joins the table with the fewest estimated rows among the neighbors of the already joined tables.
+func planJoinOrder(qg *QueryGraph, statistics *GraphStatistics, tables []string) []int {
+	aliases := make([]string, len(tables))
+	rows := make([]int64, len(tables))
+	for i, t := range tables {
+		aliases[i] = tableAlias(t)
+		rows[i] = estimateTableRows(qg, statistics, aliases[i])
+	}
+	neighbors := joinNeighbors(qg, aliases)
+
+	joined := make(map[string]bool)
+	// isConnected tells whether the table is a neighbor of one of the joined tables
+	isConnected := func(alias string) bool {
+		for _, n := range neighbors[alias] {
+			if joined[n] {
+				return true
+			}
+		}
+		return false
+	}
+
+	order := []int{}
+	planned := make([]bool, len(tables))
+	for len(order) < len(tables) {
+		best := -1
+		for i := range tables {
+			if planned[i] {
+				continue
+			}
+			// The join starts from a node or an intermediate result, the relations being joined to them.
+			if len(order) == 0 && strings.HasPrefix(aliases[i], "r") {
+				continue
+			}
+			if len(order) > 0 && !isConnected(aliases[i]) {
+				continue
+			}
+			if best < 0 || rows[i] < rows[best] {
+				best = i
+			}
+		}
+		// The remaining tables are disconnected from the joined ones, the next one starts a new chain.
+		if best < 0 {
+			for i := range tables {
+				if !planned[i] && (best < 0 || rows[i] < rows[best]) {
+					best = i
+				}
+			}
+		}
+		planned[best] = true
+		joined[aliases[best]] = true
+		order = append(order, best)
+	}
+	return order
+}
+
+// planJoins build the explicit chain of joins of the required tables from the statistics of the graph. Each
+// constraint is checked when joining the last of the tables it references, the constraints of the first table
+// and the ones referencing no joined table are returned to be checked in the WHERE clause.
+func planJoins(qg *QueryGraph, statistics *GraphStatistics, tables []string,
+	constraints AndOrExpression) (string, AndOrExpression, error) {
+	flattened, err := flattenConstraints(constraints)
+	if err != nil {
+		return "", AndOrExpression{}, err
+	}
+
+	order := planJoinOrder(qg, statistics, tables)
+
+	where := AndOrExpression{And: true}
+	placed := make([]bool, len(flattened))
+	joined := make(map[string]bool)
+	// joinedConstraints return the constraints only referencing joined tables which are not placed yet
+	joinedConstraints := func() []AndOrExpression {
+		expressions := []AndOrExpression{}
+		for i, c := range flattened {
+			if placed[i] {
+				continue
+			}
+			bound := true
+			for _, a := range c.Aliases {
+				bound = bound && joined[a]
+			}
+			if bound {
+				placed[i] = true
+				expressions = append(expressions, c.Expression)
+			}
+		}
+		return expressions
+	}
+
+	from := tables[order[0]]
+	joined[tableAlias(from)] = true
+	where.Children = append(where.Children, joinedConstraints()...)
+
+	for _, idx := range order[1:] {
+		joined[tableAlias(tables[idx])] = true
+		condition, err := BuildAndOrExpression(AndOrExpression{And: true, Children: joinedConstraints()})
+		if err != nil {
+			return "", AndOrExpression{}, err
+		}
+		if condition == "" {
+			from += fmt.Sprintf("\nCROSS JOIN %s", tables[idx])
+		} else {
+			from += fmt.Sprintf("\nINNER JOIN %s ON %s", tables[idx], condition)
+		}
+	}
+
+	for i, c := range flattened {
+		if !placed[i] {
+			where.Children = append(where.Children, c.Expression)
+		}
+	}
+	return from, where, nil
+}
@@ -0,0 +1,265 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+	"github.com/clems4ever/go-graphkb/internal/schema"
+)
+
+// Procedure is a procedure the CALL clauses of the queries can call
+type Procedure struct {
+	// Name is the name of the procedure including its namespace, like db.labels
+	Name string
+	// Arguments are the names of the arguments of the procedure
+	Arguments []string
+	// Fields are the names of the fields of the rows returned by the procedure
+	Fields []string
+	// Call return the rows of the procedure, their values are strings or numbers
+	Call func(ctx context.Context, arguments []interface{}) ([][]interface{}, error)
+}
+
+// ProcedureResult is the rows returned by the procedure called by a query
+type ProcedureResult struct {
+	Procedure string
+	Fields    []string
+	Rows      [][]interface{}
+}
+
+// ImporterLister list the importers with their authentication tokens
+type ImporterLister interface {
+	ListImporters(ctx context.Context) (map[string]string, error)
+}
+
+// ProcedureRegistry is the registry of the procedures the queries can call
+type ProcedureRegistry struct {
+	procedures map[string]Procedure
+}
+
+// NewProcedureRegistry create an empty registry of procedures
+func NewProcedureRegistry() *ProcedureRegistry {
+	return &ProcedureRegistry{procedures: make(map[string]Procedure)}
+}
+
+// Register register the procedure, it replaces the procedure with the same name if any
+func (pr *ProcedureRegistry) Register(procedure Procedure) {
+	pr.procedures[procedure.Name] = procedure
+}
+
+// Procedure return the procedure with the given name and whether it is registered
+func (pr *ProcedureRegistry) Procedure(name string) (Procedure, bool) {
+	procedure, ok := pr.procedures[name]
+	return procedure, ok
+}
+
+// RegisterBuiltins register the procedures describing the schemas of the sources and the content of the graph:
+// db.labels, db.relationshipTypes, db.schema, db.sources and db.importers
+func (pr *ProcedureRegistry) RegisterBuiltins(graphDB GraphDB, schemaPersistor schema.Persistor,
+	importers ImporterLister) {
+	// loadSchemas load the schema of each source, the sources are the importers and the manual source
+	loadSchemas := func(ctx context.Context) ([]string, map[string]schema.SchemaGraph, error) {
+		importersToToken, err := importers.ListImporters(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		sources := []string{ManualSource}
+		for name := range importersToToken {
+			sources = append(sources, name)
+		}
+		sort.Strings(sources)
+
+		schemas := make(map[string]schema.SchemaGraph)
+		for _, source := range sources {
+			sg, err := schemaPersistor.LoadSchema(ctx, source)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Unable to load schema of source %s: %v", source, err)
+			}
+			schemas[source] = sg
+		}
+		return sources, schemas, nil
+	}
+
+	// sortedRows return the rows made of a single distinct value, sorted
+	sortedRows := func(values map[string]bool) [][]interface{} {
+		sorted := []string{}
+		for v := range values {
+			sorted = append(sorted, v)
+		}
+		sort.Strings(sorted)
+
+		rows := [][]interface{}{}
+		for _, v := range sorted {
+			rows = append(rows, []interface{}{v})
+		}
+		return rows
+	}
+
+	pr.Register(Procedure{
+		Name:   "db.labels",
+		Fields: []string{"label"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			_, schemas, err := loadSchemas(ctx)
+			if err != nil {
+				return nil, err
+			}
+			labels := make(map[string]bool)
+			for _, sg := range schemas {
+				for _, a := range sg.Assets() {
+					labels[string(a)] = true
+				}
+			}
+			return sortedRows(labels), nil
+		},
+	})
+
+	pr.Register(Procedure{
+		Name:   "db.relationshipTypes",
+		Fields: []string{"relationshipType"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			_, schemas, err := loadSchemas(ctx)
+			if err != nil {
+				return nil, err
+			}
+			types := make(map[string]bool)
+			for _, sg := range schemas {
+				for _, r := range sg.Relations() {
+					types[string(r.Type)] = true
+				}
+			}
+			return sortedRows(types), nil
+		},
+	})
+
+	pr.Register(Procedure{
+		Name:   "db.schema",
+		Fields: []string{"source", "from_type", "relation_type", "to_type"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			sources, schemas, err := loadSchemas(ctx)
+			if err != nil {
+				return nil, err
+			}
+			rows := [][]interface{}{}
+			for _, source := range sources {
+				sg := schemas[source]
+				relations := sg.Relations()
+				sort.Slice(relations, func(i, j int) bool {
+					return fmt.Sprintf("%s %s %s", relations[i].FromType, relations[i].Type, relations[i].ToType) <
+						fmt.Sprintf("%s %s %s", relations[j].FromType, relations[j].Type, relations[j].ToType)
+				})
+				for _, r := range relations {
+					rows = append(rows, []interface{}{source, string(r.FromType), string(r.Type), string(r.ToType)})
+				}
+			}
+			return rows, nil
+		},
+	})
+
+	pr.Register(Procedure{
+		Name:   "db.sources",
+		Fields: []string{"source", "assets", "relations"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			statistics, err := graphDB.ReadSourcesStatistics(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to read statistics of the sources: %v", err)
+			}
+			sources := []string{}
+			for source := range statistics {
+				sources = append(sources, source)
+			}
+			sort.Strings(sources)
+
+			rows := [][]interface{}{}
+			for _, source := range sources {
+				rows = append(rows, []interface{}{
+					source, statistics[source].AssetsCount, statistics[source].RelationsCount,
+				})
+			}
+			return rows, nil
+		},
+	})
+
+	pr.Register(Procedure{
+		Name:   "db.importers",
+		Fields: []string{"name"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			importersToToken, err := importers.ListImporters(ctx)
+			if err != nil {
+				return nil, err
+			}
+			names := make(map[string]bool)
+			for name := range importersToToken {
+				names[name] = true
+			}
+			return sortedRows(names), nil
+		},
+	})
+}
+
+// queryCall return the CALL clause of the query, it can only be at the start of the query
+func queryCall(q *query.QueryCypher) *query.QueryCall {
+	if len(q.QueryParts) > 0 {
+		return q.QueryParts[0].Call
+	}
+	return q.Call
+}
+
+// callProcedure call the procedure of the CALL clause of the query, if any, so that its rows can be used by the
+// rest of the query. The arguments are literals or parameters. The procedure is not called when the query is
+// only explained.
+func (q *Querier) callProcedure(ctx context.Context, mode QueryMode, queryCypher *query.QueryCypher,
+	parameters map[string]interface{}) (*ProcedureResult, error) {
+	call := queryCall(queryCypher)
+	if call == nil {
+		return nil, nil
+	}
+
+	var procedure Procedure
+	ok := false
+	if q.Procedures != nil {
+		procedure, ok = q.Procedures.Procedure(call.Procedure)
+	}
+	if !ok {
+		return nil, fmt.Errorf("Unknown procedure %s", call.Procedure)
+	}
+	if len(call.Arguments) != len(procedure.Arguments) {
+		return nil, fmt.Errorf("Procedure %s expects %d arguments but %d were given", procedure.Name,
+			len(procedure.Arguments), len(call.Arguments))
+	}
+
+	arguments := []interface{}{}
+	for i := range call.Arguments {
+		atom, ok := expressionAtom(&call.Arguments[i])
+		switch {
+		case ok && atom.Parameter != nil:
+			value, ok := parameters[*atom.Parameter]
+			if !ok {
+				return nil, fmt.Errorf("Parameter $%s is not provided", *atom.Parameter)
+			}
+			arguments = append(arguments, value)
+		case ok && atom.Literal != nil && atom.Literal.String != nil:
+			arguments = append(arguments, *atom.Literal.String)
+		case ok && atom.Literal != nil && atom.Literal.Integer != nil:
+			arguments = append(arguments, *atom.Literal.Integer)
+		case ok && atom.Literal != nil && atom.Literal.Double != nil:
+			arguments = append(arguments, *atom.Literal.Double)
+		case ok && atom.Literal != nil && atom.Literal.Boolean != nil:
+			arguments = append(arguments, *atom.Literal.Boolean)
+		default:
+			return nil, fmt.Errorf("Argument '%s' of procedure %s must be a literal or a parameter",
+				procedure.Arguments[i], procedure.Name)
+		}
+	}
+
+	// Explaining the query must not run the procedure, the rows are only known once the query is run.
+	if mode == ExplainMode {
+		return &ProcedureResult{Procedure: procedure.Name, Fields: procedure.Fields}, nil
+	}
+
+	rows, err := procedure.Call(ctx, arguments)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to call procedure %s: %v", procedure.Name, err)
+	}
+	return &ProcedureResult{Procedure: procedure.Name, Fields: procedure.Fields, Rows: rows}, nil
+}
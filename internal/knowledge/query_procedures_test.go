@@ -0,0 +1,125 @@
+package knowledge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clems4ever/go-graphkb/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubImporterLister list the given importers
+type stubImporterLister struct {
+	importers map[string]string
+}
+
+func (sil *stubImporterLister) ListImporters(ctx context.Context) (map[string]string, error) {
+	return sil.importers, nil
+}
+
+func newProceduresQuerier(t *testing.T, db *stubGraphDB) *Querier {
+	persistor := &stubSchemaPersistor{schemas: make(map[string]schema.SchemaGraph)}
+	hosts := schema.NewSchemaGraph()
+	hosts.AddRelation(hosts.AddAsset("host"), "owned_by", hosts.AddAsset("user"))
+	require.NoError(t, persistor.SaveSchema(context.Background(), "hosts", hosts))
+	manual := schema.NewSchemaGraph()
+	manual.AddRelation(manual.AddAsset("host"), "tagged", manual.AddAsset("tag"))
+	require.NoError(t, persistor.SaveSchema(context.Background(), ManualSource, manual))
+
+	procedures := NewProcedureRegistry()
+	procedures.RegisterBuiltins(db, persistor, &stubImporterLister{importers: map[string]string{"hosts": "token"}})
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.Procedures = procedures
+	return querier
+}
+
+func TestShouldCallBuiltinProcedures(t *testing.T) {
+	cases := []struct {
+		Procedure string
+		Args      []interface{}
+	}{
+		{"db.labels", []interface{}{"host", "tag", "user"}},
+		{"db.relationshipTypes", []interface{}{"owned_by", "tagged"}},
+		{"db.schema", []interface{}{"hosts", "host", "owned_by", "user", ManualSource, "host", "tagged", "tag"}},
+		{"db.sources", []interface{}{"hosts", int64(3), int64(2)}},
+		{"db.importers", []interface{}{"hosts"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Procedure, func(t *testing.T) {
+			db := &stubGraphDB{sourcesStatistics: map[string]SourceStatistics{"hosts": {AssetsCount: 3, RelationsCount: 2}}}
+			querier := newProceduresQuerier(t, db)
+
+			_, err := querier.Query(context.Background(), "CALL "+c.Procedure+"()", nil)
+			require.NoError(t, err)
+			require.Len(t, db.queries, 1)
+			assert.Equal(t, c.Args, db.queries[0].Args)
+		})
+	}
+}
+
+func TestShouldComposeProcedureResultsWithReturn(t *testing.T) {
+	db := &stubGraphDB{}
+	querier := newProceduresQuerier(t, db)
+
+	res, err := querier.Query(context.Background(),
+		"CALL db.schema() YIELD source, relation_type AS type WHERE source <> $source RETURN type",
+		map[string]interface{}{"source": ManualSource})
+	require.NoError(t, err)
+	assert.Equal(t, []Projection{{Alias: "type", ExpressionType: PropertyExprType}}, res.Projections)
+	assert.Contains(t, db.queries[0].Query, "SELECT w0.v1 FROM w0\nWHERE w0.v0 <> ?")
+
+	_, err = querier.Query(context.Background(), "CALL db.unknown()", nil)
+	assert.EqualError(t, err, "Unknown procedure db.unknown")
+
+	_, err = querier.Query(context.Background(), "CALL db.labels('host')", nil)
+	assert.EqualError(t, err, "Procedure db.labels expects 0 arguments but 1 were given")
+}
+
+func TestShouldCallProcedureWithArguments(t *testing.T) {
+	db := &stubGraphDB{}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.Procedures = NewProcedureRegistry()
+	querier.Procedures.Register(Procedure{
+		Name:      "test.echo",
+		Arguments: []string{"value", "count"},
+		Fields:    []string{"value"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			rows := [][]interface{}{}
+			for i := int64(0); i < arguments[1].(int64); i++ {
+				rows = append(rows, []interface{}{arguments[0]})
+			}
+			return rows, nil
+		},
+	})
+
+	_, err := querier.Query(context.Background(), "CALL test.echo($value, 2)", map[string]interface{}{"value": "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"hello", "hello"}, db.queries[0].Args)
+
+	_, err = querier.Query(context.Background(), "CALL test.echo(1 + 1, 2)", nil)
+	assert.EqualError(t, err, "Argument 'value' of procedure test.echo must be a literal or a parameter")
+}
+
+func TestShouldNotCallProcedureWhenExplainingQuery(t *testing.T) {
+	db := &stubGraphDB{}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.Procedures = NewProcedureRegistry()
+	called := false
+	querier.Procedures.Register(Procedure{
+		Name:   "test.touch",
+		Fields: []string{"value"},
+		Call: func(ctx context.Context, arguments []interface{}) ([][]interface{}, error) {
+			called = true
+			return [][]interface{}{{"touched"}}, nil
+		},
+	})
+
+	res, err := querier.Query(context.Background(), "EXPLAIN CALL test.touch() YIELD value RETURN value", nil)
+	require.NoError(t, err)
+	assert.False(t, called)
+	assert.Empty(t, db.queries)
+	require.NotNil(t, res.Plan)
+	assert.Contains(t, res.Plan.Translation.Query, "SELECT NULL LIMIT 0")
+}
@@ -14,8 +14,12 @@ type ProjectionVisitor struct {
 	ExpressionType ExpressionType
 
 	funcInvoc  bool
+	funcType   ExpressionType
 	etype      ExpressionType
 	properties []string
+
+	// aggregationDepth is the number of aggregation functions the visited expression is nested in
+	aggregationDepth int
 }
 
 // ParseExpression return whether the expression require aggregation
@@ -27,13 +31,30 @@ func (pv *ProjectionVisitor) ParseExpression(q *query.QueryExpression) error {
 	return nil
 }
 
-func (pv *ProjectionVisitor) OnEnterFunctionInvocation(name string) error {
-	if name == "COUNT" {
-		pv.Aggregation = true
-		pv.funcInvoc = true
+func (pv *ProjectionVisitor) OnEnterFunctionInvocation(name string, distinct bool) error {
+	if !isAggregationFunction(name) {
+		if _, ok := scalarFunctions[name]; !ok {
+			return fmt.Errorf("Unknown function %s", name)
+		}
+		return nil
+	}
+	if pv.aggregationDepth > 0 {
+		return fmt.Errorf("Aggregation functions cannot be nested")
+	}
+	pv.aggregationDepth++
+	pv.Aggregation = true
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnExitFunctionInvocation(name string, distinct bool) error {
+	if isAggregationFunction(name) {
+		pv.aggregationDepth--
+		pv.funcType = SQLAggregation{Function: name}.ReturnType()
 	} else {
-		return fmt.Errorf("Function %s is not supported", name)
+		pv.funcType = scalarFunctions[name].ReturnType
 	}
+	// The arguments have been visited, the atom being exited is the function invocation itself.
+	pv.funcInvoc = true
 	return nil
 }
 
@@ -48,6 +69,10 @@ func (pv *ProjectionVisitor) OnVariable(name string) error {
 		pv.etype = NodeExprType
 	case RelationType:
 		pv.etype = EdgeExprType
+	case ValueType:
+		pv.etype = pv.QueryGraph.Values[typeAndIndex.Index].ExpressionType
+	case PathType:
+		pv.etype = PathExprType
 	default:
 		pv.etype = PropertyExprType
 	}
@@ -55,13 +80,88 @@ func (pv *ProjectionVisitor) OnVariable(name string) error {
 	return nil
 }
 
+func (pv *ProjectionVisitor) OnParameter(name string) error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnRelationshipsPattern(pattern query.QueryPatternElement) error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnStringLiteral(value string) error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnIntegerLiteral(value int64) error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnDoubleLiteral(value float64) error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnBooleanLiteral(value bool) error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnNullLiteral() error {
+	pv.etype = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnExitListLiteral() error {
+	// The elements have been visited, the atom being exited is the list itself.
+	pv.etype = ListExprType
+	pv.properties = nil
+	pv.funcInvoc = false
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnExitStringListNullOperatorExpression(e query.QueryStringListNullOperatorExpression) error {
+	if len(e.StringOperatorExpression) > 0 || len(e.ListOperatorExpression) > 0 || len(e.NullOperatorExpression) > 0 {
+		pv.ExpressionType = PropertyExprType
+	}
+	return nil
+}
+
+// The arithmetic operators are visited after their operands, the result of the operation is a property.
+func (pv *ProjectionVisitor) OnExitUnaryExpression(negation bool) error {
+	if negation {
+		pv.ExpressionType = PropertyExprType
+	}
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnPowerOfOperator() error {
+	pv.ExpressionType = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnMultiplyDivideModuloOperator(operator query.MultiplyDivideModuloOperator) error {
+	pv.ExpressionType = PropertyExprType
+	return nil
+}
+
+func (pv *ProjectionVisitor) OnAddOrSubtractOperator(operator query.AddOrSubtractOperator) error {
+	pv.ExpressionType = PropertyExprType
+	return nil
+}
+
 func (pv *ProjectionVisitor) OnVariablePropertiesPath(properties []string) error {
 	pv.properties = properties
 	return nil
 }
 
 func (pv *ProjectionVisitor) OnExitPropertyOrLabelsExpression(e query.QueryPropertyOrLabelsExpression) error {
-	if len(pv.properties) > 0 || pv.funcInvoc {
+	if pv.funcInvoc {
+		pv.ExpressionType = pv.funcType
+	} else if len(pv.properties) > 0 || len(e.Labels) > 0 {
 		pv.ExpressionType = PropertyExprType
 	} else {
 		pv.ExpressionType = pv.etype
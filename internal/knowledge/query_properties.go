@@ -0,0 +1,108 @@
+package knowledge
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// nodeProperties are the properties of the nodes which can be matched by a property map
+var nodeProperties = []string{"id", "value", "type"}
+
+// relationProperties are the properties of the relations which can be matched by a property map
+var relationProperties = []string{"id", "from_id", "to_id", "type", "source"}
+
+// pushNodeProperties add the constraints checking the properties of the pattern to the node
+func (sqt *SQLQueryTranslator) pushNodeProperties(nodeIdx int, properties *query.QueryProperties) error {
+	if properties == nil {
+		return nil
+	}
+	constraints, err := buildPropertiesConstraints(&sqt.QueryGraph, sqt.bindings, fmt.Sprintf("a%d", nodeIdx), "node",
+		nodeProperties, properties)
+	if err != nil {
+		return err
+	}
+	node := &sqt.QueryGraph.Nodes[nodeIdx]
+	node.Constraints.And = true
+	node.Constraints.Children = append(node.Constraints.Children, constraints...)
+	return nil
+}
+
+// pushRelationProperties add the constraints checking the properties of the pattern to the relation
+func (sqt *SQLQueryTranslator) pushRelationProperties(relationIdx int, detail *query.QueryRelationshipDetail) error {
+	if detail == nil || detail.Properties == nil {
+		return nil
+	}
+	relation := &sqt.QueryGraph.Relations[relationIdx]
+	if relation.VariableLength {
+		return fmt.Errorf("Properties of variable-length relationships are not supported")
+	}
+
+	constraints, err := buildPropertiesConstraints(&sqt.QueryGraph, sqt.bindings, fmt.Sprintf("r%d", relationIdx),
+		"relationship", relationProperties, detail.Properties)
+	if err != nil {
+		return err
+	}
+	relation.Constraints.And = true
+	relation.Constraints.Children = append(relation.Constraints.Children, constraints...)
+	return nil
+}
+
+// buildPropertiesConstraints build the constraints checking that the node or the relation with the given alias has
+// the properties of the pattern. The constraints are ordered by property key so that the query is deterministic.
+func buildPropertiesConstraints(queryGraph *QueryGraph, bindings *SQLBindings, alias string, kind string,
+	allowedProperties []string, properties *query.QueryProperties) ([]AndOrExpression, error) {
+	values := make(map[string]*SQLExpression)
+
+	if properties.Parameter != nil {
+		markers, err := bindings.BindMapParameter(*properties.Parameter)
+		if err != nil {
+			return nil, err
+		}
+		for key, marker := range markers {
+			values[key] = NewSQLAtom(marker, PropertyExprType)
+		}
+	}
+
+	for i := range properties.Entries {
+		entry := &properties.Entries[i]
+		if _, ok := values[entry.Key]; ok {
+			return nil, fmt.Errorf("Property '%s' is given more than once", entry.Key)
+		}
+		value, err := NewExpressionBuilder(queryGraph, bindings).BuildSQLExpression(&entry.Expression)
+		if err != nil {
+			return nil, err
+		}
+		if value.Type != PropertyExprType {
+			return nil, fmt.Errorf("Value of property '%s' must be a literal, a parameter or a property", entry.Key)
+		}
+		values[entry.Key] = value
+	}
+
+	keys := []string{}
+	for key := range values {
+		allowed := false
+		for _, p := range allowedProperties {
+			allowed = allowed || p == key
+		}
+		if !allowed {
+			return nil, fmt.Errorf("Property '%s' does not exist on a %s, expected one of %s", key, kind,
+				strings.Join(allowedProperties, ", "))
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	constraints := []AndOrExpression{}
+	for _, key := range keys {
+		property := NewSQLAtom(fmt.Sprintf("%s.%s", alias, key), PropertyExprType)
+		comparison, err := buildComparison(query.Equal, property, values[key])
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, AndOrExpression{Expression: comparison.String()})
+	}
+	return constraints, nil
+}
@@ -0,0 +1,137 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultQueryTimeout is the time a query can run when no timeout is configured
+const DefaultQueryTimeout = 30 * time.Second
+
+// DefaultMaxQueryTimeout is the maximum timeout a query can request when no maximum is configured
+const DefaultMaxQueryTimeout = 5 * time.Minute
+
+// QueryTimeout return the timeout of a query. The requested timeout is used when set, otherwise the default one,
+// and it is capped by the maximum timeout. Unset timeouts are replaced by DefaultQueryTimeout and
+// DefaultMaxQueryTimeout.
+func QueryTimeout(requested time.Duration, defaultTimeout time.Duration, maxTimeout time.Duration) time.Duration {
+	if defaultTimeout <= 0 {
+		defaultTimeout = DefaultQueryTimeout
+	}
+	if maxTimeout <= 0 {
+		maxTimeout = DefaultMaxQueryTimeout
+	}
+
+	timeout := defaultTimeout
+	if requested > 0 {
+		timeout = requested
+	}
+	if timeout > maxTimeout {
+		timeout = maxTimeout
+	}
+	return timeout
+}
+
+// ErrQueryNotRunning is returned when canceling a query which is not running
+var ErrQueryNotRunning = fmt.Errorf("Query is not running")
+
+// ErrQueryOfAnotherUser is returned when canceling a query run by another user
+var ErrQueryOfAnotherUser = fmt.Errorf("Query has been run by another user")
+
+// RunningQuery is a query being run
+type RunningQuery struct {
+	ID    string `json:"id"`
+	User  string `json:"user"`
+	Query string `json:"query"`
+	// StartedAt is the time the query has been registered at
+	StartedAt time.Time `json:"started_at"`
+	// ElapsedMs is the time spent running the query when the running queries are listed
+	ElapsedMs int64 `json:"elapsed_ms"`
+
+	cancel context.CancelFunc
+	// seq is the order the query has been registered in
+	seq int64
+}
+
+// QueryRegistry keeps track of the queries being run so that they can be listed and canceled
+type QueryRegistry struct {
+	// administrator is the user who can list and cancel the queries of all the users
+	administrator string
+
+	mutex   sync.Mutex
+	queries map[string]*RunningQuery
+	nextID  int64
+}
+
+// NewQueryRegistry create a registry of running queries. The other users than the administrator can only list
+// and cancel their own queries.
+func NewQueryRegistry(administrator string) *QueryRegistry {
+	return &QueryRegistry{administrator: administrator, queries: make(map[string]*RunningQuery)}
+}
+
+// Register register the query run by the user. The query must be run with the returned context which is canceled
+// when the query is canceled, and the returned function must be called once the results have been read.
+func (qr *QueryRegistry) Register(ctx context.Context, user string, query string) (string, context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	qr.mutex.Lock()
+	defer qr.mutex.Unlock()
+
+	qr.nextID++
+	id := fmt.Sprintf("%d", qr.nextID)
+	qr.queries[id] = &RunningQuery{
+		ID:        id,
+		User:      user,
+		Query:     query,
+		StartedAt: time.Now(),
+		cancel:    cancel,
+		seq:       qr.nextID,
+	}
+
+	unregister := func() {
+		cancel()
+		qr.mutex.Lock()
+		defer qr.mutex.Unlock()
+		delete(qr.queries, id)
+	}
+	return id, ctx, unregister
+}
+
+// List return the running queries the user can see, the oldest first
+func (qr *QueryRegistry) List(user string) []RunningQuery {
+	qr.mutex.Lock()
+	defer qr.mutex.Unlock()
+
+	queries := make([]RunningQuery, 0, len(qr.queries))
+	for _, q := range qr.queries {
+		if user != qr.administrator && user != q.User {
+			continue
+		}
+		running := *q
+		running.ElapsedMs = int64(time.Since(q.StartedAt) / time.Millisecond)
+		queries = append(queries, running)
+	}
+	sort.Slice(queries, func(i, j int) bool {
+		return queries[i].seq < queries[j].seq
+	})
+	return queries
+}
+
+// Cancel cancel the running query on behalf of the user
+func (qr *QueryRegistry) Cancel(id string, user string) error {
+	qr.mutex.Lock()
+	defer qr.mutex.Unlock()
+
+	q, ok := qr.queries[id]
+	if !ok {
+		return ErrQueryNotRunning
+	}
+	if user != qr.administrator && user != q.User {
+		return ErrQueryOfAnotherUser
+	}
+	q.cancel()
+	return nil
+}
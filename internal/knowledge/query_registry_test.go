@@ -0,0 +1,75 @@
+package knowledge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShouldComputeQueryTimeout(t *testing.T) {
+	cases := []struct {
+		Requested time.Duration
+		Default   time.Duration
+		Max       time.Duration
+		Timeout   time.Duration
+	}{
+		{0, 0, 0, DefaultQueryTimeout},
+		{0, 10 * time.Second, 0, 10 * time.Second},
+		{time.Minute, 10 * time.Second, 0, time.Minute},
+		{time.Hour, 0, 0, DefaultMaxQueryTimeout},
+		{time.Hour, 0, 2 * time.Minute, 2 * time.Minute},
+		{0, time.Minute, 20 * time.Second, 20 * time.Second},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.Timeout, QueryTimeout(c.Requested, c.Default, c.Max))
+	}
+}
+
+func TestShouldListAndCancelRunningQueries(t *testing.T) {
+	registry := NewQueryRegistry("admin")
+
+	id1, ctx1, unregister1 := registry.Register(context.Background(), "admin", "MATCH (h:host) RETURN h")
+	id2, ctx2, unregister2 := registry.Register(context.Background(), "anonymous", "MATCH (u:user) RETURN u")
+	defer unregister2()
+
+	running := registry.List("admin")
+	require.Len(t, running, 2)
+	assert.Equal(t, id1, running[0].ID)
+	assert.Equal(t, "admin", running[0].User)
+	assert.Equal(t, "MATCH (h:host) RETURN h", running[0].Query)
+	assert.Equal(t, id2, running[1].ID)
+
+	assert.NoError(t, registry.Cancel(id2, "admin"))
+	assert.Equal(t, context.Canceled, ctx2.Err())
+	assert.NoError(t, ctx1.Err())
+
+	unregister1()
+	assert.Equal(t, ErrQueryNotRunning, registry.Cancel(id1, "admin"))
+	running = registry.List("admin")
+	require.Len(t, running, 1)
+	assert.Equal(t, id2, running[0].ID)
+}
+
+func TestShouldRestrictRunningQueriesToTheirUser(t *testing.T) {
+	registry := NewQueryRegistry("admin")
+
+	id1, ctx1, unregister1 := registry.Register(context.Background(), "admin", "MATCH (h:host) RETURN h")
+	defer unregister1()
+	id2, ctx2, unregister2 := registry.Register(context.Background(), "alice", "MATCH (u:user) RETURN u")
+	defer unregister2()
+
+	running := registry.List("alice")
+	require.Len(t, running, 1)
+	assert.Equal(t, id2, running[0].ID)
+	assert.Empty(t, registry.List("bob"))
+
+	assert.Equal(t, ErrQueryOfAnotherUser, registry.Cancel(id1, "alice"))
+	assert.NoError(t, ctx1.Err())
+	assert.Equal(t, ErrQueryOfAnotherUser, registry.Cancel(id2, "bob"))
+	assert.NoError(t, registry.Cancel(id2, "alice"))
+	assert.Equal(t, context.Canceled, ctx2.Err())
+}
@@ -0,0 +1,116 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// ShortestPathMode tells whether only the shortest paths of a variable-length relation are matched
+type ShortestPathMode int
+
+const (
+	// NoShortestPath matches all the paths of the relation
+	NoShortestPath ShortestPathMode = iota
+	// SingleShortestPath matches only one of the shortest paths between each pair of nodes
+	SingleShortestPath ShortestPathMode = iota
+	// AllShortestPaths matches all the shortest paths between each pair of nodes
+	AllShortestPaths ShortestPathMode = iota
+)
+
+var shortestPathFunctions = map[string]ShortestPathMode{
+	"SHORTESTPATH":     SingleShortestPath,
+	"ALLSHORTESTPATHS": AllShortestPaths,
+}
+
+// shortestPathInvocation return the function invocation and the mode if the expression is a call to
+// shortestPath or allShortestPaths
+func shortestPathInvocation(e *query.QueryExpression) (*query.QueryFunctionInvocation, ShortestPathMode, bool) {
+	atom, ok := expressionAtom(e)
+	if !ok || atom.FunctionInvocation == nil {
+		return nil, NoShortestPath, false
+	}
+	mode, ok := shortestPathFunctions[strings.ToUpper(atom.FunctionInvocation.FunctionName)]
+	if !ok {
+		return nil, NoShortestPath, false
+	}
+	return atom.FunctionInvocation, mode, true
+}
+
+// shortestPathRelationship return the relationship of the pattern given to shortestPath or allShortestPaths. The
+// relationship is variable-length, with exactly one hop if no range is given.
+func shortestPathRelationship(function string, pattern *query.QueryPatternElement) (query.QueryRelationshipPattern, error) {
+	if len(pattern.QueryPatternElementChains) != 1 {
+		return query.QueryRelationshipPattern{}, fmt.Errorf("Function %s expects a pattern with a single relationship", function)
+	}
+
+	relationship := pattern.QueryPatternElementChains[0].RelationshipPattern
+	if relationship.RelationshipDetail == nil {
+		relationship.RelationshipDetail = &query.QueryRelationshipDetail{}
+	} else {
+		detail := *relationship.RelationshipDetail
+		relationship.RelationshipDetail = &detail
+	}
+	if relationship.RelationshipDetail.Variable != "" {
+		return query.QueryRelationshipPattern{}, fmt.Errorf("Relationship of the pattern given to %s cannot be bound to a variable", function)
+	}
+	if relationship.RelationshipDetail.Range == nil {
+		one := int64(1)
+		relationship.RelationshipDetail.Range = &query.QueryRangeLiteral{Min: &one, Max: &one}
+	}
+	return relationship, nil
+}
+
+// shortestPathPattern return the mode of the function a pattern of a MATCH clause is given to and the pattern with
+// the relationship matching the paths
+func shortestPathPattern(pattern query.QueryPatternElement) (ShortestPathMode, query.QueryPatternElement, error) {
+	mode, ok := shortestPathFunctions[strings.ToUpper(pattern.ShortestPathFunction)]
+	if !ok {
+		return NoShortestPath, pattern, nil
+	}
+	relationship, err := shortestPathRelationship(pattern.ShortestPathFunction, &pattern)
+	if err != nil {
+		return NoShortestPath, pattern, err
+	}
+	pattern.QueryPatternElementChains = []query.QueryPatternElementChain{{
+		RelationshipPattern: relationship,
+		QueryNodePattern:    pattern.QueryPatternElementChains[0].QueryNodePattern,
+	}}
+	return mode, pattern, nil
+}
+
+// PushShortestPath push the pattern given to shortestPath or allShortestPaths in the query graph and
+// return the index of the relation matching the paths
+func (qg *QueryGraph) PushShortestPath(fn *query.QueryFunctionInvocation, mode ShortestPathMode) (int, error) {
+	if len(fn.Expressions) != 1 {
+		return -1, fmt.Errorf("Function %s expects exactly one pattern argument", fn.FunctionName)
+	}
+
+	atom, ok := expressionAtom(&fn.Expressions[0])
+	if !ok || atom.RelationshipsPattern == nil {
+		return -1, fmt.Errorf("Function %s expects a pattern argument", fn.FunctionName)
+	}
+
+	pattern := atom.RelationshipsPattern
+	relationship, err := shortestPathRelationship(fn.FunctionName, pattern)
+	if err != nil {
+		return -1, err
+	}
+	chain := pattern.QueryPatternElementChains[0]
+
+	_, leftIdx, err := qg.PushNode(pattern.QueryNodePattern)
+	if err != nil {
+		return -1, err
+	}
+	_, rightIdx, err := qg.PushNode(chain.QueryNodePattern)
+	if err != nil {
+		return -1, err
+	}
+	_, relationIdx, err := qg.PushRelation(relationship, leftIdx, rightIdx)
+	if err != nil {
+		return -1, err
+	}
+	qg.Relations[relationIdx].ShortestPath = mode
+	return relationIdx, nil
+}
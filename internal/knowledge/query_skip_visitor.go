@@ -1,15 +1,25 @@
 package knowledge
 
-import "github.com/clems4ever/go-graphkb/internal/query"
+import (
+	"fmt"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
 
 type QuerySkipVisitor struct {
 	ExpressionVisitorBase
 
+	// Parameters are the values of the parameters of the query
+	Parameters map[string]interface{}
+
 	Skip int64
 }
 
-// ParseExpression return whether the expression require aggregation
+// ParseExpression parse the number of rows of a SKIP clause
 func (qsv *QuerySkipVisitor) ParseExpression(q *query.QueryExpression) error {
+	if !isCountExpression(q) {
+		return fmt.Errorf("SKIP must be an integer literal or a parameter")
+	}
 	err := NewExpressionParser(qsv).ParseExpression(q)
 	if err != nil {
 		return err
@@ -21,3 +31,12 @@ func (qsv *QuerySkipVisitor) OnIntegerLiteral(value int64) error {
 	qsv.Skip = value
 	return nil
 }
+
+func (qsv *QuerySkipVisitor) OnParameter(name string) error {
+	value, err := countParameter(qsv.Parameters, name, "SKIP")
+	if err != nil {
+		return err
+	}
+	qsv.Skip = value
+	return nil
+}
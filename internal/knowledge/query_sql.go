@@ -2,17 +2,52 @@ package knowledge
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/clems4ever/go-graphkb/internal/query"
+	"github.com/clems4ever/go-graphkb/internal/utils"
 )
 
 type SQLQueryTranslator struct {
 	QueryGraph QueryGraph
+	// Graphs are the query graphs of every part of the query, including the parts combined by UNION clauses
+	Graphs []QueryGraph
+
+	// MaxHops is the maximum number of hops a variable-length relationship can traverse
+	MaxHops int
+	// Parameters are the values of the parameters ($name) of the query
+	Parameters map[string]interface{}
+	// Statistics are the number of assets and relations of each type, the joins of the required patterns are
+	// planned from them when they are provided
+	Statistics *GraphStatistics
+	// Page is the page of results to return, all the results are returned when not set
+	Page *QueryPage
+	// CallResult is the result of the procedure called by the CALL clause of the query, the procedure is called
+	// before the query is translated
+	CallResult *ProcedureResult
+
+	// bindings are the values bound to the placeholders of the query
+	bindings *SQLBindings
+
+	// ctes are the common table expressions the query relies on
+	ctes []string
+	// recursive is true when one of the common table expressions is recursive
+	recursive bool
+	// stage is the intermediate result the part being translated is built upon
+	stage *queryStage
+	// union is true when the results of several queries are combined by UNION clauses
+	union bool
+	// pageKeys are the indices of the projected assets whose ids locate the page, they are only set when the
+	// results are paginated by keyset
+	pageKeys []int
 }
 
 func NewSQLQueryTranslator() *SQLQueryTranslator {
-	return &SQLQueryTranslator{QueryGraph: NewQueryGraph()}
+	return &SQLQueryTranslator{
+		QueryGraph: NewQueryGraph(),
+		MaxHops:    DefaultMaxHops,
+	}
 }
 
 type AndOrExpression struct {
@@ -22,13 +57,15 @@ type AndOrExpression struct {
 }
 
 type Projection struct {
-	Alias          string
-	ExpressionType ExpressionType
+	Alias          string         `json:"alias"`
+	ExpressionType ExpressionType `json:"expression_type"`
 }
 
 type SQLTranslation struct {
-	Query           string
-	ProjectionTypes []Projection
+	Query string `json:"query"`
+	// Args are the values bound to the placeholders of the query
+	Args            []interface{} `json:"args"`
+	ProjectionTypes []Projection  `json:"projection_types"`
 }
 
 func BuildAndOrExpression(tree AndOrExpression) (string, error) {
@@ -118,20 +155,39 @@ func UnwindOrExpressions(tree AndOrExpression) ([]AndOrExpression, error) {
 	return nil, fmt.Errorf("Unable to detect kind of node")
 }
 
+// fromBuilder build the tables of the FROM clause of a query checking the constraints, it returns the
+// constraints left to check in the WHERE clause
+type fromBuilder func(constraints AndOrExpression) ([]string, AndOrExpression, error)
+
 func (sqt *SQLQueryTranslator) buildSQLSelect(
-	distinct bool, projections []string, projectionTypes []Projection, fromTables []string,
-	whereExpressions AndOrExpression, groupBy []int, limit int, offset int) (string, error) {
+	distinct bool, projections []string, projectionTypes []Projection, buildFrom fromBuilder,
+	whereExpressions AndOrExpression, groupBy []int, aggregations []*SQLAggregation,
+	orderBy []SortItem, limit int, offset int) (string, error) {
 	var sqlQuery string
 
+	aggregationRequired := false
+	for _, a := range aggregations {
+		aggregationRequired = aggregationRequired || a != nil
+	}
+
 	andExpressions, err := UnwindOrExpressions(whereExpressions)
 	if err != nil {
 		return "", err
 	}
+	// A row matching several branches of the union would be aggregated several times, hence the OR
+	// expressions are kept in the WHERE clause when aggregating.
+	if aggregationRequired && len(andExpressions) > 1 {
+		andExpressions = []AndOrExpression{whereExpressions}
+	}
 
 	if len(andExpressions) > 1 {
 		singleQueries := []string{}
 		for _, where := range andExpressions {
-			singleQuery, err := sqt.buildSingleSQLSelect(false, projections, fromTables, where, nil, 0, 0)
+			fromTables, where, err := buildFrom(where)
+			if err != nil {
+				return "", err
+			}
+			singleQuery, err := sqt.buildSingleSQLSelect(false, projections, fromTables, where, nil, nil, 0, 0)
 			if err != nil {
 				return "", err
 			}
@@ -143,15 +199,7 @@ func (sqt *SQLQueryTranslator) buildSQLSelect(
 			sqlQuery = strings.Join(singleQueries, "\nUNION ALL\n")
 		}
 
-		if len(groupBy) > 0 {
-			groupByProjections := []string{}
-			for i := range groupBy {
-				groupByProjections = append(groupByProjections, projections[groupBy[i]])
-			}
-
-			sqlQuery = fmt.Sprintf("SELECT %s FROM\n(%s)\nGROUP BY %s",
-				strings.Join(projections, ", "), sqlQuery, strings.Join(groupByProjections, ","))
-		}
+		sqlQuery += buildOrderBy(orderBy, true)
 
 		if limit > 0 {
 			sqlQuery += fmt.Sprintf("\nLIMIT %d", limit)
@@ -162,8 +210,11 @@ func (sqt *SQLQueryTranslator) buildSQLSelect(
 		}
 
 	} else {
-		and := AndOrExpression{And: true, Children: andExpressions}
-		singleQuery, err := sqt.buildSingleSQLSelect(distinct, projections, fromTables, and, groupBy, limit, offset)
+		fromTables, and, err := buildFrom(AndOrExpression{And: true, Children: andExpressions})
+		if err != nil {
+			return "", err
+		}
+		singleQuery, err := sqt.buildSingleSQLSelect(distinct, projections, fromTables, and, groupBy, orderBy, limit, offset)
 		if err != nil {
 			return "", err
 		}
@@ -175,7 +226,7 @@ func (sqt *SQLQueryTranslator) buildSQLSelect(
 
 func (sqt *SQLQueryTranslator) buildSingleSQLSelect(
 	distinct bool, projections []string, fromTables []string,
-	whereExpressions AndOrExpression, groupBy []int, limit int, offset int) (string, error) {
+	whereExpressions AndOrExpression, groupBy []int, orderBy []SortItem, limit int, offset int) (string, error) {
 
 	projectionsStr := ""
 	if distinct {
@@ -203,6 +254,8 @@ func (sqt *SQLQueryTranslator) buildSingleSQLSelect(
 		sqlQuery += fmt.Sprintf("\nGROUP BY %s", strings.Join(groupByProjection, ", "))
 	}
 
+	sqlQuery += buildOrderBy(orderBy, false)
+
 	if limit > 0 {
 		sqlQuery += fmt.Sprintf("\nLIMIT %d", limit)
 	}
@@ -214,67 +267,276 @@ func (sqt *SQLQueryTranslator) buildSingleSQLSelect(
 	return sqlQuery, nil
 }
 
+// buildRelationsUniquenessConstraints build the constraints preventing a relation from matching several
+// relationships of a MATCH clause. The constraints are indexed by the optional group they belong to.
+func buildRelationsUniquenessConstraints(queryGraph *QueryGraph, relationsIdx []int) map[int][]AndOrExpression {
+	constraints := make(map[int][]AndOrExpression)
+	for i, leftIdx := range relationsIdx {
+		for _, rightIdx := range relationsIdx[i+1:] {
+			left, right := queryGraph.Relations[leftIdx], queryGraph.Relations[rightIdx]
+			// The same variable can be used several times in a pattern and the variable-length relations
+			// are matched by paths instead of relations.
+			if leftIdx == rightIdx || left.VariableLength || right.VariableLength {
+				continue
+			}
+			// Relations of different types cannot be the same relation.
+			if len(left.Labels) > 0 && len(right.Labels) > 0 && !haveCommonLabel(left.Labels, right.Labels) {
+				continue
+			}
+			group := left.OptionalGroup
+			if right.OptionalGroup > group {
+				group = right.OptionalGroup
+			}
+			constraints[group] = append(constraints[group], AndOrExpression{
+				Expression: fmt.Sprintf("r%d.id <> r%d.id", leftIdx, rightIdx),
+			})
+		}
+	}
+	return constraints
+}
+
+// haveCommonLabel tells whether the two sets of labels have at least one label in common
+func haveCommonLabel(labels1 []string, labels2 []string) bool {
+	for _, l := range labels1 {
+		if utils.IsStringInSlice(l, labels2) {
+			return true
+		}
+	}
+	return false
+}
+
 func (sqt *SQLQueryTranslator) Translate(query *query.QueryCypher) (*SQLTranslation, error) {
-	andExpressions := AndOrExpression{And: true}
+	sqt.union = len(query.Unions) > 0
+	sqt.bindings = NewSQLBindings(sqt.Parameters)
+
+	translation, err := sqt.translateMultiPartQuery(query.QueryParts, &query.QuerySinglePartQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	if sqt.union {
+		if err := sqt.translateUnions(translation, query.Unions); err != nil {
+			return nil, err
+		}
+		if sqt.Page != nil {
+			if err := sqt.paginateUnions(translation); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(sqt.ctes) > 0 {
+		with := "WITH"
+		if sqt.recursive {
+			with = "WITH RECURSIVE"
+		}
+		translation.Query = fmt.Sprintf("%s %s\n%s", with, strings.Join(sqt.ctes, ",\n"), translation.Query)
+	}
+	translation.Query, translation.Args = sqt.bindings.Resolve(translation.Query)
+	return translation, nil
+}
+
+// expandStar return a copy of the query projecting every variable in scope, sorted by name, in place of the star
+func (sqt *SQLQueryTranslator) expandStar(q *query.QuerySinglePartQuery) (*query.QuerySinglePartQuery, error) {
+	variables := []string{}
+	for name := range sqt.QueryGraph.VariablesIndex {
+		variables = append(variables, name)
+	}
+	if len(variables) == 0 {
+		return nil, fmt.Errorf("Projecting all variables with * is not allowed when there is no variable in scope")
+	}
+	sort.Strings(variables)
+
+	expanded := *q
+	expanded.ProjectionBody.Star = false
+	expanded.ProjectionBody.ProjectionItems = []query.QueryProjectionItem{}
+	for _, v := range variables {
+		expanded.ProjectionBody.ProjectionItems = append(expanded.ProjectionBody.ProjectionItems,
+			query.QueryProjectionItem{Expression: variableExpression(v), Alias: v})
+	}
+	expanded.ProjectionBody.ProjectionItems = append(expanded.ProjectionBody.ProjectionItems,
+		q.ProjectionBody.ProjectionItems...)
+	return &expanded, nil
+}
+
+// translateSinglePartQuery translate a single part query into SQL. When the query is an intermediate part of a
+// multi-part query, nodes and relations are projected by id and the stage describing the result is returned.
+func (sqt *SQLQueryTranslator) translateSinglePartQuery(q *query.QuerySinglePartQuery,
+	intermediate bool) (*SQLTranslation, *queryStage, error) {
 	constrainedNodes := make(map[int]bool)
 
+	stageConstraints, err := sqt.bindStage()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	filterExpressions := AndOrExpression{And: true}
-	for _, x := range query.QuerySinglePartQuery.QueryMatches {
+	if sqt.stage != nil && sqt.stage.Where != nil {
+		whereExpression, err := NewExpressionBuilder(&sqt.QueryGraph, sqt.bindings).BuildConstraint(sqt.stage.Where)
+		if err != nil {
+			return nil, nil, err
+		}
+		filterExpressions.Children = append(filterExpressions.Children,
+			AndOrExpression{Expression: whereExpression})
+	}
+
+	optionalFilterExpressions := make(map[int]AndOrExpression)
+	uniquenessConstraints := make(map[int][]AndOrExpression)
+	for _, x := range q.QueryMatches {
+		firstNodeIdx, firstRelationIdx := len(sqt.QueryGraph.Nodes), len(sqt.QueryGraph.Relations)
+		matchRelations := []int{}
 		for _, y := range x.PatternElements {
+			shortestPath, y, err := shortestPathPattern(y)
+			if err != nil {
+				return nil, nil, err
+			}
+
 			_, i1, err := sqt.QueryGraph.PushNode(y.QueryNodePattern)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
+			}
+			path := QueryPath{NodesIdx: []int{i1}}
+			if y.Properties != nil {
+				if err := sqt.pushNodeProperties(i1, y.Properties); err != nil {
+					return nil, nil, err
+				}
+				constrainedNodes[i1] = true
 			}
 
 			for _, z := range y.QueryPatternElementChains {
 				_, i2, err := sqt.QueryGraph.PushNode(z.QueryNodePattern)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
+				}
+				if z.Properties != nil {
+					if err := sqt.pushNodeProperties(i2, z.Properties); err != nil {
+						return nil, nil, err
+					}
+					constrainedNodes[i2] = true
 				}
 
-				_, _, err = sqt.QueryGraph.PushRelation(z.RelationshipPattern, i1, i2)
+				_, relationIdx, err := sqt.QueryGraph.PushRelation(z.RelationshipPattern, i1, i2)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
+				}
+				if shortestPath != NoShortestPath {
+					sqt.QueryGraph.Relations[relationIdx].ShortestPath = shortestPath
+				}
+				if err := sqt.pushRelationProperties(relationIdx, z.RelationshipPattern.RelationshipDetail); err != nil {
+					return nil, nil, err
 				}
+				path.NodesIdx = append(path.NodesIdx, i2)
+				path.RelationsIdx = append(path.RelationsIdx, relationIdx)
+				matchRelations = append(matchRelations, relationIdx)
 				i1 = i2
 			}
+
+			if y.PathVariable != "" {
+				if _, err := sqt.QueryGraph.PushPath(y.PathVariable, path); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+
+		optionalGroup := 0
+		// An optional match introducing no node nor relationship keeps every row unchanged whether it matches
+		// or not, hence it is a no-op once its condition is checked to be valid.
+		noop := x.Optional && firstNodeIdx == len(sqt.QueryGraph.Nodes) &&
+			firstRelationIdx == len(sqt.QueryGraph.Relations)
+		if x.Optional && !noop {
+			optionalGroup = sqt.QueryGraph.PushOptionalGroup(firstNodeIdx, firstRelationIdx)
+		}
+		if !noop {
+			for group, constraints := range buildRelationsUniquenessConstraints(&sqt.QueryGraph, matchRelations) {
+				uniquenessConstraints[group] = append(uniquenessConstraints[group], constraints...)
+			}
 		}
 
 		if x.Where != nil {
 			whereVisitor := QueryWhereVisitor{}
-			whereExpression, err := whereVisitor.ParseExpression(x.Where, &sqt.QueryGraph)
+			whereExpression, err := whereVisitor.ParseExpression(x.Where, &sqt.QueryGraph, sqt.bindings)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
+			}
+			if noop {
+				continue
 			}
+
+			// The filters of an optional match are part of the join condition so that they do not drop the row.
+			if optionalGroup > 0 {
+				optionalFilterExpressions[optionalGroup] = AndOrExpression{Expression: whereExpression}
+				continue
+			}
+
 			for _, v := range whereVisitor.Variables {
 				typeAndIndex, err := sqt.QueryGraph.FindVariable(v)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				constrainedNodes[typeAndIndex.Index] = true
 			}
+			for _, p := range whereSelectivePredicates(&sqt.QueryGraph, x.Where) {
+				constraint, err := NewExpressionBuilder(&sqt.QueryGraph, sqt.bindings).BuildConstraint(&p.Predicate)
+				if err != nil {
+					return nil, nil, err
+				}
+				node := &sqt.QueryGraph.Nodes[p.NodeIdx]
+				node.WhereConstraints = append(node.WhereConstraints, AndOrExpression{Expression: constraint})
+			}
 			filterExpressions.Children = append(filterExpressions.Children,
 				AndOrExpression{Expression: whereExpression})
 		}
 	}
 
+	if q.ProjectionBody.Star {
+		if q, err = sqt.expandStar(q); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	projections := make([]string, 0)
 	projectionTypes := make([]Projection, 0)
-	from := make([]string, 0)
+	stageVariables := make([]stageVariable, 0)
 
 	unaggregatedProjectionItems := []int{}
 	aggregationRequired := false
+	aggregations := make([]*SQLAggregation, 0)
+
+	for i, p := range q.ProjectionBody.ProjectionItems {
+		// Shortest paths are matched by pushing their pattern in the query graph and projected as paths.
+		if fn, mode, ok := shortestPathInvocation(&p.Expression); ok {
+			relationIdx, err := sqt.QueryGraph.PushShortestPath(fn, mode)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			unaggregatedProjectionItems = append(unaggregatedProjectionItems, i)
+			projections = append(projections, buildVariableLengthPathProjection(
+				fmt.Sprintf("r%d", relationIdx), sqt.QueryGraph.Relations[relationIdx]))
+			projectionTypes = append(projectionTypes, Projection{
+				Alias:          p.Alias,
+				ExpressionType: PathExprType,
+			})
+			stageVariables = append(stageVariables, stageVariable{Name: p.Alias, ExpressionType: PathExprType})
+			aggregations = append(aggregations, nil)
+			continue
+		}
 
-	for i, p := range query.QuerySinglePartQuery.ProjectionBody.ProjectionItems {
 		projectionVisitor := ProjectionVisitor{QueryGraph: &sqt.QueryGraph}
 		err := projectionVisitor.ParseExpression(&p.Expression)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		projection, err := NewExpressionBuilder(&sqt.QueryGraph).Build(&p.Expression)
+		aggregation, _, err := sqt.buildAggregation(&p.Expression)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		aggregations = append(aggregations, aggregation)
+
+		projection, err := NewExpressionBuilder(&sqt.QueryGraph, sqt.bindings).Build(&p.Expression)
+		if err != nil {
+			return nil, nil, err
 		}
 
 		if !projectionVisitor.Aggregation {
@@ -283,45 +545,108 @@ func (sqt *SQLQueryTranslator) Translate(query *query.QueryCypher) (*SQLTranslat
 			aggregationRequired = true
 		}
 
+		variable := stageVariable{Name: p.Alias, ExpressionType: projectionVisitor.ExpressionType}
+		// Intermediate results only keep the ids of the nodes and relations, they are joined back in the next part.
+		if intermediate {
+			index := projectionVisitor.TypeAndIndex.Index
+			switch projectionVisitor.ExpressionType {
+			case NodeExprType:
+				projection = fmt.Sprintf("a%d.id", index)
+				variable.Labels = sqt.QueryGraph.Nodes[index].Labels
+				variable.Optional = sqt.QueryGraph.Nodes[index].OptionalGroup > 0
+			case EdgeExprType:
+				projection = fmt.Sprintf("r%d.id", index)
+				variable.Labels = sqt.QueryGraph.Relations[index].Labels
+				variable.Optional = sqt.QueryGraph.Relations[index].OptionalGroup > 0
+			}
+		}
+
 		projections = append(projections, projection)
 		projectionTypes = append(projectionTypes, Projection{
 			Alias:          p.Alias,
 			ExpressionType: projectionVisitor.ExpressionType,
 		})
+		stageVariables = append(stageVariables, variable)
 	}
 
 	if !aggregationRequired {
 		unaggregatedProjectionItems = nil
 	}
 
+	orderBy, projections, err := sqt.buildSortItems(q.ProjectionBody,
+		projections, projectionTypes, aggregationRequired, sqt.union && !intermediate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sqt.Graphs = append(sqt.Graphs, sqt.QueryGraph)
+
+	// Tables and constraints are grouped by optional match, the group 0 being the required one.
+	groupsTables := make([][]string, sqt.QueryGraph.OptionalGroupsCount+1)
+	groupsConstraints := make([]AndOrExpression, sqt.QueryGraph.OptionalGroupsCount+1)
+	for i := range groupsConstraints {
+		groupsConstraints[i] = AndOrExpression{And: true}
+	}
+
+	if sqt.stage != nil {
+		groupsTables[0] = append(groupsTables[0], sqt.stage.Name)
+		for group, constraints := range stageConstraints {
+			groupsConstraints[group].Children = append(groupsConstraints[group].Children, constraints...)
+		}
+	}
+
 	for i, n := range sqt.QueryGraph.Nodes {
 		alias := fmt.Sprintf("a%d", i)
-		from = append(from, fmt.Sprintf("assets %s", alias))
+		groupsTables[n.OptionalGroup] = append(groupsTables[n.OptionalGroup], fmt.Sprintf("assets %s", alias))
 
 		typesConstraints := AndOrExpression{And: false}
 		for _, label := range n.Labels {
 			typesConstraints.Children = append(typesConstraints.Children, AndOrExpression{
-				Expression: fmt.Sprintf("%s.type = '%s'", alias, label),
+				Expression: fmt.Sprintf("%s.type = %s", alias, sqt.bindings.Bind(label)),
 			})
 		}
 
 		if len(typesConstraints.Children) > 0 {
 			// Append assets constraints
-			andExpressions.Children = append(andExpressions.Children, typesConstraints)
+			groupsConstraints[n.OptionalGroup].Children = append(groupsConstraints[n.OptionalGroup].Children, typesConstraints)
+		}
+		if len(n.Constraints.Children) > 0 {
+			groupsConstraints[n.OptionalGroup].Children = append(groupsConstraints[n.OptionalGroup].Children, n.Constraints)
 		}
 	}
 	for i, r := range sqt.QueryGraph.Relations {
 		alias := fmt.Sprintf("r%d", i)
-		from = append(from, fmt.Sprintf("relations %s", alias))
+		constraints := &groupsConstraints[r.OptionalGroup]
+
+		if r.VariableLength {
+			r.WalkFromRight = walkFromRight(&sqt.QueryGraph, r)
+			sqt.QueryGraph.Relations[i].WalkFromRight = r.WalkFromRight
+			cteName := fmt.Sprintf("vr%d", len(sqt.ctes))
+			withPath := r.ShortestPath != NoShortestPath || sqt.QueryGraph.IsRelationInPath(i)
+			cte, err := sqt.buildVariableLengthCTE(cteName, r, withPath)
+			if err != nil {
+				return nil, nil, err
+			}
+			sqt.ctes = append(sqt.ctes, cte)
+			sqt.recursive = true
+			groupsTables[r.OptionalGroup] = append(groupsTables[r.OptionalGroup], fmt.Sprintf("%s %s", cteName, alias))
+			constraints.Children = append(constraints.Children, buildVariableLengthConstraints(cteName, alias, r))
+			continue
+		}
+
+		groupsTables[r.OptionalGroup] = append(groupsTables[r.OptionalGroup], fmt.Sprintf("relations %s", alias))
 
 		typesConstraints := AndOrExpression{And: false}
 		for _, label := range r.Labels {
 			typesConstraints.Children = append(typesConstraints.Children, AndOrExpression{
-				Expression: fmt.Sprintf("%s.type = '%s'", alias, label),
+				Expression: fmt.Sprintf("%s.type = %s", alias, sqt.bindings.Bind(label)),
 			})
 		}
 		if len(typesConstraints.Children) > 0 {
-			andExpressions.Children = append(andExpressions.Children, typesConstraints)
+			constraints.Children = append(constraints.Children, typesConstraints)
+		}
+		if len(r.Constraints.Children) > 0 {
+			constraints.Children = append(constraints.Children, r.Constraints)
 		}
 
 		out := AndOrExpression{
@@ -349,13 +674,13 @@ func (sqt *SQLQueryTranslator) Translate(query *query.QueryCypher) (*SQLTranslat
 		}
 
 		if r.Direction == Right {
-			andExpressions.Children = append(andExpressions.Children, out)
+			constraints.Children = append(constraints.Children, out)
 		} else if r.Direction == Left {
-			andExpressions.Children = append(andExpressions.Children, in)
-		} else if r.Direction == Either {
+			constraints.Children = append(constraints.Children, in)
+		} else if r.Direction.Undirected() {
 			oneDirectionOptimization := false
 			// Optimization: in this case, finding in any direction is sufficient.
-			if len(sqt.QueryGraph.Relations) == 1 {
+			if len(sqt.QueryGraph.Relations) == 1 && r.OptionalGroup == 0 {
 				nodesConstrained := false
 				for idx := range constrainedNodes {
 					if idx == r.LeftIdx {
@@ -368,7 +693,7 @@ func (sqt *SQLQueryTranslator) Translate(query *query.QueryCypher) (*SQLTranslat
 				if !nodesConstrained {
 					n, err := sqt.QueryGraph.FindNode(r.LeftIdx)
 					if err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 					if len(n.Labels) > 0 {
 						nodesConstrained = true
@@ -376,7 +701,7 @@ func (sqt *SQLQueryTranslator) Translate(query *query.QueryCypher) (*SQLTranslat
 
 					n, err = sqt.QueryGraph.FindNode(r.RightIdx)
 					if err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 					if len(n.Labels) > 0 {
 						nodesConstrained = true
@@ -386,61 +711,100 @@ func (sqt *SQLQueryTranslator) Translate(query *query.QueryCypher) (*SQLTranslat
 			}
 
 			if oneDirectionOptimization {
-				andExpressions.Children = append(andExpressions.Children, out)
+				constraints.Children = append(constraints.Children, out)
 			} else {
 				orExpression := AndOrExpression{
 					And:      false,
 					Children: []AndOrExpression{out, in},
 				}
-				andExpressions.Children = append(andExpressions.Children, orExpression)
+				constraints.Children = append(constraints.Children, orExpression)
 			}
 		}
 	}
 
+	for group, constraints := range uniquenessConstraints {
+		groupsConstraints[group].Children = append(groupsConstraints[group].Children, constraints...)
+	}
+
 	limit := 0
-	if query.QuerySinglePartQuery.ProjectionBody.Limit != nil {
-		limitVisitor := QueryLimitVisitor{}
+	if q.ProjectionBody.Limit != nil {
+		limitVisitor := QueryLimitVisitor{Parameters: sqt.Parameters}
 		err := limitVisitor.ParseExpression(
-			query.QuerySinglePartQuery.ProjectionBody.Limit)
+			q.ProjectionBody.Limit)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		limit = int(limitVisitor.Limit)
 	}
 
 	offset := 0
-	if query.QuerySinglePartQuery.ProjectionBody.Skip != nil {
+	if q.ProjectionBody.Skip != nil {
 		if limit == 0 {
-			return nil, fmt.Errorf("SKIP must be used in combination with limit")
+			return nil, nil, fmt.Errorf("SKIP must be used in combination with limit")
 		}
-		skipVisitor := QuerySkipVisitor{}
+		skipVisitor := QuerySkipVisitor{Parameters: sqt.Parameters}
 		err := skipVisitor.ParseExpression(
-			query.QuerySinglePartQuery.ProjectionBody.Skip)
+			q.ProjectionBody.Skip)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		offset = int(skipVisitor.Skip)
 	}
 
+	andExpressions := groupsConstraints[0]
 	if len(filterExpressions.Children) > 0 {
 		andExpressions.Children = append(andExpressions.Children, filterExpressions)
 	}
 
+	if sqt.Page != nil && !intermediate && !sqt.union {
+		orderBy, limit, offset, err = sqt.paginate(q.ProjectionBody, projections, projectionTypes,
+			aggregationRequired, orderBy, limit, offset, &andExpressions)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for group, filter := range optionalFilterExpressions {
+		groupsConstraints[group].Children = append(groupsConstraints[group].Children, filter)
+	}
+
+	// The required tables are joined in the order planned from the statistics of the graph when they are provided.
+	buildFrom := func(constraints AndOrExpression) ([]string, AndOrExpression, error) {
+		tables := groupsTables
+		if sqt.Statistics != nil && len(groupsTables[0]) > 1 {
+			joins, where, err := planJoins(&sqt.QueryGraph, sqt.Statistics, groupsTables[0], constraints)
+			if err != nil {
+				return nil, AndOrExpression{}, err
+			}
+			tables = append([][]string{{joins}}, groupsTables[1:]...)
+			constraints = where
+		}
+		from, err := buildFromTables(tables, groupsConstraints)
+		return from, constraints, err
+	}
+
 	sqlQuery, err := sqt.buildSQLSelect(
-		query.QuerySinglePartQuery.ProjectionBody.Distinct,
+		q.ProjectionBody.Distinct,
 		projections,
 		projectionTypes,
-		from,
+		buildFrom,
 		andExpressions,
 		unaggregatedProjectionItems,
+		aggregations,
+		orderBy,
 		limit, offset)
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	var stage *queryStage
+	if intermediate {
+		stage = &queryStage{Variables: stageVariables, ColumnsCount: len(projections)}
 	}
 
 	return &SQLTranslation{
 		Query:           sqlQuery,
 		ProjectionTypes: projectionTypes,
-	}, nil
+	}, stage, nil
 }
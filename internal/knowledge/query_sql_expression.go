@@ -0,0 +1,171 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SQLPrecedence is the precedence of a SQL operator, the higher the precedence the tighter the operator binds
+type SQLPrecedence int
+
+// The precedences follow the ones of MariaDB
+const (
+	OrPrecedence             SQLPrecedence = iota
+	XorPrecedence            SQLPrecedence = iota
+	AndPrecedence            SQLPrecedence = iota
+	NotPrecedence            SQLPrecedence = iota
+	ComparisonPrecedence     SQLPrecedence = iota
+	AdditivePrecedence       SQLPrecedence = iota
+	MultiplicativePrecedence SQLPrecedence = iota
+	UnaryPrecedence          SQLPrecedence = iota
+	AtomPrecedence           SQLPrecedence = iota
+)
+
+// SQLExpressionKind tells how the operands of a SQL expression are combined
+type SQLExpressionKind int
+
+const (
+	// AtomSQLExpression is an expression without operator like a column, a placeholder or a function call
+	AtomSQLExpression SQLExpressionKind = iota
+	// InfixSQLExpression is a chain of operands separated by the same operator like a AND b AND c
+	InfixSQLExpression SQLExpressionKind = iota
+	// PrefixSQLExpression is an operator applied to the operand following it like NOT a
+	PrefixSQLExpression SQLExpressionKind = iota
+	// PostfixSQLExpression is an operator applied to the operand preceding it like a IS NULL
+	PostfixSQLExpression SQLExpressionKind = iota
+	// ParenthesizedSQLExpression is an expression parenthesized in the query
+	ParenthesizedSQLExpression SQLExpressionKind = iota
+)
+
+// ValueKind is the kind of scalar value an expression evaluates to, when it can be told from the query
+type ValueKind int
+
+const (
+	// UnknownValueKind is the kind of the values which cannot be told like the values of a previous part
+	UnknownValueKind ValueKind = iota
+	// StringValueKind is the kind of strings like the values and the types of the nodes
+	StringValueKind ValueKind = iota
+	// IntegerValueKind is the kind of integers
+	IntegerValueKind ValueKind = iota
+	// FloatValueKind is the kind of decimal numbers
+	FloatValueKind ValueKind = iota
+)
+
+// SQLExpression is a node of the abstract syntax tree of a SQL expression. Parentheses are added when
+// rendering the tree wherever the precedence of the operators requires them.
+type SQLExpression struct {
+	Kind       SQLExpressionKind
+	Precedence SQLPrecedence
+	// Operator is the operator combining the operands, it is empty for atoms
+	Operator string
+	Operands []*SQLExpression
+	// SQL is the SQL of the atom
+	SQL string
+
+	// Type is the type of the value of the expression
+	Type ExpressionType
+	// Columns are the columns of the node or the relation when the expression is one
+	Columns []string
+	// Elements are the elements of the list when the expression is a list literal or a list parameter
+	Elements []*SQLExpression
+	// StringValue is the value of the string literal or the string parameter the expression is made of, if any
+	StringValue *string
+	// PatternPredicate tells whether the expression is a pattern used as a predicate like (a)-->(b)
+	PatternPredicate bool
+	// Path is the SQL of the parts of the path when the expression is a path matched by the query
+	Path *PathSQL
+	// ValueKind is the kind of the scalar value of the expression
+	ValueKind ValueKind
+}
+
+// NewSQLAtom create an atom of the given type
+func NewSQLAtom(sql string, expressionType ExpressionType) *SQLExpression {
+	return &SQLExpression{
+		Kind:       AtomSQLExpression,
+		Precedence: AtomPrecedence,
+		SQL:        sql,
+		Type:       expressionType,
+	}
+}
+
+// NewSQLInfixExpression combine the operands with a binary operator of the given precedence
+func NewSQLInfixExpression(operator string, precedence SQLPrecedence, operands ...*SQLExpression) *SQLExpression {
+	return &SQLExpression{
+		Kind:       InfixSQLExpression,
+		Precedence: precedence,
+		Operator:   operator,
+		Operands:   operands,
+		Type:       PropertyExprType,
+	}
+}
+
+// NewSQLPrefixExpression apply a unary operator of the given precedence to the operand following it
+func NewSQLPrefixExpression(operator string, precedence SQLPrecedence, operand *SQLExpression) *SQLExpression {
+	return &SQLExpression{
+		Kind:       PrefixSQLExpression,
+		Precedence: precedence,
+		Operator:   operator,
+		Operands:   []*SQLExpression{operand},
+		Type:       PropertyExprType,
+	}
+}
+
+// NewSQLPostfixExpression apply a unary operator of the given precedence to the operand preceding it
+func NewSQLPostfixExpression(operator string, precedence SQLPrecedence, operand *SQLExpression) *SQLExpression {
+	return &SQLExpression{
+		Kind:       PostfixSQLExpression,
+		Precedence: precedence,
+		Operator:   operator,
+		Operands:   []*SQLExpression{operand},
+		Type:       PropertyExprType,
+	}
+}
+
+// NewSQLParenthesizedExpression keep the parentheses of the query around the expression
+func NewSQLParenthesizedExpression(operand *SQLExpression) *SQLExpression {
+	return &SQLExpression{
+		Kind:       ParenthesizedSQLExpression,
+		Precedence: AtomPrecedence,
+		Operands:   []*SQLExpression{operand},
+		Type:       PropertyExprType,
+		ValueKind:  operand.ValueKind,
+	}
+}
+
+// String render the expression in SQL
+func (e *SQLExpression) String() string {
+	switch e.Kind {
+	case InfixSQLExpression:
+		operands := make([]string, len(e.Operands))
+		for i, o := range e.Operands {
+			// The operators are left-associative, an operand on the right of an operator of the same
+			// precedence is parenthesized to keep the order of evaluation.
+			if i == 0 {
+				operands[i] = o.Within(e.Precedence)
+			} else {
+				operands[i] = o.Within(e.Precedence + 1)
+			}
+		}
+		return strings.Join(operands, fmt.Sprintf(" %s ", e.Operator))
+	case PrefixSQLExpression:
+		operand := e.Operands[0].Within(e.Precedence)
+		if e.Precedence == UnaryPrecedence {
+			return e.Operator + operand
+		}
+		return fmt.Sprintf("%s %s", e.Operator, operand)
+	case PostfixSQLExpression:
+		return fmt.Sprintf("%s %s", e.Operands[0].Within(e.Precedence), e.Operator)
+	case ParenthesizedSQLExpression:
+		return fmt.Sprintf("(%s)", e.Operands[0].String())
+	}
+	return e.SQL
+}
+
+// Within render the expression as the operand of an operator of the given precedence, the expression
+// is parenthesized if its operator binds less tightly.
+func (e *SQLExpression) Within(precedence SQLPrecedence) string {
+	if e.Precedence < precedence {
+		return fmt.Sprintf("(%s)", e.String())
+	}
+	return e.String()
+}
@@ -10,10 +10,13 @@ import (
 )
 
 type QueryCase struct {
-	Cypher   string
-	SQL      string
-	Error    string
-	Selected bool
+	Cypher string
+	SQL    string
+	Args   []interface{}
+	Error  string
+	// Parameters are the values of the parameters of the query
+	Parameters map[string]interface{}
+	Selected   bool
 }
 
 func TestQueryTranslation(t *testing.T) {
@@ -21,7 +24,8 @@ func TestQueryTranslation(t *testing.T) {
 		QueryCase{
 			Cypher: "MATCH (n:ip) RETURN n",
 			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
-WHERE a0.type = 'ip'`,
+WHERE a0.type = ?`,
+			Args: []interface{}{"ip"},
 		},
 		QueryCase{
 			Cypher: "MATCH (n:ip), (n:name) RETURN n",
@@ -30,84 +34,92 @@ WHERE a0.type = 'ip'`,
 		QueryCase{
 			Cypher: "MATCH (n:ip) RETURN n, n",
 			SQL: `SELECT a0.id, a0.value, a0.type, a0.id, a0.value, a0.type FROM assets a0
-WHERE a0.type = 'ip'`,
+WHERE a0.type = ?`,
+			Args: []interface{}{"ip"},
 		},
 		QueryCase{
 			Cypher: "MATCH (n) WHERE n.value = 'prod' RETURN n",
-			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value = 'prod'",
+			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value = ?",
+			Args:   []interface{}{"prod"},
 		},
 		QueryCase{
 			Cypher: "MATCH (n) WHERE n.value STARTS WITH 'prod' RETURN n",
-			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value LIKE 'prod%'",
+			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value LIKE ?",
+			Args:   []interface{}{"prod%"},
 		},
 		QueryCase{
 			Cypher: "MATCH (n) WHERE n.value ENDS WITH 'prod' RETURN n",
-			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value LIKE '%prod'",
+			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value LIKE ?",
+			Args:   []interface{}{"%prod"},
 		},
 		QueryCase{
 			Cypher: "MATCH (n) WHERE n.value CONTAINS 'prod' RETURN n",
-			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value LIKE '%prod%'",
+			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.value LIKE ?",
+			Args:   []interface{}{"%prod%"},
 		},
 		QueryCase{
 			Cypher: "MATCH (:variable)-[:has]->(n:name) RETURN n",
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n",
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)--(n:name) RETURN n",
 			SQL: `
 (SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
 UNION ALL
 (SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a1.id AND r0.to_id = a0.id)))`,
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN n",
 			SQL: `
 (SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
 UNION ALL
 (SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a1.id AND r0.to_id = a0.id)))`,
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN n LIMIT 10",
 			SQL: `
 (SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
 UNION ALL
 (SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
 LIMIT 10`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN v.name, COUNT(n.name)",
 			SQL: `
-SELECT a0.name, COUNT(a1.name) FROM
-((SELECT a0.name, COUNT(a1.name) FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
-UNION ALL
-(SELECT a0.name, COUNT(a1.name) FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a1.id AND r0.to_id = a0.id))))
+SELECT a0.name, COUNT(a1.name) FROM assets a0, assets a1, relations r0
+WHERE (a0.type = ? AND a1.type = ? AND ((r0.from_id = a0.id AND r0.to_id = a1.id) OR (r0.from_id = a1.id AND r0.to_id = a0.id)))
 GROUP BY a0.name`,
+			Args: []interface{}{"variable", "name"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN DISTINCT n.value LIMIT 10",
 			SQL: `
 (SELECT a1.value FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
 UNION
 (SELECT a1.value FROM assets a0, assets a1, relations r0
-WHERE ((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
 LIMIT 10`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v)-[r]-(n) RETURN n LIMIT 10",
@@ -120,31 +132,36 @@ LIMIT 10`,
 			Cypher: "MATCH (v:variable)<-[r]-(n:name), (v)-[r1]->(n) RETURN n",
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0, relations r1
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND (r1.from_id = a0.id AND r1.to_id = a1.id))`,
+WHERE ((((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND (r1.from_id = a0.id AND r1.to_id = a1.id)) AND r0.id <> r1.id)`,
+			Args: []interface{}{"variable", "name"},
 		},
 		QueryCase{
 			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n.value",
 			SQL: `
 SELECT a1.value FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)<-[r:has]-(n:name) RETURN v, r, n",
 			SQL: `
 SELECT a0.id, a0.value, a0.type, r0.from_id, r0.to_id, r0.type, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)<-[r]-(n) RETURN v, r, n",
 			SQL: `
 SELECT a0.id, a0.value, a0.type, r0.from_id, r0.to_id, r0.type, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (a0.type = 'variable' AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+WHERE (a0.type = ? AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+			Args: []interface{}{"variable"},
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)<-[:has]-(:name)-[:is_in]->(:program) RETURN v",
 			SQL: `
 SELECT a0.id, a0.value, a0.type FROM assets a0, assets a1, assets a2, relations r0, relations r1
-WHERE ((((((a0.type = 'variable' AND a1.type = 'name') AND a2.type = 'program') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = 'is_in') AND (r1.from_id = a1.id AND r1.to_id = a2.id))`,
+WHERE ((((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = ?) AND (r1.from_id = a1.id AND r1.to_id = a2.id))`,
+			Args: []interface{}{"variable", "name", "program", "has", "is_in"},
 		},
 		QueryCase{
 			Cypher: `MATCH (p:port)<-[:bind]-(c:consul_service)-[:is_in]->(d:datacenter) WHERE d.value = 'pa4'
@@ -152,7 +169,8 @@ MATCH (c)-[:is_in]->(e:environment) WHERE e.value = 'preprod'
 RETURN c`,
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, assets a2, assets a3, relations r0, relations r1, relations r2
-WHERE ((((((((((a0.type = 'port' AND a1.type = 'consul_service') AND a2.type = 'datacenter') AND a3.type = 'environment') AND r0.type = 'bind') AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = 'is_in') AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r2.type = 'is_in') AND (r2.from_id = a1.id AND r2.to_id = a3.id)) AND (a2.value = 'pa4' AND a3.value = 'preprod'))`,
+WHERE ((((((((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND a3.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = ?) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r2.type = ?) AND (r2.from_id = a1.id AND r2.to_id = a3.id)) AND (a2.value = ? AND a3.value = ?))`,
+			Args: []interface{}{"port", "consul_service", "datacenter", "environment", "bind", "is_in", "is_in", "pa4", "preprod"},
 		},
 		QueryCase{
 			Cypher: `MATCH (p:port)<-[:bind]-(c:consul_service)-[:is_in]->(d:datacenter) WHERE d.value = 'pa4'
@@ -160,28 +178,32 @@ MATCH (c)-[:is_in]->(e:environment) WHERE e.value <> 'preprod'
 RETURN c`,
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, assets a2, assets a3, relations r0, relations r1, relations r2
-WHERE ((((((((((a0.type = 'port' AND a1.type = 'consul_service') AND a2.type = 'datacenter') AND a3.type = 'environment') AND r0.type = 'bind') AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = 'is_in') AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r2.type = 'is_in') AND (r2.from_id = a1.id AND r2.to_id = a3.id)) AND (a2.value = 'pa4' AND a3.value <> 'preprod'))`,
+WHERE ((((((((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND a3.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = ?) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r2.type = ?) AND (r2.from_id = a1.id AND r2.to_id = a3.id)) AND (a2.value = ? AND a3.value <> ?))`,
+			Args: []interface{}{"port", "consul_service", "datacenter", "environment", "bind", "is_in", "is_in", "pa4", "preprod"},
 		},
 		QueryCase{
 			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n LIMIT 10",
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id))
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))
 LIMIT 10`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n SKIP 20 LIMIT 10",
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id))
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))
 LIMIT 10
 OFFSET 20`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN DISTINCT n",
 			SQL: `
 SELECT DISTINCT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+			Args: []interface{}{"variable", "name", "has"},
 		},
 		QueryCase{
 			Cypher: `MATCH (r:rack)<-[:is_in]-(cn:chef_name)-[:is_in]->(e:environment)
@@ -189,20 +211,904 @@ WHERE r.value = '01.04'
 RETURN e.value, COUNT(cn.value)`,
 			SQL: `
 SELECT a2.value, COUNT(a1.value) FROM assets a0, assets a1, assets a2, relations r0, relations r1
-WHERE (((((((a0.type = 'rack' AND a1.type = 'chef_name') AND a2.type = 'environment') AND r0.type = 'is_in') AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = 'is_in') AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND a0.value = '01.04')
+WHERE ((((((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = ?) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r0.id <> r1.id) AND a0.value = ?)
 GROUP BY a2.value`,
+			Args: []interface{}{"rack", "chef_name", "environment", "is_in", "is_in", "01.04"},
 		},
 		QueryCase{
 			Cypher: `MATCH (r:rack)<-[:is_in]-(cn:chef_name) RETURN COUNT(cn.value)`,
 			SQL: `
 SELECT COUNT(a1.value) FROM assets a0, assets a1, relations r0
-WHERE (((a0.type = 'rack' AND a1.type = 'chef_name') AND r0.type = 'is_in') AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))`,
+			Args: []interface{}{"rack", "chef_name", "is_in"},
+		},
+		QueryCase{
+			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n ORDER BY n.value DESC SKIP 20 LIMIT 10",
+			SQL: `
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))
+ORDER BY a1.value DESC
+LIMIT 10
+OFFSET 20`,
+			Args: []interface{}{"variable", "name", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n.value AS name, n ORDER BY name, n",
+			SQL: `
+SELECT a1.value, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))
+ORDER BY a1.value, a1.id, a1.value, a1.type`,
+			Args: []interface{}{"variable", "name", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN n ORDER BY n.value LIMIT 10",
+			SQL: `
+(SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+UNION ALL
+(SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
+ORDER BY 2
+LIMIT 10`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
+		},
+		QueryCase{
+			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN DISTINCT n.value ORDER BY n.value DESC",
+			SQL: `
+(SELECT a1.value FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+UNION
+(SELECT a1.value FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
+ORDER BY 1 DESC`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
+		},
+		QueryCase{
+			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN DISTINCT n.value ORDER BY v.value",
+			Error:  "ORDER BY expression a0.value must be projected when using DISTINCT or aggregation",
+		},
+		QueryCase{
+			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN n ORDER BY n.type, v.value LIMIT 10",
+			SQL: `
+(SELECT a1.id, a1.value, a1.type, a0.value FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+UNION ALL
+(SELECT a1.id, a1.value, a1.type, a0.value FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))
+ORDER BY 3, 4
+LIMIT 10`,
+			Args: []interface{}{"variable", "name", "variable", "name"},
+		},
+		QueryCase{
+			Cypher: "MATCH (:variable)<-[:has]-(n:name) RETURN n ORDER BY count(n)",
+			Error:  "ORDER BY aggregation COUNT(a1.id) must be projected",
+		},
+		QueryCase{
+			Cypher: `MATCH (r:rack)<-[:is_in]-(cn:chef_name)-[:is_in]->(e:environment)
+RETURN e.value, COUNT(cn.value) AS c ORDER BY c DESC, e.value`,
+			SQL: `
+SELECT a2.value, COUNT(a1.value) FROM assets a0, assets a1, assets a2, relations r0, relations r1
+WHERE (((((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)) AND r1.type = ?) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r0.id <> r1.id)
+GROUP BY a2.value
+ORDER BY COUNT(a1.value) DESC, a2.value`,
+			Args: []interface{}{"rack", "chef_name", "environment", "is_in", "is_in"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:depends_on*1..4]->(s:service) RETURN s",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)) FROM relations e
+WHERE e.from_id IN (SELECT a0.id FROM assets a0 WHERE a0.type = ?) AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ',') FROM vr0 p, relations e
+WHERE e.from_id = p.end_id AND p.depth < 4 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND e.type = ?)
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, vr0 r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.start_id = a0.id AND r0.end_id = a1.id))`,
+			Args: []interface{}{"host", "depends_on", "depends_on", "host", "service"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)<-[:depends_on*2..]-(s:service) RETURN s",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)) FROM relations e
+WHERE e.from_id IN (SELECT a1.id FROM assets a1 WHERE a1.type = ?) AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ',') FROM vr0 p, relations e
+WHERE e.from_id = p.end_id AND p.depth < 10 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND e.type = ?)
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, vr0 r0
+WHERE ((a0.type = ? AND a1.type = ?) AND ((r0.start_id = a1.id AND r0.end_id = a0.id) AND r0.depth >= 2))`,
+			Args: []interface{}{"service", "depends_on", "depends_on", "host", "service"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[*3]-(s) RETURN s",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids) AS (
+SELECT e.src_id, e.dst_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)) FROM (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e
+WHERE e.src_id IN (SELECT a0.id FROM assets a0 WHERE a0.type = ?)
+UNION ALL
+SELECT p.start_id, e.dst_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ',') FROM vr0 p, (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e
+WHERE e.src_id = p.end_id AND p.depth < 3 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0)
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, vr0 r0
+WHERE (a0.type = ? AND ((r0.start_id = a0.id AND r0.end_id = a1.id) AND r0.depth >= 3))`,
+			Args: []interface{}{"host", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (u:user), (d:database) WHERE u.value = 'john' RETURN shortestPath((u)-[*..4]-(d))",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.src_id, e.dst_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e, assets t
+WHERE e.src_id IN (SELECT a0.id FROM assets a0 WHERE (a0.type = ? AND a0.value = ?)) AND t.id = e.dst_id
+UNION ALL
+SELECT p.start_id, e.dst_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(p.path_assets, ',', JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value)), CONCAT(p.path_relations, ',', JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type)) FROM vr0 p, (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e, assets t
+WHERE e.src_id = p.end_id AND p.depth < 4 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.dst_id)
+SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', r0.path_assets, '],"relations":[', r0.path_relations, ']}') FROM assets a0, assets a1, vr0 r0
+WHERE (((a0.type = ? AND a1.type = ?) AND ((r0.start_id = a0.id AND r0.end_id = a1.id) AND r0.relation_ids = (SELECT s.relation_ids FROM vr0 s WHERE s.start_id = r0.start_id AND s.end_id = r0.end_id ORDER BY s.depth, s.relation_ids LIMIT 1))) AND a0.value = ?)`,
+			Args: []interface{}{"user", "john", "user", "database", "john"},
+		},
+		QueryCase{
+			Cypher: "MATCH (u:user) RETURN allShortestPaths((u)-[:member_of*2..3]->(:group))",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM relations e, assets t
+WHERE e.from_id IN (SELECT a0.id FROM assets a0 WHERE a0.type = ?) AND t.id = e.to_id AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(p.path_assets, ',', JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value)), CONCAT(p.path_relations, ',', JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type)) FROM vr0 p, relations e, assets t
+WHERE e.from_id = p.end_id AND p.depth < 3 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.to_id AND e.type = ?)
+SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', r0.path_assets, '],"relations":[', r0.path_relations, ']}') FROM assets a0, assets a1, vr0 r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (((r0.start_id = a0.id AND r0.end_id = a1.id) AND r0.depth >= 2) AND r0.depth = (SELECT MIN(s.depth) FROM vr0 s WHERE s.start_id = r0.start_id AND s.end_id = r0.end_id AND s.depth >= 2)))`,
+			Args: []interface{}{"user", "member_of", "member_of", "user", "group"},
+		},
+		QueryCase{
+			Cypher: "MATCH shortestPath((u:user)-[*..3]-(d:database)) WHERE u.value = 'john' RETURN d",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.src_id, e.dst_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e, assets t
+WHERE e.src_id IN (SELECT a0.id FROM assets a0 WHERE (a0.type = ? AND a0.value = ?)) AND t.id = e.dst_id
+UNION ALL
+SELECT p.start_id, e.dst_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(p.path_assets, ',', JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value)), CONCAT(p.path_relations, ',', JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type)) FROM vr0 p, (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e, assets t
+WHERE e.src_id = p.end_id AND p.depth < 3 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.dst_id)
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, vr0 r0
+WHERE (((a0.type = ? AND a1.type = ?) AND ((r0.start_id = a0.id AND r0.end_id = a1.id) AND r0.relation_ids = (SELECT s.relation_ids FROM vr0 s WHERE s.start_id = r0.start_id AND s.end_id = r0.end_id ORDER BY s.depth, s.relation_ids LIMIT 1))) AND a0.value = ?)`,
+			Args: []interface{}{"user", "john", "user", "database", "john"},
+		},
+		QueryCase{
+			Cypher: "MATCH (u:user) MATCH allShortestPaths((u)-[:member_of*]->(g:group)) RETURN g",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM relations e, assets t
+WHERE e.from_id IN (SELECT a0.id FROM assets a0 WHERE a0.type = ?) AND t.id = e.to_id AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(p.path_assets, ',', JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value)), CONCAT(p.path_relations, ',', JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type)) FROM vr0 p, relations e, assets t
+WHERE e.from_id = p.end_id AND p.depth < 10 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.to_id AND e.type = ?)
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, vr0 r0
+WHERE ((a0.type = ? AND a1.type = ?) AND ((r0.start_id = a0.id AND r0.end_id = a1.id) AND r0.depth = (SELECT MIN(s.depth) FROM vr0 s WHERE s.start_id = r0.start_id AND s.end_id = r0.end_id)))`,
+			Args: []interface{}{"user", "member_of", "member_of", "user", "group"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = shortestPath((a:user {value: 'bob'})-[*..5]-(b:db {value: 'x'})) RETURN p",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.src_id, e.dst_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e, assets t
+WHERE e.src_id IN (SELECT a0.id FROM assets a0 WHERE (a0.type = ? AND a0.value = ?)) AND t.id = e.dst_id
+UNION ALL
+SELECT p.start_id, e.dst_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(p.path_assets, ',', JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value)), CONCAT(p.path_relations, ',', JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type)) FROM vr0 p, (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e, assets t
+WHERE e.src_id = p.end_id AND p.depth < 5 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.dst_id)
+SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', r0.path_assets, '],"relations":[', r0.path_relations, ']}') FROM assets a0, assets a1, vr0 r0
+WHERE ((((a0.type = ? AND a0.value = ?) AND a1.type = ?) AND a1.value = ?) AND ((r0.start_id = a0.id AND r0.end_id = a1.id) AND r0.relation_ids = (SELECT s.relation_ids FROM vr0 s WHERE s.start_id = r0.start_id AND s.end_id = r0.end_id ORDER BY s.depth, s.relation_ids LIMIT 1)))`,
+			Args: []interface{}{"user", "bob", "user", "bob", "db", "x"},
+		},
+		QueryCase{
+			Cypher:     "MATCH (u:user)-[:member_of*..2]->(g:group) WHERE g.value = $group RETURN u",
+			Parameters: map[string]interface{}{"group": "admins"},
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids) AS (
+SELECT e.to_id, e.from_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)) FROM relations e
+WHERE e.to_id IN (SELECT a1.id FROM assets a1 WHERE (a1.type = ? AND a1.value = ?)) AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.from_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ',') FROM vr0 p, relations e
+WHERE e.to_id = p.end_id AND p.depth < 2 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND e.type = ?)
+SELECT a0.id, a0.value, a0.type FROM assets a0, assets a1, vr0 r0
+WHERE (((a0.type = ? AND a1.type = ?) AND (r0.start_id = a1.id AND r0.end_id = a0.id)) AND a1.value = ?)`,
+			Args: []interface{}{"group", "admins", "member_of", "member_of", "user", "group", "admins"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)-[:member_of*..2]->(g:group {value: 'admins'}) RETURN p",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.to_id, e.from_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM relations e, assets t
+WHERE e.to_id IN (SELECT a1.id FROM assets a1 WHERE (a1.type = ? AND a1.value = ?)) AND t.id = e.to_id AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.from_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value), ',', p.path_assets), CONCAT(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type), ',', p.path_relations) FROM vr0 p, relations e, assets t
+WHERE e.to_id = p.end_id AND p.depth < 2 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.to_id AND e.type = ?)
+SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', r0.path_assets, '],"relations":[', r0.path_relations, ']}') FROM assets a0, assets a1, vr0 r0
+WHERE (((a0.type = ? AND a1.type = ?) AND a1.value = ?) AND (r0.start_id = a1.id AND r0.end_id = a0.id))`,
+			Args: []interface{}{"group", "admins", "member_of", "member_of", "user", "group", "admins"},
+		},
+		QueryCase{
+			Cypher: "MATCH shortestPath((u:user)-[*..3]-(h)-[*..3]-(d:database)) RETURN d",
+			Error:  "Function shortestPath expects a pattern with a single relationship",
+		},
+		QueryCase{
+			Cypher: "MATCH (u:user), (d:database) RETURN shortestPath((u)-[r*..4]-(d))",
+			Error:  "Relationship of the pattern given to shortestPath cannot be bound to a variable",
+		},
+		QueryCase{
+			Cypher: "MATCH (u:user) RETURN shortestPath((u)-[*..4]-()-[*..2]-(:database))",
+			Error:  "Function shortestPath expects a pattern with a single relationship",
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)-->(g:group)-->(h:host) RETURN p",
+			SQL: `SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', JSON_OBJECT('_id', CAST(a1.id AS CHAR), 'type', a1.type, 'key', a1.value), ',', JSON_OBJECT('_id', CAST(a2.id AS CHAR), 'type', a2.type, 'key', a2.value), '],"relations":[', JSON_OBJECT('_id', CAST(r0.id AS CHAR), 'from_id', CAST(r0.from_id AS CHAR), 'to_id', CAST(r0.to_id AS CHAR), 'type', r0.type), ',', JSON_OBJECT('_id', CAST(r1.id AS CHAR), 'from_id', CAST(r1.from_id AS CHAR), 'to_id', CAST(r1.to_id AS CHAR), 'type', r1.type), ']}') FROM assets a0, assets a1, assets a2, relations r0, relations r1
+WHERE (((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r0.id <> r1.id)`,
+			Args: []interface{}{"user", "group", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (h:host) RETURN p, length(p)",
+			SQL: `SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), '],"relations":[]}'), 0 FROM assets a0
+WHERE a0.type = ?`,
+			Args: []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)<-[:member_of*..2]-(g:group) RETURN nodes(p), relationships(p), length(p)",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM relations e, assets t
+WHERE e.from_id IN (SELECT a1.id FROM assets a1 WHERE a1.type = ?) AND t.id = e.from_id AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value), ',', p.path_assets), CONCAT(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type), ',', p.path_relations) FROM vr0 p, relations e, assets t
+WHERE e.from_id = p.end_id AND p.depth < 2 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.from_id AND e.type = ?)
+SELECT CONCAT('[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', r0.path_assets, ']'), CONCAT('[', r0.path_relations, ']'), r0.depth FROM assets a0, assets a1, vr0 r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.start_id = a1.id AND r0.end_id = a0.id))`,
+			Args: []interface{}{"group", "member_of", "member_of", "user", "group"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)-->(g:group)-->(h:host) RETURN nodes(p), relationships(p), length(p)",
+			SQL: `SELECT JSON_ARRAY(JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), JSON_OBJECT('_id', CAST(a1.id AS CHAR), 'type', a1.type, 'key', a1.value), JSON_OBJECT('_id', CAST(a2.id AS CHAR), 'type', a2.type, 'key', a2.value)), JSON_ARRAY(JSON_OBJECT('_id', CAST(r0.id AS CHAR), 'from_id', CAST(r0.from_id AS CHAR), 'to_id', CAST(r0.to_id AS CHAR), 'type', r0.type), JSON_OBJECT('_id', CAST(r1.id AS CHAR), 'from_id', CAST(r1.from_id AS CHAR), 'to_id', CAST(r1.to_id AS CHAR), 'type', r1.type)), 2 FROM assets a0, assets a1, assets a2, relations r0, relations r1
+WHERE (((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r0.id <> r1.id)`,
+			Args: []interface{}{"user", "group", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)-->(:group)-[*..2]->(h:host) WHERE length(p) * 2 > 4 RETURN h",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids, path_assets, path_relations) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)), CAST(JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value) AS CHAR(65535)), CAST(JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type) AS CHAR(65535)) FROM relations e, assets t
+WHERE e.from_id IN (SELECT a1.id FROM assets a1 WHERE a1.type = ?) AND t.id = e.to_id
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ','), CONCAT(p.path_assets, ',', JSON_OBJECT('_id', CAST(t.id AS CHAR), 'type', t.type, 'key', t.value)), CONCAT(p.path_relations, ',', JSON_OBJECT('_id', CAST(e.id AS CHAR), 'from_id', CAST(e.from_id AS CHAR), 'to_id', CAST(e.to_id AS CHAR), 'type', e.type)) FROM vr0 p, relations e, assets t
+WHERE e.from_id = p.end_id AND p.depth < 2 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND t.id = e.to_id)
+SELECT a2.id, a2.value, a2.type FROM assets a0, assets a1, assets a2, relations r0, vr0 r1
+WHERE (((((a0.type = ? AND a1.type = ?) AND a2.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (r1.start_id = a1.id AND r1.end_id = a2.id)) AND (1 + r1.depth) * ? > ?)`,
+			Args: []interface{}{"group", "user", "group", "host", int64(2), int64(4)},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)-->(g:group) WHERE length(p) > 1 RETURN p.value",
+			Error:  "Variable 'p' is a path and has no property",
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user)-->(g:group) WITH p RETURN length(p)",
+			SQL: `
+WITH w0 (v0) AS (
+SELECT CONCAT('{"assets":[', JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value), ',', JSON_OBJECT('_id', CAST(a1.id AS CHAR), 'type', a1.type, 'key', a1.value), '],"relations":[', JSON_OBJECT('_id', CAST(r0.id AS CHAR), 'from_id', CAST(r0.from_id AS CHAR), 'to_id', CAST(r0.to_id AS CHAR), 'type', r0.type), ']}') FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+SELECT JSON_LENGTH(w0.v0, '$.relations') FROM w0`,
+			Args: []interface{}{"user", "group"},
+		},
+		QueryCase{
+			Cypher: "MATCH p = (u:user), p = (g:group) RETURN p",
+			Error:  "Variable 'p' is already defined",
+		},
+		QueryCase{
+			Cypher: "MATCH (u:user) RETURN length(u)",
+			Error:  "Argument 1 of function LENGTH must be a path",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:depends_on*1..20]->(s:service) RETURN s",
+			Error:  "Maximum number of hops of a variable-length relationship cannot exceed 10",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:depends_on*3..2]->(s:service) RETURN s",
+			Error:  "Maximum number of hops of a variable-length relationship must be greater than the minimum",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:depends_on*1..2]->(s:service) RETURN r",
+			Error:  "Variable-length relationship 'r' cannot be used in an expression",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h)-[:owned_by]->(o:owner) RETURN h, o",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM (assets a0)
+LEFT JOIN (assets a1, relations r0) ON (a1.type = ? AND r0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+WHERE a0.type = ?`,
+			Args: []interface{}{"owner", "owned_by", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE h.value = 'srv1' OPTIONAL MATCH (h)-[r]-(o:owner) WHERE o.value STARTS WITH 'john' RETURN h, r, o.value",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, r0.from_id, r0.to_id, r0.type, a1.value FROM (assets a0)
+LEFT JOIN (assets a1, relations r0) ON (a1.type = ? AND ((r0.from_id = a0.id AND r0.to_id = a1.id) OR (r0.from_id = a1.id AND r0.to_id = a0.id)) AND a1.value LIKE ?)
+WHERE (a0.type = ? AND a0.value = ?)`,
+			Args: []interface{}{"owner", "john%", "host", "srv1"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h)-[:owned_by]->(o:owner) OPTIONAL MATCH (h)-[:runs]->(s:service) RETURN h, o, s",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type, a2.id, a2.value, a2.type FROM (assets a0)
+LEFT JOIN (assets a1, relations r0) ON (a1.type = ? AND r0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+LEFT JOIN (assets a2, relations r1) ON (a2.type = ? AND r1.type = ? AND (r1.from_id = a0.id AND r1.to_id = a2.id))
+WHERE a0.type = ?`,
+			Args: []interface{}{"owner", "owned_by", "service", "runs", "host"},
+		},
+		QueryCase{
+			Cypher: "OPTIONAL MATCH (o:owner) RETURN o",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM (SELECT 1) d
+LEFT JOIN (assets a0) ON a0.type = ?`,
+			Args: []interface{}{"owner"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h) WHERE h.value = 'srv1' RETURN h",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?`,
+			Args: []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r]->(o:owner) OPTIONAL MATCH (o)<-[r]-(h) RETURN h, o",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args: []interface{}{"host", "owner"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h) WHERE o.value = 'srv1' RETURN h",
+			Error:  "Unable to find variable",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:runs]->(s) WITH * WHERE s.value = 'db' RETURN h",
+			SQL: `
+WITH w0 (v0, v1) AS (
+SELECT a0.id, a1.id FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+SELECT a0.id, a0.value, a0.type FROM w0, assets a0, assets a1
+WHERE (((a0.id = w0.v0 AND a1.id = w0.v1) AND a0.type = ?) AND a1.value = ?)`,
+			Args: []interface{}{"host", "runs", "host", "db"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN *, h.value AS v",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, a0.value FROM assets a0
+WHERE a0.type = ?`,
+			Args: []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (:host) WITH * RETURN 1",
+			Error:  "Projecting all variables with * is not allowed when there is no variable in scope",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:has]->(v:vulnerability) WITH h, count(v.value) AS c WHERE c > 10 RETURN h, c",
+			SQL: `
+WITH w0 (v0, v1) AS (
+SELECT a0.id, COUNT(a1.value) FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+GROUP BY a0.id)
+SELECT a0.id, a0.value, a0.type, w0.v1 FROM w0, assets a0
+WHERE ((a0.id = w0.v0 AND a0.type = ?) AND w0.v1 > ?)`,
+			Args: []interface{}{"host", "vulnerability", "has", "host", int64(10)},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WITH h ORDER BY h.value LIMIT 5 MATCH (h)-[r:runs]->(s:service) RETURN h.value, s",
+			SQL: `
+WITH w0 (v0, v1) AS (
+SELECT a0.id, a0.value FROM assets a0
+WHERE a0.type = ?
+ORDER BY a0.value
+LIMIT 5)
+SELECT a0.value, a1.id, a1.value, a1.type FROM w0, assets a0, assets a1, relations r0
+WHERE ((((a0.id = w0.v0 AND a0.type = ?) AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args: []interface{}{"host", "host", "service", "runs"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:runs]->(s) WITH r, s.value AS name WITH r, name WHERE name STARTS WITH 'db' RETURN r, name",
+			SQL: `
+WITH w0 (v0, v1) AS (
+SELECT r0.id, a1.value FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))),
+w1 (v0, v1) AS (
+SELECT r0.id, w0.v1 FROM w0, assets a0, assets a1, relations r0
+WHERE ((r0.id = w0.v0 AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+SELECT r0.from_id, r0.to_id, r0.type, w1.v1 FROM w1, assets a0, assets a1, relations r0
+WHERE (((r0.id = w1.v0 AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND w1.v1 LIKE ?)`,
+			Args: []interface{}{"host", "runs", "runs", "runs", "db%"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WITH h MATCH (h)-[*1..2]->(s) RETURN s",
+			SQL: `
+WITH RECURSIVE w0 (v0) AS (
+SELECT a0.id FROM assets a0
+WHERE a0.type = ?),
+vr1 (start_id, end_id, depth, relation_ids) AS (
+SELECT e.from_id, e.to_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)) FROM relations e
+WHERE e.from_id IN (SELECT a0.id FROM assets a0 WHERE (a0.type = ? AND a0.id IN (SELECT v0 FROM w0)))
+UNION ALL
+SELECT p.start_id, e.to_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ',') FROM vr1 p, relations e
+WHERE e.from_id = p.end_id AND p.depth < 2 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0)
+SELECT a1.id, a1.value, a1.type FROM w0, assets a0, assets a1, vr1 r0
+WHERE ((a0.id = w0.v0 AND a0.type = ?) AND (r0.start_id = a0.id AND r0.end_id = a1.id))`,
+			Args: []interface{}{"host", "host", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:runs]->(s) WITH h RETURN s",
+			Error:  "Unable to find variable",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WITH h.value AS name RETURN name.length",
+			Error:  "Variable 'name' is not a node or a relationship and has no property",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h.value AS name UNION MATCH (s:service) RETURN s.value AS name",
+			SQL: `
+(SELECT a0.value FROM assets a0
+WHERE a0.type = ?)
+UNION
+(SELECT a0.value FROM assets a0
+WHERE a0.type = ?)`,
+			Args: []interface{}{"host", "service"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h AS n UNION ALL MATCH (s:service) RETURN s AS n ORDER BY n LIMIT 2 UNION ALL MATCH (d:database) RETURN d AS n",
+			SQL: `
+(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?)
+UNION ALL
+(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?
+ORDER BY a0.id, a0.value, a0.type
+LIMIT 2)
+UNION ALL
+(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?)`,
+			Args: []interface{}{"host", "service", "database"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WITH h MATCH (h)-[:runs]->(s) RETURN s UNION ALL MATCH (h:host) WITH h RETURN h AS s",
+			SQL: `
+WITH w0 (v0) AS (
+SELECT a0.id FROM assets a0
+WHERE a0.type = ?),
+w1 (v0) AS (
+SELECT a0.id FROM assets a0
+WHERE a0.type = ?)
+(SELECT a1.id, a1.value, a1.type FROM w0, assets a0, assets a1, relations r0
+WHERE (((a0.id = w0.v0 AND a0.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+UNION ALL
+(SELECT a0.id, a0.value, a0.type FROM w1, assets a0
+WHERE (a0.id = w1.v0 AND a0.type = ?))`,
+			Args: []interface{}{"host", "host", "host", "runs", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h.value AS name UNION MATCH (s:service) RETURN s.value AS service",
+			Error:  "All sub queries in an UNION must have the same column names",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h AS name UNION MATCH (s:service) RETURN s.value AS name",
+			Error:  "Column 'name' must have the same type in all sub queries of an UNION",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h AS n UNION MATCH (s:service) RETURN s AS n UNION ALL MATCH (d:database) RETURN d AS n",
+			Error:  "Invalid combination of UNION and UNION ALL",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h AS n UNION MATCH (s:service) RETURN s AS n ORDER BY s.value LIMIT 2",
+			SQL: `
+(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?)
+UNION
+(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?
+ORDER BY a0.value
+LIMIT 2)`,
+			Args: []interface{}{"host", "service"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h.type AS n UNION MATCH (s:service) RETURN s.type AS n ORDER BY s.value",
+			Error:  "ORDER BY expression a0.value must be projected in a query combined by UNION",
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:ip) WHERE n.value = $ip RETURN n",
+			Parameters: map[string]interface{}{"ip": "127.0.0.1"},
+			SQL:        "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE (a0.type = ? AND a0.value = ?)",
+			Args:       []interface{}{"ip", "127.0.0.1"},
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:ip) WHERE n.value = $ip RETURN n.value AS v UNION MATCH (n:host)-[:has]->(i:ip) WHERE i.value = $ip RETURN n.value AS v",
+			Parameters: map[string]interface{}{"ip": "127.0.0.1"},
+			SQL: `
+(SELECT a0.value FROM assets a0
+WHERE (a0.type = ? AND a0.value = ?))
+UNION
+(SELECT a0.value FROM assets a0, assets a1, relations r0
+WHERE ((((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND a1.value = ?))`,
+			Args: []interface{}{"ip", "127.0.0.1", "host", "ip", "has", "127.0.0.1"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value = 'x\\' OR \\'1\\'=\\'1' RETURN n",
+			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE (a0.type = ? AND a0.value = ?)",
+			Args:   []interface{}{"ip", "x' OR '1'='1"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value = $ip RETURN n",
+			Error:  "Parameter $ip is not provided",
+		},
+		QueryCase{
+			Cypher:     "MATCH (:variable)<-[:has]-(n:name) RETURN n SKIP $offset LIMIT $count",
+			Parameters: map[string]interface{}{"offset": float64(20), "count": int64(10)},
+			SQL: `
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id))
+LIMIT 10
+OFFSET 20`,
+			Args: []interface{}{"variable", "name", "has"},
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:name) RETURN n LIMIT $count",
+			Parameters: map[string]interface{}{"count": float64(-1)},
+			Error:      "Parameter $count of LIMIT must be a non-negative integer",
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:name) RETURN n SKIP $offset LIMIT 10",
+			Parameters: map[string]interface{}{"offset": "20"},
+			Error:      "Parameter $offset of SKIP must be a non-negative integer",
+		},
+		QueryCase{
+			Cypher: "MATCH (n:name) RETURN n LIMIT $count",
+			Error:  "Parameter $count is not provided",
+		},
+		QueryCase{
+			Cypher: "MATCH (n:name) RETURN n LIMIT 5 + 5",
+			Error:  "LIMIT must be an integer literal or a parameter",
+		},
+		QueryCase{
+			Cypher: "MATCH (n:name) RETURN n SKIP n.value LIMIT 10",
+			Error:  "SKIP must be an integer literal or a parameter",
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:name) WHERE n.value STARTS WITH $prefix AND n.value CONTAINS $part RETURN n",
+			Parameters: map[string]interface{}{"prefix": "50%_", "part": "a"},
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND a0.value LIKE ? AND a0.value LIKE ?)`,
+			Args: []interface{}{"name", `50\%\_%`, "%a%"},
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:name) WHERE n.value ENDS WITH $suffix RETURN n",
+			Parameters: map[string]interface{}{"suffix": int64(1)},
+			Error:      "Operand of string operator must be a string",
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:ip) WHERE n.value = $ip RETURN n",
+			Parameters: map[string]interface{}{"ip": map[string]interface{}{"address": "127.0.0.1"}},
+			Error:      "Parameter $ip must be a string, a number, a boolean or null",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:has]->(v:vulnerability) RETURN h.value, count(DISTINCT v.value)",
+			SQL: `
+SELECT a0.value, COUNT(DISTINCT a1.value) FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+GROUP BY a0.value`,
+			Args: []interface{}{"host", "vulnerability", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:has]->(v:vulnerability) RETURN h, count(v)",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, COUNT(a1.id) FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+GROUP BY a0.id, a0.value, a0.type`,
+			Args: []interface{}{"host", "vulnerability", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:has]->(v:vulnerability) RETURN h.value, collect(v.value), min(v.value), max(v.value)",
+			SQL: `
+SELECT a0.value, JSON_ARRAYAGG(a1.value), MIN(a1.value), MAX(a1.value) FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+GROUP BY a0.value`,
+			Args: []interface{}{"host", "vulnerability", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:has]->(v:vulnerability) RETURN sum(v.score), avg(v.score), count(DISTINCT r)",
+			SQL: `
+SELECT SUM(a1.score), AVG(a1.score), COUNT(DISTINCT r0.id) FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args: []interface{}{"host", "vulnerability", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN count(*)",
+			SQL: `
+SELECT COUNT(*) FROM assets a0
+WHERE a0.type = ?`,
+			Args: []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:has]->(v:vulnerability) RETURN collect(DISTINCT h), collect(r)",
+			SQL: `
+SELECT JSON_ARRAYAGG(DISTINCT IF(a0.id IS NULL, NULL, JSON_OBJECT('_id', CAST(a0.id AS CHAR), 'type', a0.type, 'key', a0.value))), JSON_ARRAYAGG(IF(r0.id IS NULL, NULL, JSON_OBJECT('_id', CAST(r0.id AS CHAR), 'from_id', CAST(r0.from_id AS CHAR), 'to_id', CAST(r0.to_id AS CHAR), 'type', r0.type))) FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args: []interface{}{"host", "vulnerability", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN count(*)",
+			SQL: `
+SELECT COUNT(*) FROM assets a0, assets a1, relations r0
+WHERE (a0.type = ? AND a1.type = ? AND ((r0.from_id = a0.id AND r0.to_id = a1.id) OR (r0.from_id = a1.id AND r0.to_id = a0.id)))`,
+			Args: []interface{}{"variable", "name"},
+		},
+		QueryCase{
+			Cypher: "MATCH (v:variable)-[r]-(n:name) RETURN v, collect(DISTINCT n.value) AS names, count(n) AS c ORDER BY c DESC",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, JSON_ARRAYAGG(DISTINCT a1.value), COUNT(a1.id) FROM assets a0, assets a1, relations r0
+WHERE (a0.type = ? AND a1.type = ? AND ((r0.from_id = a0.id AND r0.to_id = a1.id) OR (r0.from_id = a1.id AND r0.to_id = a0.id)))
+GROUP BY a0.id, a0.value, a0.type
+ORDER BY COUNT(a1.id) DESC`,
+			Args: []interface{}{"variable", "name"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN min(h)",
+			Error:  "Function MIN cannot be applied to a node or a relationship",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN count(count(h.value))",
+			Error:  "Aggregation functions cannot be nested",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN sum(h.value, h.type)",
+			Error:  "Function SUM expects exactly one argument",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r]->(v) WHERE type(r) = 'has' RETURN id(h), labels(v), toLower(v.value)",
+			SQL: `
+SELECT a0.id, JSON_ARRAY(a1.type), LOWER(a1.value) FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND r0.type = ?)`,
+			Args: []interface{}{"host", "has"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n) RETURN type(n)",
+			Error:  "Argument 1 of function TYPE must be a relationship",
+		},
+		QueryCase{
+			Cypher: "MATCH (n) WHERE foo(n.value) = 1 RETURN n",
+			Error:  "Unknown function FOO",
+		},
+		QueryCase{
+			Cypher: "MATCH (n) RETURN toLower(n.value, n.type)",
+			Error:  "Function TOLOWER expects exactly one argument",
+		},
+		QueryCase{
+			Cypher: "MATCH (n) RETURN coalesce()",
+			Error:  "Function COALESCE expects at least 1 argument(s)",
+		},
+		QueryCase{
+			Cypher: "MATCH (n) RETURN toLower(DISTINCT n.value)",
+			Error:  "DISTINCT cannot be used with function TOLOWER",
+		},
+		QueryCase{
+			Cypher:     "MATCH (n:ip) WHERE n.value IN $ips RETURN n",
+			Parameters: map[string]interface{}{"ips": []interface{}{"127.0.0.1", "127.0.0.2"}},
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND a0.value IN (?, ?))`,
+			Args: []interface{}{"ip", "127.0.0.1", "127.0.0.2"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value IN ['127.0.0.1'] AND n.value =~ '127[.].*' RETURN n",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND a0.value IN (?) AND a0.value REGEXP CONCAT('(?-i)^(?:', ?, ')$'))`,
+			Args: []interface{}{"ip", "127.0.0.1", "127[.].*"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h)-[:owned_by]->(o:owner) WITH h, o WHERE o IS NULL RETURN h",
+			SQL: `
+WITH w0 (v0, v1) AS (
+SELECT a0.id, a1.id FROM (assets a0)
+LEFT JOIN (assets a1, relations r0) ON (a1.type = ? AND r0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+WHERE a0.type = ?)
+SELECT a0.id, a0.value, a0.type FROM (w0, assets a0)
+LEFT JOIN (assets a1) ON (a1.id = w0.v1 AND a1.type = ?)
+WHERE ((a0.id = w0.v0 AND a0.type = ?) AND a1.id IS NULL)`,
+			Args: []interface{}{"owner", "owned_by", "host", "owner", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value IS NOT NULL RETURN n.value IN ['127.0.0.1']",
+			SQL: `
+SELECT a0.value IN (?) FROM assets a0
+WHERE (a0.type = ? AND a0.value IS NOT NULL)`,
+			Args: []interface{}{"127.0.0.1", "ip"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n IN ['127.0.0.1'] RETURN n",
+			Error:  "A node or a relationship cannot be the left operand of IN",
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value IN '127.0.0.1' RETURN n",
+			Error:  "The right operand of IN must be a list",
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value[0] = '1' RETURN n",
+			Error:  "List indexing and slicing are not supported",
 		},
 		QueryCase{
 			Cypher: "MATCH (v:variable)-[:has]->(n:name) WHERE v.value = '0x16' AND (n.value = 'myvar' OR n.value = 'myvar2') RETURN n",
 			SQL: `
 SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
-WHERE ((((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (a0.value = '0x16' AND a1.value = 'myvar' OR a1.value = 'myvar2'))`,
+WHERE ((((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND a0.value = ? AND (a1.value = ? OR a1.value = ?))`,
+			Args: []interface{}{"variable", "name", "has", "0x16", "myvar", "myvar2"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value = 'a' OR n.value = 'b' RETURN n",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND (a0.value = ? OR a0.value = ?))`,
+			Args: []interface{}{"ip", "a", "b"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE NOT (n.value = 'a' OR n.value = 'b') AND n.value <> 'c' RETURN n",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND NOT (a0.value = ? OR a0.value = ?) AND a0.value <> ?)`,
+			Args: []interface{}{"ip", "a", "b", "c"},
+		},
+		QueryCase{
+			Cypher: "MATCH (n:ip) WHERE n.value STARTS WITH '10.' XOR n.value ENDS WITH '.1' RETURN n",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND (a0.value LIKE ? XOR a0.value LIKE ?))`,
+			Args: []interface{}{"ip", "10.%", "%.1"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host {value: 'web-01'})-->(x) RETURN x",
+			SQL: `
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE ((a0.type = ? AND a0.value = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args: []interface{}{"host", "web-01"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host {value: $host})-[:owned_by {source: 'ldap'}]->(u {value: h.value}) RETURN u",
+			SQL: `
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE (((((a0.type = ? AND a0.value = ?) AND a1.value = a0.value) AND r0.type = ?) AND r0.source = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id))`,
+			Args:       []interface{}{"host", "web-01", "owned_by", "ldap"},
+			Parameters: map[string]interface{}{"host": "web-01"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h $props) RETURN h",
+			SQL: `
+SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND a0.value = ?)`,
+			Args:       []interface{}{"host", "web-01"},
+			Parameters: map[string]interface{}{"props": map[string]interface{}{"value": "web-01", "type": "host"}},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h)-[:owned_by]->(u:user {value: 'john'}) RETURN h, u",
+			SQL: `
+SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM (assets a0)
+LEFT JOIN (assets a1, relations r0) ON (a1.type = ? AND a1.value = ? AND r0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id))
+WHERE a0.type = ?`,
+			Args: []interface{}{"user", "john", "owned_by", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host {owner: 'john'}) RETURN h",
+			Error:  "Property 'owner' does not exist on a node, expected one of id, value, type",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:owned_by*1..2 {source: 'ldap'}]->(u) RETURN u",
+			Error:  "Properties of variable-length relationships are not supported",
+		},
+		QueryCase{
+			Cypher:     "MATCH (h:host $props) RETURN h",
+			Error:      "Parameter $props must be a map",
+			Parameters: map[string]interface{}{"props": "web-01"},
+		},
+		QueryCase{
+			Cypher: "MATCH (a:host)<-[:linked]->(b:host) RETURN a, b",
+			SQL: `(SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)))
+UNION ALL
+(SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE (((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a1.id AND r0.to_id = a0.id)))`,
+			Args: []interface{}{"host", "host", "linked", "host", "host", "linked"},
+		},
+		QueryCase{
+			Cypher: "MATCH (a:host)<-[:linked*1..2]->(b:host) RETURN b",
+			SQL: `
+WITH RECURSIVE vr0 (start_id, end_id, depth, relation_ids) AS (
+SELECT e.src_id, e.dst_id, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096)) FROM (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e
+WHERE e.src_id IN (SELECT a0.id FROM assets a0 WHERE a0.type = ?) AND e.type = ?
+UNION ALL
+SELECT p.start_id, e.dst_id, p.depth + 1, CONCAT(p.relation_ids, e.id, ',') FROM vr0 p, (SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations) e
+WHERE e.src_id = p.end_id AND p.depth < 2 AND LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0 AND e.type = ?)
+SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, vr0 r0
+WHERE ((a0.type = ? AND a1.type = ?) AND (r0.start_id = a0.id AND r0.end_id = a1.id))`,
+			Args: []interface{}{"host", "linked", "linked", "host", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (a:host)-[r1]->(b)-[r2]->(c), (c)-[r3:owned_by]->(d) RETURN a",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0, assets a1, assets a2, assets a3, relations r0, relations r1, relations r2
+WHERE (((((((a0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (r1.from_id = a1.id AND r1.to_id = a2.id)) AND r2.type = ?) AND (r2.from_id = a2.id AND r2.to_id = a3.id)) AND r0.id <> r1.id) AND r0.id <> r2.id) AND r1.id <> r2.id)`,
+			Args: []interface{}{"host", "owned_by"},
+		},
+		QueryCase{
+			Cypher: "MATCH (a:host)-[r]->(b) MATCH (b)-[s]->(c) RETURN c",
+			SQL: `SELECT a2.id, a2.value, a2.type FROM assets a0, assets a1, assets a2, relations r0, relations r1
+WHERE ((a0.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (r1.from_id = a1.id AND r1.to_id = a2.id))`,
+			Args: []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE NOT (h)-[:owned_by]->() RETURN h",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND NOT EXISTS (SELECT 1 FROM relations pr0 WHERE (pr0.type = ? AND pr0.from_id = a0.id)))`,
+			Args: []interface{}{"host", "owned_by"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE (h)<-[:member_of]-(:group {value: 'admins'}) RETURN h",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND EXISTS (SELECT 1 FROM assets pa1, relations pr0 WHERE (pa1.type = ? AND pa1.value = ? AND pr0.type = ? AND pr0.from_id = pa1.id AND pr0.to_id = a0.id)))`,
+			Args: []interface{}{"host", "group", "admins", "member_of"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host), (u:user) WHERE exists((h)-[:owned_by]-(u)) AND h.value = 'web' RETURN h",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0, assets a1
+WHERE ((a0.type = ? AND a1.type = ?) AND EXISTS (SELECT 1 FROM relations pr0 WHERE (pr0.type = ? AND ((pr0.from_id = a0.id AND pr0.to_id = a1.id) OR (pr0.to_id = a0.id AND pr0.from_id = a1.id)))) AND a0.value = ?)`,
+			Args: []interface{}{"host", "user", "owned_by", "web"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE (h)-->()-[:owned_by]->(:user) OR exists(h.value) RETURN h",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND (EXISTS (SELECT 1 FROM assets pa2, relations pr0, relations pr1 WHERE (pa2.type = ? AND pr0.from_id = a0.id AND pr1.type = ? AND pr1.from_id = pr0.to_id AND pr1.to_id = pa2.id)) OR a0.value IS NOT NULL))`,
+			Args: []interface{}{"host", "user", "owned_by"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h, (h)-->() AS linked",
+			SQL: `SELECT a0.id, a0.value, a0.type, EXISTS (SELECT 1 FROM relations pr0 WHERE pr0.from_id = a0.id) FROM assets a0
+WHERE a0.type = ?`,
+			Args: []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE (h)-[r]->() RETURN h",
+			Error:  "Pattern predicates cannot introduce new variable 'r'",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE (h)-->(x) RETURN h",
+			Error:  "Pattern predicates cannot introduce new variable 'x'",
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) WHERE (h)-[*2]->() RETURN h",
+			Error:  "Variable-length relationships are not supported in pattern predicates",
+		},
+		QueryCase{
+			Cypher: "MATCH ()-[r]->(h:host) WHERE NOT ()-[r]->(:user) RETURN h",
+			SQL: `SELECT a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE ((a1.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND NOT EXISTS (SELECT 1 FROM assets pa1, relations pr0 WHERE (pa1.type = ? AND pr0.id = r0.id AND pr0.to_id = pa1.id)))`,
+			Args: []interface{}{"host", "user"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) OPTIONAL MATCH (h)-->(u:user) WHERE NOT (u)-->(h) RETURN u",
+			SQL: `SELECT a1.id, a1.value, a1.type FROM (assets a0)
+LEFT JOIN (assets a1, relations r0) ON (a1.type = ? AND (r0.from_id = a0.id AND r0.to_id = a1.id) AND NOT EXISTS (SELECT 1 FROM relations pr0 WHERE (pr0.from_id = a1.id AND pr0.to_id = a0.id)))
+WHERE a0.type = ?`,
+			Args: []interface{}{"user", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h) WHERE h:host RETURN h",
+			SQL:    "SELECT a0.id, a0.value, a0.type FROM assets a0\nWHERE a0.type = ?",
+			Args:   []interface{}{"host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h) WHERE h:host:server AND h.value = 'web' RETURN h, h:server AS server",
+			SQL:    "SELECT a0.id, a0.value, a0.type, a0.type = ? FROM assets a0\nWHERE (a0.type = ? OR a0.type = ?) AND a0.value = ?",
+			Args:   []interface{}{"server", "host", "server", "web"},
+		},
+		QueryCase{
+			Cypher: "MATCH ()-[r]->() WHERE r:owned_by RETURN r",
+			Error:  "Variable 'r' is not a node, it cannot be tested against labels",
 		},
 	}
 
@@ -217,6 +1123,7 @@ WHERE ((((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r
 		}
 		t.Run(c.Cypher, func(t *testing.T) {
 			translator := NewSQLQueryTranslator()
+			translator.Parameters = c.Parameters
 			q, err := query.TransformCypher(c.Cypher)
 			require.NoError(t, err)
 
@@ -229,11 +1136,300 @@ WHERE ((((a0.type = 'variable' AND a1.type = 'name') AND r0.type = 'has') AND (r
 			} else {
 				require.NoError(t, err)
 				assert.Equal(t, strings.TrimSpace(c.SQL), sql.Query)
+				assert.Equal(t, c.Args, sql.Args)
 			}
 		})
 	}
 }
 
+func TestPlannedQueryTranslation(t *testing.T) {
+	statistics := &GraphStatistics{
+		AssetsCount:    map[string]int64{"host": 1000, "user": 50, "ip": 5000},
+		RelationsCount: map[string]int64{"owned_by": 1000, "resolves": 8000},
+	}
+
+	cases := []QueryCase{
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:owned_by]->(u:user) RETURN h, u",
+			SQL: `SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM assets a1
+INNER JOIN relations r0 ON (r0.type = ? AND r0.to_id = a1.id)
+INNER JOIN assets a0 ON (a0.type = ? AND r0.from_id = a0.id)
+WHERE a1.type = ?`,
+			Args: []interface{}{"owned_by", "host", "user"},
+		},
+		QueryCase{
+			Cypher: "MATCH (ip:ip)-[:resolves]->(h:host)-[:owned_by]->(u:user {value: 'john'}) RETURN ip",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a2
+INNER JOIN relations r1 ON (r1.type = ? AND r1.to_id = a2.id)
+INNER JOIN assets a1 ON (a1.type = ? AND r1.from_id = a1.id)
+INNER JOIN relations r0 ON (r0.type = ? AND r0.to_id = a1.id)
+INNER JOIN assets a0 ON (a0.type = ? AND r0.from_id = a0.id)
+WHERE (a2.type = ? AND a2.value = ?)`,
+			Args: []interface{}{"owned_by", "host", "resolves", "ip", "user", "john"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r]-(u) RETURN h, r, u",
+			SQL: `(SELECT a0.id, a0.value, a0.type, r0.from_id, r0.to_id, r0.type, a1.id, a1.value, a1.type FROM assets a0
+INNER JOIN relations r0 ON r0.from_id = a0.id
+INNER JOIN assets a1 ON r0.to_id = a1.id
+WHERE a0.type = ?)
+UNION ALL
+(SELECT a0.id, a0.value, a0.type, r0.from_id, r0.to_id, r0.type, a1.id, a1.value, a1.type FROM assets a0
+INNER JOIN relations r0 ON r0.to_id = a0.id
+INNER JOIN assets a1 ON r0.from_id = a1.id
+WHERE a0.type = ?)`,
+			Args: []interface{}{"host", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:owned_by]->(u:user) OPTIONAL MATCH (h)<-[:resolves]-(ip) RETURN h, u, ip",
+			SQL: `SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type, a2.id, a2.value, a2.type FROM (assets a1
+INNER JOIN relations r0 ON (r0.type = ? AND r0.to_id = a1.id)
+INNER JOIN assets a0 ON (a0.type = ? AND r0.from_id = a0.id))
+LEFT JOIN (assets a2, relations r1) ON (r1.type = ? AND (r1.from_id = a2.id AND r1.to_id = a0.id))
+WHERE a1.type = ?`,
+			Args: []interface{}{"owned_by", "host", "resolves", "user"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[:owned_by]->(u:user) WITH u, count(h) AS c MATCH (u)<-[:owned_by]-(h2:host) RETURN u, c, h2",
+			SQL: `
+WITH w0 (v0, v1) AS (
+SELECT a1.id, COUNT(a0.id) FROM assets a1
+INNER JOIN relations r0 ON (r0.type = ? AND r0.to_id = a1.id)
+INNER JOIN assets a0 ON (a0.type = ? AND r0.from_id = a0.id)
+WHERE a1.type = ?
+GROUP BY a1.id)
+SELECT a0.id, a0.value, a0.type, w0.v1, a1.id, a1.value, a1.type FROM w0
+INNER JOIN assets a0 ON (a0.id = w0.v0 AND a0.type = ?)
+INNER JOIN relations r0 ON (r0.type = ? AND r0.to_id = a0.id)
+INNER JOIN assets a1 ON (a1.type = ? AND r0.from_id = a1.id)`,
+			Args: []interface{}{"owned_by", "host", "user", "user", "owned_by", "host"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host)-[r:owned_by]->(u:user) WHERE h.value = 'x' OR u.value = 'y' RETURN h",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a1
+INNER JOIN relations r0 ON (r0.type = ? AND r0.to_id = a1.id)
+INNER JOIN assets a0 ON (a0.type = ? AND r0.from_id = a0.id AND (a0.value = ? OR a1.value = ?))
+WHERE a1.type = ?`,
+			Args: []interface{}{"owned_by", "host", "x", "y", "user"},
+		},
+		QueryCase{
+			Cypher: "MATCH (h:host) RETURN h",
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?`,
+			Args: []interface{}{"host"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Cypher, func(t *testing.T) {
+			translator := NewSQLQueryTranslator()
+			translator.Statistics = statistics
+			q, err := query.TransformCypher(c.Cypher)
+			require.NoError(t, err)
+
+			sql, err := translator.Translate(q)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(c.SQL), sql.Query)
+			assert.Equal(t, c.Args, sql.Args)
+		})
+	}
+}
+
+func TestPaginatedQueryTranslation(t *testing.T) {
+	cases := []struct {
+		Cypher string
+		Page   QueryPage
+		SQL    string
+		Args   []interface{}
+		Error  string
+	}{
+		{
+			Cypher: "MATCH (h:host) RETURN h",
+			Page:   QueryPage{Size: 10},
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?
+ORDER BY a0.id
+LIMIT 11`,
+			Args: []interface{}{"host"},
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h",
+			Page:   QueryPage{Size: 10, After: []int64{42}, Offset: 2},
+			SQL: `SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE (a0.type = ? AND a0.id >= ?)
+ORDER BY a0.id
+LIMIT 11
+OFFSET 2`,
+			Args: []interface{}{"host", int64(42)},
+		},
+		{
+			Cypher: "MATCH (h:host)-[:owned_by]->(u:user) RETURN h, u",
+			Page:   QueryPage{Size: 10, After: []int64{42, 7}},
+			SQL: `SELECT a0.id, a0.value, a0.type, a1.id, a1.value, a1.type FROM assets a0, assets a1, relations r0
+WHERE ((((a0.type = ? AND a1.type = ?) AND r0.type = ?) AND (r0.from_id = a0.id AND r0.to_id = a1.id)) AND (a0.id, a1.id) >= (?, ?))
+ORDER BY a0.id, a1.id
+LIMIT 11`,
+			Args: []interface{}{"host", "user", "owned_by", int64(42), int64(7)},
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h",
+			Page:   QueryPage{Size: 10, After: []int64{42, 7}},
+			Error:  "Page token does not match the query",
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h.value",
+			Page:   QueryPage{Size: 10, Offset: 20},
+			SQL: `SELECT a0.value FROM assets a0
+WHERE a0.type = ?
+ORDER BY a0.value
+LIMIT 11
+OFFSET 20`,
+			Args: []interface{}{"host"},
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h.value",
+			Page:   QueryPage{Size: 10, After: []int64{42}},
+			Error:  "Page token does not match the query",
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h.value LIMIT 25",
+			Page:   QueryPage{Size: 10, Offset: 20},
+			SQL: `SELECT a0.value FROM assets a0
+WHERE a0.type = ?
+ORDER BY a0.value
+LIMIT 5
+OFFSET 20`,
+			Args: []interface{}{"host"},
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h.value LIMIT 25",
+			Page:   QueryPage{Size: 10, Offset: 30},
+			Error:  "Page token does not match the query",
+		},
+		{
+			Cypher: "MATCH (h:host) RETURN h UNION MATCH (u:user) RETURN u AS h",
+			Page:   QueryPage{Size: 10, Offset: 20},
+			SQL: `(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?)
+UNION
+(SELECT a0.id, a0.value, a0.type FROM assets a0
+WHERE a0.type = ?)
+ORDER BY 1, 2, 3
+LIMIT 11
+OFFSET 20`,
+			Args: []interface{}{"host", "user"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Cypher, func(t *testing.T) {
+			translator := NewSQLQueryTranslator()
+			page := c.Page
+			translator.Page = &page
+			q, err := query.TransformCypher(c.Cypher)
+			require.NoError(t, err)
+
+			sql, err := translator.Translate(q)
+			if c.Error != "" {
+				require.EqualError(t, err, c.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(c.SQL), sql.Query)
+			assert.Equal(t, c.Args, sql.Args)
+		})
+	}
+}
+
+func TestCallQueryTranslation(t *testing.T) {
+	labels := &ProcedureResult{
+		Procedure: "db.labels",
+		Fields:    []string{"label"},
+		Rows:      [][]interface{}{{"host"}, {"user"}},
+	}
+
+	cases := []struct {
+		Cypher string
+		Result *ProcedureResult
+		SQL    string
+		Args   []interface{}
+		Error  string
+	}{
+		{
+			Cypher: "CALL db.labels()",
+			Result: labels,
+			SQL: `WITH w0 (v0) AS (
+SELECT ?
+UNION ALL
+SELECT ?)
+SELECT w0.v0 FROM w0`,
+			Args: []interface{}{"host", "user"},
+		},
+		{
+			Cypher: "CALL db.labels() YIELD label WHERE label STARTS WITH 'h' RETURN count(label) AS c",
+			Result: labels,
+			SQL: `WITH w0 (v0) AS (
+SELECT ?
+UNION ALL
+SELECT ?)
+SELECT COUNT(w0.v0) FROM w0
+WHERE w0.v0 LIKE ?`,
+			Args: []interface{}{"host", "user", "h%"},
+		},
+		{
+			Cypher: "CALL db.labels() YIELD label AS l MATCH (a) WHERE a.type = l RETURN l, count(a)",
+			Result: labels,
+			SQL: `WITH w0 (v0) AS (
+SELECT ?
+UNION ALL
+SELECT ?)
+SELECT w0.v0, COUNT(a0.id) FROM w0, assets a0
+WHERE a0.type = w0.v0
+GROUP BY w0.v0`,
+			Args: []interface{}{"host", "user"},
+		},
+		{
+			Cypher: "CALL db.labels() YIELD label",
+			Result: &ProcedureResult{Procedure: "db.labels", Fields: []string{"label"}},
+			SQL: `WITH w0 (v0) AS (
+SELECT NULL LIMIT 0)
+SELECT w0.v0 FROM w0`,
+		},
+		{
+			Cypher: "CALL db.labels() YIELD type RETURN type",
+			Result: labels,
+			Error:  "Procedure db.labels has no field type",
+		},
+		{
+			Cypher: "CALL db.labels() YIELD label RETURN label UNION MATCH (h:host) RETURN h.value AS label",
+			Result: labels,
+			Error:  "CALL clauses cannot be combined with UNION clauses",
+		},
+		{
+			Cypher: "CALL db.labels() YIELD label RETURN label",
+			Error:  "Procedure db.labels has not been called",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Cypher, func(t *testing.T) {
+			translator := NewSQLQueryTranslator()
+			translator.CallResult = c.Result
+			q, err := query.TransformCypher(c.Cypher)
+			require.NoError(t, err)
+
+			sql, err := translator.Translate(q)
+			if c.Error != "" {
+				require.EqualError(t, err, c.Error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(c.SQL), sql.Query)
+			assert.Equal(t, c.Args, sql.Args)
+		})
+	}
+}
+
 func TestUnwindOrExpressions(t *testing.T) {
 	And := func(e ...AndOrExpression) AndOrExpression {
 		return AndOrExpression{
@@ -0,0 +1,56 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// translateUnions translate the queries combined by UNION clauses and combine them with the already
+// translated first query. The queries must project the same columns so that the cursor can read
+// the rows of any of them with the same projection types.
+func (sqt *SQLQueryTranslator) translateUnions(translation *SQLTranslation, unions []query.QueryUnion) error {
+	all := unions[0].All
+	queries := []string{fmt.Sprintf("(%s)", translation.Query)}
+
+	for i := range unions {
+		if unions[i].All != all {
+			return fmt.Errorf("Invalid combination of UNION and UNION ALL")
+		}
+
+		unionTranslation, err := sqt.translateMultiPartQuery(unions[i].QueryParts, &unions[i].QuerySinglePartQuery)
+		if err != nil {
+			return err
+		}
+
+		if err := checkUnionProjections(translation.ProjectionTypes, unionTranslation.ProjectionTypes); err != nil {
+			return err
+		}
+		queries = append(queries, fmt.Sprintf("(%s)", unionTranslation.Query))
+	}
+
+	operator := "\nUNION\n"
+	if all {
+		operator = "\nUNION ALL\n"
+	}
+	translation.Query = strings.Join(queries, operator)
+	return nil
+}
+
+// checkUnionProjections check the projections of two queries combined by UNION line up
+func checkUnionProjections(projections []Projection, unionProjections []Projection) error {
+	if len(projections) != len(unionProjections) {
+		return fmt.Errorf("All sub queries in an UNION must have the same column names")
+	}
+
+	for i := range projections {
+		if projections[i].Alias != unionProjections[i].Alias {
+			return fmt.Errorf("All sub queries in an UNION must have the same column names")
+		}
+		if projections[i].ExpressionType != unionProjections[i].ExpressionType {
+			return fmt.Errorf("Column '%s' must have the same type in all sub queries of an UNION", projections[i].Alias)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,711 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+	"github.com/clems4ever/go-graphkb/internal/schema"
+)
+
+// ManualSource is the source of the assets and relations created by the updating clauses of the queries
+const ManualSource = "manual"
+
+// ErrUpdatesNotAllowed is returned when a query with updating clauses is run by a user who is not allowed to
+// update the graph
+var ErrUpdatesNotAllowed = fmt.Errorf("Creating and deleting assets and relations is disabled or reserved to administrators")
+
+// updateProjections are the columns of the row counting the assets and relations created and deleted by a query
+var updateProjections = []Projection{
+	{Alias: "assets_created", ExpressionType: PropertyExprType},
+	{Alias: "assets_deleted", ExpressionType: PropertyExprType},
+	{Alias: "relations_created", ExpressionType: PropertyExprType},
+	{Alias: "relations_deleted", ExpressionType: PropertyExprType},
+}
+
+// graphUpdate creates and deletes the assets and relations of the updating clauses of a query for each row
+// matched by its reading clauses. The values of the assets to create are the only properties that can be set.
+type graphUpdate struct {
+	clauses    []query.QueryUpdatingClause
+	parameters map[string]interface{}
+
+	// matches are the reading clauses binding the variables used by the updating clauses
+	matches []query.QueryMatch
+	// variables are the types of the variables bound by the reading clauses
+	variables map[string]VariableType
+	// projected are the variables read from the rows matched by the reading clauses
+	projected map[string]bool
+	// relationEnds are the variables of the left and right nodes of the patterns of the deleted relations, they
+	// tell the direction of the relations
+	relationEnds map[string][2]string
+	// lookups are the optional matches looking up the patterns of the MERGE clauses in the graph
+	lookups []query.QueryMatch
+	// mergeMarkers are the variables of the lookups indexed by the MERGE clauses, they are null when the pattern
+	// does not exist yet
+	mergeMarkers map[int]string
+}
+
+// matchedVariables return the types of the variables bound by the patterns of the reading clauses
+func matchedVariables(matches []query.QueryMatch) map[string]VariableType {
+	variables := make(map[string]VariableType)
+	for _, m := range matches {
+		for _, e := range m.PatternElements {
+			if e.PathVariable != "" {
+				variables[e.PathVariable] = PathType
+			}
+			if e.Variable != "" {
+				variables[e.Variable] = NodeType
+			}
+			for _, c := range e.QueryPatternElementChains {
+				if d := c.RelationshipPattern.RelationshipDetail; d != nil && d.Variable != "" {
+					// A variable-length relationship is bound to a list of relations.
+					if d.Range != nil {
+						variables[d.Variable] = ValueType
+					} else {
+						variables[d.Variable] = RelationType
+					}
+				}
+				if c.Variable != "" {
+					variables[c.Variable] = NodeType
+				}
+			}
+		}
+	}
+	return variables
+}
+
+// newGraphUpdate check the updating clauses of the query and prepare the reading of the variables they use
+func newGraphUpdate(q *query.QueryCypher, parameters map[string]interface{}) (*graphUpdate, error) {
+	if len(q.QueryParts) > 0 || len(q.Unions) > 0 {
+		return nil, fmt.Errorf("Updating clauses cannot be combined with WITH and UNION clauses")
+	}
+	if q.Call != nil {
+		return nil, fmt.Errorf("Updating clauses cannot be combined with CALL clauses")
+	}
+	if q.ProjectionBody.Star || len(q.ProjectionBody.ProjectionItems) > 0 {
+		return nil, fmt.Errorf("RETURN clauses are not supported after updating clauses")
+	}
+
+	u := &graphUpdate{
+		clauses:      q.UpdatingClauses,
+		parameters:   parameters,
+		matches:      q.QueryMatches,
+		variables:    matchedVariables(q.QueryMatches),
+		projected:    make(map[string]bool),
+		relationEnds: make(map[string][2]string),
+		mergeMarkers: make(map[int]string),
+	}
+
+	// created are the variables bound to the assets and relations created by the updating clauses
+	created := make(map[string]bool)
+	// createdNodes are the patterns describing the assets created by the previous clauses
+	createdNodes := make(map[string]query.QueryNodePattern)
+	for i, c := range u.clauses {
+		if c.Merge != nil {
+			u.lookupMerge(i, *c.Merge, createdNodes)
+		}
+		for _, p := range updatingPatterns(c) {
+			if p.PathVariable != "" || p.ShortestPathFunction != "" {
+				return nil, fmt.Errorf("Paths cannot be bound to variables in updating clauses")
+			}
+			if err := u.checkNode(p.QueryNodePattern, created); err != nil {
+				return nil, err
+			}
+			for _, chain := range p.QueryPatternElementChains {
+				if err := u.checkRelation(chain.RelationshipPattern, created); err != nil {
+					return nil, err
+				}
+				if err := u.checkNode(chain.QueryNodePattern, created); err != nil {
+					return nil, err
+				}
+			}
+			for _, node := range patternNodes(p) {
+				if node.Variable != "" && len(node.Labels) > 0 {
+					createdNodes[node.Variable] = node
+				}
+			}
+		}
+
+		for i := range c.Delete {
+			atom, ok := expressionAtom(&c.Delete[i])
+			if !ok || atom.Variable == nil {
+				return nil, fmt.Errorf("Only the variables bound to assets and relations can be deleted")
+			}
+			name := *atom.Variable
+			variableType, ok := u.variables[name]
+			if !ok {
+				if created[name] {
+					return nil, fmt.Errorf("Variable '%s' is created by the query, it cannot be deleted", name)
+				}
+				return nil, fmt.Errorf("Variable '%s' is not defined", name)
+			}
+
+			switch variableType {
+			case NodeType:
+				u.projected[name] = true
+			case RelationType:
+				u.projected[name] = true
+				u.bindRelationEnds(name)
+			default:
+				return nil, fmt.Errorf("Variable '%s' is bound to neither an asset nor a relation", name)
+			}
+		}
+	}
+
+	// The rows are read even when no variable is used so that nothing is created when nothing is matched.
+	if len(u.matches) > 0 && len(u.projected) == 0 {
+		first := &u.matches[0].PatternElements[0].QueryNodePattern
+		u.projected[u.nodeVariable(first, "$row")] = true
+	}
+	return u, nil
+}
+
+// patternNodes return the nodes of the pattern
+func patternNodes(pattern query.QueryPatternElement) []query.QueryNodePattern {
+	nodes := []query.QueryNodePattern{pattern.QueryNodePattern}
+	for _, chain := range pattern.QueryPatternElementChains {
+		nodes = append(nodes, chain.QueryNodePattern)
+	}
+	return nodes
+}
+
+// lookupMerge prepare the optional match looking up the pattern of the MERGE clause for each row so that it is
+// only created when it does not exist yet. The nodes bound by the reading clauses are looked up as they are, the
+// ones created by the previous clauses by their type and value, and the other variables are renamed so that
+// they cannot collide with the variables of the query. The check of the pattern is left to checkNode and
+// checkRelation.
+func (u *graphUpdate) lookupMerge(clauseIdx int, pattern query.QueryPatternElement,
+	createdNodes map[string]query.QueryNodePattern) {
+	renamed := make(map[string]string)
+	rename := func(variable string) string {
+		if variable == "" {
+			variable = fmt.Sprintf("$%d", len(renamed))
+		}
+		if _, ok := renamed[variable]; !ok {
+			renamed[variable] = fmt.Sprintf("merge%d$%s", clauseIdx, variable)
+		}
+		return renamed[variable]
+	}
+	lookupNode := func(node query.QueryNodePattern) query.QueryNodePattern {
+		if variableType, ok := u.variables[node.Variable]; ok && node.Variable != "" && variableType == NodeType {
+			return node
+		}
+		if createdNode, ok := createdNodes[node.Variable]; ok && node.Variable != "" && len(node.Labels) == 0 {
+			node = createdNode
+		}
+		node.Variable = rename(node.Variable)
+		return node
+	}
+
+	lookup := query.QueryPatternElement{QueryNodePattern: lookupNode(pattern.QueryNodePattern)}
+	marker := lookup.Variable
+	for _, chain := range pattern.QueryPatternElementChains {
+		if chain.RelationshipPattern.RelationshipDetail == nil {
+			return
+		}
+		detail := *chain.RelationshipPattern.RelationshipDetail
+		detail.Variable = rename(detail.Variable)
+		chain.RelationshipPattern.RelationshipDetail = &detail
+		chain.QueryNodePattern = lookupNode(chain.QueryNodePattern)
+		lookup.QueryPatternElementChains = append(lookup.QueryPatternElementChains, chain)
+		marker = detail.Variable
+	}
+	// The pattern made of a node bound by the reading clauses always exists.
+	if _, ok := u.variables[marker]; ok {
+		return
+	}
+
+	u.lookups = append(u.lookups, query.QueryMatch{
+		PatternElements: []query.QueryPatternElement{lookup},
+		Optional:        true,
+	})
+	u.mergeMarkers[clauseIdx] = marker
+	u.projected[marker] = true
+	if len(lookup.QueryPatternElementChains) > 0 {
+		u.variables[marker] = RelationType
+	} else {
+		u.variables[marker] = NodeType
+	}
+}
+
+// updatingPatterns return the patterns created by a CREATE or a MERGE clause
+func updatingPatterns(c query.QueryUpdatingClause) []query.QueryPatternElement {
+	if c.Merge != nil {
+		return []query.QueryPatternElement{*c.Merge}
+	}
+	return c.Create
+}
+
+// checkNode check the node of a pattern to create is either bound to an asset or describes the asset to create
+// with a single type and a value
+func (u *graphUpdate) checkNode(node query.QueryNodePattern, created map[string]bool) error {
+	if node.Variable != "" {
+		variableType, matched := u.variables[node.Variable]
+		if matched || created[node.Variable] {
+			if matched && variableType != NodeType {
+				return fmt.Errorf("Variable '%s' is not bound to an asset", node.Variable)
+			}
+			if len(node.Labels) > 0 || node.Properties != nil {
+				return fmt.Errorf("Variable '%s' is already bound, it cannot be given types or properties", node.Variable)
+			}
+			if matched {
+				u.projected[node.Variable] = true
+			}
+			return nil
+		}
+	}
+
+	if len(node.Labels) != 1 {
+		return fmt.Errorf("Assets must be created with exactly one type")
+	}
+	if node.Properties == nil {
+		return fmt.Errorf("Assets must be created with a value like (:%s {value: 'name'})", node.Labels[0])
+	}
+	if node.Variable != "" {
+		created[node.Variable] = true
+	}
+	return nil
+}
+
+// checkRelation check the relationship of a pattern to create has a single type and a direction
+func (u *graphUpdate) checkRelation(relationship query.QueryRelationshipPattern, created map[string]bool) error {
+	detail := relationship.RelationshipDetail
+	if detail == nil || len(detail.Labels) != 1 {
+		return fmt.Errorf("Relations must be created with exactly one type")
+	}
+	if detail.Range != nil {
+		return fmt.Errorf("Variable-length relations cannot be created")
+	}
+	if detail.Properties != nil {
+		return fmt.Errorf("Relations have no properties, they cannot be created with properties")
+	}
+	if relationship.LeftArrow == relationship.RightArrow {
+		return fmt.Errorf("Relations must be created with a direction")
+	}
+	if detail.Variable != "" {
+		if _, ok := u.variables[detail.Variable]; ok || created[detail.Variable] {
+			return fmt.Errorf("Variable '%s' is already bound", detail.Variable)
+		}
+		created[detail.Variable] = true
+	}
+	return nil
+}
+
+// bindRelationEnds bind the nodes of the pattern of the relation to variables so that the direction of the
+// relation can be told from the ids of its ends
+func (u *graphUpdate) bindRelationEnds(relationVariable string) {
+	for i := range u.matches {
+		for j := range u.matches[i].PatternElements {
+			element := &u.matches[i].PatternElements[j]
+			for k := range element.QueryPatternElementChains {
+				detail := element.QueryPatternElementChains[k].RelationshipPattern.RelationshipDetail
+				if detail == nil || detail.Variable != relationVariable {
+					continue
+				}
+
+				left := &element.QueryNodePattern
+				if k > 0 {
+					left = &element.QueryPatternElementChains[k-1].QueryNodePattern
+				}
+				right := &element.QueryPatternElementChains[k].QueryNodePattern
+				ends := [2]string{
+					u.nodeVariable(left, relationVariable+"$left"),
+					u.nodeVariable(right, relationVariable+"$right"),
+				}
+				u.projected[ends[0]] = true
+				u.projected[ends[1]] = true
+				u.relationEnds[relationVariable] = ends
+				return
+			}
+		}
+	}
+}
+
+// nodeVariable return the variable of the node, an anonymous node is bound to the given variable which cannot
+// collide with the variables of the query
+func (u *graphUpdate) nodeVariable(node *query.QueryNodePattern, variable string) string {
+	if node.Variable == "" {
+		node.Variable = variable
+		u.variables[variable] = NodeType
+	}
+	return node.Variable
+}
+
+// readQuery return the query reading the variables used by the updating clauses from the rows matched by the
+// reading clauses and looking up the patterns of the MERGE clauses, nil when there is nothing to read
+func (u *graphUpdate) readQuery() *query.QueryCypher {
+	matches := append(append([]query.QueryMatch{}, u.matches...), u.lookups...)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	variables := []string{}
+	for v := range u.projected {
+		variables = append(variables, v)
+	}
+	sort.Strings(variables)
+
+	// The updates of identical rows are identical, they are therefore read once.
+	body := query.QueryProjectionBody{Distinct: true}
+	for _, v := range variables {
+		body.ProjectionItems = append(body.ProjectionItems, query.QueryProjectionItem{
+			Expression: variableExpression(v),
+			Alias:      v,
+		})
+	}
+	return &query.QueryCypher{
+		QuerySinglePartQuery: query.QuerySinglePartQuery{QueryMatches: matches, ProjectionBody: body},
+	}
+}
+
+// apply create and delete the assets and relations of the updating clauses in the graph for each row. The
+// columns are the indices of the variables in the rows.
+func (u *graphUpdate) apply(graph *Graph, rows [][]interface{}, columns map[string]int) error {
+	// deleted are the assets deleted without their relations, they must have none left once the rows are applied
+	deleted := []Asset{}
+	// removed are the assets and relations already removed by the previous rows
+	removed := NewGraph()
+
+	for _, row := range rows {
+		created := make(map[string]Asset)
+		for i, c := range u.clauses {
+			// The pattern of a MERGE clause which already exists is not created, its assets are only bound.
+			if marker, ok := u.mergeMarkers[i]; ok && row[columns[marker]] != nil {
+				if err := u.create(NewGraph(), *c.Merge, row, columns, created); err != nil {
+					return err
+				}
+				continue
+			}
+			for _, p := range updatingPatterns(c) {
+				if err := u.create(graph, p, row, columns, created); err != nil {
+					return err
+				}
+			}
+
+			for i := range c.Delete {
+				atom, _ := expressionAtom(&c.Delete[i])
+				assets, err := u.delete(graph, removed, *atom.Variable, c.Detach, row, columns)
+				if err != nil {
+					return err
+				}
+				deleted = append(deleted, assets...)
+			}
+		}
+	}
+
+	for _, a := range deleted {
+		for _, r := range graph.Relations() {
+			if r.From == AssetKey(a) || r.To == AssetKey(a) {
+				return fmt.Errorf("Asset %s of type %s still has relations, use DETACH DELETE to delete them too",
+					a.Key, a.Type)
+			}
+		}
+	}
+	return nil
+}
+
+// create add the assets and relations of the pattern to the graph
+func (u *graphUpdate) create(graph *Graph, pattern query.QueryPatternElement, row []interface{},
+	columns map[string]int, created map[string]Asset) error {
+	from, err := u.resolveNode(graph, pattern.QueryNodePattern, row, columns, created)
+	if err != nil {
+		return err
+	}
+
+	for _, chain := range pattern.QueryPatternElementChains {
+		to, err := u.resolveNode(graph, chain.QueryNodePattern, row, columns, created)
+		if err != nil {
+			return err
+		}
+
+		relationType := schema.RelationKeyType(chain.RelationshipPattern.RelationshipDetail.Labels[0])
+		if chain.RelationshipPattern.LeftArrow {
+			graph.AddRelation(AssetKey(to), relationType, AssetKey(from))
+		} else {
+			graph.AddRelation(AssetKey(from), relationType, AssetKey(to))
+		}
+		from = to
+	}
+	return nil
+}
+
+// resolveNode return the asset of a node of a pattern to create, either the asset its variable is bound to or
+// the asset it describes, and add it to the graph
+func (u *graphUpdate) resolveNode(graph *Graph, node query.QueryNodePattern, row []interface{},
+	columns map[string]int, created map[string]Asset) (Asset, error) {
+	if asset, ok := created[node.Variable]; ok {
+		return asset, nil
+	}
+
+	var asset Asset
+	if idx, ok := columns[node.Variable]; ok {
+		if row[idx] == nil {
+			return Asset{}, fmt.Errorf("Variable '%s' is null, relations cannot be created with unmatched assets",
+				node.Variable)
+		}
+		matched, ok := row[idx].(AssetWithID)
+		if !ok {
+			return Asset{}, fmt.Errorf("Unable to read the asset bound to variable '%s'", node.Variable)
+		}
+		asset = matched.Asset
+	} else {
+		value, err := u.assetValue(node.Properties)
+		if err != nil {
+			return Asset{}, err
+		}
+		asset = Asset{Type: schema.AssetType(node.Labels[0]), Key: value}
+		if node.Variable != "" {
+			created[node.Variable] = asset
+		}
+	}
+
+	graph.AddAsset(asset.Type, asset.Key)
+	return asset, nil
+}
+
+// assetValue return the value of the asset to create given by its properties, the value is the only property
+// of an asset
+func (u *graphUpdate) assetValue(properties *query.QueryProperties) (string, error) {
+	values := make(map[string]interface{})
+	if properties.Parameter != nil {
+		parameter, ok := u.parameters[*properties.Parameter].(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("Parameter $%s must be a map", *properties.Parameter)
+		}
+		for k, v := range parameter {
+			values[k] = v
+		}
+	}
+
+	for i := range properties.Entries {
+		entry := &properties.Entries[i]
+		atom, ok := expressionAtom(&entry.Expression)
+		switch {
+		case ok && atom.Literal != nil && atom.Literal.String != nil:
+			values[entry.Key] = *atom.Literal.String
+		case ok && atom.Parameter != nil:
+			value, ok := u.parameters[*atom.Parameter]
+			if !ok {
+				return "", fmt.Errorf("Parameter $%s is not provided", *atom.Parameter)
+			}
+			values[entry.Key] = value
+		default:
+			return "", fmt.Errorf("Value of property '%s' must be a string or a parameter", entry.Key)
+		}
+	}
+
+	for k := range values {
+		if k != "value" {
+			return "", fmt.Errorf("Property '%s' cannot be set, the value is the only property of an asset", k)
+		}
+	}
+	value, ok := values["value"].(string)
+	if !ok || value == "" {
+		return "", fmt.Errorf("Assets must be created with a non-empty string value")
+	}
+	return value, nil
+}
+
+// delete remove the asset or the relation bound to the variable from the graph. The relations of a deleted
+// asset are removed too when it is detached, otherwise the asset is returned to check it has no relation left.
+// Only the assets and relations of the manual graph can be deleted, the ones already removed by the previous rows
+// being skipped.
+func (u *graphUpdate) delete(graph *Graph, removed *Graph, variable string, detach bool, row []interface{},
+	columns map[string]int) ([]Asset, error) {
+	value := row[columns[variable]]
+	// Deleting the null of an unmatched optional pattern does nothing.
+	if value == nil {
+		return nil, nil
+	}
+
+	if u.variables[variable] == RelationType {
+		relation, ok := value.(RelationWithID)
+		if !ok {
+			return nil, fmt.Errorf("Unable to read the relation bound to variable '%s'", variable)
+		}
+		ends := u.relationEnds[variable]
+		left, ok1 := row[columns[ends[0]]].(AssetWithID)
+		right, ok2 := row[columns[ends[1]]].(AssetWithID)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("Unable to read the assets of the relation bound to variable '%s'", variable)
+		}
+		from, to := left, right
+		if relation.From != left.ID {
+			from, to = right, left
+		}
+		r := Relation{Type: relation.Type, From: AssetKey(from.Asset), To: AssetKey(to.Asset)}
+		if removed.HasRelation(r) {
+			return nil, nil
+		}
+		// The relations of the importers are not part of the manual graph, removing them would do nothing.
+		if !graph.HasRelation(r) {
+			return nil, fmt.Errorf("Relation %s from %s to %s was not created manually, only facts created manually can be deleted",
+				r.Type, r.From.Key, r.To.Key)
+		}
+		graph.RemoveRelation(r)
+		removed.AddRelation(r.From, r.Type, r.To)
+		return nil, nil
+	}
+
+	asset, ok := value.(AssetWithID)
+	if !ok {
+		return nil, fmt.Errorf("Unable to read the asset bound to variable '%s'", variable)
+	}
+	if removed.HasAsset(asset.Asset) {
+		return nil, nil
+	}
+	if !graph.HasAsset(asset.Asset) {
+		return nil, fmt.Errorf("Asset %s of type %s was not created manually, only facts created manually can be deleted",
+			asset.Key, asset.Type)
+	}
+	graph.RemoveAsset(asset.Asset)
+	removed.AddAsset(asset.Type, asset.Key)
+	if !detach {
+		return []Asset{asset.Asset}, nil
+	}
+	for _, r := range graph.Relations() {
+		if r.From == AssetKey(asset.Asset) || r.To == AssetKey(asset.Asset) {
+			graph.RemoveRelation(r)
+			removed.AddRelation(r.From, r.Type, r.To)
+		}
+	}
+	return nil, nil
+}
+
+// update run a query with updating clauses. The rows matched by its reading clauses are read and the assets and
+// relations created and deleted for each of them are applied to the graph of the manual source by the graph
+// updater, like the updates of an importer. The result is a single row counting them.
+func (q *Querier) update(ctx context.Context, mode QueryMode, queryCypher *query.QueryCypher,
+	parameters map[string]interface{}, s Statistics) (*QuerierResult, string, error) {
+	if !q.AllowUpdates || q.Updater == nil {
+		return nil, "", ErrUpdatesNotAllowed
+	}
+	if mode != RunMode {
+		return nil, "", fmt.Errorf("Queries with updating clauses cannot be explained or profiled")
+	}
+
+	var u *graphUpdate
+	var err error
+	s.Translation = MeasureDuration(func() {
+		u, err = newGraphUpdate(queryCypher, parameters)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	rows := [][]interface{}{{}}
+	columns := make(map[string]int)
+	var warnings []QueryWarning
+	sql := ""
+	if readQuery := u.readQuery(); readQuery != nil {
+		rows, columns, warnings, sql, err = q.readUpdateRows(ctx, readQuery, parameters, &s)
+		if err != nil {
+			return nil, sql, err
+		}
+	}
+
+	var count GraphUpdatesCount
+	execution := MeasureDuration(func() {
+		count, err = q.Updater.UpdateSourceGraph(ManualSource, func(graph *Graph) error {
+			return u.apply(graph, rows, columns)
+		})
+	})
+	s.Execution += execution
+	if err != nil {
+		return nil, sql, err
+	}
+
+	result := &QuerierResult{
+		Cursor: &rowsCursor{rows: [][]interface{}{{
+			count.AssetUpserts, count.AssetRemovals, count.RelationUpserts, count.RelationRemovals,
+		}}},
+		Projections: updateProjections,
+		Statistics:  s,
+		Mode:        mode,
+		Warnings:    warnings,
+	}
+	return result, sql, nil
+}
+
+// readUpdateRows run the query reading the variables used by the updating clauses and return the rows with the
+// indices of the variables in them
+func (q *Querier) readUpdateRows(ctx context.Context, readQuery *query.QueryCypher, parameters map[string]interface{},
+	s *Statistics) ([][]interface{}, map[string]int, []QueryWarning, string, error) {
+	translator := NewSQLQueryTranslator()
+	if q.MaxHops > 0 {
+		translator.MaxHops = q.MaxHops
+	}
+	translator.Parameters = parameters
+
+	statistics, err := q.GraphDB.ReadStatistics()
+	if err != nil {
+		return nil, nil, nil, "", fmt.Errorf("Unable to read graph statistics: %v", err)
+	}
+	translator.Statistics = statistics
+
+	var translation *SQLTranslation
+	s.Translation += MeasureDuration(func() {
+		translation, err = translator.Translate(readQuery)
+	})
+	if err != nil {
+		return nil, nil, nil, "", err
+	}
+
+	warnings, err := q.validate(ctx, translator.Graphs)
+	if err != nil {
+		return nil, nil, nil, translation.Query, err
+	}
+	if !q.CostConfirmed {
+		if err := q.checkCost(translator.Graphs, statistics); err != nil {
+			return nil, nil, nil, translation.Query, err
+		}
+	}
+
+	rows := [][]interface{}{}
+	s.Execution = MeasureDuration(func() {
+		var res *GraphQueryResult
+		res, err = q.GraphDB.Query(ctx, *translation)
+		if err != nil {
+			return
+		}
+		defer res.Cursor.Close()
+
+		for res.Cursor.HasMore() {
+			var doc interface{}
+			if err = res.Cursor.Read(ctx, &doc); err != nil {
+				return
+			}
+			rows = append(rows, doc.([]interface{}))
+		}
+		// The cursor has no more rows when the context is canceled, the rows are then incomplete.
+		err = ctx.Err()
+	})
+	if err != nil {
+		return nil, nil, nil, translation.Query, err
+	}
+
+	columns := make(map[string]int)
+	for i, p := range translation.ProjectionTypes {
+		columns[p.Alias] = i
+	}
+	return rows, columns, warnings, translation.Query, nil
+}
+
+// rowsCursor is a cursor over rows computed without querying the database
+type rowsCursor struct {
+	rows [][]interface{}
+}
+
+func (rc *rowsCursor) HasMore() bool { return len(rc.rows) > 0 }
+
+func (rc *rowsCursor) Read(ctx context.Context, doc interface{}) error {
+	if len(rc.rows) == 0 {
+		return fmt.Errorf("No more rows to read")
+	}
+	*(doc.(*interface{})) = rc.rows[0]
+	rc.rows = rc.rows[1:]
+	return nil
+}
+
+func (rc *rowsCursor) Close() error { return nil }
@@ -0,0 +1,199 @@
+package knowledge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clems4ever/go-graphkb/internal/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newUpdatingQuerier(db *stubGraphDB) (*Querier, *stubSchemaPersistor) {
+	persistor := &stubSchemaPersistor{schemas: make(map[string]schema.SchemaGraph)}
+	querier := NewQuerier(db, &stubHistorizer{})
+	querier.Updater = NewGraphUpdater(db, persistor)
+	querier.AllowUpdates = true
+	return querier, persistor
+}
+
+func asset(assetType, value string) Asset {
+	return Asset{Type: schema.AssetType(assetType), Key: value}
+}
+
+func TestShouldCreateAssetsAndRelationsUnderManualSource(t *testing.T) {
+	// The pattern of the MERGE clause does not exist yet.
+	db := &stubGraphDB{rows: [][]interface{}{{nil}}}
+	querier, persistor := newUpdatingQuerier(db)
+
+	res, err := querier.Query(context.Background(),
+		"CREATE (t:team {value: 'infra'})<-[:owned_by]-(:host {value: $host}) MERGE (t)-[:tagged]->(:tag {value: 'critical'})",
+		map[string]interface{}{"host": "web-01"})
+	require.NoError(t, err)
+
+	assert.Equal(t, updateProjections, res.Projections)
+	assert.Equal(t, []interface{}{[]interface{}{3, 0, 2, 0}}, readAll(t, res.Cursor))
+	require.Len(t, db.queries, 1)
+	assert.Equal(t, []interface{}{"team", "infra", "tag", "critical", "tagged"}, db.queries[0].Args)
+
+	require.Len(t, db.updates, 1)
+	bulk := db.updates[0]
+	assert.ElementsMatch(t, []Relation{
+		{Type: "owned_by", From: AssetKey(asset("host", "web-01")), To: AssetKey(asset("team", "infra"))},
+		{Type: "tagged", From: AssetKey(asset("team", "infra")), To: AssetKey(asset("tag", "critical"))},
+		{Type: "observed", From: AssetKey(asset("source", ManualSource)), To: AssetKey(asset("host", "web-01"))},
+		{Type: "observed", From: AssetKey(asset("source", ManualSource)), To: AssetKey(asset("team", "infra"))},
+		{Type: "observed", From: AssetKey(asset("source", ManualSource)), To: AssetKey(asset("tag", "critical"))},
+	}, bulk.GetRelationUpserts())
+
+	sg := persistor.schemas[ManualSource]
+	assert.ElementsMatch(t, []schema.AssetType{"host", "team", "tag", "source"}, sg.Assets())
+	assert.Contains(t, sg.Relations(), schema.RelationType{FromType: "host", Type: "owned_by", ToType: "team"})
+	assert.Contains(t, sg.Relations(), schema.RelationType{FromType: "source", Type: "observed", ToType: "tag"})
+}
+
+func TestShouldNotCreateExistingPatternOfMerge(t *testing.T) {
+	tagged := RelationWithID{From: "1", To: "2", Type: "tagged"}
+	db := &stubGraphDB{rows: [][]interface{}{
+		{AssetWithID{ID: "1", Asset: asset("host", "web-01")}, tagged},
+		{AssetWithID{ID: "3", Asset: asset("host", "web-02")}, nil},
+	}}
+	querier, _ := newUpdatingQuerier(db)
+
+	res, err := querier.Query(context.Background(),
+		"MATCH (h:host) MERGE (h)-[:tagged]->(t:tag {value: 'critical'}) CREATE (t)-[:owned_by]->(:team {value: 'sec'})", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{3, 0, 2, 0}}, readAll(t, res.Cursor))
+
+	require.Len(t, db.queries, 1)
+	assert.Contains(t, db.queries[0].Query, "LEFT JOIN (assets a1, relations r0)")
+	require.Len(t, db.updates, 1)
+	relations := db.updates[0].GetRelationUpserts()
+	assert.NotContains(t, relations,
+		Relation{Type: "tagged", From: AssetKey(asset("host", "web-01")), To: AssetKey(asset("tag", "critical"))})
+	assert.Contains(t, relations,
+		Relation{Type: "tagged", From: AssetKey(asset("host", "web-02")), To: AssetKey(asset("tag", "critical"))})
+	// The assets of the existing pattern are still bound for the following clauses.
+	assert.Contains(t, relations,
+		Relation{Type: "owned_by", From: AssetKey(asset("tag", "critical")), To: AssetKey(asset("team", "sec"))})
+}
+
+func TestShouldCreateRelationsWithMatchedAssets(t *testing.T) {
+	db := &stubGraphDB{rows: [][]interface{}{
+		{AssetWithID{ID: "1", Asset: asset("host", "web-01")}},
+		{AssetWithID{ID: "2", Asset: asset("host", "web-02")}},
+	}}
+	querier, _ := newUpdatingQuerier(db)
+
+	res, err := querier.Query(context.Background(),
+		"MATCH (h:host) WHERE h.value STARTS WITH 'web' CREATE (h)-[:owned_by]->(:team {value: 'web'})", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{3, 0, 2, 0}}, readAll(t, res.Cursor))
+
+	require.Len(t, db.queries, 1)
+	assert.Equal(t, []Projection{{Alias: "h", ExpressionType: NodeExprType}}, db.queries[0].ProjectionTypes)
+
+	require.Len(t, db.updates, 1)
+	assert.Contains(t, db.updates[0].GetRelationUpserts(),
+		Relation{Type: "owned_by", From: AssetKey(asset("host", "web-02")), To: AssetKey(asset("team", "web"))})
+}
+
+func TestShouldDeleteManualAssetsAndRelations(t *testing.T) {
+	owned := Relation{Type: "owned_by", From: AssetKey(asset("host", "web-01")), To: AssetKey(asset("team", "infra"))}
+	db := &stubGraphDB{graph: NewGraph()}
+	db.graph.AddAsset("host", "web-01")
+	db.graph.AddAsset("team", "infra")
+	db.graph.AddRelation(owned.From, owned.Type, owned.To)
+	querier, _ := newUpdatingQuerier(db)
+
+	// The relation is matched from the team, its direction is told by the ids of its ends.
+	db.rows = [][]interface{}{{
+		AssetWithID{ID: "1", Asset: asset("host", "web-01")},
+		RelationWithID{From: "1", To: "2", Type: "owned_by"},
+		AssetWithID{ID: "2", Asset: asset("team", "infra")},
+	}}
+	res, err := querier.Query(context.Background(), "MATCH (:team {value: 'infra'})<-[r:owned_by]-(h) DELETE r", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{0, 0, 0, 1}}, readAll(t, res.Cursor))
+	assert.Equal(t, []Projection{
+		{Alias: "h", ExpressionType: NodeExprType},
+		{Alias: "r", ExpressionType: EdgeExprType},
+		{Alias: "r$left", ExpressionType: NodeExprType},
+	}, db.queries[0].ProjectionTypes)
+	require.Len(t, db.updates, 1)
+	assert.Contains(t, db.updates[0].GetRelationRemovals(), owned)
+
+	// The relations of an asset must be deleted with it.
+	db.rows = [][]interface{}{{AssetWithID{ID: "2", Asset: asset("team", "infra")}}}
+	_, err = querier.Query(context.Background(), "MATCH (t:team {value: 'infra'}) DELETE t", nil)
+	assert.EqualError(t, err, "Asset infra of type team still has relations, use DETACH DELETE to delete them too")
+	require.Len(t, db.updates, 1)
+
+	db.rows = [][]interface{}{{AssetWithID{ID: "2", Asset: asset("team", "infra")}}}
+	res, err = querier.Query(context.Background(), "MATCH (t:team {value: 'infra'}) DETACH DELETE t", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{0, 1, 0, 1}}, readAll(t, res.Cursor))
+}
+
+func TestShouldRejectDeletingImportedAssetsAndRelations(t *testing.T) {
+	db := &stubGraphDB{graph: NewGraph()}
+	db.graph.AddAsset("team", "infra")
+	querier, _ := newUpdatingQuerier(db)
+
+	// The host and its relation come from an importer, they are not in the graph of the manual source.
+	db.rows = [][]interface{}{{AssetWithID{ID: "1", Asset: asset("host", "web-01")}}}
+	_, err := querier.Query(context.Background(), "MATCH (h:host {value: 'web-01'}) DETACH DELETE h", nil)
+	assert.EqualError(t, err,
+		"Asset web-01 of type host was not created manually, only facts created manually can be deleted")
+
+	db.rows = [][]interface{}{{
+		AssetWithID{ID: "1", Asset: asset("host", "web-01")},
+		RelationWithID{From: "1", To: "2", Type: "owned_by"},
+		AssetWithID{ID: "2", Asset: asset("team", "infra")},
+	}}
+	_, err = querier.Query(context.Background(), "MATCH (h:host)-[r:owned_by]->(:team {value: 'infra'}) DELETE r", nil)
+	assert.EqualError(t, err,
+		"Relation owned_by from web-01 to infra was not created manually, only facts created manually can be deleted")
+	assert.Empty(t, db.updates)
+
+	// An asset matched by several rows is deleted once.
+	db.rows = [][]interface{}{
+		{AssetWithID{ID: "2", Asset: asset("team", "infra")}},
+		{AssetWithID{ID: "2", Asset: asset("team", "infra")}},
+	}
+	res, err := querier.Query(context.Background(), "MATCH (t:team) DELETE t", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]interface{}{0, 1, 0, 0}}, readAll(t, res.Cursor))
+}
+
+func TestShouldRejectInvalidUpdates(t *testing.T) {
+	db := &stubGraphDB{}
+	querier, _ := newUpdatingQuerier(db)
+
+	cases := []struct {
+		Query string
+		Error string
+	}{
+		{"CREATE (:host)", "Assets must be created with a value like (:host {value: 'name'})"},
+		{"CREATE (:host:server {value: 'web-01'})", "Assets must be created with exactly one type"},
+		{"CREATE (:host {value: 'web-01', os: 'linux'})", "Property 'os' cannot be set, the value is the only property of an asset"},
+		{"CREATE (:host {value: 3})", "Value of property 'value' must be a string or a parameter"},
+		{"CREATE (:host {value: 'web-01'})-[:owned_by]-(:team {value: 'infra'})", "Relations must be created with a direction"},
+		{"CREATE (:host {value: 'web-01'})-->(:team {value: 'infra'})", "Relations must be created with exactly one type"},
+		{"MATCH (h:host) CREATE (h:server)", "Variable 'h' is already bound, it cannot be given types or properties"},
+		{"MATCH p = (h:host)-->() DELETE p", "Variable 'p' is bound to neither an asset nor a relation"},
+		{"MATCH (h:host) DELETE u", "Variable 'u' is not defined"},
+		{"CREATE (:host {value: 'web-01'}) RETURN 1", "RETURN clauses are not supported after updating clauses"},
+		{"EXPLAIN CREATE (:host {value: 'web-01'})", "Queries with updating clauses cannot be explained or profiled"},
+	}
+
+	for _, c := range cases {
+		_, err := querier.Query(context.Background(), c.Query, nil)
+		assert.EqualError(t, err, c.Error, c.Query)
+	}
+	assert.Empty(t, db.updates)
+
+	querier.AllowUpdates = false
+	_, err := querier.Query(context.Background(), "CREATE (:host {value: 'web-01'})", nil)
+	assert.Equal(t, ErrUpdatesNotAllowed, err)
+}
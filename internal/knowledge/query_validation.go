@@ -0,0 +1,211 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/schema"
+	"github.com/clems4ever/go-graphkb/internal/utils"
+)
+
+// maxSuggestions is the maximum number of suggestions of a warning
+const maxSuggestions = 3
+
+// SchemaLoader load the schema graph merging the schemas of all the sources
+type SchemaLoader interface {
+	LoadSchemaGraph(ctx context.Context) (schema.SchemaGraph, error)
+}
+
+// QueryWarning describes a part of a query which does not match the schema and therefore matches nothing
+type QueryWarning struct {
+	Message string `json:"message"`
+	// Suggestions are the types of the schema close to the unknown one
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// QueryValidationError is returned in strict mode when the query does not match the schema
+type QueryValidationError struct {
+	Warnings []QueryWarning `json:"warnings"`
+}
+
+func (e *QueryValidationError) Error() string {
+	messages := []string{}
+	for _, w := range e.Warnings {
+		messages = append(messages, w.Message)
+	}
+	return fmt.Sprintf("Query does not match the schema: %s", strings.Join(messages, ", "))
+}
+
+// suggestTypes return the candidates close to the unknown type or starting with it, the closest first
+func suggestTypes(unknown string, candidates []string) []string {
+	maxDistance := len(unknown) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	distances := make(map[string]int)
+	var suggestions []string
+	for _, c := range candidates {
+		distance := utils.LevenshteinDistance(strings.ToLower(unknown), strings.ToLower(c))
+		if distance <= maxDistance || strings.HasPrefix(strings.ToLower(c), strings.ToLower(unknown)) {
+			distances[c] = distance
+			suggestions = append(suggestions, c)
+		}
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if distances[suggestions[i]] != distances[suggestions[j]] {
+			return distances[suggestions[i]] < distances[suggestions[j]]
+		}
+		return suggestions[i] < suggestions[j]
+	})
+	if len(suggestions) > maxSuggestions {
+		suggestions = suggestions[:maxSuggestions]
+	}
+	return suggestions
+}
+
+// unknownTypeWarning build the warning of a type missing from the schema
+func unknownTypeWarning(kind, unknown string, candidates []string) QueryWarning {
+	warning := QueryWarning{
+		Message:     fmt.Sprintf("Unknown %s type '%s'", kind, unknown),
+		Suggestions: suggestTypes(unknown, candidates),
+	}
+	if len(warning.Suggestions) > 0 {
+		warning.Message += fmt.Sprintf(", did you mean '%s'?", strings.Join(warning.Suggestions, "', '"))
+	}
+	return warning
+}
+
+// schemaValidator check the types of the query graphs against the schema
+type schemaValidator struct {
+	schema        schema.SchemaGraph
+	assetTypes    []string
+	relationTypes []string
+
+	warnings []QueryWarning
+}
+
+func newSchemaValidator(sg schema.SchemaGraph) *schemaValidator {
+	v := &schemaValidator{schema: sg, assetTypes: []string{}, relationTypes: []string{}}
+	for _, a := range sg.Assets() {
+		v.assetTypes = append(v.assetTypes, string(a))
+	}
+	for _, r := range sg.Relations() {
+		if !utils.IsStringInSlice(string(r.Type), v.relationTypes) {
+			v.relationTypes = append(v.relationTypes, string(r.Type))
+		}
+	}
+	sort.Strings(v.assetTypes)
+	sort.Strings(v.relationTypes)
+	return v
+}
+
+// warn add the warning unless it has already been raised by another part of the query
+func (v *schemaValidator) warn(warning QueryWarning) {
+	for _, w := range v.warnings {
+		if w.Message == warning.Message {
+			return
+		}
+	}
+	v.warnings = append(v.warnings, warning)
+}
+
+// checkLabels check the labels are types of the schema and tells whether they all are
+func (v *schemaValidator) checkLabels(kind string, labels []string, types []string) bool {
+	known := true
+	for _, l := range labels {
+		if !utils.IsStringInSlice(l, types) {
+			v.warn(unknownTypeWarning(kind, l, types))
+			known = false
+		}
+	}
+	return known
+}
+
+// hasEdge tells whether the schema has an edge of one of the relation types from one of the asset types to one
+// of the other asset types. An empty list of types matches any type.
+func (v *schemaValidator) hasEdge(fromTypes []string, relationTypes []string, toTypes []string) bool {
+	matches := func(t string, types []string) bool {
+		return len(types) == 0 || utils.IsStringInSlice(t, types)
+	}
+	for _, e := range v.schema.Relations() {
+		if matches(string(e.FromType), fromTypes) && matches(string(e.Type), relationTypes) &&
+			matches(string(e.ToType), toTypes) {
+			return true
+		}
+	}
+	return false
+}
+
+// describeEdge describe the relation between the types like (:host)-[:owned_by]->(:user)
+func describeEdge(fromTypes []string, relationTypes []string, toTypes []string) string {
+	describe := func(types []string) string {
+		if len(types) == 0 {
+			return ""
+		}
+		return ":" + strings.Join(types, "|")
+	}
+	return fmt.Sprintf("(%s)-[%s]->(%s)", describe(fromTypes), describe(relationTypes), describe(toTypes))
+}
+
+// validate check the types of the nodes and relations of the query graph and the relations between them
+func (v *schemaValidator) validate(qg *QueryGraph) {
+	knownNodes := make([]bool, len(qg.Nodes))
+	for i, n := range qg.Nodes {
+		knownNodes[i] = v.checkLabels("asset", n.Labels, v.assetTypes)
+	}
+
+	for _, r := range qg.Relations {
+		if !v.checkLabels("relation", r.Labels, v.relationTypes) || !knownNodes[r.LeftIdx] || !knownNodes[r.RightIdx] {
+			continue
+		}
+		// The ends of a variable-length relation are not linked by a single edge of the schema.
+		if r.VariableLength {
+			continue
+		}
+
+		left, right := qg.Nodes[r.LeftIdx].Labels, qg.Nodes[r.RightIdx].Labels
+		if len(left) == 0 && len(right) == 0 {
+			continue
+		}
+
+		switch {
+		case r.Direction == Right && !v.hasEdge(left, r.Labels, right):
+			v.warn(v.missingEdgeWarning(left, r.Labels, right))
+		case r.Direction == Left && !v.hasEdge(right, r.Labels, left):
+			v.warn(v.missingEdgeWarning(right, r.Labels, left))
+		case r.Direction.Undirected() && !v.hasEdge(left, r.Labels, right) && !v.hasEdge(right, r.Labels, left):
+			v.warn(v.missingEdgeWarning(left, r.Labels, right))
+		}
+	}
+}
+
+// missingEdgeWarning build the warning of a relation absent from the schema, the relation in the other
+// direction is suggested when it exists
+func (v *schemaValidator) missingEdgeWarning(fromTypes []string, relationTypes []string, toTypes []string) QueryWarning {
+	warning := QueryWarning{
+		Message: fmt.Sprintf("The schema has no relation %s", describeEdge(fromTypes, relationTypes, toTypes)),
+	}
+	if v.hasEdge(toTypes, relationTypes, fromTypes) {
+		reversed := describeEdge(toTypes, relationTypes, fromTypes)
+		warning.Suggestions = []string{reversed}
+		warning.Message += fmt.Sprintf(", did you mean %s?", reversed)
+	}
+	return warning
+}
+
+// validateQueryGraphs check the query graphs against the schema and return the warnings about the types and
+// relations absent from it. Nothing is checked when the schema is empty since there is nothing to compare with.
+func validateQueryGraphs(graphs []QueryGraph, sg schema.SchemaGraph) []QueryWarning {
+	if sg.Vertices.Cardinality() == 0 {
+		return nil
+	}
+
+	validator := newSchemaValidator(sg)
+	for i := range graphs {
+		validator.validate(&graphs[i])
+	}
+	return validator.warnings
+}
@@ -0,0 +1,254 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultMaxHops is the default maximum number of hops a variable-length relationship can traverse
+const DefaultMaxHops = 10
+
+// bidirectionalRelationsTable is a table containing each relation in both directions. It is used to walk paths
+// regardless of the direction of the relations. src_id and dst_id are the walking direction while from_id and
+// to_id keep the direction of the relation.
+const bidirectionalRelationsTable = "(SELECT id, from_id AS src_id, to_id AS dst_id, from_id, to_id, type FROM relations " +
+	"UNION ALL SELECT id, to_id, from_id, from_id, to_id, type FROM relations)"
+
+// assetJSONObject build the JSON representation of the asset with the given alias
+func assetJSONObject(alias string) string {
+	return fmt.Sprintf("JSON_OBJECT('_id', CAST(%s.id AS CHAR), 'type', %s.type, 'key', %s.value)", alias, alias, alias)
+}
+
+// relationJSONObject build the JSON representation of the relation with the given alias
+func relationJSONObject(alias string) string {
+	return fmt.Sprintf("JSON_OBJECT('_id', CAST(%s.id AS CHAR), 'from_id', CAST(%s.from_id AS CHAR), 'to_id', CAST(%s.to_id AS CHAR), 'type', %s.type)",
+		alias, alias, alias, alias)
+}
+
+// resolveMaxHops compute the maximum number of hops of the relation given the limit enforced by the server
+func (sqt *SQLQueryTranslator) resolveMaxHops(r QueryRelation) (int, error) {
+	if r.MaxHops == 0 {
+		if r.MinHops > sqt.MaxHops {
+			return 0, fmt.Errorf("Minimum number of hops of a variable-length relationship cannot exceed %d", sqt.MaxHops)
+		}
+		return sqt.MaxHops, nil
+	}
+
+	if r.MaxHops > sqt.MaxHops {
+		return 0, fmt.Errorf("Maximum number of hops of a variable-length relationship cannot exceed %d", sqt.MaxHops)
+	}
+	return r.MaxHops, nil
+}
+
+// walkFromRight tells whether the paths of the variable-length relation are better walked from its right node.
+// They are walked in the direction of the relation unless only the node they would end to is constrained, walking
+// from the constrained node restricts the paths to the ones starting from its few assets.
+func walkFromRight(qg *QueryGraph, r QueryRelation) bool {
+	fromRight := r.Direction == Left
+	startIdx, endIdx := r.LeftIdx, r.RightIdx
+	if fromRight {
+		startIdx, endIdx = endIdx, startIdx
+	}
+	if !qg.Nodes[startIdx].isConstrained() && qg.Nodes[endIdx].isConstrained() {
+		return !fromRight
+	}
+	return fromRight
+}
+
+// variableLengthEndpoints return the indices of the nodes the paths of the relation start from and end to
+func variableLengthEndpoints(r QueryRelation) (int, int) {
+	if r.WalkFromRight {
+		return r.RightIdx, r.LeftIdx
+	}
+	return r.LeftIdx, r.RightIdx
+}
+
+// buildVariableLengthCTE build the recursive common table expression computing the paths matched by a
+// variable-length relation. Each row of the table is a path from start_id to end_id made of depth relations.
+// The ids of the traversed relations are kept to avoid traversing the same relation twice in a path.
+// When withPath is true, the JSON representations of the traversed assets and relations are accumulated
+// so that the path can be projected.
+func (sqt *SQLQueryTranslator) buildVariableLengthCTE(name string, r QueryRelation, withPath bool) (string, error) {
+	seed, err := sqt.buildVariableLengthSeed(r)
+	if err != nil {
+		return "", err
+	}
+
+	maxHops, err := sqt.resolveMaxHops(r)
+	if err != nil {
+		return "", err
+	}
+
+	relationsTable := "relations"
+	// The relations are walked backwards when the paths start from the node they point to.
+	srcColumn, dstColumn := "from_id", "to_id"
+	if (r.Direction == Left) != r.WalkFromRight {
+		srcColumn, dstColumn = "to_id", "from_id"
+	}
+	if r.Direction.Undirected() {
+		relationsTable = bidirectionalRelationsTable
+		srcColumn, dstColumn = "src_id", "dst_id"
+	}
+
+	typesConstraints := AndOrExpression{And: false}
+	for _, label := range r.Labels {
+		typesConstraints.Children = append(typesConstraints.Children, AndOrExpression{
+			Expression: fmt.Sprintf("e.type = %s", sqt.bindings.Bind(label)),
+		})
+	}
+	typesConstraintsStr, err := BuildAndOrExpression(typesConstraints)
+	if err != nil {
+		return "", err
+	}
+
+	columns := "start_id, end_id, depth, relation_ids"
+	anchorProjections := fmt.Sprintf("e.%s, e.%s, 1, CAST(CONCAT(',', e.id, ',') AS CHAR(4096))", srcColumn, dstColumn)
+	recursiveProjections := fmt.Sprintf("p.start_id, e.%s, p.depth + 1, CONCAT(p.relation_ids, e.id, ',')", dstColumn)
+	anchorFrom := fmt.Sprintf("%s e", relationsTable)
+	recursiveFrom := fmt.Sprintf("%s p, %s e", name, relationsTable)
+	anchorWhere := []string{}
+	if seed != "" {
+		anchorWhere = append(anchorWhere, fmt.Sprintf("e.%s IN (%s)", srcColumn, seed))
+	}
+	recursiveWhere := []string{
+		fmt.Sprintf("e.%s = p.end_id", srcColumn),
+		fmt.Sprintf("p.depth < %d", maxHops),
+		"LOCATE(CONCAT(',', e.id, ','), p.relation_ids) = 0",
+	}
+
+	if withPath {
+		columns += ", path_assets, path_relations"
+		anchorProjections += fmt.Sprintf(", CAST(%s AS CHAR(65535)), CAST(%s AS CHAR(65535))",
+			assetJSONObject("t"), relationJSONObject("e"))
+		// The path is kept in the order of the pattern. When the paths are walked from the right node, the
+		// traversed assets and relations are prepended.
+		assetColumn := dstColumn
+		if r.WalkFromRight {
+			assetColumn = srcColumn
+			recursiveProjections += fmt.Sprintf(", CONCAT(%s, ',', p.path_assets), CONCAT(%s, ',', p.path_relations)",
+				assetJSONObject("t"), relationJSONObject("e"))
+		} else {
+			recursiveProjections += fmt.Sprintf(", CONCAT(p.path_assets, ',', %s), CONCAT(p.path_relations, ',', %s)",
+				assetJSONObject("t"), relationJSONObject("e"))
+		}
+		anchorFrom += ", assets t"
+		recursiveFrom += ", assets t"
+		anchorWhere = append(anchorWhere, fmt.Sprintf("t.id = e.%s", assetColumn))
+		recursiveWhere = append(recursiveWhere, fmt.Sprintf("t.id = e.%s", assetColumn))
+	}
+
+	if typesConstraintsStr != "" {
+		anchorWhere = append(anchorWhere, typesConstraintsStr)
+		recursiveWhere = append(recursiveWhere, typesConstraintsStr)
+	}
+
+	anchorWhereStr := ""
+	if len(anchorWhere) > 0 {
+		anchorWhereStr = fmt.Sprintf("\nWHERE %s", strings.Join(anchorWhere, " AND "))
+	}
+
+	return fmt.Sprintf("%s (%s) AS (\nSELECT %s FROM %s%s\nUNION ALL\nSELECT %s FROM %s\nWHERE %s)",
+		name, columns,
+		anchorProjections, anchorFrom, anchorWhereStr,
+		recursiveProjections, recursiveFrom, strings.Join(recursiveWhere, " AND ")), nil
+}
+
+// buildVariableLengthSeed build the query selecting the candidates of the start node of a variable-length
+// relation from its types, its properties, the equalities of the WHERE clause on its value and the intermediate
+// result it is bound to. The paths are then only walked from these candidates instead of every asset. It returns
+// an empty string when the start node is not constrained.
+func (sqt *SQLQueryTranslator) buildVariableLengthSeed(r QueryRelation) (string, error) {
+	startIdx, _ := variableLengthEndpoints(r)
+	n := sqt.QueryGraph.Nodes[startIdx]
+	alias := fmt.Sprintf("a%d", startIdx)
+
+	constraints := AndOrExpression{And: true}
+	typesConstraints := AndOrExpression{And: false}
+	for _, label := range n.Labels {
+		typesConstraints.Children = append(typesConstraints.Children, AndOrExpression{
+			Expression: fmt.Sprintf("%s.type = %s", alias, sqt.bindings.Bind(label)),
+		})
+	}
+	if len(typesConstraints.Children) > 0 {
+		constraints.Children = append(constraints.Children, typesConstraints)
+	}
+	// Only the constraints referencing the start node alone can be checked before the paths are walked.
+	for _, c := range append(append([]AndOrExpression{}, n.Constraints.Children...), n.WhereConstraints...) {
+		expression, err := BuildAndOrExpression(c)
+		if err != nil {
+			return "", err
+		}
+		aliases := tableAliasRegexp.FindAllStringSubmatch(expression, -1)
+		local := len(aliases) > 0
+		for _, a := range aliases {
+			local = local && a[1] == alias
+		}
+		if local {
+			constraints.Children = append(constraints.Children, c)
+		}
+	}
+	if sqt.stage != nil {
+		for i, v := range sqt.stage.Variables {
+			if v.ExpressionType != NodeExprType {
+				continue
+			}
+			typeAndIndex, err := sqt.QueryGraph.FindVariable(v.Name)
+			if err == nil && typeAndIndex.Type == NodeType && typeAndIndex.Index == startIdx {
+				constraints.Children = append(constraints.Children, AndOrExpression{
+					Expression: fmt.Sprintf("%s.id IN (SELECT v%d FROM %s)", alias, i, sqt.stage.Name),
+				})
+				break
+			}
+		}
+	}
+
+	if len(constraints.Children) == 0 {
+		return "", nil
+	}
+	where, err := BuildAndOrExpression(constraints)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("SELECT %s.id FROM assets %s WHERE %s", alias, alias, where), nil
+}
+
+// buildVariableLengthConstraints build the constraints binding the paths of a variable-length relation to its nodes
+func buildVariableLengthConstraints(cteName string, alias string, r QueryRelation) AndOrExpression {
+	startIdx, endIdx := variableLengthEndpoints(r)
+
+	constraints := AndOrExpression{
+		And: true,
+		Children: []AndOrExpression{
+			AndOrExpression{Expression: fmt.Sprintf("%s.start_id = a%d.id", alias, startIdx)},
+			AndOrExpression{Expression: fmt.Sprintf("%s.end_id = a%d.id", alias, endIdx)},
+		},
+	}
+
+	minDepthConstraint := ""
+	if r.MinHops > 1 {
+		constraints.Children = append(constraints.Children, AndOrExpression{
+			Expression: fmt.Sprintf("%s.depth >= %d", alias, r.MinHops),
+		})
+		minDepthConstraint = fmt.Sprintf(" AND s.depth >= %d", r.MinHops)
+	}
+
+	switch r.ShortestPath {
+	case SingleShortestPath:
+		constraints.Children = append(constraints.Children, AndOrExpression{
+			Expression: fmt.Sprintf("%s.relation_ids = (SELECT s.relation_ids FROM %s s WHERE s.start_id = %s.start_id AND s.end_id = %s.end_id%s ORDER BY s.depth, s.relation_ids LIMIT 1)",
+				alias, cteName, alias, alias, minDepthConstraint),
+		})
+	case AllShortestPaths:
+		constraints.Children = append(constraints.Children, AndOrExpression{
+			Expression: fmt.Sprintf("%s.depth = (SELECT MIN(s.depth) FROM %s s WHERE s.start_id = %s.start_id AND s.end_id = %s.end_id%s)",
+				alias, cteName, alias, alias, minDepthConstraint),
+		})
+	}
+	return constraints
+}
+
+// buildVariableLengthPathProjection build the JSON document representing the path matched by a variable-length relation
+func buildVariableLengthPathProjection(alias string, r QueryRelation) string {
+	return fmt.Sprintf("CONCAT('{\"assets\":[', %s, ',', %s.path_assets, '],\"relations\":[', %s.path_relations, ']}')",
+		assetJSONObject(fmt.Sprintf("a%d", r.LeftIdx)), alias, alias)
+}
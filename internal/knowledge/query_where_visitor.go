@@ -9,8 +9,8 @@ type QueryWhereVisitor struct {
 }
 
 // ParseExpression return whether the expression require aggregation
-func (qwv *QueryWhereVisitor) ParseExpression(q *query.QueryExpression, qg *QueryGraph) (string, error) {
-	expression, err := NewExpressionBuilder(qg).Build(q)
+func (qwv *QueryWhereVisitor) ParseExpression(q *query.QueryExpression, qg *QueryGraph, bindings *SQLBindings) (string, error) {
+	expression, err := NewExpressionBuilder(qg, bindings).BuildConstraint(q)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,187 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clems4ever/go-graphkb/internal/query"
+)
+
+// queryStage is the intermediate result of a part of a multi-part query. The result is computed in a
+// common table expression and the variables projected by the WITH clause are bound to its columns.
+type queryStage struct {
+	// Name of the common table expression
+	Name      string
+	Variables []stageVariable
+	// ColumnsCount is the number of columns of the result including the hidden ones used for sorting
+	ColumnsCount int
+	// Where is the filter of the WITH clause, it is applied when the result is used by the next part
+	Where *query.QueryExpression
+}
+
+// stageVariable is a variable projected by a WITH clause
+type stageVariable struct {
+	Name           string
+	ExpressionType ExpressionType
+	// Labels of the node or relation bound to the variable
+	Labels []string
+	// Optional tells whether the node or relation comes from an optional match and can therefore be NULL
+	Optional bool
+}
+
+// stageColumn return the column of the common table expression holding the ith variable of the stage
+func stageColumn(stage *queryStage, i int) string {
+	return fmt.Sprintf("%s.v%d", stage.Name, i)
+}
+
+// translateMultiPartQuery translate the parts of a multi-part query into common table expressions and the final
+// single part query into the main query built on top of them.
+func (sqt *SQLQueryTranslator) translateMultiPartQuery(parts []query.QueryPart,
+	singlePartQuery *query.QuerySinglePartQuery) (*SQLTranslation, error) {
+	sqt.QueryGraph = NewQueryGraph()
+	sqt.stage = nil
+
+	call := singlePartQuery.Call
+	if len(parts) > 0 {
+		call = parts[0].Call
+		if singlePartQuery.Call != nil {
+			return nil, fmt.Errorf("CALL clauses are only supported at the start of a query")
+		}
+	}
+	if call != nil {
+		if err := sqt.translateCall(call); err != nil {
+			return nil, err
+		}
+		body := singlePartQuery.ProjectionBody
+		if len(parts) == 0 && !body.Star && len(body.ProjectionItems) == 0 {
+			singlePartQuery = sqt.projectCall(singlePartQuery)
+		}
+	}
+
+	for i := range parts {
+		if err := sqt.translateQueryPart(&parts[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	translation, _, err := sqt.translateSinglePartQuery(singlePartQuery, false)
+	if err != nil {
+		return nil, err
+	}
+	return translation, nil
+}
+
+// translateQueryPart translate a part of a multi-part query into a common table expression. The
+// following part is then translated on top of it.
+func (sqt *SQLQueryTranslator) translateQueryPart(part *query.QueryPart) error {
+	singlePartQuery := query.QuerySinglePartQuery{
+		QueryMatches:   part.QueryMatches,
+		ProjectionBody: part.With.ProjectionBody,
+	}
+
+	translation, stage, err := sqt.translateSinglePartQuery(&singlePartQuery, true)
+	if err != nil {
+		return err
+	}
+	stage.Name = fmt.Sprintf("w%d", len(sqt.ctes))
+	stage.Where = part.With.Where
+
+	columns := make([]string, stage.ColumnsCount)
+	for i := range columns {
+		columns[i] = fmt.Sprintf("v%d", i)
+	}
+
+	sqt.ctes = append(sqt.ctes, fmt.Sprintf("%s (%s) AS (\n%s)", stage.Name, strings.Join(columns, ", "), translation.Query))
+	sqt.stage = stage
+	return nil
+}
+
+// bindStage bind the variables of the intermediate result the part is built upon to a new query graph.
+// It returns the constraints joining the nodes and relations to the intermediate result by optional group.
+// The optional nodes and relations are bound in their own optional group so that the rows where they are
+// NULL are kept.
+func (sqt *SQLQueryTranslator) bindStage() (map[int][]AndOrExpression, error) {
+	if sqt.stage == nil {
+		return nil, nil
+	}
+
+	sqt.QueryGraph = NewQueryGraph()
+	constraints := make(map[int][]AndOrExpression)
+	for i, v := range sqt.stage.Variables {
+		column := stageColumn(sqt.stage, i)
+		firstNodeIdx, firstRelationIdx := len(sqt.QueryGraph.Nodes), len(sqt.QueryGraph.Relations)
+		group := func() int {
+			if !v.Optional {
+				return 0
+			}
+			return sqt.QueryGraph.PushOptionalGroup(firstNodeIdx, firstRelationIdx)
+		}
+
+		switch v.ExpressionType {
+		case NodeExprType:
+			_, idx, err := sqt.QueryGraph.PushNode(query.QueryNodePattern{Variable: v.Name, Labels: v.Labels})
+			if err != nil {
+				return nil, err
+			}
+			sqt.QueryGraph.Nodes[idx].Staged = true
+			g := group()
+			constraints[g] = append(constraints[g], AndOrExpression{Expression: fmt.Sprintf("a%d.id = %s", idx, column)})
+		case EdgeExprType:
+			_, leftIdx, err := sqt.QueryGraph.PushNode(query.QueryNodePattern{})
+			if err != nil {
+				return nil, err
+			}
+			_, rightIdx, err := sqt.QueryGraph.PushNode(query.QueryNodePattern{})
+			if err != nil {
+				return nil, err
+			}
+			_, idx, err := sqt.QueryGraph.PushRelation(query.QueryRelationshipPattern{
+				RightArrow:         true,
+				RelationshipDetail: &query.QueryRelationshipDetail{Variable: v.Name, Labels: v.Labels},
+			}, leftIdx, rightIdx)
+			if err != nil {
+				return nil, err
+			}
+			sqt.QueryGraph.Nodes[leftIdx].Staged = true
+			sqt.QueryGraph.Nodes[rightIdx].Staged = true
+			g := group()
+			constraints[g] = append(constraints[g], AndOrExpression{Expression: fmt.Sprintf("r%d.id = %s", idx, column)})
+		default:
+			_, err := sqt.QueryGraph.PushValue(v.Name, QueryValue{Expression: column, ExpressionType: v.ExpressionType})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return constraints, nil
+}
+
+// variableExpression build the expression made of the variable only
+func variableExpression(name string) query.QueryExpression {
+	atom := query.QueryAtom{Variable: &name}
+	unary := query.QueryUnaryAddOrSubtractExpression{
+		StringListNullOperatorExpression: query.QueryStringListNullOperatorExpression{
+			PropertyOrLabelsExpression: query.QueryPropertyOrLabelsExpression{Atom: atom},
+		},
+	}
+	not := query.QueryNotExpression{
+		ComparisonExpression: query.QueryComparisonExpression{
+			AddOrSubtractExpression: query.QueryAddOrSubtractExpression{
+				MultipleDivideModuloExpression: query.QueryMultipleDivideModuloExpression{
+					PowerOfExpression: query.QueryPowerOfExpression{
+						QueryUnaryAddOrSubtractExpressions: []query.QueryUnaryAddOrSubtractExpression{unary},
+					},
+				},
+			},
+		},
+	}
+	return query.QueryExpression{
+		OrExpression: query.QueryOrExpression{
+			XorExpressions: []query.QueryXorExpression{{
+				AndExpressions: []query.QueryAndExpression{{
+					NotExpressions: []query.QueryNotExpression{not},
+				}},
+			}},
+		},
+	}
+}
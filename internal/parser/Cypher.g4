@@ -220,7 +220,18 @@ oC_PatternPart
                ;
 
 oC_AnonymousPatternPart
-                    :  oC_PatternElement ;
+                    :  oC_ShortestPathPattern
+                        | oC_PatternElement
+                        ;
+
+oC_ShortestPathPattern
+                   :  ( SHORTESTPATH SP? '(' SP? oC_PatternElement SP? ')' )
+                       | ( ALLSHORTESTPATHS SP? '(' SP? oC_PatternElement SP? ')' )
+                       ;
+
+SHORTESTPATH : ( 'S' | 's' ) ( 'H' | 'h' ) ( 'O' | 'o' ) ( 'R' | 'r' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'S' | 's' ) ( 'T' | 't' ) ( 'P' | 'p' ) ( 'A' | 'a' ) ( 'T' | 't' ) ( 'H' | 'h' )  ;
+
+ALLSHORTESTPATHS : ( 'A' | 'a' ) ( 'L' | 'l' ) ( 'L' | 'l' ) ( 'S' | 's' ) ( 'H' | 'h' ) ( 'O' | 'o' ) ( 'R' | 'r' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'S' | 's' ) ( 'T' | 't' ) ( 'P' | 'p' ) ( 'A' | 'a' ) ( 'T' | 't' ) ( 'H' | 'h' ) ( 'S' | 's' )  ;
 
 oC_PatternElement
               :  ( oC_NodePattern ( SP? oC_PatternElementChain )* )
@@ -389,6 +400,7 @@ oC_PartialComparisonExpression
                                | ( '>' SP? oC_AddOrSubtractExpression )
                                | ( '<=' SP? oC_AddOrSubtractExpression )
                                | ( '>=' SP? oC_AddOrSubtractExpression )
+                               | ( '=~' SP? oC_AddOrSubtractExpression )
                                ;
 
 oC_ParenthesizedExpression
@@ -643,6 +655,8 @@ oC_SymbolicName
                 | ANY
                 | NONE
                 | SINGLE
+                | SHORTESTPATH
+                | ALLSHORTESTPATHS
                 ;
 
 FILTER : ( 'F' | 'f' ) ( 'I' | 'i' ) ( 'L' | 'l' ) ( 'T' | 't' ) ( 'E' | 'e' ) ( 'R' | 'r' )  ;
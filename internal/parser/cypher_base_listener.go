@@ -243,6 +243,12 @@ func (s *BaseCypherListener) EnterOC_AnonymousPatternPart(ctx *OC_AnonymousPatte
 // ExitOC_AnonymousPatternPart is called when production oC_AnonymousPatternPart is exited.
 func (s *BaseCypherListener) ExitOC_AnonymousPatternPart(ctx *OC_AnonymousPatternPartContext) {}
 
+// EnterOC_ShortestPathPattern is called when production oC_ShortestPathPattern is entered.
+func (s *BaseCypherListener) EnterOC_ShortestPathPattern(ctx *OC_ShortestPathPatternContext) {}
+
+// ExitOC_ShortestPathPattern is called when production oC_ShortestPathPattern is exited.
+func (s *BaseCypherListener) ExitOC_ShortestPathPattern(ctx *OC_ShortestPathPatternContext) {}
+
 // EnterOC_PatternElement is called when production oC_PatternElement is entered.
 func (s *BaseCypherListener) EnterOC_PatternElement(ctx *OC_PatternElementContext) {}
 
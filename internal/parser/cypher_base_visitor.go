@@ -156,6 +156,10 @@ func (v *BaseCypherVisitor) VisitOC_AnonymousPatternPart(ctx *OC_AnonymousPatter
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseCypherVisitor) VisitOC_ShortestPathPattern(ctx *OC_ShortestPathPatternContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseCypherVisitor) VisitOC_PatternElement(ctx *OC_PatternElementContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 129, 993,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 132, 1032,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -44,270 +44,427 @@ var serializedLexerAtn = []uint16{
 	9, 133, 4, 134, 9, 134, 4, 135, 9, 135, 4, 136, 9, 136, 4, 137, 9, 137,
 	4, 138, 9, 138, 4, 139, 9, 139, 4, 140, 9, 140, 4, 141, 9, 141, 4, 142,
 	9, 142, 4, 143, 9, 143, 4, 144, 9, 144, 4, 145, 9, 145, 4, 146, 9, 146,
-	4, 147, 9, 147, 4, 148, 9, 148, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3,
-	5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3,
-	10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15,
-	3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3,
-	20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24,
-	3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3,
-	29, 3, 30, 3, 30, 3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34,
-	3, 35, 3, 35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39, 3, 39, 3,
-	40, 3, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 3, 44, 3, 44, 3, 45,
-	3, 45, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3,
-	48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49,
-	3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3,
-	51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53,
-	3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3,
-	55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57,
-	3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3,
-	59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60,
-	3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3,
-	62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3,
-	65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68,
-	3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3,
-	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3,
-	72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74,
-	3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3,
-	77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3,
-	81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 83,
-	3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3,
-	85, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87,
-	3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 88, 3, 88, 3, 88, 3,
-	89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90,
-	3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92, 3,
-	92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94,
-	3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3, 96, 7, 96, 671, 10,
-	96, 12, 96, 14, 96, 674, 11, 96, 3, 96, 3, 96, 3, 96, 3, 96, 7, 96, 680,
-	10, 96, 12, 96, 14, 96, 683, 11, 96, 3, 96, 5, 96, 686, 10, 96, 3, 97,
-	3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3,
-	97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 5, 97, 706, 10, 97, 3, 98,
-	3, 98, 3, 98, 3, 98, 6, 98, 712, 10, 98, 13, 98, 14, 98, 713, 3, 99, 3,
-	99, 3, 99, 7, 99, 719, 10, 99, 12, 99, 14, 99, 722, 11, 99, 5, 99, 724,
-	10, 99, 3, 100, 3, 100, 6, 100, 728, 10, 100, 13, 100, 14, 100, 729, 3,
-	101, 5, 101, 733, 10, 101, 3, 102, 3, 102, 5, 102, 737, 10, 102, 3, 103,
-	3, 103, 5, 103, 741, 10, 103, 3, 104, 3, 104, 5, 104, 745, 10, 104, 3,
-	105, 3, 105, 3, 106, 3, 106, 5, 106, 751, 10, 106, 3, 107, 3, 107, 3, 108,
-	6, 108, 756, 10, 108, 13, 108, 14, 108, 757, 3, 108, 6, 108, 761, 10, 108,
-	13, 108, 14, 108, 762, 3, 108, 3, 108, 6, 108, 767, 10, 108, 13, 108, 14,
-	108, 768, 3, 108, 3, 108, 6, 108, 773, 10, 108, 13, 108, 14, 108, 774,
-	5, 108, 777, 10, 108, 3, 108, 3, 108, 5, 108, 781, 10, 108, 3, 108, 6,
-	108, 784, 10, 108, 13, 108, 14, 108, 785, 3, 109, 7, 109, 789, 10, 109,
-	12, 109, 14, 109, 792, 11, 109, 3, 109, 3, 109, 6, 109, 796, 10, 109, 13,
-	109, 14, 109, 797, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110,
-	3, 110, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111, 3, 111, 3, 112, 3, 112,
-	3, 112, 3, 112, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113,
-	3, 113, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 114, 3, 115,
-	3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115,
-	3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116, 3, 117, 3, 117,
-	3, 117, 3, 118, 3, 118, 3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119,
-	3, 119, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 120, 3, 121,
-	3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122,
-	7, 122, 879, 10, 122, 12, 122, 14, 122, 882, 11, 122, 3, 123, 3, 123, 5,
-	123, 886, 10, 123, 3, 124, 3, 124, 5, 124, 890, 10, 124, 3, 125, 3, 125,
-	7, 125, 894, 10, 125, 12, 125, 14, 125, 897, 11, 125, 3, 125, 6, 125, 900,
-	10, 125, 13, 125, 14, 125, 901, 3, 126, 6, 126, 905, 10, 126, 13, 126,
-	14, 126, 906, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3, 127, 3,
-	127, 3, 127, 3, 127, 3, 127, 3, 127, 5, 127, 921, 10, 127, 3, 128, 3, 128,
-	3, 128, 3, 128, 3, 128, 3, 128, 7, 128, 929, 10, 128, 12, 128, 14, 128,
-	932, 11, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 3, 128, 7, 128, 940,
-	10, 128, 12, 128, 14, 128, 943, 11, 128, 3, 128, 5, 128, 946, 10, 128,
-	3, 128, 3, 128, 5, 128, 950, 10, 128, 5, 128, 952, 10, 128, 3, 129, 3,
-	129, 3, 130, 3, 130, 3, 131, 3, 131, 3, 132, 3, 132, 3, 133, 3, 133, 3,
-	134, 3, 134, 3, 135, 3, 135, 3, 136, 3, 136, 3, 137, 3, 137, 3, 138, 3,
-	138, 3, 139, 3, 139, 3, 140, 3, 140, 3, 141, 3, 141, 3, 142, 3, 142, 3,
-	143, 3, 143, 3, 144, 3, 144, 3, 145, 3, 145, 3, 146, 3, 146, 3, 147, 3,
-	147, 3, 148, 3, 148, 2, 2, 149, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15,
-	9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33,
-	18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51,
-	27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69,
-	36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87,
-	45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105,
-	54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121,
-	62, 123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137,
-	70, 139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149, 76, 151, 77, 153,
-	78, 155, 79, 157, 80, 159, 81, 161, 82, 163, 83, 165, 84, 167, 85, 169,
-	86, 171, 87, 173, 88, 175, 89, 177, 90, 179, 91, 181, 92, 183, 93, 185,
-	94, 187, 95, 189, 96, 191, 97, 193, 98, 195, 99, 197, 100, 199, 101, 201,
-	102, 203, 103, 205, 104, 207, 105, 209, 106, 211, 107, 213, 108, 215, 109,
-	217, 110, 219, 111, 221, 112, 223, 113, 225, 114, 227, 115, 229, 116, 231,
-	117, 233, 118, 235, 119, 237, 120, 239, 121, 241, 122, 243, 123, 245, 124,
-	247, 125, 249, 126, 251, 127, 253, 128, 255, 129, 257, 2, 259, 2, 261,
-	2, 263, 2, 265, 2, 267, 2, 269, 2, 271, 2, 273, 2, 275, 2, 277, 2, 279,
-	2, 281, 2, 283, 2, 285, 2, 287, 2, 289, 2, 291, 2, 293, 2, 295, 2, 3, 2,
-	47, 4, 2, 87, 87, 119, 119, 4, 2, 80, 80, 112, 112, 4, 2, 75, 75, 107,
-	107, 4, 2, 81, 81, 113, 113, 4, 2, 67, 67, 99, 99, 4, 2, 78, 78, 110, 110,
-	4, 2, 82, 82, 114, 114, 4, 2, 86, 86, 118, 118, 4, 2, 79, 79, 111, 111,
-	4, 2, 69, 69, 101, 101, 4, 2, 74, 74, 106, 106, 4, 2, 89, 89, 121, 121,
-	4, 2, 70, 70, 102, 102, 4, 2, 85, 85, 117, 117, 4, 2, 71, 71, 103, 103,
-	4, 2, 84, 84, 116, 116, 4, 2, 73, 73, 105, 105, 4, 2, 88, 88, 120, 120,
-	4, 2, 91, 91, 123, 123, 4, 2, 68, 68, 100, 100, 4, 2, 77, 77, 109, 109,
-	4, 2, 90, 90, 122, 122, 4, 2, 72, 72, 104, 104, 15, 2, 36, 36, 41, 41,
-	68, 68, 72, 72, 80, 80, 84, 84, 86, 86, 94, 94, 100, 100, 104, 104, 112,
-	112, 116, 116, 118, 118, 4, 2, 67, 72, 99, 104, 4, 2, 83, 83, 115, 115,
-	10, 2, 162, 162, 5762, 5762, 6160, 6160, 8194, 8204, 8234, 8235, 8241,
-	8241, 8289, 8289, 12290, 12290, 3, 2, 14, 14, 3, 2, 98, 98, 3, 2, 32, 32,
-	3, 2, 44, 44, 4, 2, 41, 41, 94, 94, 4, 2, 12, 12, 15, 15, 3, 2, 49, 49,
-	3, 2, 31, 31, 3, 2, 30, 30, 3, 2, 15, 15, 19, 2, 38, 38, 164, 167, 1425,
-	1425, 1549, 1549, 2548, 2549, 2557, 2557, 2803, 2803, 3067, 3067, 3649,
-	3649, 6109, 6109, 8354, 8385, 43066, 43066, 65022, 65022, 65131, 65131,
-	65286, 65286, 65506, 65507, 65511, 65512, 3, 2, 34, 34, 8, 2, 97, 97, 8257,
-	8258, 8278, 8278, 65077, 65078, 65103, 65105, 65345, 65345, 3, 2, 11, 11,
-	4, 2, 36, 36, 94, 94, 3, 2, 12, 12, 3, 2, 13, 13, 3, 2, 33, 33, 4, 691,
-	2, 50, 2, 59, 2, 67, 2, 92, 2, 97, 2, 97, 2, 99, 2, 124, 2, 172, 2, 172,
-	2, 183, 2, 183, 2, 185, 2, 185, 2, 188, 2, 188, 2, 194, 2, 216, 2, 218,
-	2, 248, 2, 250, 2, 707, 2, 712, 2, 723, 2, 738, 2, 742, 2, 750, 2, 750,
-	2, 752, 2, 752, 2, 770, 2, 886, 2, 888, 2, 889, 2, 892, 2, 895, 2, 897,
-	2, 897, 2, 904, 2, 908, 2, 910, 2, 910, 2, 912, 2, 931, 2, 933, 2, 1015,
-	2, 1017, 2, 1155, 2, 1157, 2, 1161, 2, 1164, 2, 1329, 2, 1331, 2, 1368,
-	2, 1371, 2, 1371, 2, 1379, 2, 1417, 2, 1427, 2, 1471, 2, 1473, 2, 1473,
-	2, 1475, 2, 1476, 2, 1478, 2, 1479, 2, 1481, 2, 1481, 2, 1490, 2, 1516,
-	2, 1522, 2, 1524, 2, 1554, 2, 1564, 2, 1570, 2, 1643, 2, 1648, 2, 1749,
-	2, 1751, 2, 1758, 2, 1761, 2, 1770, 2, 1772, 2, 1790, 2, 1793, 2, 1793,
-	2, 1810, 2, 1868, 2, 1871, 2, 1971, 2, 1986, 2, 2039, 2, 2044, 2, 2044,
-	2, 2050, 2, 2095, 2, 2114, 2, 2141, 2, 2146, 2, 2156, 2, 2210, 2, 2230,
-	2, 2232, 2, 2239, 2, 2262, 2, 2275, 2, 2277, 2, 2405, 2, 2408, 2, 2417,
-	2, 2419, 2, 2437, 2, 2439, 2, 2446, 2, 2449, 2, 2450, 2, 2453, 2, 2474,
-	2, 2476, 2, 2482, 2, 2484, 2, 2484, 2, 2488, 2, 2491, 2, 2494, 2, 2502,
-	2, 2505, 2, 2506, 2, 2509, 2, 2512, 2, 2521, 2, 2521, 2, 2526, 2, 2527,
-	2, 2529, 2, 2533, 2, 2536, 2, 2547, 2, 2558, 2, 2558, 2, 2563, 2, 2565,
-	2, 2567, 2, 2572, 2, 2577, 2, 2578, 2, 2581, 2, 2602, 2, 2604, 2, 2610,
-	2, 2612, 2, 2613, 2, 2615, 2, 2616, 2, 2618, 2, 2619, 2, 2622, 2, 2622,
-	2, 2624, 2, 2628, 2, 2633, 2, 2634, 2, 2637, 2, 2639, 2, 2643, 2, 2643,
-	2, 2651, 2, 2654, 2, 2656, 2, 2656, 2, 2664, 2, 2679, 2, 2691, 2, 2693,
+	4, 147, 9, 147, 4, 148, 9, 148, 4, 149, 9, 149, 4, 150, 9, 150, 4, 151,
+	9, 151, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 6, 3,
+	6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12,
+	3, 12, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15, 3, 16, 3, 16, 3,
+	17, 3, 17, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 21, 3, 21,
+	3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 25, 3,
+	25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3, 29, 3, 30, 3, 30,
+	3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 35, 3, 35, 3,
+	36, 3, 36, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39, 3, 39, 3, 40, 3, 40, 3, 41,
+	3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 3, 44, 3, 44, 3, 45, 3, 45, 3, 46, 3,
+	46, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49,
+	3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3,
+	50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3,
+	54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56,
+	3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3,
+	58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60,
+	3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3,
+	62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63,
+	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3,
+	65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
+	3, 66, 3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3,
+	69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70,
+	3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3,
+	72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73,
+	3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3,
+	76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77,
+	3, 78, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79, 3, 80, 3, 80, 3,
+	80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82,
+	3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3,
+	84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86,
+	3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3,
+	88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90,
+	3, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3,
+	92, 3, 92, 3, 92, 3, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93,
+	3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3,
+	96, 3, 96, 3, 96, 3, 96, 3, 97, 3, 97, 3, 97, 3, 97, 3, 97, 3, 98, 3, 98,
+	3, 98, 3, 98, 3, 98, 3, 99, 3, 99, 3, 99, 7, 99, 710, 10, 99, 12, 99, 14,
+	99, 713, 11, 99, 3, 99, 3, 99, 3, 99, 3, 99, 7, 99, 719, 10, 99, 12, 99,
+	14, 99, 722, 11, 99, 3, 99, 5, 99, 725, 10, 99, 3, 100, 3, 100, 3, 100,
+	3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100,
+	3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 5, 100, 745, 10, 100, 3,
+	101, 3, 101, 3, 101, 3, 101, 6, 101, 751, 10, 101, 13, 101, 14, 101, 752,
+	3, 102, 3, 102, 3, 102, 7, 102, 758, 10, 102, 12, 102, 14, 102, 761, 11,
+	102, 5, 102, 763, 10, 102, 3, 103, 3, 103, 6, 103, 767, 10, 103, 13, 103,
+	14, 103, 768, 3, 104, 5, 104, 772, 10, 104, 3, 105, 3, 105, 5, 105, 776,
+	10, 105, 3, 106, 3, 106, 5, 106, 780, 10, 106, 3, 107, 3, 107, 5, 107,
+	784, 10, 107, 3, 108, 3, 108, 3, 109, 3, 109, 5, 109, 790, 10, 109, 3,
+	110, 3, 110, 3, 111, 6, 111, 795, 10, 111, 13, 111, 14, 111, 796, 3, 111,
+	6, 111, 800, 10, 111, 13, 111, 14, 111, 801, 3, 111, 3, 111, 6, 111, 806,
+	10, 111, 13, 111, 14, 111, 807, 3, 111, 3, 111, 6, 111, 812, 10, 111, 13,
+	111, 14, 111, 813, 5, 111, 816, 10, 111, 3, 111, 3, 111, 5, 111, 820, 10,
+	111, 3, 111, 6, 111, 823, 10, 111, 13, 111, 14, 111, 824, 3, 112, 7, 112,
+	828, 10, 112, 12, 112, 14, 112, 831, 11, 112, 3, 112, 3, 112, 6, 112, 835,
+	10, 112, 13, 112, 14, 112, 836, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113,
+	3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 114, 3, 114, 3, 114,
+	3, 115, 3, 115, 3, 115, 3, 115, 3, 116, 3, 116, 3, 116, 3, 116, 3, 116,
+	3, 116, 3, 116, 3, 116, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117, 3, 117,
+	3, 117, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118, 3, 118,
+	3, 118, 3, 118, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119, 3, 119,
+	3, 120, 3, 120, 3, 120, 3, 121, 3, 121, 3, 121, 3, 121, 3, 122, 3, 122,
+	3, 122, 3, 122, 3, 122, 3, 123, 3, 123, 3, 123, 3, 123, 3, 123, 3, 123,
+	3, 123, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124, 3, 124,
+	3, 125, 3, 125, 7, 125, 918, 10, 125, 12, 125, 14, 125, 921, 11, 125, 3,
+	126, 3, 126, 5, 126, 925, 10, 126, 3, 127, 3, 127, 5, 127, 929, 10, 127,
+	3, 128, 3, 128, 7, 128, 933, 10, 128, 12, 128, 14, 128, 936, 11, 128, 3,
+	128, 6, 128, 939, 10, 128, 13, 128, 14, 128, 940, 3, 129, 6, 129, 944,
+	10, 129, 13, 129, 14, 129, 945, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130,
+	3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 3, 130, 5, 130, 960, 10,
+	130, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131, 7, 131, 968, 10, 131,
+	12, 131, 14, 131, 971, 11, 131, 3, 131, 3, 131, 3, 131, 3, 131, 3, 131,
+	3, 131, 7, 131, 979, 10, 131, 12, 131, 14, 131, 982, 11, 131, 3, 131, 5,
+	131, 985, 10, 131, 3, 131, 3, 131, 5, 131, 989, 10, 131, 5, 131, 991, 10,
+	131, 3, 132, 3, 132, 3, 133, 3, 133, 3, 134, 3, 134, 3, 135, 3, 135, 3,
+	136, 3, 136, 3, 137, 3, 137, 3, 138, 3, 138, 3, 139, 3, 139, 3, 140, 3,
+	140, 3, 141, 3, 141, 3, 142, 3, 142, 3, 143, 3, 143, 3, 144, 3, 144, 3,
+	145, 3, 145, 3, 146, 3, 146, 3, 147, 3, 147, 3, 148, 3, 148, 3, 149, 3,
+	149, 3, 150, 3, 150, 3, 151, 3, 151, 2, 2, 152, 3, 3, 5, 4, 7, 5, 9, 6,
+	11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29,
+	16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47,
+	25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65,
+	34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83,
+	43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101,
+	52, 103, 53, 105, 54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117,
+	60, 119, 61, 121, 62, 123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133,
+	68, 135, 69, 137, 70, 139, 71, 141, 72, 143, 73, 145, 74, 147, 75, 149,
+	76, 151, 77, 153, 78, 155, 79, 157, 80, 159, 81, 161, 82, 163, 83, 165,
+	84, 167, 85, 169, 86, 171, 87, 173, 88, 175, 89, 177, 90, 179, 91, 181,
+	92, 183, 93, 185, 94, 187, 95, 189, 96, 191, 97, 193, 98, 195, 99, 197,
+	100, 199, 101, 201, 102, 203, 103, 205, 104, 207, 105, 209, 106, 211, 107,
+	213, 108, 215, 109, 217, 110, 219, 111, 221, 112, 223, 113, 225, 114, 227,
+	115, 229, 116, 231, 117, 233, 118, 235, 119, 237, 120, 239, 121, 241, 122,
+	243, 123, 245, 124, 247, 125, 249, 126, 251, 127, 253, 128, 255, 129, 257,
+	130, 259, 131, 261, 132, 263, 2, 265, 2, 267, 2, 269, 2, 271, 2, 273, 2,
+	275, 2, 277, 2, 279, 2, 281, 2, 283, 2, 285, 2, 287, 2, 289, 2, 291, 2,
+	293, 2, 295, 2, 297, 2, 299, 2, 301, 2, 3, 2, 47, 4, 2, 87, 87, 119, 119,
+	4, 2, 80, 80, 112, 112, 4, 2, 75, 75, 107, 107, 4, 2, 81, 81, 113, 113,
+	4, 2, 67, 67, 99, 99, 4, 2, 78, 78, 110, 110, 4, 2, 82, 82, 114, 114, 4,
+	2, 86, 86, 118, 118, 4, 2, 79, 79, 111, 111, 4, 2, 69, 69, 101, 101, 4,
+	2, 74, 74, 106, 106, 4, 2, 89, 89, 121, 121, 4, 2, 70, 70, 102, 102, 4,
+	2, 85, 85, 117, 117, 4, 2, 71, 71, 103, 103, 4, 2, 84, 84, 116, 116, 4,
+	2, 73, 73, 105, 105, 4, 2, 88, 88, 120, 120, 4, 2, 91, 91, 123, 123, 4,
+	2, 68, 68, 100, 100, 4, 2, 77, 77, 109, 109, 4, 2, 90, 90, 122, 122, 4,
+	2, 72, 72, 104, 104, 15, 2, 36, 36, 41, 41, 68, 68, 72, 72, 80, 80, 84,
+	84, 86, 86, 94, 94, 100, 100, 104, 104, 112, 112, 116, 116, 118, 118, 4,
+	2, 67, 72, 99, 104, 4, 2, 83, 83, 115, 115, 10, 2, 162, 162, 5762, 5762,
+	6160, 6160, 8194, 8204, 8234, 8235, 8241, 8241, 8289, 8289, 12290, 12290,
+	3, 2, 14, 14, 3, 2, 98, 98, 3, 2, 32, 32, 3, 2, 44, 44, 4, 2, 41, 41, 94,
+	94, 4, 2, 12, 12, 15, 15, 3, 2, 49, 49, 3, 2, 31, 31, 3, 2, 30, 30, 3,
+	2, 15, 15, 19, 2, 38, 38, 164, 167, 1425, 1425, 1549, 1549, 2548, 2549,
+	2557, 2557, 2803, 2803, 3067, 3067, 3649, 3649, 6109, 6109, 8354, 8385,
+	43066, 43066, 65022, 65022, 65131, 65131, 65286, 65286, 65506, 65507, 65511,
+	65512, 3, 2, 34, 34, 8, 2, 97, 97, 8257, 8258, 8278, 8278, 65077, 65078,
+	65103, 65105, 65345, 65345, 3, 2, 11, 11, 4, 2, 36, 36, 94, 94, 3, 2, 12,
+	12, 3, 2, 13, 13, 3, 2, 33, 33, 4, 691, 2, 50, 2, 59, 2, 67, 2, 92, 2,
+	97, 2, 97, 2, 99, 2, 124, 2, 172, 2, 172, 2, 183, 2, 183, 2, 185, 2, 185,
+	2, 188, 2, 188, 2, 194, 2, 216, 2, 218, 2, 248, 2, 250, 2, 707, 2, 712,
+	2, 723, 2, 738, 2, 742, 2, 750, 2, 750, 2, 752, 2, 752, 2, 770, 2, 886,
+	2, 888, 2, 889, 2, 892, 2, 895, 2, 897, 2, 897, 2, 904, 2, 908, 2, 910,
+	2, 910, 2, 912, 2, 931, 2, 933, 2, 1015, 2, 1017, 2, 1155, 2, 1157, 2,
+	1161, 2, 1164, 2, 1329, 2, 1331, 2, 1368, 2, 1371, 2, 1371, 2, 1379, 2,
+	1417, 2, 1427, 2, 1471, 2, 1473, 2, 1473, 2, 1475, 2, 1476, 2, 1478, 2,
+	1479, 2, 1481, 2, 1481, 2, 1490, 2, 1516, 2, 1522, 2, 1524, 2, 1554, 2,
+	1564, 2, 1570, 2, 1643, 2, 1648, 2, 1749, 2, 1751, 2, 1758, 2, 1761, 2,
+	1770, 2, 1772, 2, 1790, 2, 1793, 2, 1793, 2, 1810, 2, 1868, 2, 1871, 2,
+	1971, 2, 1986, 2, 2039, 2, 2044, 2, 2044, 2, 2050, 2, 2095, 2, 2114, 2,
+	2141, 2, 2146, 2, 2156, 2, 2210, 2, 2230, 2, 2232, 2, 2239, 2, 2262, 2,
+	2275, 2, 2277, 2, 2405, 2, 2408, 2, 2417, 2, 2419, 2, 2437, 2, 2439, 2,
+	2446, 2, 2449, 2, 2450, 2, 2453, 2, 2474, 2, 2476, 2, 2482, 2, 2484, 2,
+	2484, 2, 2488, 2, 2491, 2, 2494, 2, 2502, 2, 2505, 2, 2506, 2, 2509, 2,
+	2512, 2, 2521, 2, 2521, 2, 2526, 2, 2527, 2, 2529, 2, 2533, 2, 2536, 2,
+	2547, 2, 2558, 2, 2558, 2, 2563, 2, 2565, 2, 2567, 2, 2572, 2, 2577, 2,
+	2578, 2, 2581, 2, 2602, 2, 2604, 2, 2610, 2, 2612, 2, 2613, 2, 2615, 2,
+	2616, 2, 2618, 2, 2619, 2, 2622, 2, 2622, 2, 2624, 2, 2628, 2, 2633, 2,
+	2634, 2, 2637, 2, 2639, 2, 2643, 2, 2643, 2, 2651, 2, 2654, 2, 2656, 2,
+	2656, 2, 2664, 2, 2679, 2, 2691, 2, 2693, 2, 2695, 2, 2703, 2, 2705, 2,
+	2707, 2, 2709, 2, 2730, 2, 2732, 2, 2738, 2, 2740, 2, 2741, 2, 2743, 2,
+	2747, 2, 2750, 2, 2759, 2, 2761, 2, 2763, 2, 2765, 2, 2767, 2, 2770, 2,
+	2770, 2, 2786, 2, 2789, 2, 2792, 2, 2801, 2, 2811, 2, 2817, 2, 2819, 2,
+	2821, 2, 2823, 2, 2830, 2, 2833, 2, 2834, 2, 2837, 2, 2858, 2, 2860, 2,
+	2866, 2, 2868, 2, 2869, 2, 2871, 2, 2875, 2, 2878, 2, 2886, 2, 2889, 2,
+	2890, 2, 2893, 2, 2895, 2, 2904, 2, 2905, 2, 2910, 2, 2911, 2, 2913, 2,
+	2917, 2, 2920, 2, 2929, 2, 2931, 2, 2931, 2, 2948, 2, 2949, 2, 2951, 2,
+	2956, 2, 2960, 2, 2962, 2, 2964, 2, 2967, 2, 2971, 2, 2972, 2, 2974, 2,
+	2974, 2, 2976, 2, 2977, 2, 2981, 2, 2982, 2, 2986, 2, 2988, 2, 2992, 2,
+	3003, 2, 3008, 2, 3012, 2, 3016, 2, 3018, 2, 3020, 2, 3023, 2, 3026, 2,
+	3026, 2, 3033, 2, 3033, 2, 3048, 2, 3057, 2, 3074, 2, 3077, 2, 3079, 2,
+	3086, 2, 3088, 2, 3090, 2, 3092, 2, 3114, 2, 3116, 2, 3131, 2, 3135, 2,
+	3142, 2, 3144, 2, 3146, 2, 3148, 2, 3151, 2, 3159, 2, 3160, 2, 3162, 2,
+	3164, 2, 3170, 2, 3173, 2, 3176, 2, 3185, 2, 3202, 2, 3205, 2, 3207, 2,
+	3214, 2, 3216, 2, 3218, 2, 3220, 2, 3242, 2, 3244, 2, 3253, 2, 3255, 2,
+	3259, 2, 3262, 2, 3270, 2, 3272, 2, 3274, 2, 3276, 2, 3279, 2, 3287, 2,
+	3288, 2, 3296, 2, 3296, 2, 3298, 2, 3301, 2, 3304, 2, 3313, 2, 3315, 2,
+	3316, 2, 3330, 2, 3333, 2, 3335, 2, 3342, 2, 3344, 2, 3346, 2, 3348, 2,
+	3398, 2, 3400, 2, 3402, 2, 3404, 2, 3408, 2, 3414, 2, 3417, 2, 3425, 2,
+	3429, 2, 3432, 2, 3441, 2, 3452, 2, 3457, 2, 3460, 2, 3461, 2, 3463, 2,
+	3480, 2, 3484, 2, 3507, 2, 3509, 2, 3517, 2, 3519, 2, 3519, 2, 3522, 2,
+	3528, 2, 3532, 2, 3532, 2, 3537, 2, 3542, 2, 3544, 2, 3544, 2, 3546, 2,
+	3553, 2, 3560, 2, 3569, 2, 3572, 2, 3573, 2, 3587, 2, 3644, 2, 3650, 2,
+	3664, 2, 3666, 2, 3675, 2, 3715, 2, 3716, 2, 3718, 2, 3718, 2, 3721, 2,
+	3722, 2, 3724, 2, 3724, 2, 3727, 2, 3727, 2, 3734, 2, 3737, 2, 3739, 2,
+	3745, 2, 3747, 2, 3749, 2, 3751, 2, 3751, 2, 3753, 2, 3753, 2, 3756, 2,
+	3757, 2, 3759, 2, 3771, 2, 3773, 2, 3775, 2, 3778, 2, 3782, 2, 3784, 2,
+	3784, 2, 3786, 2, 3791, 2, 3794, 2, 3803, 2, 3806, 2, 3809, 2, 3842, 2,
+	3842, 2, 3866, 2, 3867, 2, 3874, 2, 3883, 2, 3895, 2, 3895, 2, 3897, 2,
+	3897, 2, 3899, 2, 3899, 2, 3904, 2, 3913, 2, 3915, 2, 3950, 2, 3955, 2,
+	3974, 2, 3976, 2, 3993, 2, 3995, 2, 4030, 2, 4040, 2, 4040, 2, 4098, 2,
+	4171, 2, 4178, 2, 4255, 2, 4258, 2, 4295, 2, 4297, 2, 4297, 2, 4303, 2,
+	4303, 2, 4306, 2, 4348, 2, 4350, 2, 4682, 2, 4684, 2, 4687, 2, 4690, 2,
+	4696, 2, 4698, 2, 4698, 2, 4700, 2, 4703, 2, 4706, 2, 4746, 2, 4748, 2,
+	4751, 2, 4754, 2, 4786, 2, 4788, 2, 4791, 2, 4794, 2, 4800, 2, 4802, 2,
+	4802, 2, 4804, 2, 4807, 2, 4810, 2, 4824, 2, 4826, 2, 4882, 2, 4884, 2,
+	4887, 2, 4890, 2, 4956, 2, 4959, 2, 4961, 2, 4971, 2, 4979, 2, 4994, 2,
+	5009, 2, 5026, 2, 5111, 2, 5114, 2, 5119, 2, 5123, 2, 5742, 2, 5745, 2,
+	5761, 2, 5763, 2, 5788, 2, 5794, 2, 5868, 2, 5872, 2, 5882, 2, 5890, 2,
+	5902, 2, 5904, 2, 5910, 2, 5922, 2, 5942, 2, 5954, 2, 5973, 2, 5986, 2,
+	5998, 2, 6000, 2, 6002, 2, 6004, 2, 6005, 2, 6018, 2, 6101, 2, 6105, 2,
+	6105, 2, 6110, 2, 6111, 2, 6114, 2, 6123, 2, 6157, 2, 6159, 2, 6162, 2,
+	6171, 2, 6178, 2, 6265, 2, 6274, 2, 6316, 2, 6322, 2, 6391, 2, 6402, 2,
+	6432, 2, 6434, 2, 6445, 2, 6450, 2, 6461, 2, 6472, 2, 6511, 2, 6514, 2,
+	6518, 2, 6530, 2, 6573, 2, 6578, 2, 6603, 2, 6610, 2, 6620, 2, 6658, 2,
+	6685, 2, 6690, 2, 6752, 2, 6754, 2, 6782, 2, 6785, 2, 6795, 2, 6802, 2,
+	6811, 2, 6825, 2, 6825, 2, 6834, 2, 6847, 2, 6914, 2, 6989, 2, 6994, 2,
+	7003, 2, 7021, 2, 7029, 2, 7042, 2, 7157, 2, 7170, 2, 7225, 2, 7234, 2,
+	7243, 2, 7247, 2, 7295, 2, 7298, 2, 7306, 2, 7378, 2, 7380, 2, 7382, 2,
+	7419, 2, 7426, 2, 7675, 2, 7677, 2, 7959, 2, 7962, 2, 7967, 2, 7970, 2,
+	8007, 2, 8010, 2, 8015, 2, 8018, 2, 8025, 2, 8027, 2, 8027, 2, 8029, 2,
+	8029, 2, 8031, 2, 8031, 2, 8033, 2, 8063, 2, 8066, 2, 8118, 2, 8120, 2,
+	8126, 2, 8128, 2, 8128, 2, 8132, 2, 8134, 2, 8136, 2, 8142, 2, 8146, 2,
+	8149, 2, 8152, 2, 8157, 2, 8162, 2, 8174, 2, 8180, 2, 8182, 2, 8184, 2,
+	8190, 2, 8257, 2, 8258, 2, 8278, 2, 8278, 2, 8307, 2, 8307, 2, 8321, 2,
+	8321, 2, 8338, 2, 8350, 2, 8402, 2, 8414, 2, 8419, 2, 8419, 2, 8423, 2,
+	8434, 2, 8452, 2, 8452, 2, 8457, 2, 8457, 2, 8460, 2, 8469, 2, 8471, 2,
+	8471, 2, 8474, 2, 8479, 2, 8486, 2, 8486, 2, 8488, 2, 8488, 2, 8490, 2,
+	8490, 2, 8492, 2, 8507, 2, 8510, 2, 8513, 2, 8519, 2, 8523, 2, 8528, 2,
+	8528, 2, 8546, 2, 8586, 2, 11266, 2, 11312, 2, 11314, 2, 11360, 2, 11362,
+	2, 11494, 2, 11501, 2, 11509, 2, 11522, 2, 11559, 2, 11561, 2, 11561, 2,
+	11567, 2, 11567, 2, 11570, 2, 11625, 2, 11633, 2, 11633, 2, 11649, 2, 11672,
+	2, 11682, 2, 11688, 2, 11690, 2, 11696, 2, 11698, 2, 11704, 2, 11706, 2,
+	11712, 2, 11714, 2, 11720, 2, 11722, 2, 11728, 2, 11730, 2, 11736, 2, 11738,
+	2, 11744, 2, 11746, 2, 11777, 2, 12295, 2, 12297, 2, 12323, 2, 12337, 2,
+	12339, 2, 12343, 2, 12346, 2, 12350, 2, 12355, 2, 12440, 2, 12443, 2, 12449,
+	2, 12451, 2, 12540, 2, 12542, 2, 12545, 2, 12551, 2, 12592, 2, 12595, 2,
+	12688, 2, 12706, 2, 12732, 2, 12786, 2, 12801, 2, 13314, 2, 19895, 2, 19970,
+	2, 40940, 2, 40962, 2, 42126, 2, 42194, 2, 42239, 2, 42242, 2, 42510, 2,
+	42514, 2, 42541, 2, 42562, 2, 42609, 2, 42614, 2, 42623, 2, 42625, 2, 42739,
+	2, 42777, 2, 42785, 2, 42788, 2, 42890, 2, 42893, 2, 42928, 2, 42930, 2,
+	42937, 2, 43001, 2, 43049, 2, 43074, 2, 43125, 2, 43138, 2, 43207, 2, 43218,
+	2, 43227, 2, 43234, 2, 43257, 2, 43261, 2, 43261, 2, 43263, 2, 43263, 2,
+	43266, 2, 43311, 2, 43314, 2, 43349, 2, 43362, 2, 43390, 2, 43394, 2, 43458,
+	2, 43473, 2, 43483, 2, 43490, 2, 43520, 2, 43522, 2, 43576, 2, 43586, 2,
+	43599, 2, 43602, 2, 43611, 2, 43618, 2, 43640, 2, 43644, 2, 43716, 2, 43741,
+	2, 43743, 2, 43746, 2, 43761, 2, 43764, 2, 43768, 2, 43779, 2, 43784, 2,
+	43787, 2, 43792, 2, 43795, 2, 43800, 2, 43810, 2, 43816, 2, 43818, 2, 43824,
+	2, 43826, 2, 43868, 2, 43870, 2, 43879, 2, 43890, 2, 44012, 2, 44014, 2,
+	44015, 2, 44018, 2, 44027, 2, 44034, 2, 55205, 2, 55218, 2, 55240, 2, 55245,
+	2, 55293, 2, 63746, 2, 64111, 2, 64114, 2, 64219, 2, 64258, 2, 64264, 2,
+	64277, 2, 64281, 2, 64287, 2, 64298, 2, 64300, 2, 64312, 2, 64314, 2, 64318,
+	2, 64320, 2, 64320, 2, 64322, 2, 64323, 2, 64325, 2, 64326, 2, 64328, 2,
+	64435, 2, 64469, 2, 64831, 2, 64850, 2, 64913, 2, 64916, 2, 64969, 2, 65010,
+	2, 65021, 2, 65026, 2, 65041, 2, 65058, 2, 65073, 2, 65077, 2, 65078, 2,
+	65103, 2, 65105, 2, 65138, 2, 65142, 2, 65144, 2, 65278, 2, 65298, 2, 65307,
+	2, 65315, 2, 65340, 2, 65345, 2, 65345, 2, 65347, 2, 65372, 2, 65384, 2,
+	65472, 2, 65476, 2, 65481, 2, 65484, 2, 65489, 2, 65492, 2, 65497, 2, 65500,
+	2, 65502, 2, 2, 3, 13, 3, 15, 3, 40, 3, 42, 3, 60, 3, 62, 3, 63, 3, 65,
+	3, 79, 3, 82, 3, 95, 3, 130, 3, 252, 3, 322, 3, 374, 3, 511, 3, 511, 3,
+	642, 3, 670, 3, 674, 3, 722, 3, 738, 3, 738, 3, 770, 3, 801, 3, 815, 3,
+	844, 3, 850, 3, 892, 3, 898, 3, 927, 3, 930, 3, 965, 3, 970, 3, 977, 3,
+	979, 3, 983, 3, 1026, 3, 1183, 3, 1186, 3, 1195, 3, 1202, 3, 1237, 3, 1242,
+	3, 1277, 3, 1282, 3, 1321, 3, 1330, 3, 1381, 3, 1538, 3, 1848, 3, 1858,
+	3, 1879, 3, 1890, 3, 1897, 3, 2050, 3, 2055, 3, 2058, 3, 2058, 3, 2060,
+	3, 2103, 3, 2105, 3, 2106, 3, 2110, 3, 2110, 3, 2113, 3, 2135, 3, 2146,
+	3, 2168, 3, 2178, 3, 2208, 3, 2274, 3, 2292, 3, 2294, 3, 2295, 3, 2306,
+	3, 2327, 3, 2338, 3, 2363, 3, 2434, 3, 2489, 3, 2496, 3, 2497, 3, 2562,
+	3, 2565, 3, 2567, 3, 2568, 3, 2574, 3, 2581, 3, 2583, 3, 2585, 3, 2587,
+	3, 2613, 3, 2618, 3, 2620, 3, 2625, 3, 2625, 3, 2658, 3, 2686, 3, 2690,
+	3, 2718, 3, 2754, 3, 2761, 3, 2763, 3, 2792, 3, 2818, 3, 2871, 3, 2882,
+	3, 2903, 3, 2914, 3, 2932, 3, 2946, 3, 2963, 3, 3074, 3, 3146, 3, 3202,
+	3, 3252, 3, 3266, 3, 3316, 3, 4098, 3, 4168, 3, 4200, 3, 4209, 3, 4225,
+	3, 4284, 3, 4306, 3, 4330, 3, 4338, 3, 4347, 3, 4354, 3, 4406, 3, 4408,
+	3, 4417, 3, 4434, 3, 4469, 3, 4472, 3, 4472, 3, 4482, 3, 4550, 3, 4556,
+	3, 4558, 3, 4562, 3, 4572, 3, 4574, 3, 4574, 3, 4610, 3, 4627, 3, 4629,
+	3, 4665, 3, 4672, 3, 4672, 3, 4738, 3, 4744, 3, 4746, 3, 4746, 3, 4748,
+	3, 4751, 3, 4753, 3, 4767, 3, 4769, 3, 4778, 3, 4786, 3, 4844, 3, 4850,
+	3, 4859, 3, 4866, 3, 4869, 3, 4871, 3, 4878, 3, 4881, 3, 4882, 3, 4885,
+	3, 4906, 3, 4908, 3, 4914, 3, 4916, 3, 4917, 3, 4919, 3, 4923, 3, 4926,
+	3, 4934, 3, 4937, 3, 4938, 3, 4941, 3, 4943, 3, 4946, 3, 4946, 3, 4953,
+	3, 4953, 3, 4959, 3, 4965, 3, 4968, 3, 4974, 3, 4978, 3, 4982, 3, 5122,
+	3, 5196, 3, 5202, 3, 5211, 3, 5250, 3, 5319, 3, 5321, 3, 5321, 3, 5330,
+	3, 5339, 3, 5506, 3, 5559, 3, 5562, 3, 5570, 3, 5594, 3, 5599, 3, 5634,
+	3, 5698, 3, 5702, 3, 5702, 3, 5714, 3, 5723, 3, 5762, 3, 5817, 3, 5826,
+	3, 5835, 3, 5890, 3, 5915, 3, 5919, 3, 5933, 3, 5938, 3, 5947, 3, 6306,
+	3, 6379, 3, 6401, 3, 6401, 3, 6658, 3, 6720, 3, 6729, 3, 6729, 3, 6738,
+	3, 6789, 3, 6792, 3, 6811, 3, 6850, 3, 6906, 3, 7170, 3, 7178, 3, 7180,
+	3, 7224, 3, 7226, 3, 7234, 3, 7250, 3, 7259, 3, 7284, 3, 7313, 3, 7316,
+	3, 7337, 3, 7339, 3, 7352, 3, 7426, 3, 7432, 3, 7434, 3, 7435, 3, 7437,
+	3, 7480, 3, 7484, 3, 7484, 3, 7486, 3, 7487, 3, 7489, 3, 7497, 3, 7506,
+	3, 7515, 3, 8194, 3, 9115, 3, 9218, 3, 9328, 3, 9346, 3, 9541, 3, 12290,
+	3, 13360, 3, 17410, 3, 17992, 3, 26626, 3, 27194, 3, 27202, 3, 27232, 3,
+	27234, 3, 27243, 3, 27346, 3, 27375, 3, 27378, 3, 27382, 3, 27394, 3, 27448,
+	3, 27458, 3, 27461, 3, 27474, 3, 27483, 3, 27493, 3, 27513, 3, 27519, 3,
+	27537, 3, 28418, 3, 28486, 3, 28498, 3, 28544, 3, 28561, 3, 28577, 3, 28642,
+	3, 28643, 3, 28674, 3, 34798, 3, 34818, 3, 35572, 3, 45058, 3, 45344, 3,
+	45426, 3, 45821, 3, 48130, 3, 48236, 3, 48242, 3, 48254, 3, 48258, 3, 48266,
+	3, 48274, 3, 48283, 3, 48287, 3, 48288, 3, 53607, 3, 53611, 3, 53615, 3,
+	53620, 3, 53629, 3, 53636, 3, 53639, 3, 53645, 3, 53676, 3, 53679, 3, 53828,
+	3, 53830, 3, 54274, 3, 54358, 3, 54360, 3, 54430, 3, 54432, 3, 54433, 3,
+	54436, 3, 54436, 3, 54439, 3, 54440, 3, 54443, 3, 54446, 3, 54448, 3, 54459,
+	3, 54461, 3, 54461, 3, 54463, 3, 54469, 3, 54471, 3, 54535, 3, 54537, 3,
+	54540, 3, 54543, 3, 54550, 3, 54552, 3, 54558, 3, 54560, 3, 54587, 3, 54589,
+	3, 54592, 3, 54594, 3, 54598, 3, 54600, 3, 54600, 3, 54604, 3, 54610, 3,
+	54612, 3, 54951, 3, 54954, 3, 54978, 3, 54980, 3, 55004, 3, 55006, 3, 55036,
+	3, 55038, 3, 55062, 3, 55064, 3, 55094, 3, 55096, 3, 55120, 3, 55122, 3,
+	55152, 3, 55154, 3, 55178, 3, 55180, 3, 55210, 3, 55212, 3, 55236, 3, 55238,
+	3, 55245, 3, 55248, 3, 55297, 3, 55810, 3, 55864, 3, 55869, 3, 55918, 3,
+	55927, 3, 55927, 3, 55942, 3, 55942, 3, 55965, 3, 55969, 3, 55971, 3, 55985,
+	3, 57346, 3, 57352, 3, 57354, 3, 57370, 3, 57373, 3, 57379, 3, 57381, 3,
+	57382, 3, 57384, 3, 57388, 3, 59394, 3, 59590, 3, 59602, 3, 59608, 3, 59650,
+	3, 59724, 3, 59730, 3, 59739, 3, 60930, 3, 60933, 3, 60935, 3, 60961, 3,
+	60963, 3, 60964, 3, 60966, 3, 60966, 3, 60969, 3, 60969, 3, 60971, 3, 60980,
+	3, 60982, 3, 60985, 3, 60987, 3, 60987, 3, 60989, 3, 60989, 3, 60996, 3,
+	60996, 3, 61001, 3, 61001, 3, 61003, 3, 61003, 3, 61005, 3, 61005, 3, 61007,
+	3, 61009, 3, 61011, 3, 61012, 3, 61014, 3, 61014, 3, 61017, 3, 61017, 3,
+	61019, 3, 61019, 3, 61021, 3, 61021, 3, 61023, 3, 61023, 3, 61025, 3, 61025,
+	3, 61027, 3, 61028, 3, 61030, 3, 61030, 3, 61033, 3, 61036, 3, 61038, 3,
+	61044, 3, 61046, 3, 61049, 3, 61051, 3, 61054, 3, 61056, 3, 61056, 3, 61058,
+	3, 61067, 3, 61069, 3, 61085, 3, 61091, 3, 61093, 3, 61095, 3, 61099, 3,
+	61101, 3, 61117, 3, 2, 4, 42712, 4, 42754, 4, 46902, 4, 46914, 4, 47135,
+	4, 47138, 4, 52899, 4, 52914, 4, 60386, 4, 63490, 4, 64031, 4, 258, 16,
+	497, 16, 587, 2, 67, 2, 92, 2, 99, 2, 124, 2, 172, 2, 172, 2, 183, 2, 183,
+	2, 188, 2, 188, 2, 194, 2, 216, 2, 218, 2, 248, 2, 250, 2, 707, 2, 712,
+	2, 723, 2, 738, 2, 742, 2, 750, 2, 750, 2, 752, 2, 752, 2, 882, 2, 886,
+	2, 888, 2, 889, 2, 892, 2, 895, 2, 897, 2, 897, 2, 904, 2, 904, 2, 906,
+	2, 908, 2, 910, 2, 910, 2, 912, 2, 931, 2, 933, 2, 1015, 2, 1017, 2, 1155,
+	2, 1164, 2, 1329, 2, 1331, 2, 1368, 2, 1371, 2, 1371, 2, 1379, 2, 1417,
+	2, 1490, 2, 1516, 2, 1522, 2, 1524, 2, 1570, 2, 1612, 2, 1648, 2, 1649,
+	2, 1651, 2, 1749, 2, 1751, 2, 1751, 2, 1767, 2, 1768, 2, 1776, 2, 1777,
+	2, 1788, 2, 1790, 2, 1793, 2, 1793, 2, 1810, 2, 1810, 2, 1812, 2, 1841,
+	2, 1871, 2, 1959, 2, 1971, 2, 1971, 2, 1996, 2, 2028, 2, 2038, 2, 2039,
+	2, 2044, 2, 2044, 2, 2050, 2, 2071, 2, 2076, 2, 2076, 2, 2086, 2, 2086,
+	2, 2090, 2, 2090, 2, 2114, 2, 2138, 2, 2146, 2, 2156, 2, 2210, 2, 2230,
+	2, 2232, 2, 2239, 2, 2310, 2, 2363, 2, 2367, 2, 2367, 2, 2386, 2, 2386,
+	2, 2394, 2, 2403, 2, 2419, 2, 2434, 2, 2439, 2, 2446, 2, 2449, 2, 2450,
+	2, 2453, 2, 2474, 2, 2476, 2, 2482, 2, 2484, 2, 2484, 2, 2488, 2, 2491,
+	2, 2495, 2, 2495, 2, 2512, 2, 2512, 2, 2526, 2, 2527, 2, 2529, 2, 2531,
+	2, 2546, 2, 2547, 2, 2558, 2, 2558, 2, 2567, 2, 2572, 2, 2577, 2, 2578,
+	2, 2581, 2, 2602, 2, 2604, 2, 2610, 2, 2612, 2, 2613, 2, 2615, 2, 2616,
+	2, 2618, 2, 2619, 2, 2651, 2, 2654, 2, 2656, 2, 2656, 2, 2676, 2, 2678,
 	2, 2695, 2, 2703, 2, 2705, 2, 2707, 2, 2709, 2, 2730, 2, 2732, 2, 2738,
-	2, 2740, 2, 2741, 2, 2743, 2, 2747, 2, 2750, 2, 2759, 2, 2761, 2, 2763,
-	2, 2765, 2, 2767, 2, 2770, 2, 2770, 2, 2786, 2, 2789, 2, 2792, 2, 2801,
-	2, 2811, 2, 2817, 2, 2819, 2, 2821, 2, 2823, 2, 2830, 2, 2833, 2, 2834,
+	2, 2740, 2, 2741, 2, 2743, 2, 2747, 2, 2751, 2, 2751, 2, 2770, 2, 2770,
+	2, 2786, 2, 2787, 2, 2811, 2, 2811, 2, 2823, 2, 2830, 2, 2833, 2, 2834,
 	2, 2837, 2, 2858, 2, 2860, 2, 2866, 2, 2868, 2, 2869, 2, 2871, 2, 2875,
-	2, 2878, 2, 2886, 2, 2889, 2, 2890, 2, 2893, 2, 2895, 2, 2904, 2, 2905,
-	2, 2910, 2, 2911, 2, 2913, 2, 2917, 2, 2920, 2, 2929, 2, 2931, 2, 2931,
-	2, 2948, 2, 2949, 2, 2951, 2, 2956, 2, 2960, 2, 2962, 2, 2964, 2, 2967,
+	2, 2879, 2, 2879, 2, 2910, 2, 2911, 2, 2913, 2, 2915, 2, 2931, 2, 2931,
+	2, 2949, 2, 2949, 2, 2951, 2, 2956, 2, 2960, 2, 2962, 2, 2964, 2, 2967,
 	2, 2971, 2, 2972, 2, 2974, 2, 2974, 2, 2976, 2, 2977, 2, 2981, 2, 2982,
-	2, 2986, 2, 2988, 2, 2992, 2, 3003, 2, 3008, 2, 3012, 2, 3016, 2, 3018,
-	2, 3020, 2, 3023, 2, 3026, 2, 3026, 2, 3033, 2, 3033, 2, 3048, 2, 3057,
-	2, 3074, 2, 3077, 2, 3079, 2, 3086, 2, 3088, 2, 3090, 2, 3092, 2, 3114,
-	2, 3116, 2, 3131, 2, 3135, 2, 3142, 2, 3144, 2, 3146, 2, 3148, 2, 3151,
-	2, 3159, 2, 3160, 2, 3162, 2, 3164, 2, 3170, 2, 3173, 2, 3176, 2, 3185,
-	2, 3202, 2, 3205, 2, 3207, 2, 3214, 2, 3216, 2, 3218, 2, 3220, 2, 3242,
-	2, 3244, 2, 3253, 2, 3255, 2, 3259, 2, 3262, 2, 3270, 2, 3272, 2, 3274,
-	2, 3276, 2, 3279, 2, 3287, 2, 3288, 2, 3296, 2, 3296, 2, 3298, 2, 3301,
-	2, 3304, 2, 3313, 2, 3315, 2, 3316, 2, 3330, 2, 3333, 2, 3335, 2, 3342,
-	2, 3344, 2, 3346, 2, 3348, 2, 3398, 2, 3400, 2, 3402, 2, 3404, 2, 3408,
-	2, 3414, 2, 3417, 2, 3425, 2, 3429, 2, 3432, 2, 3441, 2, 3452, 2, 3457,
-	2, 3460, 2, 3461, 2, 3463, 2, 3480, 2, 3484, 2, 3507, 2, 3509, 2, 3517,
-	2, 3519, 2, 3519, 2, 3522, 2, 3528, 2, 3532, 2, 3532, 2, 3537, 2, 3542,
-	2, 3544, 2, 3544, 2, 3546, 2, 3553, 2, 3560, 2, 3569, 2, 3572, 2, 3573,
-	2, 3587, 2, 3644, 2, 3650, 2, 3664, 2, 3666, 2, 3675, 2, 3715, 2, 3716,
-	2, 3718, 2, 3718, 2, 3721, 2, 3722, 2, 3724, 2, 3724, 2, 3727, 2, 3727,
-	2, 3734, 2, 3737, 2, 3739, 2, 3745, 2, 3747, 2, 3749, 2, 3751, 2, 3751,
-	2, 3753, 2, 3753, 2, 3756, 2, 3757, 2, 3759, 2, 3771, 2, 3773, 2, 3775,
-	2, 3778, 2, 3782, 2, 3784, 2, 3784, 2, 3786, 2, 3791, 2, 3794, 2, 3803,
-	2, 3806, 2, 3809, 2, 3842, 2, 3842, 2, 3866, 2, 3867, 2, 3874, 2, 3883,
-	2, 3895, 2, 3895, 2, 3897, 2, 3897, 2, 3899, 2, 3899, 2, 3904, 2, 3913,
-	2, 3915, 2, 3950, 2, 3955, 2, 3974, 2, 3976, 2, 3993, 2, 3995, 2, 4030,
-	2, 4040, 2, 4040, 2, 4098, 2, 4171, 2, 4178, 2, 4255, 2, 4258, 2, 4295,
-	2, 4297, 2, 4297, 2, 4303, 2, 4303, 2, 4306, 2, 4348, 2, 4350, 2, 4682,
-	2, 4684, 2, 4687, 2, 4690, 2, 4696, 2, 4698, 2, 4698, 2, 4700, 2, 4703,
-	2, 4706, 2, 4746, 2, 4748, 2, 4751, 2, 4754, 2, 4786, 2, 4788, 2, 4791,
-	2, 4794, 2, 4800, 2, 4802, 2, 4802, 2, 4804, 2, 4807, 2, 4810, 2, 4824,
-	2, 4826, 2, 4882, 2, 4884, 2, 4887, 2, 4890, 2, 4956, 2, 4959, 2, 4961,
-	2, 4971, 2, 4979, 2, 4994, 2, 5009, 2, 5026, 2, 5111, 2, 5114, 2, 5119,
-	2, 5123, 2, 5742, 2, 5745, 2, 5761, 2, 5763, 2, 5788, 2, 5794, 2, 5868,
-	2, 5872, 2, 5882, 2, 5890, 2, 5902, 2, 5904, 2, 5910, 2, 5922, 2, 5942,
-	2, 5954, 2, 5973, 2, 5986, 2, 5998, 2, 6000, 2, 6002, 2, 6004, 2, 6005,
-	2, 6018, 2, 6101, 2, 6105, 2, 6105, 2, 6110, 2, 6111, 2, 6114, 2, 6123,
-	2, 6157, 2, 6159, 2, 6162, 2, 6171, 2, 6178, 2, 6265, 2, 6274, 2, 6316,
-	2, 6322, 2, 6391, 2, 6402, 2, 6432, 2, 6434, 2, 6445, 2, 6450, 2, 6461,
-	2, 6472, 2, 6511, 2, 6514, 2, 6518, 2, 6530, 2, 6573, 2, 6578, 2, 6603,
-	2, 6610, 2, 6620, 2, 6658, 2, 6685, 2, 6690, 2, 6752, 2, 6754, 2, 6782,
-	2, 6785, 2, 6795, 2, 6802, 2, 6811, 2, 6825, 2, 6825, 2, 6834, 2, 6847,
-	2, 6914, 2, 6989, 2, 6994, 2, 7003, 2, 7021, 2, 7029, 2, 7042, 2, 7157,
-	2, 7170, 2, 7225, 2, 7234, 2, 7243, 2, 7247, 2, 7295, 2, 7298, 2, 7306,
-	2, 7378, 2, 7380, 2, 7382, 2, 7419, 2, 7426, 2, 7675, 2, 7677, 2, 7959,
-	2, 7962, 2, 7967, 2, 7970, 2, 8007, 2, 8010, 2, 8015, 2, 8018, 2, 8025,
-	2, 8027, 2, 8027, 2, 8029, 2, 8029, 2, 8031, 2, 8031, 2, 8033, 2, 8063,
-	2, 8066, 2, 8118, 2, 8120, 2, 8126, 2, 8128, 2, 8128, 2, 8132, 2, 8134,
-	2, 8136, 2, 8142, 2, 8146, 2, 8149, 2, 8152, 2, 8157, 2, 8162, 2, 8174,
-	2, 8180, 2, 8182, 2, 8184, 2, 8190, 2, 8257, 2, 8258, 2, 8278, 2, 8278,
-	2, 8307, 2, 8307, 2, 8321, 2, 8321, 2, 8338, 2, 8350, 2, 8402, 2, 8414,
-	2, 8419, 2, 8419, 2, 8423, 2, 8434, 2, 8452, 2, 8452, 2, 8457, 2, 8457,
+	2, 2986, 2, 2988, 2, 2992, 2, 3003, 2, 3026, 2, 3026, 2, 3079, 2, 3086,
+	2, 3088, 2, 3090, 2, 3092, 2, 3114, 2, 3116, 2, 3131, 2, 3135, 2, 3135,
+	2, 3162, 2, 3164, 2, 3170, 2, 3171, 2, 3202, 2, 3202, 2, 3207, 2, 3214,
+	2, 3216, 2, 3218, 2, 3220, 2, 3242, 2, 3244, 2, 3253, 2, 3255, 2, 3259,
+	2, 3263, 2, 3263, 2, 3296, 2, 3296, 2, 3298, 2, 3299, 2, 3315, 2, 3316,
+	2, 3335, 2, 3342, 2, 3344, 2, 3346, 2, 3348, 2, 3388, 2, 3391, 2, 3391,
+	2, 3408, 2, 3408, 2, 3414, 2, 3416, 2, 3425, 2, 3427, 2, 3452, 2, 3457,
+	2, 3463, 2, 3480, 2, 3484, 2, 3507, 2, 3509, 2, 3517, 2, 3519, 2, 3519,
+	2, 3522, 2, 3528, 2, 3587, 2, 3634, 2, 3636, 2, 3637, 2, 3650, 2, 3656,
+	2, 3715, 2, 3716, 2, 3718, 2, 3718, 2, 3721, 2, 3722, 2, 3724, 2, 3724,
+	2, 3727, 2, 3727, 2, 3734, 2, 3737, 2, 3739, 2, 3745, 2, 3747, 2, 3749,
+	2, 3751, 2, 3751, 2, 3753, 2, 3753, 2, 3756, 2, 3757, 2, 3759, 2, 3762,
+	2, 3764, 2, 3765, 2, 3775, 2, 3775, 2, 3778, 2, 3782, 2, 3784, 2, 3784,
+	2, 3806, 2, 3809, 2, 3842, 2, 3842, 2, 3906, 2, 3913, 2, 3915, 2, 3950,
+	2, 3978, 2, 3982, 2, 4098, 2, 4140, 2, 4161, 2, 4161, 2, 4178, 2, 4183,
+	2, 4188, 2, 4191, 2, 4195, 2, 4195, 2, 4199, 2, 4200, 2, 4208, 2, 4210,
+	2, 4215, 2, 4227, 2, 4240, 2, 4240, 2, 4258, 2, 4295, 2, 4297, 2, 4297,
+	2, 4303, 2, 4303, 2, 4306, 2, 4348, 2, 4350, 2, 4682, 2, 4684, 2, 4687,
+	2, 4690, 2, 4696, 2, 4698, 2, 4698, 2, 4700, 2, 4703, 2, 4706, 2, 4746,
+	2, 4748, 2, 4751, 2, 4754, 2, 4786, 2, 4788, 2, 4791, 2, 4794, 2, 4800,
+	2, 4802, 2, 4802, 2, 4804, 2, 4807, 2, 4810, 2, 4824, 2, 4826, 2, 4882,
+	2, 4884, 2, 4887, 2, 4890, 2, 4956, 2, 4994, 2, 5009, 2, 5026, 2, 5111,
+	2, 5114, 2, 5119, 2, 5123, 2, 5742, 2, 5745, 2, 5761, 2, 5763, 2, 5788,
+	2, 5794, 2, 5868, 2, 5872, 2, 5882, 2, 5890, 2, 5902, 2, 5904, 2, 5907,
+	2, 5922, 2, 5939, 2, 5954, 2, 5971, 2, 5986, 2, 5998, 2, 6000, 2, 6002,
+	2, 6018, 2, 6069, 2, 6105, 2, 6105, 2, 6110, 2, 6110, 2, 6178, 2, 6265,
+	2, 6274, 2, 6314, 2, 6316, 2, 6316, 2, 6322, 2, 6391, 2, 6402, 2, 6432,
+	2, 6482, 2, 6511, 2, 6514, 2, 6518, 2, 6530, 2, 6573, 2, 6578, 2, 6603,
+	2, 6658, 2, 6680, 2, 6690, 2, 6742, 2, 6825, 2, 6825, 2, 6919, 2, 6965,
+	2, 6983, 2, 6989, 2, 7045, 2, 7074, 2, 7088, 2, 7089, 2, 7100, 2, 7143,
+	2, 7170, 2, 7205, 2, 7247, 2, 7249, 2, 7260, 2, 7295, 2, 7298, 2, 7306,
+	2, 7403, 2, 7406, 2, 7408, 2, 7411, 2, 7415, 2, 7416, 2, 7426, 2, 7617,
+	2, 7682, 2, 7959, 2, 7962, 2, 7967, 2, 7970, 2, 8007, 2, 8010, 2, 8015,
+	2, 8018, 2, 8025, 2, 8027, 2, 8027, 2, 8029, 2, 8029, 2, 8031, 2, 8031,
+	2, 8033, 2, 8063, 2, 8066, 2, 8118, 2, 8120, 2, 8126, 2, 8128, 2, 8128,
+	2, 8132, 2, 8134, 2, 8136, 2, 8142, 2, 8146, 2, 8149, 2, 8152, 2, 8157,
+	2, 8162, 2, 8174, 2, 8180, 2, 8182, 2, 8184, 2, 8190, 2, 8307, 2, 8307,
+	2, 8321, 2, 8321, 2, 8338, 2, 8350, 2, 8452, 2, 8452, 2, 8457, 2, 8457,
 	2, 8460, 2, 8469, 2, 8471, 2, 8471, 2, 8474, 2, 8479, 2, 8486, 2, 8486,
 	2, 8488, 2, 8488, 2, 8490, 2, 8490, 2, 8492, 2, 8507, 2, 8510, 2, 8513,
 	2, 8519, 2, 8523, 2, 8528, 2, 8528, 2, 8546, 2, 8586, 2, 11266, 2, 11312,
-	2, 11314, 2, 11360, 2, 11362, 2, 11494, 2, 11501, 2, 11509, 2, 11522, 2,
-	11559, 2, 11561, 2, 11561, 2, 11567, 2, 11567, 2, 11570, 2, 11625, 2, 11633,
-	2, 11633, 2, 11649, 2, 11672, 2, 11682, 2, 11688, 2, 11690, 2, 11696, 2,
-	11698, 2, 11704, 2, 11706, 2, 11712, 2, 11714, 2, 11720, 2, 11722, 2, 11728,
-	2, 11730, 2, 11736, 2, 11738, 2, 11744, 2, 11746, 2, 11777, 2, 12295, 2,
-	12297, 2, 12323, 2, 12337, 2, 12339, 2, 12343, 2, 12346, 2, 12350, 2, 12355,
-	2, 12440, 2, 12443, 2, 12449, 2, 12451, 2, 12540, 2, 12542, 2, 12545, 2,
+	2, 11314, 2, 11360, 2, 11362, 2, 11494, 2, 11501, 2, 11504, 2, 11508, 2,
+	11509, 2, 11522, 2, 11559, 2, 11561, 2, 11561, 2, 11567, 2, 11567, 2, 11570,
+	2, 11625, 2, 11633, 2, 11633, 2, 11650, 2, 11672, 2, 11682, 2, 11688, 2,
+	11690, 2, 11696, 2, 11698, 2, 11704, 2, 11706, 2, 11712, 2, 11714, 2, 11720,
+	2, 11722, 2, 11728, 2, 11730, 2, 11736, 2, 11738, 2, 11744, 2, 12295, 2,
+	12297, 2, 12323, 2, 12331, 2, 12339, 2, 12343, 2, 12346, 2, 12350, 2, 12355,
+	2, 12440, 2, 12445, 2, 12449, 2, 12451, 2, 12540, 2, 12542, 2, 12545, 2,
 	12551, 2, 12592, 2, 12595, 2, 12688, 2, 12706, 2, 12732, 2, 12786, 2, 12801,
 	2, 13314, 2, 19895, 2, 19970, 2, 40940, 2, 40962, 2, 42126, 2, 42194, 2,
-	42239, 2, 42242, 2, 42510, 2, 42514, 2, 42541, 2, 42562, 2, 42609, 2, 42614,
-	2, 42623, 2, 42625, 2, 42739, 2, 42777, 2, 42785, 2, 42788, 2, 42890, 2,
-	42893, 2, 42928, 2, 42930, 2, 42937, 2, 43001, 2, 43049, 2, 43074, 2, 43125,
-	2, 43138, 2, 43207, 2, 43218, 2, 43227, 2, 43234, 2, 43257, 2, 43261, 2,
-	43261, 2, 43263, 2, 43263, 2, 43266, 2, 43311, 2, 43314, 2, 43349, 2, 43362,
-	2, 43390, 2, 43394, 2, 43458, 2, 43473, 2, 43483, 2, 43490, 2, 43520, 2,
-	43522, 2, 43576, 2, 43586, 2, 43599, 2, 43602, 2, 43611, 2, 43618, 2, 43640,
-	2, 43644, 2, 43716, 2, 43741, 2, 43743, 2, 43746, 2, 43761, 2, 43764, 2,
-	43768, 2, 43779, 2, 43784, 2, 43787, 2, 43792, 2, 43795, 2, 43800, 2, 43810,
-	2, 43816, 2, 43818, 2, 43824, 2, 43826, 2, 43868, 2, 43870, 2, 43879, 2,
-	43890, 2, 44012, 2, 44014, 2, 44015, 2, 44018, 2, 44027, 2, 44034, 2, 55205,
-	2, 55218, 2, 55240, 2, 55245, 2, 55293, 2, 63746, 2, 64111, 2, 64114, 2,
-	64219, 2, 64258, 2, 64264, 2, 64277, 2, 64281, 2, 64287, 2, 64298, 2, 64300,
-	2, 64312, 2, 64314, 2, 64318, 2, 64320, 2, 64320, 2, 64322, 2, 64323, 2,
-	64325, 2, 64326, 2, 64328, 2, 64435, 2, 64469, 2, 64831, 2, 64850, 2, 64913,
-	2, 64916, 2, 64969, 2, 65010, 2, 65021, 2, 65026, 2, 65041, 2, 65058, 2,
-	65073, 2, 65077, 2, 65078, 2, 65103, 2, 65105, 2, 65138, 2, 65142, 2, 65144,
-	2, 65278, 2, 65298, 2, 65307, 2, 65315, 2, 65340, 2, 65345, 2, 65345, 2,
-	65347, 2, 65372, 2, 65384, 2, 65472, 2, 65476, 2, 65481, 2, 65484, 2, 65489,
-	2, 65492, 2, 65497, 2, 65500, 2, 65502, 2, 2, 3, 13, 3, 15, 3, 40, 3, 42,
-	3, 60, 3, 62, 3, 63, 3, 65, 3, 79, 3, 82, 3, 95, 3, 130, 3, 252, 3, 322,
-	3, 374, 3, 511, 3, 511, 3, 642, 3, 670, 3, 674, 3, 722, 3, 738, 3, 738,
-	3, 770, 3, 801, 3, 815, 3, 844, 3, 850, 3, 892, 3, 898, 3, 927, 3, 930,
-	3, 965, 3, 970, 3, 977, 3, 979, 3, 983, 3, 1026, 3, 1183, 3, 1186, 3, 1195,
-	3, 1202, 3, 1237, 3, 1242, 3, 1277, 3, 1282, 3, 1321, 3, 1330, 3, 1381,
-	3, 1538, 3, 1848, 3, 1858, 3, 1879, 3, 1890, 3, 1897, 3, 2050, 3, 2055,
-	3, 2058, 3, 2058, 3, 2060, 3, 2103, 3, 2105, 3, 2106, 3, 2110, 3, 2110,
-	3, 2113, 3, 2135, 3, 2146, 3, 2168, 3, 2178, 3, 2208, 3, 2274, 3, 2292,
-	3, 2294, 3, 2295, 3, 2306, 3, 2327, 3, 2338, 3, 2363, 3, 2434, 3, 2489,
-	3, 2496, 3, 2497, 3, 2562, 3, 2565, 3, 2567, 3, 2568, 3, 2574, 3, 2581,
-	3, 2583, 3, 2585, 3, 2587, 3, 2613, 3, 2618, 3, 2620, 3, 2625, 3, 2625,
-	3, 2658, 3, 2686, 3, 2690, 3, 2718, 3, 2754, 3, 2761, 3, 2763, 3, 2792,
-	3, 2818, 3, 2871, 3, 2882, 3, 2903, 3, 2914, 3, 2932, 3, 2946, 3, 2963,
-	3, 3074, 3, 3146, 3, 3202, 3, 3252, 3, 3266, 3, 3316, 3, 4098, 3, 4168,
-	3, 4200, 3, 4209, 3, 4225, 3, 4284, 3, 4306, 3, 4330, 3, 4338, 3, 4347,
-	3, 4354, 3, 4406, 3, 4408, 3, 4417, 3, 4434, 3, 4469, 3, 4472, 3, 4472,
-	3, 4482, 3, 4550, 3, 4556, 3, 4558, 3, 4562, 3, 4572, 3, 4574, 3, 4574,
-	3, 4610, 3, 4627, 3, 4629, 3, 4665, 3, 4672, 3, 4672, 3, 4738, 3, 4744,
-	3, 4746, 3, 4746, 3, 4748, 3, 4751, 3, 4753, 3, 4767, 3, 4769, 3, 4778,
-	3, 4786, 3, 4844, 3, 4850, 3, 4859, 3, 4866, 3, 4869, 3, 4871, 3, 4878,
-	3, 4881, 3, 4882, 3, 4885, 3, 4906, 3, 4908, 3, 4914, 3, 4916, 3, 4917,
-	3, 4919, 3, 4923, 3, 4926, 3, 4934, 3, 4937, 3, 4938, 3, 4941, 3, 4943,
-	3, 4946, 3, 4946, 3, 4953, 3, 4953, 3, 4959, 3, 4965, 3, 4968, 3, 4974,
-	3, 4978, 3, 4982, 3, 5122, 3, 5196, 3, 5202, 3, 5211, 3, 5250, 3, 5319,
-	3, 5321, 3, 5321, 3, 5330, 3, 5339, 3, 5506, 3, 5559, 3, 5562, 3, 5570,
-	3, 5594, 3, 5599, 3, 5634, 3, 5698, 3, 5702, 3, 5702, 3, 5714, 3, 5723,
-	3, 5762, 3, 5817, 3, 5826, 3, 5835, 3, 5890, 3, 5915, 3, 5919, 3, 5933,
-	3, 5938, 3, 5947, 3, 6306, 3, 6379, 3, 6401, 3, 6401, 3, 6658, 3, 6720,
-	3, 6729, 3, 6729, 3, 6738, 3, 6789, 3, 6792, 3, 6811, 3, 6850, 3, 6906,
-	3, 7170, 3, 7178, 3, 7180, 3, 7224, 3, 7226, 3, 7234, 3, 7250, 3, 7259,
-	3, 7284, 3, 7313, 3, 7316, 3, 7337, 3, 7339, 3, 7352, 3, 7426, 3, 7432,
-	3, 7434, 3, 7435, 3, 7437, 3, 7480, 3, 7484, 3, 7484, 3, 7486, 3, 7487,
-	3, 7489, 3, 7497, 3, 7506, 3, 7515, 3, 8194, 3, 9115, 3, 9218, 3, 9328,
-	3, 9346, 3, 9541, 3, 12290, 3, 13360, 3, 17410, 3, 17992, 3, 26626, 3,
-	27194, 3, 27202, 3, 27232, 3, 27234, 3, 27243, 3, 27346, 3, 27375, 3, 27378,
-	3, 27382, 3, 27394, 3, 27448, 3, 27458, 3, 27461, 3, 27474, 3, 27483, 3,
-	27493, 3, 27513, 3, 27519, 3, 27537, 3, 28418, 3, 28486, 3, 28498, 3, 28544,
-	3, 28561, 3, 28577, 3, 28642, 3, 28643, 3, 28674, 3, 34798, 3, 34818, 3,
-	35572, 3, 45058, 3, 45344, 3, 45426, 3, 45821, 3, 48130, 3, 48236, 3, 48242,
-	3, 48254, 3, 48258, 3, 48266, 3, 48274, 3, 48283, 3, 48287, 3, 48288, 3,
-	53607, 3, 53611, 3, 53615, 3, 53620, 3, 53629, 3, 53636, 3, 53639, 3, 53645,
-	3, 53676, 3, 53679, 3, 53828, 3, 53830, 3, 54274, 3, 54358, 3, 54360, 3,
+	42239, 2, 42242, 2, 42510, 2, 42514, 2, 42529, 2, 42540, 2, 42541, 2, 42562,
+	2, 42608, 2, 42625, 2, 42655, 2, 42658, 2, 42737, 2, 42777, 2, 42785, 2,
+	42788, 2, 42890, 2, 42893, 2, 42928, 2, 42930, 2, 42937, 2, 43001, 2, 43011,
+	2, 43013, 2, 43015, 2, 43017, 2, 43020, 2, 43022, 2, 43044, 2, 43074, 2,
+	43125, 2, 43140, 2, 43189, 2, 43252, 2, 43257, 2, 43261, 2, 43261, 2, 43263,
+	2, 43263, 2, 43276, 2, 43303, 2, 43314, 2, 43336, 2, 43362, 2, 43390, 2,
+	43398, 2, 43444, 2, 43473, 2, 43473, 2, 43490, 2, 43494, 2, 43496, 2, 43505,
+	2, 43516, 2, 43520, 2, 43522, 2, 43562, 2, 43586, 2, 43588, 2, 43590, 2,
+	43597, 2, 43618, 2, 43640, 2, 43644, 2, 43644, 2, 43648, 2, 43697, 2, 43699,
+	2, 43699, 2, 43703, 2, 43704, 2, 43707, 2, 43711, 2, 43714, 2, 43714, 2,
+	43716, 2, 43716, 2, 43741, 2, 43743, 2, 43746, 2, 43756, 2, 43764, 2, 43766,
+	2, 43779, 2, 43784, 2, 43787, 2, 43792, 2, 43795, 2, 43800, 2, 43810, 2,
+	43816, 2, 43818, 2, 43824, 2, 43826, 2, 43868, 2, 43870, 2, 43879, 2, 43890,
+	2, 44004, 2, 44034, 2, 55205, 2, 55218, 2, 55240, 2, 55245, 2, 55293, 2,
+	63746, 2, 64111, 2, 64114, 2, 64219, 2, 64258, 2, 64264, 2, 64277, 2, 64281,
+	2, 64287, 2, 64287, 2, 64289, 2, 64298, 2, 64300, 2, 64312, 2, 64314, 2,
+	64318, 2, 64320, 2, 64320, 2, 64322, 2, 64323, 2, 64325, 2, 64326, 2, 64328,
+	2, 64435, 2, 64469, 2, 64831, 2, 64850, 2, 64913, 2, 64916, 2, 64969, 2,
+	65010, 2, 65021, 2, 65138, 2, 65142, 2, 65144, 2, 65278, 2, 65315, 2, 65340,
+	2, 65347, 2, 65372, 2, 65384, 2, 65472, 2, 65476, 2, 65481, 2, 65484, 2,
+	65489, 2, 65492, 2, 65497, 2, 65500, 2, 65502, 2, 2, 3, 13, 3, 15, 3, 40,
+	3, 42, 3, 60, 3, 62, 3, 63, 3, 65, 3, 79, 3, 82, 3, 95, 3, 130, 3, 252,
+	3, 322, 3, 374, 3, 642, 3, 670, 3, 674, 3, 722, 3, 770, 3, 801, 3, 815,
+	3, 844, 3, 850, 3, 887, 3, 898, 3, 927, 3, 930, 3, 965, 3, 970, 3, 977,
+	3, 979, 3, 983, 3, 1026, 3, 1183, 3, 1202, 3, 1237, 3, 1242, 3, 1277, 3,
+	1282, 3, 1321, 3, 1330, 3, 1381, 3, 1538, 3, 1848, 3, 1858, 3, 1879, 3,
+	1890, 3, 1897, 3, 2050, 3, 2055, 3, 2058, 3, 2058, 3, 2060, 3, 2103, 3,
+	2105, 3, 2106, 3, 2110, 3, 2110, 3, 2113, 3, 2135, 3, 2146, 3, 2168, 3,
+	2178, 3, 2208, 3, 2274, 3, 2292, 3, 2294, 3, 2295, 3, 2306, 3, 2327, 3,
+	2338, 3, 2363, 3, 2434, 3, 2489, 3, 2496, 3, 2497, 3, 2562, 3, 2562, 3,
+	2578, 3, 2581, 3, 2583, 3, 2585, 3, 2587, 3, 2613, 3, 2658, 3, 2686, 3,
+	2690, 3, 2718, 3, 2754, 3, 2761, 3, 2763, 3, 2790, 3, 2818, 3, 2871, 3,
+	2882, 3, 2903, 3, 2914, 3, 2932, 3, 2946, 3, 2963, 3, 3074, 3, 3146, 3,
+	3202, 3, 3252, 3, 3266, 3, 3316, 3, 4101, 3, 4153, 3, 4229, 3, 4273, 3,
+	4306, 3, 4330, 3, 4357, 3, 4392, 3, 4434, 3, 4468, 3, 4472, 3, 4472, 3,
+	4485, 3, 4532, 3, 4547, 3, 4550, 3, 4572, 3, 4572, 3, 4574, 3, 4574, 3,
+	4610, 3, 4627, 3, 4629, 3, 4653, 3, 4738, 3, 4744, 3, 4746, 3, 4746, 3,
+	4748, 3, 4751, 3, 4753, 3, 4767, 3, 4769, 3, 4778, 3, 4786, 3, 4832, 3,
+	4871, 3, 4878, 3, 4881, 3, 4882, 3, 4885, 3, 4906, 3, 4908, 3, 4914, 3,
+	4916, 3, 4917, 3, 4919, 3, 4923, 3, 4927, 3, 4927, 3, 4946, 3, 4946, 3,
+	4959, 3, 4963, 3, 5122, 3, 5174, 3, 5193, 3, 5196, 3, 5250, 3, 5297, 3,
+	5318, 3, 5319, 3, 5321, 3, 5321, 3, 5506, 3, 5552, 3, 5594, 3, 5597, 3,
+	5634, 3, 5681, 3, 5702, 3, 5702, 3, 5762, 3, 5804, 3, 5890, 3, 5915, 3,
+	6306, 3, 6369, 3, 6401, 3, 6401, 3, 6658, 3, 6658, 3, 6669, 3, 6708, 3,
+	6716, 3, 6716, 3, 6738, 3, 6738, 3, 6750, 3, 6789, 3, 6792, 3, 6795, 3,
+	6850, 3, 6906, 3, 7170, 3, 7178, 3, 7180, 3, 7216, 3, 7234, 3, 7234, 3,
+	7284, 3, 7313, 3, 7426, 3, 7432, 3, 7434, 3, 7435, 3, 7437, 3, 7474, 3,
+	7496, 3, 7496, 3, 8194, 3, 9115, 3, 9218, 3, 9328, 3, 9346, 3, 9541, 3,
+	12290, 3, 13360, 3, 17410, 3, 17992, 3, 26626, 3, 27194, 3, 27202, 3, 27232,
+	3, 27346, 3, 27375, 3, 27394, 3, 27441, 3, 27458, 3, 27461, 3, 27493, 3,
+	27513, 3, 27519, 3, 27537, 3, 28418, 3, 28486, 3, 28498, 3, 28498, 3, 28565,
+	3, 28577, 3, 28642, 3, 28643, 3, 28674, 3, 34798, 3, 34818, 3, 35572, 3,
+	45058, 3, 45344, 3, 45426, 3, 45821, 3, 48130, 3, 48236, 3, 48242, 3, 48254,
+	3, 48258, 3, 48266, 3, 48274, 3, 48283, 3, 54274, 3, 54358, 3, 54360, 3,
 	54430, 3, 54432, 3, 54433, 3, 54436, 3, 54436, 3, 54439, 3, 54440, 3, 54443,
 	3, 54446, 3, 54448, 3, 54459, 3, 54461, 3, 54461, 3, 54463, 3, 54469, 3,
 	54471, 3, 54535, 3, 54537, 3, 54540, 3, 54543, 3, 54550, 3, 54552, 3, 54558,
@@ -315,485 +472,345 @@ var serializedLexerAtn = []uint16{
 	54600, 3, 54604, 3, 54610, 3, 54612, 3, 54951, 3, 54954, 3, 54978, 3, 54980,
 	3, 55004, 3, 55006, 3, 55036, 3, 55038, 3, 55062, 3, 55064, 3, 55094, 3,
 	55096, 3, 55120, 3, 55122, 3, 55152, 3, 55154, 3, 55178, 3, 55180, 3, 55210,
-	3, 55212, 3, 55236, 3, 55238, 3, 55245, 3, 55248, 3, 55297, 3, 55810, 3,
-	55864, 3, 55869, 3, 55918, 3, 55927, 3, 55927, 3, 55942, 3, 55942, 3, 55965,
-	3, 55969, 3, 55971, 3, 55985, 3, 57346, 3, 57352, 3, 57354, 3, 57370, 3,
-	57373, 3, 57379, 3, 57381, 3, 57382, 3, 57384, 3, 57388, 3, 59394, 3, 59590,
-	3, 59602, 3, 59608, 3, 59650, 3, 59724, 3, 59730, 3, 59739, 3, 60930, 3,
-	60933, 3, 60935, 3, 60961, 3, 60963, 3, 60964, 3, 60966, 3, 60966, 3, 60969,
-	3, 60969, 3, 60971, 3, 60980, 3, 60982, 3, 60985, 3, 60987, 3, 60987, 3,
-	60989, 3, 60989, 3, 60996, 3, 60996, 3, 61001, 3, 61001, 3, 61003, 3, 61003,
-	3, 61005, 3, 61005, 3, 61007, 3, 61009, 3, 61011, 3, 61012, 3, 61014, 3,
-	61014, 3, 61017, 3, 61017, 3, 61019, 3, 61019, 3, 61021, 3, 61021, 3, 61023,
-	3, 61023, 3, 61025, 3, 61025, 3, 61027, 3, 61028, 3, 61030, 3, 61030, 3,
-	61033, 3, 61036, 3, 61038, 3, 61044, 3, 61046, 3, 61049, 3, 61051, 3, 61054,
-	3, 61056, 3, 61056, 3, 61058, 3, 61067, 3, 61069, 3, 61085, 3, 61091, 3,
-	61093, 3, 61095, 3, 61099, 3, 61101, 3, 61117, 3, 2, 4, 42712, 4, 42754,
-	4, 46902, 4, 46914, 4, 47135, 4, 47138, 4, 52899, 4, 52914, 4, 60386, 4,
-	63490, 4, 64031, 4, 258, 16, 497, 16, 587, 2, 67, 2, 92, 2, 99, 2, 124,
-	2, 172, 2, 172, 2, 183, 2, 183, 2, 188, 2, 188, 2, 194, 2, 216, 2, 218,
-	2, 248, 2, 250, 2, 707, 2, 712, 2, 723, 2, 738, 2, 742, 2, 750, 2, 750,
-	2, 752, 2, 752, 2, 882, 2, 886, 2, 888, 2, 889, 2, 892, 2, 895, 2, 897,
-	2, 897, 2, 904, 2, 904, 2, 906, 2, 908, 2, 910, 2, 910, 2, 912, 2, 931,
-	2, 933, 2, 1015, 2, 1017, 2, 1155, 2, 1164, 2, 1329, 2, 1331, 2, 1368,
-	2, 1371, 2, 1371, 2, 1379, 2, 1417, 2, 1490, 2, 1516, 2, 1522, 2, 1524,
-	2, 1570, 2, 1612, 2, 1648, 2, 1649, 2, 1651, 2, 1749, 2, 1751, 2, 1751,
-	2, 1767, 2, 1768, 2, 1776, 2, 1777, 2, 1788, 2, 1790, 2, 1793, 2, 1793,
-	2, 1810, 2, 1810, 2, 1812, 2, 1841, 2, 1871, 2, 1959, 2, 1971, 2, 1971,
-	2, 1996, 2, 2028, 2, 2038, 2, 2039, 2, 2044, 2, 2044, 2, 2050, 2, 2071,
-	2, 2076, 2, 2076, 2, 2086, 2, 2086, 2, 2090, 2, 2090, 2, 2114, 2, 2138,
-	2, 2146, 2, 2156, 2, 2210, 2, 2230, 2, 2232, 2, 2239, 2, 2310, 2, 2363,
-	2, 2367, 2, 2367, 2, 2386, 2, 2386, 2, 2394, 2, 2403, 2, 2419, 2, 2434,
-	2, 2439, 2, 2446, 2, 2449, 2, 2450, 2, 2453, 2, 2474, 2, 2476, 2, 2482,
-	2, 2484, 2, 2484, 2, 2488, 2, 2491, 2, 2495, 2, 2495, 2, 2512, 2, 2512,
-	2, 2526, 2, 2527, 2, 2529, 2, 2531, 2, 2546, 2, 2547, 2, 2558, 2, 2558,
-	2, 2567, 2, 2572, 2, 2577, 2, 2578, 2, 2581, 2, 2602, 2, 2604, 2, 2610,
-	2, 2612, 2, 2613, 2, 2615, 2, 2616, 2, 2618, 2, 2619, 2, 2651, 2, 2654,
-	2, 2656, 2, 2656, 2, 2676, 2, 2678, 2, 2695, 2, 2703, 2, 2705, 2, 2707,
-	2, 2709, 2, 2730, 2, 2732, 2, 2738, 2, 2740, 2, 2741, 2, 2743, 2, 2747,
-	2, 2751, 2, 2751, 2, 2770, 2, 2770, 2, 2786, 2, 2787, 2, 2811, 2, 2811,
-	2, 2823, 2, 2830, 2, 2833, 2, 2834, 2, 2837, 2, 2858, 2, 2860, 2, 2866,
-	2, 2868, 2, 2869, 2, 2871, 2, 2875, 2, 2879, 2, 2879, 2, 2910, 2, 2911,
-	2, 2913, 2, 2915, 2, 2931, 2, 2931, 2, 2949, 2, 2949, 2, 2951, 2, 2956,
-	2, 2960, 2, 2962, 2, 2964, 2, 2967, 2, 2971, 2, 2972, 2, 2974, 2, 2974,
-	2, 2976, 2, 2977, 2, 2981, 2, 2982, 2, 2986, 2, 2988, 2, 2992, 2, 3003,
-	2, 3026, 2, 3026, 2, 3079, 2, 3086, 2, 3088, 2, 3090, 2, 3092, 2, 3114,
-	2, 3116, 2, 3131, 2, 3135, 2, 3135, 2, 3162, 2, 3164, 2, 3170, 2, 3171,
-	2, 3202, 2, 3202, 2, 3207, 2, 3214, 2, 3216, 2, 3218, 2, 3220, 2, 3242,
-	2, 3244, 2, 3253, 2, 3255, 2, 3259, 2, 3263, 2, 3263, 2, 3296, 2, 3296,
-	2, 3298, 2, 3299, 2, 3315, 2, 3316, 2, 3335, 2, 3342, 2, 3344, 2, 3346,
-	2, 3348, 2, 3388, 2, 3391, 2, 3391, 2, 3408, 2, 3408, 2, 3414, 2, 3416,
-	2, 3425, 2, 3427, 2, 3452, 2, 3457, 2, 3463, 2, 3480, 2, 3484, 2, 3507,
-	2, 3509, 2, 3517, 2, 3519, 2, 3519, 2, 3522, 2, 3528, 2, 3587, 2, 3634,
-	2, 3636, 2, 3637, 2, 3650, 2, 3656, 2, 3715, 2, 3716, 2, 3718, 2, 3718,
-	2, 3721, 2, 3722, 2, 3724, 2, 3724, 2, 3727, 2, 3727, 2, 3734, 2, 3737,
-	2, 3739, 2, 3745, 2, 3747, 2, 3749, 2, 3751, 2, 3751, 2, 3753, 2, 3753,
-	2, 3756, 2, 3757, 2, 3759, 2, 3762, 2, 3764, 2, 3765, 2, 3775, 2, 3775,
-	2, 3778, 2, 3782, 2, 3784, 2, 3784, 2, 3806, 2, 3809, 2, 3842, 2, 3842,
-	2, 3906, 2, 3913, 2, 3915, 2, 3950, 2, 3978, 2, 3982, 2, 4098, 2, 4140,
-	2, 4161, 2, 4161, 2, 4178, 2, 4183, 2, 4188, 2, 4191, 2, 4195, 2, 4195,
-	2, 4199, 2, 4200, 2, 4208, 2, 4210, 2, 4215, 2, 4227, 2, 4240, 2, 4240,
-	2, 4258, 2, 4295, 2, 4297, 2, 4297, 2, 4303, 2, 4303, 2, 4306, 2, 4348,
-	2, 4350, 2, 4682, 2, 4684, 2, 4687, 2, 4690, 2, 4696, 2, 4698, 2, 4698,
-	2, 4700, 2, 4703, 2, 4706, 2, 4746, 2, 4748, 2, 4751, 2, 4754, 2, 4786,
-	2, 4788, 2, 4791, 2, 4794, 2, 4800, 2, 4802, 2, 4802, 2, 4804, 2, 4807,
-	2, 4810, 2, 4824, 2, 4826, 2, 4882, 2, 4884, 2, 4887, 2, 4890, 2, 4956,
-	2, 4994, 2, 5009, 2, 5026, 2, 5111, 2, 5114, 2, 5119, 2, 5123, 2, 5742,
-	2, 5745, 2, 5761, 2, 5763, 2, 5788, 2, 5794, 2, 5868, 2, 5872, 2, 5882,
-	2, 5890, 2, 5902, 2, 5904, 2, 5907, 2, 5922, 2, 5939, 2, 5954, 2, 5971,
-	2, 5986, 2, 5998, 2, 6000, 2, 6002, 2, 6018, 2, 6069, 2, 6105, 2, 6105,
-	2, 6110, 2, 6110, 2, 6178, 2, 6265, 2, 6274, 2, 6314, 2, 6316, 2, 6316,
-	2, 6322, 2, 6391, 2, 6402, 2, 6432, 2, 6482, 2, 6511, 2, 6514, 2, 6518,
-	2, 6530, 2, 6573, 2, 6578, 2, 6603, 2, 6658, 2, 6680, 2, 6690, 2, 6742,
-	2, 6825, 2, 6825, 2, 6919, 2, 6965, 2, 6983, 2, 6989, 2, 7045, 2, 7074,
-	2, 7088, 2, 7089, 2, 7100, 2, 7143, 2, 7170, 2, 7205, 2, 7247, 2, 7249,
-	2, 7260, 2, 7295, 2, 7298, 2, 7306, 2, 7403, 2, 7406, 2, 7408, 2, 7411,
-	2, 7415, 2, 7416, 2, 7426, 2, 7617, 2, 7682, 2, 7959, 2, 7962, 2, 7967,
-	2, 7970, 2, 8007, 2, 8010, 2, 8015, 2, 8018, 2, 8025, 2, 8027, 2, 8027,
-	2, 8029, 2, 8029, 2, 8031, 2, 8031, 2, 8033, 2, 8063, 2, 8066, 2, 8118,
-	2, 8120, 2, 8126, 2, 8128, 2, 8128, 2, 8132, 2, 8134, 2, 8136, 2, 8142,
-	2, 8146, 2, 8149, 2, 8152, 2, 8157, 2, 8162, 2, 8174, 2, 8180, 2, 8182,
-	2, 8184, 2, 8190, 2, 8307, 2, 8307, 2, 8321, 2, 8321, 2, 8338, 2, 8350,
-	2, 8452, 2, 8452, 2, 8457, 2, 8457, 2, 8460, 2, 8469, 2, 8471, 2, 8471,
-	2, 8474, 2, 8479, 2, 8486, 2, 8486, 2, 8488, 2, 8488, 2, 8490, 2, 8490,
-	2, 8492, 2, 8507, 2, 8510, 2, 8513, 2, 8519, 2, 8523, 2, 8528, 2, 8528,
-	2, 8546, 2, 8586, 2, 11266, 2, 11312, 2, 11314, 2, 11360, 2, 11362, 2,
-	11494, 2, 11501, 2, 11504, 2, 11508, 2, 11509, 2, 11522, 2, 11559, 2, 11561,
-	2, 11561, 2, 11567, 2, 11567, 2, 11570, 2, 11625, 2, 11633, 2, 11633, 2,
-	11650, 2, 11672, 2, 11682, 2, 11688, 2, 11690, 2, 11696, 2, 11698, 2, 11704,
-	2, 11706, 2, 11712, 2, 11714, 2, 11720, 2, 11722, 2, 11728, 2, 11730, 2,
-	11736, 2, 11738, 2, 11744, 2, 12295, 2, 12297, 2, 12323, 2, 12331, 2, 12339,
-	2, 12343, 2, 12346, 2, 12350, 2, 12355, 2, 12440, 2, 12445, 2, 12449, 2,
-	12451, 2, 12540, 2, 12542, 2, 12545, 2, 12551, 2, 12592, 2, 12595, 2, 12688,
-	2, 12706, 2, 12732, 2, 12786, 2, 12801, 2, 13314, 2, 19895, 2, 19970, 2,
-	40940, 2, 40962, 2, 42126, 2, 42194, 2, 42239, 2, 42242, 2, 42510, 2, 42514,
-	2, 42529, 2, 42540, 2, 42541, 2, 42562, 2, 42608, 2, 42625, 2, 42655, 2,
-	42658, 2, 42737, 2, 42777, 2, 42785, 2, 42788, 2, 42890, 2, 42893, 2, 42928,
-	2, 42930, 2, 42937, 2, 43001, 2, 43011, 2, 43013, 2, 43015, 2, 43017, 2,
-	43020, 2, 43022, 2, 43044, 2, 43074, 2, 43125, 2, 43140, 2, 43189, 2, 43252,
-	2, 43257, 2, 43261, 2, 43261, 2, 43263, 2, 43263, 2, 43276, 2, 43303, 2,
-	43314, 2, 43336, 2, 43362, 2, 43390, 2, 43398, 2, 43444, 2, 43473, 2, 43473,
-	2, 43490, 2, 43494, 2, 43496, 2, 43505, 2, 43516, 2, 43520, 2, 43522, 2,
-	43562, 2, 43586, 2, 43588, 2, 43590, 2, 43597, 2, 43618, 2, 43640, 2, 43644,
-	2, 43644, 2, 43648, 2, 43697, 2, 43699, 2, 43699, 2, 43703, 2, 43704, 2,
-	43707, 2, 43711, 2, 43714, 2, 43714, 2, 43716, 2, 43716, 2, 43741, 2, 43743,
-	2, 43746, 2, 43756, 2, 43764, 2, 43766, 2, 43779, 2, 43784, 2, 43787, 2,
-	43792, 2, 43795, 2, 43800, 2, 43810, 2, 43816, 2, 43818, 2, 43824, 2, 43826,
-	2, 43868, 2, 43870, 2, 43879, 2, 43890, 2, 44004, 2, 44034, 2, 55205, 2,
-	55218, 2, 55240, 2, 55245, 2, 55293, 2, 63746, 2, 64111, 2, 64114, 2, 64219,
-	2, 64258, 2, 64264, 2, 64277, 2, 64281, 2, 64287, 2, 64287, 2, 64289, 2,
-	64298, 2, 64300, 2, 64312, 2, 64314, 2, 64318, 2, 64320, 2, 64320, 2, 64322,
-	2, 64323, 2, 64325, 2, 64326, 2, 64328, 2, 64435, 2, 64469, 2, 64831, 2,
-	64850, 2, 64913, 2, 64916, 2, 64969, 2, 65010, 2, 65021, 2, 65138, 2, 65142,
-	2, 65144, 2, 65278, 2, 65315, 2, 65340, 2, 65347, 2, 65372, 2, 65384, 2,
-	65472, 2, 65476, 2, 65481, 2, 65484, 2, 65489, 2, 65492, 2, 65497, 2, 65500,
-	2, 65502, 2, 2, 3, 13, 3, 15, 3, 40, 3, 42, 3, 60, 3, 62, 3, 63, 3, 65,
-	3, 79, 3, 82, 3, 95, 3, 130, 3, 252, 3, 322, 3, 374, 3, 642, 3, 670, 3,
-	674, 3, 722, 3, 770, 3, 801, 3, 815, 3, 844, 3, 850, 3, 887, 3, 898, 3,
-	927, 3, 930, 3, 965, 3, 970, 3, 977, 3, 979, 3, 983, 3, 1026, 3, 1183,
-	3, 1202, 3, 1237, 3, 1242, 3, 1277, 3, 1282, 3, 1321, 3, 1330, 3, 1381,
-	3, 1538, 3, 1848, 3, 1858, 3, 1879, 3, 1890, 3, 1897, 3, 2050, 3, 2055,
-	3, 2058, 3, 2058, 3, 2060, 3, 2103, 3, 2105, 3, 2106, 3, 2110, 3, 2110,
-	3, 2113, 3, 2135, 3, 2146, 3, 2168, 3, 2178, 3, 2208, 3, 2274, 3, 2292,
-	3, 2294, 3, 2295, 3, 2306, 3, 2327, 3, 2338, 3, 2363, 3, 2434, 3, 2489,
-	3, 2496, 3, 2497, 3, 2562, 3, 2562, 3, 2578, 3, 2581, 3, 2583, 3, 2585,
-	3, 2587, 3, 2613, 3, 2658, 3, 2686, 3, 2690, 3, 2718, 3, 2754, 3, 2761,
-	3, 2763, 3, 2790, 3, 2818, 3, 2871, 3, 2882, 3, 2903, 3, 2914, 3, 2932,
-	3, 2946, 3, 2963, 3, 3074, 3, 3146, 3, 3202, 3, 3252, 3, 3266, 3, 3316,
-	3, 4101, 3, 4153, 3, 4229, 3, 4273, 3, 4306, 3, 4330, 3, 4357, 3, 4392,
-	3, 4434, 3, 4468, 3, 4472, 3, 4472, 3, 4485, 3, 4532, 3, 4547, 3, 4550,
-	3, 4572, 3, 4572, 3, 4574, 3, 4574, 3, 4610, 3, 4627, 3, 4629, 3, 4653,
-	3, 4738, 3, 4744, 3, 4746, 3, 4746, 3, 4748, 3, 4751, 3, 4753, 3, 4767,
-	3, 4769, 3, 4778, 3, 4786, 3, 4832, 3, 4871, 3, 4878, 3, 4881, 3, 4882,
-	3, 4885, 3, 4906, 3, 4908, 3, 4914, 3, 4916, 3, 4917, 3, 4919, 3, 4923,
-	3, 4927, 3, 4927, 3, 4946, 3, 4946, 3, 4959, 3, 4963, 3, 5122, 3, 5174,
-	3, 5193, 3, 5196, 3, 5250, 3, 5297, 3, 5318, 3, 5319, 3, 5321, 3, 5321,
-	3, 5506, 3, 5552, 3, 5594, 3, 5597, 3, 5634, 3, 5681, 3, 5702, 3, 5702,
-	3, 5762, 3, 5804, 3, 5890, 3, 5915, 3, 6306, 3, 6369, 3, 6401, 3, 6401,
-	3, 6658, 3, 6658, 3, 6669, 3, 6708, 3, 6716, 3, 6716, 3, 6738, 3, 6738,
-	3, 6750, 3, 6789, 3, 6792, 3, 6795, 3, 6850, 3, 6906, 3, 7170, 3, 7178,
-	3, 7180, 3, 7216, 3, 7234, 3, 7234, 3, 7284, 3, 7313, 3, 7426, 3, 7432,
-	3, 7434, 3, 7435, 3, 7437, 3, 7474, 3, 7496, 3, 7496, 3, 8194, 3, 9115,
-	3, 9218, 3, 9328, 3, 9346, 3, 9541, 3, 12290, 3, 13360, 3, 17410, 3, 17992,
-	3, 26626, 3, 27194, 3, 27202, 3, 27232, 3, 27346, 3, 27375, 3, 27394, 3,
-	27441, 3, 27458, 3, 27461, 3, 27493, 3, 27513, 3, 27519, 3, 27537, 3, 28418,
-	3, 28486, 3, 28498, 3, 28498, 3, 28565, 3, 28577, 3, 28642, 3, 28643, 3,
-	28674, 3, 34798, 3, 34818, 3, 35572, 3, 45058, 3, 45344, 3, 45426, 3, 45821,
-	3, 48130, 3, 48236, 3, 48242, 3, 48254, 3, 48258, 3, 48266, 3, 48274, 3,
-	48283, 3, 54274, 3, 54358, 3, 54360, 3, 54430, 3, 54432, 3, 54433, 3, 54436,
-	3, 54436, 3, 54439, 3, 54440, 3, 54443, 3, 54446, 3, 54448, 3, 54459, 3,
-	54461, 3, 54461, 3, 54463, 3, 54469, 3, 54471, 3, 54535, 3, 54537, 3, 54540,
-	3, 54543, 3, 54550, 3, 54552, 3, 54558, 3, 54560, 3, 54587, 3, 54589, 3,
-	54592, 3, 54594, 3, 54598, 3, 54600, 3, 54600, 3, 54604, 3, 54610, 3, 54612,
-	3, 54951, 3, 54954, 3, 54978, 3, 54980, 3, 55004, 3, 55006, 3, 55036, 3,
-	55038, 3, 55062, 3, 55064, 3, 55094, 3, 55096, 3, 55120, 3, 55122, 3, 55152,
-	3, 55154, 3, 55178, 3, 55180, 3, 55210, 3, 55212, 3, 55236, 3, 55238, 3,
-	55245, 3, 59394, 3, 59590, 3, 59650, 3, 59717, 3, 60930, 3, 60933, 3, 60935,
-	3, 60961, 3, 60963, 3, 60964, 3, 60966, 3, 60966, 3, 60969, 3, 60969, 3,
-	60971, 3, 60980, 3, 60982, 3, 60985, 3, 60987, 3, 60987, 3, 60989, 3, 60989,
-	3, 60996, 3, 60996, 3, 61001, 3, 61001, 3, 61003, 3, 61003, 3, 61005, 3,
-	61005, 3, 61007, 3, 61009, 3, 61011, 3, 61012, 3, 61014, 3, 61014, 3, 61017,
-	3, 61017, 3, 61019, 3, 61019, 3, 61021, 3, 61021, 3, 61023, 3, 61023, 3,
-	61025, 3, 61025, 3, 61027, 3, 61028, 3, 61030, 3, 61030, 3, 61033, 3, 61036,
-	3, 61038, 3, 61044, 3, 61046, 3, 61049, 3, 61051, 3, 61054, 3, 61056, 3,
-	61056, 3, 61058, 3, 61067, 3, 61069, 3, 61085, 3, 61091, 3, 61093, 3, 61095,
-	3, 61099, 3, 61101, 3, 61117, 3, 2, 4, 42712, 4, 42754, 4, 46902, 4, 46914,
-	4, 47135, 4, 47138, 4, 52899, 4, 52914, 4, 60386, 4, 63490, 4, 64031, 4,
-	1020, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2,
-	2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3,
-	2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25,
-	3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2,
-	33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2,
-	2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2,
-	2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2,
-	2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3,
-	2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71,
-	3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2,
-	79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2,
-	2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2,
-	2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3,
-	2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2,
-	109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2,
-	2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123,
-	3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2,
-	2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3,
-	2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2,
-	145, 3, 2, 2, 2, 2, 147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2,
-	2, 2, 2, 153, 3, 2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159,
-	3, 2, 2, 2, 2, 161, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2,
-	2, 167, 3, 2, 2, 2, 2, 169, 3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3,
-	2, 2, 2, 2, 175, 3, 2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2,
-	181, 3, 2, 2, 2, 2, 183, 3, 2, 2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2,
-	2, 2, 2, 189, 3, 2, 2, 2, 2, 191, 3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195,
-	3, 2, 2, 2, 2, 197, 3, 2, 2, 2, 2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2,
-	2, 203, 3, 2, 2, 2, 2, 205, 3, 2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3,
-	2, 2, 2, 2, 211, 3, 2, 2, 2, 2, 213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2,
-	217, 3, 2, 2, 2, 2, 219, 3, 2, 2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2,
-	2, 2, 2, 225, 3, 2, 2, 2, 2, 227, 3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231,
-	3, 2, 2, 2, 2, 233, 3, 2, 2, 2, 2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2,
-	2, 239, 3, 2, 2, 2, 2, 241, 3, 2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3,
-	2, 2, 2, 2, 247, 3, 2, 2, 2, 2, 249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2,
-	253, 3, 2, 2, 2, 2, 255, 3, 2, 2, 2, 3, 297, 3, 2, 2, 2, 5, 299, 3, 2,
-	2, 2, 7, 301, 3, 2, 2, 2, 9, 303, 3, 2, 2, 2, 11, 306, 3, 2, 2, 2, 13,
-	308, 3, 2, 2, 2, 15, 310, 3, 2, 2, 2, 17, 312, 3, 2, 2, 2, 19, 314, 3,
-	2, 2, 2, 21, 316, 3, 2, 2, 2, 23, 318, 3, 2, 2, 2, 25, 320, 3, 2, 2, 2,
-	27, 323, 3, 2, 2, 2, 29, 325, 3, 2, 2, 2, 31, 327, 3, 2, 2, 2, 33, 329,
-	3, 2, 2, 2, 35, 331, 3, 2, 2, 2, 37, 333, 3, 2, 2, 2, 39, 336, 3, 2, 2,
-	2, 41, 338, 3, 2, 2, 2, 43, 340, 3, 2, 2, 2, 45, 343, 3, 2, 2, 2, 47, 346,
-	3, 2, 2, 2, 49, 348, 3, 2, 2, 2, 51, 350, 3, 2, 2, 2, 53, 352, 3, 2, 2,
-	2, 55, 354, 3, 2, 2, 2, 57, 356, 3, 2, 2, 2, 59, 358, 3, 2, 2, 2, 61, 360,
-	3, 2, 2, 2, 63, 362, 3, 2, 2, 2, 65, 364, 3, 2, 2, 2, 67, 366, 3, 2, 2,
-	2, 69, 368, 3, 2, 2, 2, 71, 370, 3, 2, 2, 2, 73, 372, 3, 2, 2, 2, 75, 374,
-	3, 2, 2, 2, 77, 376, 3, 2, 2, 2, 79, 378, 3, 2, 2, 2, 81, 380, 3, 2, 2,
-	2, 83, 382, 3, 2, 2, 2, 85, 384, 3, 2, 2, 2, 87, 386, 3, 2, 2, 2, 89, 388,
-	3, 2, 2, 2, 91, 390, 3, 2, 2, 2, 93, 392, 3, 2, 2, 2, 95, 398, 3, 2, 2,
-	2, 97, 402, 3, 2, 2, 2, 99, 411, 3, 2, 2, 2, 101, 417, 3, 2, 2, 2, 103,
-	424, 3, 2, 2, 2, 105, 427, 3, 2, 2, 2, 107, 433, 3, 2, 2, 2, 109, 436,
-	3, 2, 2, 2, 111, 443, 3, 2, 2, 2, 113, 447, 3, 2, 2, 2, 115, 454, 3, 2,
-	2, 2, 117, 461, 3, 2, 2, 2, 119, 468, 3, 2, 2, 2, 121, 473, 3, 2, 2, 2,
-	123, 479, 3, 2, 2, 2, 125, 484, 3, 2, 2, 2, 127, 491, 3, 2, 2, 2, 129,
-	500, 3, 2, 2, 2, 131, 506, 3, 2, 2, 2, 133, 509, 3, 2, 2, 2, 135, 514,
-	3, 2, 2, 2, 137, 520, 3, 2, 2, 2, 139, 530, 3, 2, 2, 2, 141, 534, 3, 2,
-	2, 2, 143, 545, 3, 2, 2, 2, 145, 550, 3, 2, 2, 2, 147, 556, 3, 2, 2, 2,
-	149, 559, 3, 2, 2, 2, 151, 563, 3, 2, 2, 2, 153, 567, 3, 2, 2, 2, 155,
-	571, 3, 2, 2, 2, 157, 574, 3, 2, 2, 2, 159, 581, 3, 2, 2, 2, 161, 586,
-	3, 2, 2, 2, 163, 595, 3, 2, 2, 2, 165, 598, 3, 2, 2, 2, 167, 603, 3, 2,
-	2, 2, 169, 609, 3, 2, 2, 2, 171, 613, 3, 2, 2, 2, 173, 618, 3, 2, 2, 2,
-	175, 625, 3, 2, 2, 2, 177, 630, 3, 2, 2, 2, 179, 636, 3, 2, 2, 2, 181,
-	643, 3, 2, 2, 2, 183, 648, 3, 2, 2, 2, 185, 653, 3, 2, 2, 2, 187, 657,
-	3, 2, 2, 2, 189, 662, 3, 2, 2, 2, 191, 685, 3, 2, 2, 2, 193, 687, 3, 2,
-	2, 2, 195, 707, 3, 2, 2, 2, 197, 723, 3, 2, 2, 2, 199, 725, 3, 2, 2, 2,
-	201, 732, 3, 2, 2, 2, 203, 736, 3, 2, 2, 2, 205, 740, 3, 2, 2, 2, 207,
-	744, 3, 2, 2, 2, 209, 746, 3, 2, 2, 2, 211, 750, 3, 2, 2, 2, 213, 752,
-	3, 2, 2, 2, 215, 776, 3, 2, 2, 2, 217, 790, 3, 2, 2, 2, 219, 799, 3, 2,
-	2, 2, 221, 810, 3, 2, 2, 2, 223, 813, 3, 2, 2, 2, 225, 817, 3, 2, 2, 2,
-	227, 825, 3, 2, 2, 2, 229, 832, 3, 2, 2, 2, 231, 842, 3, 2, 2, 2, 233,
-	849, 3, 2, 2, 2, 235, 852, 3, 2, 2, 2, 237, 856, 3, 2, 2, 2, 239, 861,
-	3, 2, 2, 2, 241, 868, 3, 2, 2, 2, 243, 876, 3, 2, 2, 2, 245, 885, 3, 2,
-	2, 2, 247, 889, 3, 2, 2, 2, 249, 899, 3, 2, 2, 2, 251, 904, 3, 2, 2, 2,
-	253, 920, 3, 2, 2, 2, 255, 951, 3, 2, 2, 2, 257, 953, 3, 2, 2, 2, 259,
-	955, 3, 2, 2, 2, 261, 957, 3, 2, 2, 2, 263, 959, 3, 2, 2, 2, 265, 961,
-	3, 2, 2, 2, 267, 963, 3, 2, 2, 2, 269, 965, 3, 2, 2, 2, 271, 967, 3, 2,
-	2, 2, 273, 969, 3, 2, 2, 2, 275, 971, 3, 2, 2, 2, 277, 973, 3, 2, 2, 2,
-	279, 975, 3, 2, 2, 2, 281, 977, 3, 2, 2, 2, 283, 979, 3, 2, 2, 2, 285,
-	981, 3, 2, 2, 2, 287, 983, 3, 2, 2, 2, 289, 985, 3, 2, 2, 2, 291, 987,
-	3, 2, 2, 2, 293, 989, 3, 2, 2, 2, 295, 991, 3, 2, 2, 2, 297, 298, 7, 61,
-	2, 2, 298, 4, 3, 2, 2, 2, 299, 300, 7, 46, 2, 2, 300, 6, 3, 2, 2, 2, 301,
-	302, 7, 63, 2, 2, 302, 8, 3, 2, 2, 2, 303, 304, 7, 45, 2, 2, 304, 305,
-	7, 63, 2, 2, 305, 10, 3, 2, 2, 2, 306, 307, 7, 44, 2, 2, 307, 12, 3, 2,
-	2, 2, 308, 309, 7, 42, 2, 2, 309, 14, 3, 2, 2, 2, 310, 311, 7, 43, 2, 2,
-	311, 16, 3, 2, 2, 2, 312, 313, 7, 93, 2, 2, 313, 18, 3, 2, 2, 2, 314, 315,
-	7, 95, 2, 2, 315, 20, 3, 2, 2, 2, 316, 317, 7, 60, 2, 2, 317, 22, 3, 2,
-	2, 2, 318, 319, 7, 126, 2, 2, 319, 24, 3, 2, 2, 2, 320, 321, 7, 48, 2,
-	2, 321, 322, 7, 48, 2, 2, 322, 26, 3, 2, 2, 2, 323, 324, 7, 45, 2, 2, 324,
-	28, 3, 2, 2, 2, 325, 326, 7, 47, 2, 2, 326, 30, 3, 2, 2, 2, 327, 328, 7,
-	49, 2, 2, 328, 32, 3, 2, 2, 2, 329, 330, 7, 39, 2, 2, 330, 34, 3, 2, 2,
-	2, 331, 332, 7, 96, 2, 2, 332, 36, 3, 2, 2, 2, 333, 334, 7, 62, 2, 2, 334,
-	335, 7, 64, 2, 2, 335, 38, 3, 2, 2, 2, 336, 337, 7, 62, 2, 2, 337, 40,
-	3, 2, 2, 2, 338, 339, 7, 64, 2, 2, 339, 42, 3, 2, 2, 2, 340, 341, 7, 62,
-	2, 2, 341, 342, 7, 63, 2, 2, 342, 44, 3, 2, 2, 2, 343, 344, 7, 64, 2, 2,
-	344, 345, 7, 63, 2, 2, 345, 46, 3, 2, 2, 2, 346, 347, 7, 48, 2, 2, 347,
-	48, 3, 2, 2, 2, 348, 349, 7, 125, 2, 2, 349, 50, 3, 2, 2, 2, 350, 351,
-	7, 127, 2, 2, 351, 52, 3, 2, 2, 2, 352, 353, 7, 38, 2, 2, 353, 54, 3, 2,
-	2, 2, 354, 355, 7, 10218, 2, 2, 355, 56, 3, 2, 2, 2, 356, 357, 7, 12298,
-	2, 2, 357, 58, 3, 2, 2, 2, 358, 359, 7, 65126, 2, 2, 359, 60, 3, 2, 2,
-	2, 360, 361, 7, 65310, 2, 2, 361, 62, 3, 2, 2, 2, 362, 363, 7, 10219, 2,
-	2, 363, 64, 3, 2, 2, 2, 364, 365, 7, 12299, 2, 2, 365, 66, 3, 2, 2, 2,
-	366, 367, 7, 65127, 2, 2, 367, 68, 3, 2, 2, 2, 368, 369, 7, 65312, 2, 2,
-	369, 70, 3, 2, 2, 2, 370, 371, 7, 175, 2, 2, 371, 72, 3, 2, 2, 2, 372,
-	373, 7, 8210, 2, 2, 373, 74, 3, 2, 2, 2, 374, 375, 7, 8211, 2, 2, 375,
-	76, 3, 2, 2, 2, 376, 377, 7, 8212, 2, 2, 377, 78, 3, 2, 2, 2, 378, 379,
-	7, 8213, 2, 2, 379, 80, 3, 2, 2, 2, 380, 381, 7, 8214, 2, 2, 381, 82, 3,
-	2, 2, 2, 382, 383, 7, 8215, 2, 2, 383, 84, 3, 2, 2, 2, 384, 385, 7, 8724,
-	2, 2, 385, 86, 3, 2, 2, 2, 386, 387, 7, 65114, 2, 2, 387, 88, 3, 2, 2,
-	2, 388, 389, 7, 65125, 2, 2, 389, 90, 3, 2, 2, 2, 390, 391, 7, 65295, 2,
-	2, 391, 92, 3, 2, 2, 2, 392, 393, 9, 2, 2, 2, 393, 394, 9, 3, 2, 2, 394,
-	395, 9, 4, 2, 2, 395, 396, 9, 5, 2, 2, 396, 397, 9, 3, 2, 2, 397, 94, 3,
-	2, 2, 2, 398, 399, 9, 6, 2, 2, 399, 400, 9, 7, 2, 2, 400, 401, 9, 7, 2,
-	2, 401, 96, 3, 2, 2, 2, 402, 403, 9, 5, 2, 2, 403, 404, 9, 8, 2, 2, 404,
-	405, 9, 9, 2, 2, 405, 406, 9, 4, 2, 2, 406, 407, 9, 5, 2, 2, 407, 408,
-	9, 3, 2, 2, 408, 409, 9, 6, 2, 2, 409, 410, 9, 7, 2, 2, 410, 98, 3, 2,
-	2, 2, 411, 412, 9, 10, 2, 2, 412, 413, 9, 6, 2, 2, 413, 414, 9, 9, 2, 2,
-	414, 415, 9, 11, 2, 2, 415, 416, 9, 12, 2, 2, 416, 100, 3, 2, 2, 2, 417,
-	418, 9, 2, 2, 2, 418, 419, 9, 3, 2, 2, 419, 420, 9, 13, 2, 2, 420, 421,
-	9, 4, 2, 2, 421, 422, 9, 3, 2, 2, 422, 423, 9, 14, 2, 2, 423, 102, 3, 2,
-	2, 2, 424, 425, 9, 6, 2, 2, 425, 426, 9, 15, 2, 2, 426, 104, 3, 2, 2, 2,
-	427, 428, 9, 10, 2, 2, 428, 429, 9, 16, 2, 2, 429, 430, 9, 17, 2, 2, 430,
-	431, 9, 18, 2, 2, 431, 432, 9, 16, 2, 2, 432, 106, 3, 2, 2, 2, 433, 434,
-	9, 5, 2, 2, 434, 435, 9, 3, 2, 2, 435, 108, 3, 2, 2, 2, 436, 437, 9, 11,
-	2, 2, 437, 438, 9, 17, 2, 2, 438, 439, 9, 16, 2, 2, 439, 440, 9, 6, 2,
-	2, 440, 441, 9, 9, 2, 2, 441, 442, 9, 16, 2, 2, 442, 110, 3, 2, 2, 2, 443,
-	444, 9, 15, 2, 2, 444, 445, 9, 16, 2, 2, 445, 446, 9, 9, 2, 2, 446, 112,
-	3, 2, 2, 2, 447, 448, 9, 14, 2, 2, 448, 449, 9, 16, 2, 2, 449, 450, 9,
-	9, 2, 2, 450, 451, 9, 6, 2, 2, 451, 452, 9, 11, 2, 2, 452, 453, 9, 12,
-	2, 2, 453, 114, 3, 2, 2, 2, 454, 455, 9, 14, 2, 2, 455, 456, 9, 16, 2,
-	2, 456, 457, 9, 7, 2, 2, 457, 458, 9, 16, 2, 2, 458, 459, 9, 9, 2, 2, 459,
-	460, 9, 16, 2, 2, 460, 116, 3, 2, 2, 2, 461, 462, 9, 17, 2, 2, 462, 463,
-	9, 16, 2, 2, 463, 464, 9, 10, 2, 2, 464, 465, 9, 5, 2, 2, 465, 466, 9,
-	19, 2, 2, 466, 467, 9, 16, 2, 2, 467, 118, 3, 2, 2, 2, 468, 469, 9, 11,
-	2, 2, 469, 470, 9, 6, 2, 2, 470, 471, 9, 7, 2, 2, 471, 472, 9, 7, 2, 2,
-	472, 120, 3, 2, 2, 2, 473, 474, 9, 20, 2, 2, 474, 475, 9, 4, 2, 2, 475,
-	476, 9, 16, 2, 2, 476, 477, 9, 7, 2, 2, 477, 478, 9, 14, 2, 2, 478, 122,
-	3, 2, 2, 2, 479, 480, 9, 13, 2, 2, 480, 481, 9, 4, 2, 2, 481, 482, 9, 9,
-	2, 2, 482, 483, 9, 12, 2, 2, 483, 124, 3, 2, 2, 2, 484, 485, 9, 17, 2,
-	2, 485, 486, 9, 16, 2, 2, 486, 487, 9, 9, 2, 2, 487, 488, 9, 2, 2, 2, 488,
-	489, 9, 17, 2, 2, 489, 490, 9, 3, 2, 2, 490, 126, 3, 2, 2, 2, 491, 492,
-	9, 14, 2, 2, 492, 493, 9, 4, 2, 2, 493, 494, 9, 15, 2, 2, 494, 495, 9,
-	9, 2, 2, 495, 496, 9, 4, 2, 2, 496, 497, 9, 3, 2, 2, 497, 498, 9, 11, 2,
-	2, 498, 499, 9, 9, 2, 2, 499, 128, 3, 2, 2, 2, 500, 501, 9, 5, 2, 2, 501,
-	502, 9, 17, 2, 2, 502, 503, 9, 14, 2, 2, 503, 504, 9, 16, 2, 2, 504, 505,
-	9, 17, 2, 2, 505, 130, 3, 2, 2, 2, 506, 507, 9, 21, 2, 2, 507, 508, 9,
-	20, 2, 2, 508, 132, 3, 2, 2, 2, 509, 510, 9, 15, 2, 2, 510, 511, 9, 22,
-	2, 2, 511, 512, 9, 4, 2, 2, 512, 513, 9, 8, 2, 2, 513, 134, 3, 2, 2, 2,
-	514, 515, 9, 7, 2, 2, 515, 516, 9, 4, 2, 2, 516, 517, 9, 10, 2, 2, 517,
-	518, 9, 4, 2, 2, 518, 519, 9, 9, 2, 2, 519, 136, 3, 2, 2, 2, 520, 521,
-	9, 6, 2, 2, 521, 522, 9, 15, 2, 2, 522, 523, 9, 11, 2, 2, 523, 524, 9,
-	16, 2, 2, 524, 525, 9, 3, 2, 2, 525, 526, 9, 14, 2, 2, 526, 527, 9, 4,
-	2, 2, 527, 528, 9, 3, 2, 2, 528, 529, 9, 18, 2, 2, 529, 138, 3, 2, 2, 2,
-	530, 531, 9, 6, 2, 2, 531, 532, 9, 15, 2, 2, 532, 533, 9, 11, 2, 2, 533,
-	140, 3, 2, 2, 2, 534, 535, 9, 14, 2, 2, 535, 536, 9, 16, 2, 2, 536, 537,
-	9, 15, 2, 2, 537, 538, 9, 11, 2, 2, 538, 539, 9, 16, 2, 2, 539, 540, 9,
-	3, 2, 2, 540, 541, 9, 14, 2, 2, 541, 542, 9, 4, 2, 2, 542, 543, 9, 3, 2,
-	2, 543, 544, 9, 18, 2, 2, 544, 142, 3, 2, 2, 2, 545, 546, 9, 14, 2, 2,
-	546, 547, 9, 16, 2, 2, 547, 548, 9, 15, 2, 2, 548, 549, 9, 11, 2, 2, 549,
-	144, 3, 2, 2, 2, 550, 551, 9, 13, 2, 2, 551, 552, 9, 12, 2, 2, 552, 553,
-	9, 16, 2, 2, 553, 554, 9, 17, 2, 2, 554, 555, 9, 16, 2, 2, 555, 146, 3,
-	2, 2, 2, 556, 557, 9, 5, 2, 2, 557, 558, 9, 17, 2, 2, 558, 148, 3, 2, 2,
-	2, 559, 560, 9, 23, 2, 2, 560, 561, 9, 5, 2, 2, 561, 562, 9, 17, 2, 2,
-	562, 150, 3, 2, 2, 2, 563, 564, 9, 6, 2, 2, 564, 565, 9, 3, 2, 2, 565,
-	566, 9, 14, 2, 2, 566, 152, 3, 2, 2, 2, 567, 568, 9, 3, 2, 2, 568, 569,
-	9, 5, 2, 2, 569, 570, 9, 9, 2, 2, 570, 154, 3, 2, 2, 2, 571, 572, 9, 4,
-	2, 2, 572, 573, 9, 3, 2, 2, 573, 156, 3, 2, 2, 2, 574, 575, 9, 15, 2, 2,
-	575, 576, 9, 9, 2, 2, 576, 577, 9, 6, 2, 2, 577, 578, 9, 17, 2, 2, 578,
-	579, 9, 9, 2, 2, 579, 580, 9, 15, 2, 2, 580, 158, 3, 2, 2, 2, 581, 582,
-	9, 16, 2, 2, 582, 583, 9, 3, 2, 2, 583, 584, 9, 14, 2, 2, 584, 585, 9,
-	15, 2, 2, 585, 160, 3, 2, 2, 2, 586, 587, 9, 11, 2, 2, 587, 588, 9, 5,
-	2, 2, 588, 589, 9, 3, 2, 2, 589, 590, 9, 9, 2, 2, 590, 591, 9, 6, 2, 2,
-	591, 592, 9, 4, 2, 2, 592, 593, 9, 3, 2, 2, 593, 594, 9, 15, 2, 2, 594,
-	162, 3, 2, 2, 2, 595, 596, 9, 4, 2, 2, 596, 597, 9, 15, 2, 2, 597, 164,
-	3, 2, 2, 2, 598, 599, 9, 3, 2, 2, 599, 600, 9, 2, 2, 2, 600, 601, 9, 7,
-	2, 2, 601, 602, 9, 7, 2, 2, 602, 166, 3, 2, 2, 2, 603, 604, 9, 11, 2, 2,
-	604, 605, 9, 5, 2, 2, 605, 606, 9, 2, 2, 2, 606, 607, 9, 3, 2, 2, 607,
-	608, 9, 9, 2, 2, 608, 168, 3, 2, 2, 2, 609, 610, 9, 6, 2, 2, 610, 611,
-	9, 3, 2, 2, 611, 612, 9, 20, 2, 2, 612, 170, 3, 2, 2, 2, 613, 614, 9, 3,
-	2, 2, 614, 615, 9, 5, 2, 2, 615, 616, 9, 3, 2, 2, 616, 617, 9, 16, 2, 2,
-	617, 172, 3, 2, 2, 2, 618, 619, 9, 15, 2, 2, 619, 620, 9, 4, 2, 2, 620,
-	621, 9, 3, 2, 2, 621, 622, 9, 18, 2, 2, 622, 623, 9, 7, 2, 2, 623, 624,
-	9, 16, 2, 2, 624, 174, 3, 2, 2, 2, 625, 626, 9, 9, 2, 2, 626, 627, 9, 17,
-	2, 2, 627, 628, 9, 2, 2, 2, 628, 629, 9, 16, 2, 2, 629, 176, 3, 2, 2, 2,
-	630, 631, 9, 24, 2, 2, 631, 632, 9, 6, 2, 2, 632, 633, 9, 7, 2, 2, 633,
-	634, 9, 15, 2, 2, 634, 635, 9, 16, 2, 2, 635, 178, 3, 2, 2, 2, 636, 637,
-	9, 16, 2, 2, 637, 638, 9, 23, 2, 2, 638, 639, 9, 4, 2, 2, 639, 640, 9,
-	15, 2, 2, 640, 641, 9, 9, 2, 2, 641, 642, 9, 15, 2, 2, 642, 180, 3, 2,
-	2, 2, 643, 644, 9, 11, 2, 2, 644, 645, 9, 6, 2, 2, 645, 646, 9, 15, 2,
-	2, 646, 647, 9, 16, 2, 2, 647, 182, 3, 2, 2, 2, 648, 649, 9, 16, 2, 2,
-	649, 650, 9, 7, 2, 2, 650, 651, 9, 15, 2, 2, 651, 652, 9, 16, 2, 2, 652,
-	184, 3, 2, 2, 2, 653, 654, 9, 16, 2, 2, 654, 655, 9, 3, 2, 2, 655, 656,
-	9, 14, 2, 2, 656, 186, 3, 2, 2, 2, 657, 658, 9, 13, 2, 2, 658, 659, 9,
-	12, 2, 2, 659, 660, 9, 16, 2, 2, 660, 661, 9, 3, 2, 2, 661, 188, 3, 2,
-	2, 2, 662, 663, 9, 9, 2, 2, 663, 664, 9, 12, 2, 2, 664, 665, 9, 16, 2,
-	2, 665, 666, 9, 3, 2, 2, 666, 190, 3, 2, 2, 2, 667, 672, 7, 36, 2, 2, 668,
-	671, 5, 287, 144, 2, 669, 671, 5, 193, 97, 2, 670, 668, 3, 2, 2, 2, 670,
-	669, 3, 2, 2, 2, 671, 674, 3, 2, 2, 2, 672, 670, 3, 2, 2, 2, 672, 673,
-	3, 2, 2, 2, 673, 675, 3, 2, 2, 2, 674, 672, 3, 2, 2, 2, 675, 686, 7, 36,
-	2, 2, 676, 681, 7, 41, 2, 2, 677, 680, 5, 267, 134, 2, 678, 680, 5, 193,
-	97, 2, 679, 677, 3, 2, 2, 2, 679, 678, 3, 2, 2, 2, 680, 683, 3, 2, 2, 2,
-	681, 679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682, 684, 3, 2, 2, 2, 683,
-	681, 3, 2, 2, 2, 684, 686, 7, 41, 2, 2, 685, 667, 3, 2, 2, 2, 685, 676,
-	3, 2, 2, 2, 686, 192, 3, 2, 2, 2, 687, 705, 7, 94, 2, 2, 688, 706, 9, 25,
-	2, 2, 689, 690, 9, 2, 2, 2, 690, 691, 5, 203, 102, 2, 691, 692, 5, 203,
-	102, 2, 692, 693, 5, 203, 102, 2, 693, 694, 5, 203, 102, 2, 694, 706, 3,
-	2, 2, 2, 695, 696, 9, 2, 2, 2, 696, 697, 5, 203, 102, 2, 697, 698, 5, 203,
-	102, 2, 698, 699, 5, 203, 102, 2, 699, 700, 5, 203, 102, 2, 700, 701, 5,
-	203, 102, 2, 701, 702, 5, 203, 102, 2, 702, 703, 5, 203, 102, 2, 703, 704,
-	5, 203, 102, 2, 704, 706, 3, 2, 2, 2, 705, 688, 3, 2, 2, 2, 705, 689, 3,
-	2, 2, 2, 705, 695, 3, 2, 2, 2, 706, 194, 3, 2, 2, 2, 707, 708, 7, 50, 2,
-	2, 708, 709, 7, 122, 2, 2, 709, 711, 3, 2, 2, 2, 710, 712, 5, 203, 102,
-	2, 711, 710, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 711, 3, 2, 2, 2, 713,
-	714, 3, 2, 2, 2, 714, 196, 3, 2, 2, 2, 715, 724, 5, 213, 107, 2, 716, 720,
-	5, 207, 104, 2, 717, 719, 5, 205, 103, 2, 718, 717, 3, 2, 2, 2, 719, 722,
-	3, 2, 2, 2, 720, 718, 3, 2, 2, 2, 720, 721, 3, 2, 2, 2, 721, 724, 3, 2,
-	2, 2, 722, 720, 3, 2, 2, 2, 723, 715, 3, 2, 2, 2, 723, 716, 3, 2, 2, 2,
-	724, 198, 3, 2, 2, 2, 725, 727, 5, 213, 107, 2, 726, 728, 5, 211, 106,
-	2, 727, 726, 3, 2, 2, 2, 728, 729, 3, 2, 2, 2, 729, 727, 3, 2, 2, 2, 729,
-	730, 3, 2, 2, 2, 730, 200, 3, 2, 2, 2, 731, 733, 9, 26, 2, 2, 732, 731,
-	3, 2, 2, 2, 733, 202, 3, 2, 2, 2, 734, 737, 5, 205, 103, 2, 735, 737, 5,
-	201, 101, 2, 736, 734, 3, 2, 2, 2, 736, 735, 3, 2, 2, 2, 737, 204, 3, 2,
-	2, 2, 738, 741, 5, 213, 107, 2, 739, 741, 5, 207, 104, 2, 740, 738, 3,
-	2, 2, 2, 740, 739, 3, 2, 2, 2, 741, 206, 3, 2, 2, 2, 742, 745, 5, 209,
-	105, 2, 743, 745, 4, 58, 59, 2, 744, 742, 3, 2, 2, 2, 744, 743, 3, 2, 2,
-	2, 745, 208, 3, 2, 2, 2, 746, 747, 4, 51, 57, 2, 747, 210, 3, 2, 2, 2,
-	748, 751, 5, 213, 107, 2, 749, 751, 5, 209, 105, 2, 750, 748, 3, 2, 2,
-	2, 750, 749, 3, 2, 2, 2, 751, 212, 3, 2, 2, 2, 752, 753, 7, 50, 2, 2, 753,
-	214, 3, 2, 2, 2, 754, 756, 5, 205, 103, 2, 755, 754, 3, 2, 2, 2, 756, 757,
-	3, 2, 2, 2, 757, 755, 3, 2, 2, 2, 757, 758, 3, 2, 2, 2, 758, 777, 3, 2,
-	2, 2, 759, 761, 5, 205, 103, 2, 760, 759, 3, 2, 2, 2, 761, 762, 3, 2, 2,
-	2, 762, 760, 3, 2, 2, 2, 762, 763, 3, 2, 2, 2, 763, 764, 3, 2, 2, 2, 764,
-	766, 7, 48, 2, 2, 765, 767, 5, 205, 103, 2, 766, 765, 3, 2, 2, 2, 767,
-	768, 3, 2, 2, 2, 768, 766, 3, 2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 777,
-	3, 2, 2, 2, 770, 772, 7, 48, 2, 2, 771, 773, 5, 205, 103, 2, 772, 771,
-	3, 2, 2, 2, 773, 774, 3, 2, 2, 2, 774, 772, 3, 2, 2, 2, 774, 775, 3, 2,
-	2, 2, 775, 777, 3, 2, 2, 2, 776, 755, 3, 2, 2, 2, 776, 760, 3, 2, 2, 2,
-	776, 770, 3, 2, 2, 2, 777, 778, 3, 2, 2, 2, 778, 780, 9, 16, 2, 2, 779,
-	781, 7, 47, 2, 2, 780, 779, 3, 2, 2, 2, 780, 781, 3, 2, 2, 2, 781, 783,
-	3, 2, 2, 2, 782, 784, 5, 205, 103, 2, 783, 782, 3, 2, 2, 2, 784, 785, 3,
-	2, 2, 2, 785, 783, 3, 2, 2, 2, 785, 786, 3, 2, 2, 2, 786, 216, 3, 2, 2,
-	2, 787, 789, 5, 205, 103, 2, 788, 787, 3, 2, 2, 2, 789, 792, 3, 2, 2, 2,
-	790, 788, 3, 2, 2, 2, 790, 791, 3, 2, 2, 2, 791, 793, 3, 2, 2, 2, 792,
-	790, 3, 2, 2, 2, 793, 795, 7, 48, 2, 2, 794, 796, 5, 205, 103, 2, 795,
-	794, 3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797, 795, 3, 2, 2, 2, 797, 798,
-	3, 2, 2, 2, 798, 218, 3, 2, 2, 2, 799, 800, 9, 11, 2, 2, 800, 801, 9, 5,
-	2, 2, 801, 802, 9, 3, 2, 2, 802, 803, 9, 15, 2, 2, 803, 804, 9, 9, 2, 2,
-	804, 805, 9, 17, 2, 2, 805, 806, 9, 6, 2, 2, 806, 807, 9, 4, 2, 2, 807,
-	808, 9, 3, 2, 2, 808, 809, 9, 9, 2, 2, 809, 220, 3, 2, 2, 2, 810, 811,
-	9, 14, 2, 2, 811, 812, 9, 5, 2, 2, 812, 222, 3, 2, 2, 2, 813, 814, 9, 24,
-	2, 2, 814, 815, 9, 5, 2, 2, 815, 816, 9, 17, 2, 2, 816, 224, 3, 2, 2, 2,
-	817, 818, 9, 17, 2, 2, 818, 819, 9, 16, 2, 2, 819, 820, 9, 27, 2, 2, 820,
-	821, 9, 2, 2, 2, 821, 822, 9, 4, 2, 2, 822, 823, 9, 17, 2, 2, 823, 824,
-	9, 16, 2, 2, 824, 226, 3, 2, 2, 2, 825, 826, 9, 2, 2, 2, 826, 827, 9, 3,
-	2, 2, 827, 828, 9, 4, 2, 2, 828, 829, 9, 27, 2, 2, 829, 830, 9, 2, 2, 2,
-	830, 831, 9, 16, 2, 2, 831, 228, 3, 2, 2, 2, 832, 833, 9, 10, 2, 2, 833,
-	834, 9, 6, 2, 2, 834, 835, 9, 3, 2, 2, 835, 836, 9, 14, 2, 2, 836, 837,
-	9, 6, 2, 2, 837, 838, 9, 9, 2, 2, 838, 839, 9, 5, 2, 2, 839, 840, 9, 17,
-	2, 2, 840, 841, 9, 20, 2, 2, 841, 230, 3, 2, 2, 2, 842, 843, 9, 15, 2,
-	2, 843, 844, 9, 11, 2, 2, 844, 845, 9, 6, 2, 2, 845, 846, 9, 7, 2, 2, 846,
-	847, 9, 6, 2, 2, 847, 848, 9, 17, 2, 2, 848, 232, 3, 2, 2, 2, 849, 850,
-	9, 5, 2, 2, 850, 851, 9, 24, 2, 2, 851, 234, 3, 2, 2, 2, 852, 853, 9, 6,
-	2, 2, 853, 854, 9, 14, 2, 2, 854, 855, 9, 14, 2, 2, 855, 236, 3, 2, 2,
-	2, 856, 857, 9, 14, 2, 2, 857, 858, 9, 17, 2, 2, 858, 859, 9, 5, 2, 2,
-	859, 860, 9, 8, 2, 2, 860, 238, 3, 2, 2, 2, 861, 862, 9, 24, 2, 2, 862,
-	863, 9, 4, 2, 2, 863, 864, 9, 7, 2, 2, 864, 865, 9, 9, 2, 2, 865, 866,
-	9, 16, 2, 2, 866, 867, 9, 17, 2, 2, 867, 240, 3, 2, 2, 2, 868, 869, 9,
-	16, 2, 2, 869, 870, 9, 23, 2, 2, 870, 871, 9, 9, 2, 2, 871, 872, 9, 17,
-	2, 2, 872, 873, 9, 6, 2, 2, 873, 874, 9, 11, 2, 2, 874, 875, 9, 9, 2, 2,
-	875, 242, 3, 2, 2, 2, 876, 880, 5, 245, 123, 2, 877, 879, 5, 247, 124,
-	2, 878, 877, 3, 2, 2, 2, 879, 882, 3, 2, 2, 2, 880, 878, 3, 2, 2, 2, 880,
-	881, 3, 2, 2, 2, 881, 244, 3, 2, 2, 2, 882, 880, 3, 2, 2, 2, 883, 886,
-	5, 295, 148, 2, 884, 886, 5, 283, 142, 2, 885, 883, 3, 2, 2, 2, 885, 884,
-	3, 2, 2, 2, 886, 246, 3, 2, 2, 2, 887, 890, 5, 263, 132, 2, 888, 890, 5,
-	279, 140, 2, 889, 887, 3, 2, 2, 2, 889, 888, 3, 2, 2, 2, 890, 248, 3, 2,
-	2, 2, 891, 895, 7, 98, 2, 2, 892, 894, 5, 259, 130, 2, 893, 892, 3, 2,
-	2, 2, 894, 897, 3, 2, 2, 2, 895, 893, 3, 2, 2, 2, 895, 896, 3, 2, 2, 2,
-	896, 898, 3, 2, 2, 2, 897, 895, 3, 2, 2, 2, 898, 900, 7, 98, 2, 2, 899,
-	891, 3, 2, 2, 2, 900, 901, 3, 2, 2, 2, 901, 899, 3, 2, 2, 2, 901, 902,
-	3, 2, 2, 2, 902, 250, 3, 2, 2, 2, 903, 905, 5, 253, 127, 2, 904, 903, 3,
-	2, 2, 2, 905, 906, 3, 2, 2, 2, 906, 904, 3, 2, 2, 2, 906, 907, 3, 2, 2,
-	2, 907, 252, 3, 2, 2, 2, 908, 921, 5, 281, 141, 2, 909, 921, 5, 285, 143,
-	2, 910, 921, 5, 289, 145, 2, 911, 921, 5, 291, 146, 2, 912, 921, 5, 257,
-	129, 2, 913, 921, 5, 277, 139, 2, 914, 921, 5, 275, 138, 2, 915, 921, 5,
-	273, 137, 2, 916, 921, 5, 261, 131, 2, 917, 921, 5, 293, 147, 2, 918, 921,
-	9, 28, 2, 2, 919, 921, 5, 255, 128, 2, 920, 908, 3, 2, 2, 2, 920, 909,
-	3, 2, 2, 2, 920, 910, 3, 2, 2, 2, 920, 911, 3, 2, 2, 2, 920, 912, 3, 2,
-	2, 2, 920, 913, 3, 2, 2, 2, 920, 914, 3, 2, 2, 2, 920, 915, 3, 2, 2, 2,
-	920, 916, 3, 2, 2, 2, 920, 917, 3, 2, 2, 2, 920, 918, 3, 2, 2, 2, 920,
-	919, 3, 2, 2, 2, 921, 254, 3, 2, 2, 2, 922, 923, 7, 49, 2, 2, 923, 924,
-	7, 44, 2, 2, 924, 930, 3, 2, 2, 2, 925, 929, 5, 265, 133, 2, 926, 927,
-	7, 44, 2, 2, 927, 929, 5, 271, 136, 2, 928, 925, 3, 2, 2, 2, 928, 926,
-	3, 2, 2, 2, 929, 932, 3, 2, 2, 2, 930, 928, 3, 2, 2, 2, 930, 931, 3, 2,
-	2, 2, 931, 933, 3, 2, 2, 2, 932, 930, 3, 2, 2, 2, 933, 934, 7, 44, 2, 2,
-	934, 952, 7, 49, 2, 2, 935, 936, 7, 49, 2, 2, 936, 937, 7, 49, 2, 2, 937,
-	941, 3, 2, 2, 2, 938, 940, 5, 269, 135, 2, 939, 938, 3, 2, 2, 2, 940, 943,
-	3, 2, 2, 2, 941, 939, 3, 2, 2, 2, 941, 942, 3, 2, 2, 2, 942, 945, 3, 2,
-	2, 2, 943, 941, 3, 2, 2, 2, 944, 946, 5, 277, 139, 2, 945, 944, 3, 2, 2,
-	2, 945, 946, 3, 2, 2, 2, 946, 949, 3, 2, 2, 2, 947, 950, 5, 289, 145, 2,
-	948, 950, 7, 2, 2, 3, 949, 947, 3, 2, 2, 2, 949, 948, 3, 2, 2, 2, 950,
-	952, 3, 2, 2, 2, 951, 922, 3, 2, 2, 2, 951, 935, 3, 2, 2, 2, 952, 256,
-	3, 2, 2, 2, 953, 954, 9, 29, 2, 2, 954, 258, 3, 2, 2, 2, 955, 956, 10,
-	30, 2, 2, 956, 260, 3, 2, 2, 2, 957, 958, 9, 31, 2, 2, 958, 262, 3, 2,
-	2, 2, 959, 960, 9, 47, 2, 2, 960, 264, 3, 2, 2, 2, 961, 962, 10, 32, 2,
-	2, 962, 266, 3, 2, 2, 2, 963, 964, 10, 33, 2, 2, 964, 268, 3, 2, 2, 2,
-	965, 966, 10, 34, 2, 2, 966, 270, 3, 2, 2, 2, 967, 968, 10, 35, 2, 2, 968,
-	272, 3, 2, 2, 2, 969, 970, 9, 36, 2, 2, 970, 274, 3, 2, 2, 2, 971, 972,
-	9, 37, 2, 2, 972, 276, 3, 2, 2, 2, 973, 974, 9, 38, 2, 2, 974, 278, 3,
-	2, 2, 2, 975, 976, 9, 39, 2, 2, 976, 280, 3, 2, 2, 2, 977, 978, 9, 40,
-	2, 2, 978, 282, 3, 2, 2, 2, 979, 980, 9, 41, 2, 2, 980, 284, 3, 2, 2, 2,
-	981, 982, 9, 42, 2, 2, 982, 286, 3, 2, 2, 2, 983, 984, 10, 43, 2, 2, 984,
-	288, 3, 2, 2, 2, 985, 986, 9, 44, 2, 2, 986, 290, 3, 2, 2, 2, 987, 988,
-	9, 45, 2, 2, 988, 292, 3, 2, 2, 2, 989, 990, 9, 46, 2, 2, 990, 294, 3,
-	2, 2, 2, 991, 992, 9, 48, 2, 2, 992, 296, 3, 2, 2, 2, 40, 2, 670, 672,
-	679, 681, 685, 705, 713, 720, 723, 729, 732, 736, 740, 744, 750, 757, 762,
-	768, 774, 776, 780, 785, 790, 797, 880, 885, 889, 895, 901, 906, 920, 928,
-	930, 941, 945, 949, 951, 2,
+	3, 55212, 3, 55236, 3, 55238, 3, 55245, 3, 59394, 3, 59590, 3, 59650, 3,
+	59717, 3, 60930, 3, 60933, 3, 60935, 3, 60961, 3, 60963, 3, 60964, 3, 60966,
+	3, 60966, 3, 60969, 3, 60969, 3, 60971, 3, 60980, 3, 60982, 3, 60985, 3,
+	60987, 3, 60987, 3, 60989, 3, 60989, 3, 60996, 3, 60996, 3, 61001, 3, 61001,
+	3, 61003, 3, 61003, 3, 61005, 3, 61005, 3, 61007, 3, 61009, 3, 61011, 3,
+	61012, 3, 61014, 3, 61014, 3, 61017, 3, 61017, 3, 61019, 3, 61019, 3, 61021,
+	3, 61021, 3, 61023, 3, 61023, 3, 61025, 3, 61025, 3, 61027, 3, 61028, 3,
+	61030, 3, 61030, 3, 61033, 3, 61036, 3, 61038, 3, 61044, 3, 61046, 3, 61049,
+	3, 61051, 3, 61054, 3, 61056, 3, 61056, 3, 61058, 3, 61067, 3, 61069, 3,
+	61085, 3, 61091, 3, 61093, 3, 61095, 3, 61099, 3, 61101, 3, 61117, 3, 2,
+	4, 42712, 4, 42754, 4, 46902, 4, 46914, 4, 47135, 4, 47138, 4, 52899, 4,
+	52914, 4, 60386, 4, 63490, 4, 64031, 4, 1059, 2, 3, 3, 2, 2, 2, 2, 5, 3,
+	2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13,
+	3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2,
+	21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2,
+	2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2,
+	2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2,
+	2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3,
+	2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59,
+	3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2,
+	67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2,
+	2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2,
+	2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2,
+	2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3,
+	2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2,
+	105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2,
+	2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119,
+	3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2,
+	2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3,
+	2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2,
+	141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2,
+	2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153, 3, 2, 2, 2, 2, 155,
+	3, 2, 2, 2, 2, 157, 3, 2, 2, 2, 2, 159, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2,
+	2, 163, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2, 2, 167, 3, 2, 2, 2, 2, 169, 3,
+	2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 173, 3, 2, 2, 2, 2, 175, 3, 2, 2, 2, 2,
+	177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183, 3, 2,
+	2, 2, 2, 185, 3, 2, 2, 2, 2, 187, 3, 2, 2, 2, 2, 189, 3, 2, 2, 2, 2, 191,
+	3, 2, 2, 2, 2, 193, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 197, 3, 2, 2, 2,
+	2, 199, 3, 2, 2, 2, 2, 201, 3, 2, 2, 2, 2, 203, 3, 2, 2, 2, 2, 205, 3,
+	2, 2, 2, 2, 207, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3, 2, 2, 2, 2,
+	213, 3, 2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2, 219, 3, 2,
+	2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3, 2, 2, 2, 2, 227,
+	3, 2, 2, 2, 2, 229, 3, 2, 2, 2, 2, 231, 3, 2, 2, 2, 2, 233, 3, 2, 2, 2,
+	2, 235, 3, 2, 2, 2, 2, 237, 3, 2, 2, 2, 2, 239, 3, 2, 2, 2, 2, 241, 3,
+	2, 2, 2, 2, 243, 3, 2, 2, 2, 2, 245, 3, 2, 2, 2, 2, 247, 3, 2, 2, 2, 2,
+	249, 3, 2, 2, 2, 2, 251, 3, 2, 2, 2, 2, 253, 3, 2, 2, 2, 2, 255, 3, 2,
+	2, 2, 2, 257, 3, 2, 2, 2, 2, 259, 3, 2, 2, 2, 2, 261, 3, 2, 2, 2, 3, 303,
+	3, 2, 2, 2, 5, 305, 3, 2, 2, 2, 7, 307, 3, 2, 2, 2, 9, 309, 3, 2, 2, 2,
+	11, 312, 3, 2, 2, 2, 13, 314, 3, 2, 2, 2, 15, 316, 3, 2, 2, 2, 17, 318,
+	3, 2, 2, 2, 19, 320, 3, 2, 2, 2, 21, 322, 3, 2, 2, 2, 23, 324, 3, 2, 2,
+	2, 25, 326, 3, 2, 2, 2, 27, 329, 3, 2, 2, 2, 29, 331, 3, 2, 2, 2, 31, 333,
+	3, 2, 2, 2, 33, 335, 3, 2, 2, 2, 35, 337, 3, 2, 2, 2, 37, 339, 3, 2, 2,
+	2, 39, 342, 3, 2, 2, 2, 41, 344, 3, 2, 2, 2, 43, 346, 3, 2, 2, 2, 45, 349,
+	3, 2, 2, 2, 47, 352, 3, 2, 2, 2, 49, 355, 3, 2, 2, 2, 51, 357, 3, 2, 2,
+	2, 53, 359, 3, 2, 2, 2, 55, 361, 3, 2, 2, 2, 57, 363, 3, 2, 2, 2, 59, 365,
+	3, 2, 2, 2, 61, 367, 3, 2, 2, 2, 63, 369, 3, 2, 2, 2, 65, 371, 3, 2, 2,
+	2, 67, 373, 3, 2, 2, 2, 69, 375, 3, 2, 2, 2, 71, 377, 3, 2, 2, 2, 73, 379,
+	3, 2, 2, 2, 75, 381, 3, 2, 2, 2, 77, 383, 3, 2, 2, 2, 79, 385, 3, 2, 2,
+	2, 81, 387, 3, 2, 2, 2, 83, 389, 3, 2, 2, 2, 85, 391, 3, 2, 2, 2, 87, 393,
+	3, 2, 2, 2, 89, 395, 3, 2, 2, 2, 91, 397, 3, 2, 2, 2, 93, 399, 3, 2, 2,
+	2, 95, 401, 3, 2, 2, 2, 97, 407, 3, 2, 2, 2, 99, 411, 3, 2, 2, 2, 101,
+	420, 3, 2, 2, 2, 103, 426, 3, 2, 2, 2, 105, 433, 3, 2, 2, 2, 107, 436,
+	3, 2, 2, 2, 109, 442, 3, 2, 2, 2, 111, 445, 3, 2, 2, 2, 113, 452, 3, 2,
+	2, 2, 115, 456, 3, 2, 2, 2, 117, 463, 3, 2, 2, 2, 119, 470, 3, 2, 2, 2,
+	121, 477, 3, 2, 2, 2, 123, 482, 3, 2, 2, 2, 125, 488, 3, 2, 2, 2, 127,
+	493, 3, 2, 2, 2, 129, 500, 3, 2, 2, 2, 131, 509, 3, 2, 2, 2, 133, 515,
+	3, 2, 2, 2, 135, 518, 3, 2, 2, 2, 137, 523, 3, 2, 2, 2, 139, 529, 3, 2,
+	2, 2, 141, 539, 3, 2, 2, 2, 143, 543, 3, 2, 2, 2, 145, 554, 3, 2, 2, 2,
+	147, 559, 3, 2, 2, 2, 149, 565, 3, 2, 2, 2, 151, 578, 3, 2, 2, 2, 153,
+	595, 3, 2, 2, 2, 155, 598, 3, 2, 2, 2, 157, 602, 3, 2, 2, 2, 159, 606,
+	3, 2, 2, 2, 161, 610, 3, 2, 2, 2, 163, 613, 3, 2, 2, 2, 165, 620, 3, 2,
+	2, 2, 167, 625, 3, 2, 2, 2, 169, 634, 3, 2, 2, 2, 171, 637, 3, 2, 2, 2,
+	173, 642, 3, 2, 2, 2, 175, 648, 3, 2, 2, 2, 177, 652, 3, 2, 2, 2, 179,
+	657, 3, 2, 2, 2, 181, 664, 3, 2, 2, 2, 183, 669, 3, 2, 2, 2, 185, 675,
+	3, 2, 2, 2, 187, 682, 3, 2, 2, 2, 189, 687, 3, 2, 2, 2, 191, 692, 3, 2,
+	2, 2, 193, 696, 3, 2, 2, 2, 195, 701, 3, 2, 2, 2, 197, 724, 3, 2, 2, 2,
+	199, 726, 3, 2, 2, 2, 201, 746, 3, 2, 2, 2, 203, 762, 3, 2, 2, 2, 205,
+	764, 3, 2, 2, 2, 207, 771, 3, 2, 2, 2, 209, 775, 3, 2, 2, 2, 211, 779,
+	3, 2, 2, 2, 213, 783, 3, 2, 2, 2, 215, 785, 3, 2, 2, 2, 217, 789, 3, 2,
+	2, 2, 219, 791, 3, 2, 2, 2, 221, 815, 3, 2, 2, 2, 223, 829, 3, 2, 2, 2,
+	225, 838, 3, 2, 2, 2, 227, 849, 3, 2, 2, 2, 229, 852, 3, 2, 2, 2, 231,
+	856, 3, 2, 2, 2, 233, 864, 3, 2, 2, 2, 235, 871, 3, 2, 2, 2, 237, 881,
+	3, 2, 2, 2, 239, 888, 3, 2, 2, 2, 241, 891, 3, 2, 2, 2, 243, 895, 3, 2,
+	2, 2, 245, 900, 3, 2, 2, 2, 247, 907, 3, 2, 2, 2, 249, 915, 3, 2, 2, 2,
+	251, 924, 3, 2, 2, 2, 253, 928, 3, 2, 2, 2, 255, 938, 3, 2, 2, 2, 257,
+	943, 3, 2, 2, 2, 259, 959, 3, 2, 2, 2, 261, 990, 3, 2, 2, 2, 263, 992,
+	3, 2, 2, 2, 265, 994, 3, 2, 2, 2, 267, 996, 3, 2, 2, 2, 269, 998, 3, 2,
+	2, 2, 271, 1000, 3, 2, 2, 2, 273, 1002, 3, 2, 2, 2, 275, 1004, 3, 2, 2,
+	2, 277, 1006, 3, 2, 2, 2, 279, 1008, 3, 2, 2, 2, 281, 1010, 3, 2, 2, 2,
+	283, 1012, 3, 2, 2, 2, 285, 1014, 3, 2, 2, 2, 287, 1016, 3, 2, 2, 2, 289,
+	1018, 3, 2, 2, 2, 291, 1020, 3, 2, 2, 2, 293, 1022, 3, 2, 2, 2, 295, 1024,
+	3, 2, 2, 2, 297, 1026, 3, 2, 2, 2, 299, 1028, 3, 2, 2, 2, 301, 1030, 3,
+	2, 2, 2, 303, 304, 7, 61, 2, 2, 304, 4, 3, 2, 2, 2, 305, 306, 7, 46, 2,
+	2, 306, 6, 3, 2, 2, 2, 307, 308, 7, 63, 2, 2, 308, 8, 3, 2, 2, 2, 309,
+	310, 7, 45, 2, 2, 310, 311, 7, 63, 2, 2, 311, 10, 3, 2, 2, 2, 312, 313,
+	7, 44, 2, 2, 313, 12, 3, 2, 2, 2, 314, 315, 7, 42, 2, 2, 315, 14, 3, 2,
+	2, 2, 316, 317, 7, 43, 2, 2, 317, 16, 3, 2, 2, 2, 318, 319, 7, 93, 2, 2,
+	319, 18, 3, 2, 2, 2, 320, 321, 7, 95, 2, 2, 321, 20, 3, 2, 2, 2, 322, 323,
+	7, 60, 2, 2, 323, 22, 3, 2, 2, 2, 324, 325, 7, 126, 2, 2, 325, 24, 3, 2,
+	2, 2, 326, 327, 7, 48, 2, 2, 327, 328, 7, 48, 2, 2, 328, 26, 3, 2, 2, 2,
+	329, 330, 7, 45, 2, 2, 330, 28, 3, 2, 2, 2, 331, 332, 7, 47, 2, 2, 332,
+	30, 3, 2, 2, 2, 333, 334, 7, 49, 2, 2, 334, 32, 3, 2, 2, 2, 335, 336, 7,
+	39, 2, 2, 336, 34, 3, 2, 2, 2, 337, 338, 7, 96, 2, 2, 338, 36, 3, 2, 2,
+	2, 339, 340, 7, 62, 2, 2, 340, 341, 7, 64, 2, 2, 341, 38, 3, 2, 2, 2, 342,
+	343, 7, 62, 2, 2, 343, 40, 3, 2, 2, 2, 344, 345, 7, 64, 2, 2, 345, 42,
+	3, 2, 2, 2, 346, 347, 7, 62, 2, 2, 347, 348, 7, 63, 2, 2, 348, 44, 3, 2,
+	2, 2, 349, 350, 7, 64, 2, 2, 350, 351, 7, 63, 2, 2, 351, 46, 3, 2, 2, 2,
+	352, 353, 7, 63, 2, 2, 353, 354, 7, 128, 2, 2, 354, 48, 3, 2, 2, 2, 355,
+	356, 7, 48, 2, 2, 356, 50, 3, 2, 2, 2, 357, 358, 7, 125, 2, 2, 358, 52,
+	3, 2, 2, 2, 359, 360, 7, 127, 2, 2, 360, 54, 3, 2, 2, 2, 361, 362, 7, 38,
+	2, 2, 362, 56, 3, 2, 2, 2, 363, 364, 7, 10218, 2, 2, 364, 58, 3, 2, 2,
+	2, 365, 366, 7, 12298, 2, 2, 366, 60, 3, 2, 2, 2, 367, 368, 7, 65126, 2,
+	2, 368, 62, 3, 2, 2, 2, 369, 370, 7, 65310, 2, 2, 370, 64, 3, 2, 2, 2,
+	371, 372, 7, 10219, 2, 2, 372, 66, 3, 2, 2, 2, 373, 374, 7, 12299, 2, 2,
+	374, 68, 3, 2, 2, 2, 375, 376, 7, 65127, 2, 2, 376, 70, 3, 2, 2, 2, 377,
+	378, 7, 65312, 2, 2, 378, 72, 3, 2, 2, 2, 379, 380, 7, 175, 2, 2, 380,
+	74, 3, 2, 2, 2, 381, 382, 7, 8210, 2, 2, 382, 76, 3, 2, 2, 2, 383, 384,
+	7, 8211, 2, 2, 384, 78, 3, 2, 2, 2, 385, 386, 7, 8212, 2, 2, 386, 80, 3,
+	2, 2, 2, 387, 388, 7, 8213, 2, 2, 388, 82, 3, 2, 2, 2, 389, 390, 7, 8214,
+	2, 2, 390, 84, 3, 2, 2, 2, 391, 392, 7, 8215, 2, 2, 392, 86, 3, 2, 2, 2,
+	393, 394, 7, 8724, 2, 2, 394, 88, 3, 2, 2, 2, 395, 396, 7, 65114, 2, 2,
+	396, 90, 3, 2, 2, 2, 397, 398, 7, 65125, 2, 2, 398, 92, 3, 2, 2, 2, 399,
+	400, 7, 65295, 2, 2, 400, 94, 3, 2, 2, 2, 401, 402, 9, 2, 2, 2, 402, 403,
+	9, 3, 2, 2, 403, 404, 9, 4, 2, 2, 404, 405, 9, 5, 2, 2, 405, 406, 9, 3,
+	2, 2, 406, 96, 3, 2, 2, 2, 407, 408, 9, 6, 2, 2, 408, 409, 9, 7, 2, 2,
+	409, 410, 9, 7, 2, 2, 410, 98, 3, 2, 2, 2, 411, 412, 9, 5, 2, 2, 412, 413,
+	9, 8, 2, 2, 413, 414, 9, 9, 2, 2, 414, 415, 9, 4, 2, 2, 415, 416, 9, 5,
+	2, 2, 416, 417, 9, 3, 2, 2, 417, 418, 9, 6, 2, 2, 418, 419, 9, 7, 2, 2,
+	419, 100, 3, 2, 2, 2, 420, 421, 9, 10, 2, 2, 421, 422, 9, 6, 2, 2, 422,
+	423, 9, 9, 2, 2, 423, 424, 9, 11, 2, 2, 424, 425, 9, 12, 2, 2, 425, 102,
+	3, 2, 2, 2, 426, 427, 9, 2, 2, 2, 427, 428, 9, 3, 2, 2, 428, 429, 9, 13,
+	2, 2, 429, 430, 9, 4, 2, 2, 430, 431, 9, 3, 2, 2, 431, 432, 9, 14, 2, 2,
+	432, 104, 3, 2, 2, 2, 433, 434, 9, 6, 2, 2, 434, 435, 9, 15, 2, 2, 435,
+	106, 3, 2, 2, 2, 436, 437, 9, 10, 2, 2, 437, 438, 9, 16, 2, 2, 438, 439,
+	9, 17, 2, 2, 439, 440, 9, 18, 2, 2, 440, 441, 9, 16, 2, 2, 441, 108, 3,
+	2, 2, 2, 442, 443, 9, 5, 2, 2, 443, 444, 9, 3, 2, 2, 444, 110, 3, 2, 2,
+	2, 445, 446, 9, 11, 2, 2, 446, 447, 9, 17, 2, 2, 447, 448, 9, 16, 2, 2,
+	448, 449, 9, 6, 2, 2, 449, 450, 9, 9, 2, 2, 450, 451, 9, 16, 2, 2, 451,
+	112, 3, 2, 2, 2, 452, 453, 9, 15, 2, 2, 453, 454, 9, 16, 2, 2, 454, 455,
+	9, 9, 2, 2, 455, 114, 3, 2, 2, 2, 456, 457, 9, 14, 2, 2, 457, 458, 9, 16,
+	2, 2, 458, 459, 9, 9, 2, 2, 459, 460, 9, 6, 2, 2, 460, 461, 9, 11, 2, 2,
+	461, 462, 9, 12, 2, 2, 462, 116, 3, 2, 2, 2, 463, 464, 9, 14, 2, 2, 464,
+	465, 9, 16, 2, 2, 465, 466, 9, 7, 2, 2, 466, 467, 9, 16, 2, 2, 467, 468,
+	9, 9, 2, 2, 468, 469, 9, 16, 2, 2, 469, 118, 3, 2, 2, 2, 470, 471, 9, 17,
+	2, 2, 471, 472, 9, 16, 2, 2, 472, 473, 9, 10, 2, 2, 473, 474, 9, 5, 2,
+	2, 474, 475, 9, 19, 2, 2, 475, 476, 9, 16, 2, 2, 476, 120, 3, 2, 2, 2,
+	477, 478, 9, 11, 2, 2, 478, 479, 9, 6, 2, 2, 479, 480, 9, 7, 2, 2, 480,
+	481, 9, 7, 2, 2, 481, 122, 3, 2, 2, 2, 482, 483, 9, 20, 2, 2, 483, 484,
+	9, 4, 2, 2, 484, 485, 9, 16, 2, 2, 485, 486, 9, 7, 2, 2, 486, 487, 9, 14,
+	2, 2, 487, 124, 3, 2, 2, 2, 488, 489, 9, 13, 2, 2, 489, 490, 9, 4, 2, 2,
+	490, 491, 9, 9, 2, 2, 491, 492, 9, 12, 2, 2, 492, 126, 3, 2, 2, 2, 493,
+	494, 9, 17, 2, 2, 494, 495, 9, 16, 2, 2, 495, 496, 9, 9, 2, 2, 496, 497,
+	9, 2, 2, 2, 497, 498, 9, 17, 2, 2, 498, 499, 9, 3, 2, 2, 499, 128, 3, 2,
+	2, 2, 500, 501, 9, 14, 2, 2, 501, 502, 9, 4, 2, 2, 502, 503, 9, 15, 2,
+	2, 503, 504, 9, 9, 2, 2, 504, 505, 9, 4, 2, 2, 505, 506, 9, 3, 2, 2, 506,
+	507, 9, 11, 2, 2, 507, 508, 9, 9, 2, 2, 508, 130, 3, 2, 2, 2, 509, 510,
+	9, 5, 2, 2, 510, 511, 9, 17, 2, 2, 511, 512, 9, 14, 2, 2, 512, 513, 9,
+	16, 2, 2, 513, 514, 9, 17, 2, 2, 514, 132, 3, 2, 2, 2, 515, 516, 9, 21,
+	2, 2, 516, 517, 9, 20, 2, 2, 517, 134, 3, 2, 2, 2, 518, 519, 9, 15, 2,
+	2, 519, 520, 9, 22, 2, 2, 520, 521, 9, 4, 2, 2, 521, 522, 9, 8, 2, 2, 522,
+	136, 3, 2, 2, 2, 523, 524, 9, 7, 2, 2, 524, 525, 9, 4, 2, 2, 525, 526,
+	9, 10, 2, 2, 526, 527, 9, 4, 2, 2, 527, 528, 9, 9, 2, 2, 528, 138, 3, 2,
+	2, 2, 529, 530, 9, 6, 2, 2, 530, 531, 9, 15, 2, 2, 531, 532, 9, 11, 2,
+	2, 532, 533, 9, 16, 2, 2, 533, 534, 9, 3, 2, 2, 534, 535, 9, 14, 2, 2,
+	535, 536, 9, 4, 2, 2, 536, 537, 9, 3, 2, 2, 537, 538, 9, 18, 2, 2, 538,
+	140, 3, 2, 2, 2, 539, 540, 9, 6, 2, 2, 540, 541, 9, 15, 2, 2, 541, 542,
+	9, 11, 2, 2, 542, 142, 3, 2, 2, 2, 543, 544, 9, 14, 2, 2, 544, 545, 9,
+	16, 2, 2, 545, 546, 9, 15, 2, 2, 546, 547, 9, 11, 2, 2, 547, 548, 9, 16,
+	2, 2, 548, 549, 9, 3, 2, 2, 549, 550, 9, 14, 2, 2, 550, 551, 9, 4, 2, 2,
+	551, 552, 9, 3, 2, 2, 552, 553, 9, 18, 2, 2, 553, 144, 3, 2, 2, 2, 554,
+	555, 9, 14, 2, 2, 555, 556, 9, 16, 2, 2, 556, 557, 9, 15, 2, 2, 557, 558,
+	9, 11, 2, 2, 558, 146, 3, 2, 2, 2, 559, 560, 9, 13, 2, 2, 560, 561, 9,
+	12, 2, 2, 561, 562, 9, 16, 2, 2, 562, 563, 9, 17, 2, 2, 563, 564, 9, 16,
+	2, 2, 564, 148, 3, 2, 2, 2, 565, 566, 9, 15, 2, 2, 566, 567, 9, 12, 2,
+	2, 567, 568, 9, 5, 2, 2, 568, 569, 9, 17, 2, 2, 569, 570, 9, 9, 2, 2, 570,
+	571, 9, 16, 2, 2, 571, 572, 9, 15, 2, 2, 572, 573, 9, 9, 2, 2, 573, 574,
+	9, 8, 2, 2, 574, 575, 9, 6, 2, 2, 575, 576, 9, 9, 2, 2, 576, 577, 9, 12,
+	2, 2, 577, 150, 3, 2, 2, 2, 578, 579, 9, 6, 2, 2, 579, 580, 9, 7, 2, 2,
+	580, 581, 9, 7, 2, 2, 581, 582, 9, 15, 2, 2, 582, 583, 9, 12, 2, 2, 583,
+	584, 9, 5, 2, 2, 584, 585, 9, 17, 2, 2, 585, 586, 9, 9, 2, 2, 586, 587,
+	9, 16, 2, 2, 587, 588, 9, 15, 2, 2, 588, 589, 9, 9, 2, 2, 589, 590, 9,
+	8, 2, 2, 590, 591, 9, 6, 2, 2, 591, 592, 9, 9, 2, 2, 592, 593, 9, 12, 2,
+	2, 593, 594, 9, 15, 2, 2, 594, 152, 3, 2, 2, 2, 595, 596, 9, 5, 2, 2, 596,
+	597, 9, 17, 2, 2, 597, 154, 3, 2, 2, 2, 598, 599, 9, 23, 2, 2, 599, 600,
+	9, 5, 2, 2, 600, 601, 9, 17, 2, 2, 601, 156, 3, 2, 2, 2, 602, 603, 9, 6,
+	2, 2, 603, 604, 9, 3, 2, 2, 604, 605, 9, 14, 2, 2, 605, 158, 3, 2, 2, 2,
+	606, 607, 9, 3, 2, 2, 607, 608, 9, 5, 2, 2, 608, 609, 9, 9, 2, 2, 609,
+	160, 3, 2, 2, 2, 610, 611, 9, 4, 2, 2, 611, 612, 9, 3, 2, 2, 612, 162,
+	3, 2, 2, 2, 613, 614, 9, 15, 2, 2, 614, 615, 9, 9, 2, 2, 615, 616, 9, 6,
+	2, 2, 616, 617, 9, 17, 2, 2, 617, 618, 9, 9, 2, 2, 618, 619, 9, 15, 2,
+	2, 619, 164, 3, 2, 2, 2, 620, 621, 9, 16, 2, 2, 621, 622, 9, 3, 2, 2, 622,
+	623, 9, 14, 2, 2, 623, 624, 9, 15, 2, 2, 624, 166, 3, 2, 2, 2, 625, 626,
+	9, 11, 2, 2, 626, 627, 9, 5, 2, 2, 627, 628, 9, 3, 2, 2, 628, 629, 9, 9,
+	2, 2, 629, 630, 9, 6, 2, 2, 630, 631, 9, 4, 2, 2, 631, 632, 9, 3, 2, 2,
+	632, 633, 9, 15, 2, 2, 633, 168, 3, 2, 2, 2, 634, 635, 9, 4, 2, 2, 635,
+	636, 9, 15, 2, 2, 636, 170, 3, 2, 2, 2, 637, 638, 9, 3, 2, 2, 638, 639,
+	9, 2, 2, 2, 639, 640, 9, 7, 2, 2, 640, 641, 9, 7, 2, 2, 641, 172, 3, 2,
+	2, 2, 642, 643, 9, 11, 2, 2, 643, 644, 9, 5, 2, 2, 644, 645, 9, 2, 2, 2,
+	645, 646, 9, 3, 2, 2, 646, 647, 9, 9, 2, 2, 647, 174, 3, 2, 2, 2, 648,
+	649, 9, 6, 2, 2, 649, 650, 9, 3, 2, 2, 650, 651, 9, 20, 2, 2, 651, 176,
+	3, 2, 2, 2, 652, 653, 9, 3, 2, 2, 653, 654, 9, 5, 2, 2, 654, 655, 9, 3,
+	2, 2, 655, 656, 9, 16, 2, 2, 656, 178, 3, 2, 2, 2, 657, 658, 9, 15, 2,
+	2, 658, 659, 9, 4, 2, 2, 659, 660, 9, 3, 2, 2, 660, 661, 9, 18, 2, 2, 661,
+	662, 9, 7, 2, 2, 662, 663, 9, 16, 2, 2, 663, 180, 3, 2, 2, 2, 664, 665,
+	9, 9, 2, 2, 665, 666, 9, 17, 2, 2, 666, 667, 9, 2, 2, 2, 667, 668, 9, 16,
+	2, 2, 668, 182, 3, 2, 2, 2, 669, 670, 9, 24, 2, 2, 670, 671, 9, 6, 2, 2,
+	671, 672, 9, 7, 2, 2, 672, 673, 9, 15, 2, 2, 673, 674, 9, 16, 2, 2, 674,
+	184, 3, 2, 2, 2, 675, 676, 9, 16, 2, 2, 676, 677, 9, 23, 2, 2, 677, 678,
+	9, 4, 2, 2, 678, 679, 9, 15, 2, 2, 679, 680, 9, 9, 2, 2, 680, 681, 9, 15,
+	2, 2, 681, 186, 3, 2, 2, 2, 682, 683, 9, 11, 2, 2, 683, 684, 9, 6, 2, 2,
+	684, 685, 9, 15, 2, 2, 685, 686, 9, 16, 2, 2, 686, 188, 3, 2, 2, 2, 687,
+	688, 9, 16, 2, 2, 688, 689, 9, 7, 2, 2, 689, 690, 9, 15, 2, 2, 690, 691,
+	9, 16, 2, 2, 691, 190, 3, 2, 2, 2, 692, 693, 9, 16, 2, 2, 693, 694, 9,
+	3, 2, 2, 694, 695, 9, 14, 2, 2, 695, 192, 3, 2, 2, 2, 696, 697, 9, 13,
+	2, 2, 697, 698, 9, 12, 2, 2, 698, 699, 9, 16, 2, 2, 699, 700, 9, 3, 2,
+	2, 700, 194, 3, 2, 2, 2, 701, 702, 9, 9, 2, 2, 702, 703, 9, 12, 2, 2, 703,
+	704, 9, 16, 2, 2, 704, 705, 9, 3, 2, 2, 705, 196, 3, 2, 2, 2, 706, 711,
+	7, 36, 2, 2, 707, 710, 5, 293, 147, 2, 708, 710, 5, 199, 100, 2, 709, 707,
+	3, 2, 2, 2, 709, 708, 3, 2, 2, 2, 710, 713, 3, 2, 2, 2, 711, 709, 3, 2,
+	2, 2, 711, 712, 3, 2, 2, 2, 712, 714, 3, 2, 2, 2, 713, 711, 3, 2, 2, 2,
+	714, 725, 7, 36, 2, 2, 715, 720, 7, 41, 2, 2, 716, 719, 5, 273, 137, 2,
+	717, 719, 5, 199, 100, 2, 718, 716, 3, 2, 2, 2, 718, 717, 3, 2, 2, 2, 719,
+	722, 3, 2, 2, 2, 720, 718, 3, 2, 2, 2, 720, 721, 3, 2, 2, 2, 721, 723,
+	3, 2, 2, 2, 722, 720, 3, 2, 2, 2, 723, 725, 7, 41, 2, 2, 724, 706, 3, 2,
+	2, 2, 724, 715, 3, 2, 2, 2, 725, 198, 3, 2, 2, 2, 726, 744, 7, 94, 2, 2,
+	727, 745, 9, 25, 2, 2, 728, 729, 9, 2, 2, 2, 729, 730, 5, 209, 105, 2,
+	730, 731, 5, 209, 105, 2, 731, 732, 5, 209, 105, 2, 732, 733, 5, 209, 105,
+	2, 733, 745, 3, 2, 2, 2, 734, 735, 9, 2, 2, 2, 735, 736, 5, 209, 105, 2,
+	736, 737, 5, 209, 105, 2, 737, 738, 5, 209, 105, 2, 738, 739, 5, 209, 105,
+	2, 739, 740, 5, 209, 105, 2, 740, 741, 5, 209, 105, 2, 741, 742, 5, 209,
+	105, 2, 742, 743, 5, 209, 105, 2, 743, 745, 3, 2, 2, 2, 744, 727, 3, 2,
+	2, 2, 744, 728, 3, 2, 2, 2, 744, 734, 3, 2, 2, 2, 745, 200, 3, 2, 2, 2,
+	746, 747, 7, 50, 2, 2, 747, 748, 7, 122, 2, 2, 748, 750, 3, 2, 2, 2, 749,
+	751, 5, 209, 105, 2, 750, 749, 3, 2, 2, 2, 751, 752, 3, 2, 2, 2, 752, 750,
+	3, 2, 2, 2, 752, 753, 3, 2, 2, 2, 753, 202, 3, 2, 2, 2, 754, 763, 5, 219,
+	110, 2, 755, 759, 5, 213, 107, 2, 756, 758, 5, 211, 106, 2, 757, 756, 3,
+	2, 2, 2, 758, 761, 3, 2, 2, 2, 759, 757, 3, 2, 2, 2, 759, 760, 3, 2, 2,
+	2, 760, 763, 3, 2, 2, 2, 761, 759, 3, 2, 2, 2, 762, 754, 3, 2, 2, 2, 762,
+	755, 3, 2, 2, 2, 763, 204, 3, 2, 2, 2, 764, 766, 5, 219, 110, 2, 765, 767,
+	5, 217, 109, 2, 766, 765, 3, 2, 2, 2, 767, 768, 3, 2, 2, 2, 768, 766, 3,
+	2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 206, 3, 2, 2, 2, 770, 772, 9, 26, 2,
+	2, 771, 770, 3, 2, 2, 2, 772, 208, 3, 2, 2, 2, 773, 776, 5, 211, 106, 2,
+	774, 776, 5, 207, 104, 2, 775, 773, 3, 2, 2, 2, 775, 774, 3, 2, 2, 2, 776,
+	210, 3, 2, 2, 2, 777, 780, 5, 219, 110, 2, 778, 780, 5, 213, 107, 2, 779,
+	777, 3, 2, 2, 2, 779, 778, 3, 2, 2, 2, 780, 212, 3, 2, 2, 2, 781, 784,
+	5, 215, 108, 2, 782, 784, 4, 58, 59, 2, 783, 781, 3, 2, 2, 2, 783, 782,
+	3, 2, 2, 2, 784, 214, 3, 2, 2, 2, 785, 786, 4, 51, 57, 2, 786, 216, 3,
+	2, 2, 2, 787, 790, 5, 219, 110, 2, 788, 790, 5, 215, 108, 2, 789, 787,
+	3, 2, 2, 2, 789, 788, 3, 2, 2, 2, 790, 218, 3, 2, 2, 2, 791, 792, 7, 50,
+	2, 2, 792, 220, 3, 2, 2, 2, 793, 795, 5, 211, 106, 2, 794, 793, 3, 2, 2,
+	2, 795, 796, 3, 2, 2, 2, 796, 794, 3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797,
+	816, 3, 2, 2, 2, 798, 800, 5, 211, 106, 2, 799, 798, 3, 2, 2, 2, 800, 801,
+	3, 2, 2, 2, 801, 799, 3, 2, 2, 2, 801, 802, 3, 2, 2, 2, 802, 803, 3, 2,
+	2, 2, 803, 805, 7, 48, 2, 2, 804, 806, 5, 211, 106, 2, 805, 804, 3, 2,
+	2, 2, 806, 807, 3, 2, 2, 2, 807, 805, 3, 2, 2, 2, 807, 808, 3, 2, 2, 2,
+	808, 816, 3, 2, 2, 2, 809, 811, 7, 48, 2, 2, 810, 812, 5, 211, 106, 2,
+	811, 810, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813, 811, 3, 2, 2, 2, 813,
+	814, 3, 2, 2, 2, 814, 816, 3, 2, 2, 2, 815, 794, 3, 2, 2, 2, 815, 799,
+	3, 2, 2, 2, 815, 809, 3, 2, 2, 2, 816, 817, 3, 2, 2, 2, 817, 819, 9, 16,
+	2, 2, 818, 820, 7, 47, 2, 2, 819, 818, 3, 2, 2, 2, 819, 820, 3, 2, 2, 2,
+	820, 822, 3, 2, 2, 2, 821, 823, 5, 211, 106, 2, 822, 821, 3, 2, 2, 2, 823,
+	824, 3, 2, 2, 2, 824, 822, 3, 2, 2, 2, 824, 825, 3, 2, 2, 2, 825, 222,
+	3, 2, 2, 2, 826, 828, 5, 211, 106, 2, 827, 826, 3, 2, 2, 2, 828, 831, 3,
+	2, 2, 2, 829, 827, 3, 2, 2, 2, 829, 830, 3, 2, 2, 2, 830, 832, 3, 2, 2,
+	2, 831, 829, 3, 2, 2, 2, 832, 834, 7, 48, 2, 2, 833, 835, 5, 211, 106,
+	2, 834, 833, 3, 2, 2, 2, 835, 836, 3, 2, 2, 2, 836, 834, 3, 2, 2, 2, 836,
+	837, 3, 2, 2, 2, 837, 224, 3, 2, 2, 2, 838, 839, 9, 11, 2, 2, 839, 840,
+	9, 5, 2, 2, 840, 841, 9, 3, 2, 2, 841, 842, 9, 15, 2, 2, 842, 843, 9, 9,
+	2, 2, 843, 844, 9, 17, 2, 2, 844, 845, 9, 6, 2, 2, 845, 846, 9, 4, 2, 2,
+	846, 847, 9, 3, 2, 2, 847, 848, 9, 9, 2, 2, 848, 226, 3, 2, 2, 2, 849,
+	850, 9, 14, 2, 2, 850, 851, 9, 5, 2, 2, 851, 228, 3, 2, 2, 2, 852, 853,
+	9, 24, 2, 2, 853, 854, 9, 5, 2, 2, 854, 855, 9, 17, 2, 2, 855, 230, 3,
+	2, 2, 2, 856, 857, 9, 17, 2, 2, 857, 858, 9, 16, 2, 2, 858, 859, 9, 27,
+	2, 2, 859, 860, 9, 2, 2, 2, 860, 861, 9, 4, 2, 2, 861, 862, 9, 17, 2, 2,
+	862, 863, 9, 16, 2, 2, 863, 232, 3, 2, 2, 2, 864, 865, 9, 2, 2, 2, 865,
+	866, 9, 3, 2, 2, 866, 867, 9, 4, 2, 2, 867, 868, 9, 27, 2, 2, 868, 869,
+	9, 2, 2, 2, 869, 870, 9, 16, 2, 2, 870, 234, 3, 2, 2, 2, 871, 872, 9, 10,
+	2, 2, 872, 873, 9, 6, 2, 2, 873, 874, 9, 3, 2, 2, 874, 875, 9, 14, 2, 2,
+	875, 876, 9, 6, 2, 2, 876, 877, 9, 9, 2, 2, 877, 878, 9, 5, 2, 2, 878,
+	879, 9, 17, 2, 2, 879, 880, 9, 20, 2, 2, 880, 236, 3, 2, 2, 2, 881, 882,
+	9, 15, 2, 2, 882, 883, 9, 11, 2, 2, 883, 884, 9, 6, 2, 2, 884, 885, 9,
+	7, 2, 2, 885, 886, 9, 6, 2, 2, 886, 887, 9, 17, 2, 2, 887, 238, 3, 2, 2,
+	2, 888, 889, 9, 5, 2, 2, 889, 890, 9, 24, 2, 2, 890, 240, 3, 2, 2, 2, 891,
+	892, 9, 6, 2, 2, 892, 893, 9, 14, 2, 2, 893, 894, 9, 14, 2, 2, 894, 242,
+	3, 2, 2, 2, 895, 896, 9, 14, 2, 2, 896, 897, 9, 17, 2, 2, 897, 898, 9,
+	5, 2, 2, 898, 899, 9, 8, 2, 2, 899, 244, 3, 2, 2, 2, 900, 901, 9, 24, 2,
+	2, 901, 902, 9, 4, 2, 2, 902, 903, 9, 7, 2, 2, 903, 904, 9, 9, 2, 2, 904,
+	905, 9, 16, 2, 2, 905, 906, 9, 17, 2, 2, 906, 246, 3, 2, 2, 2, 907, 908,
+	9, 16, 2, 2, 908, 909, 9, 23, 2, 2, 909, 910, 9, 9, 2, 2, 910, 911, 9,
+	17, 2, 2, 911, 912, 9, 6, 2, 2, 912, 913, 9, 11, 2, 2, 913, 914, 9, 9,
+	2, 2, 914, 248, 3, 2, 2, 2, 915, 919, 5, 251, 126, 2, 916, 918, 5, 253,
+	127, 2, 917, 916, 3, 2, 2, 2, 918, 921, 3, 2, 2, 2, 919, 917, 3, 2, 2,
+	2, 919, 920, 3, 2, 2, 2, 920, 250, 3, 2, 2, 2, 921, 919, 3, 2, 2, 2, 922,
+	925, 5, 301, 151, 2, 923, 925, 5, 289, 145, 2, 924, 922, 3, 2, 2, 2, 924,
+	923, 3, 2, 2, 2, 925, 252, 3, 2, 2, 2, 926, 929, 5, 269, 135, 2, 927, 929,
+	5, 285, 143, 2, 928, 926, 3, 2, 2, 2, 928, 927, 3, 2, 2, 2, 929, 254, 3,
+	2, 2, 2, 930, 934, 7, 98, 2, 2, 931, 933, 5, 265, 133, 2, 932, 931, 3,
+	2, 2, 2, 933, 936, 3, 2, 2, 2, 934, 932, 3, 2, 2, 2, 934, 935, 3, 2, 2,
+	2, 935, 937, 3, 2, 2, 2, 936, 934, 3, 2, 2, 2, 937, 939, 7, 98, 2, 2, 938,
+	930, 3, 2, 2, 2, 939, 940, 3, 2, 2, 2, 940, 938, 3, 2, 2, 2, 940, 941,
+	3, 2, 2, 2, 941, 256, 3, 2, 2, 2, 942, 944, 5, 259, 130, 2, 943, 942, 3,
+	2, 2, 2, 944, 945, 3, 2, 2, 2, 945, 943, 3, 2, 2, 2, 945, 946, 3, 2, 2,
+	2, 946, 258, 3, 2, 2, 2, 947, 960, 5, 287, 144, 2, 948, 960, 5, 291, 146,
+	2, 949, 960, 5, 295, 148, 2, 950, 960, 5, 297, 149, 2, 951, 960, 5, 263,
+	132, 2, 952, 960, 5, 283, 142, 2, 953, 960, 5, 281, 141, 2, 954, 960, 5,
+	279, 140, 2, 955, 960, 5, 267, 134, 2, 956, 960, 5, 299, 150, 2, 957, 960,
+	9, 28, 2, 2, 958, 960, 5, 261, 131, 2, 959, 947, 3, 2, 2, 2, 959, 948,
+	3, 2, 2, 2, 959, 949, 3, 2, 2, 2, 959, 950, 3, 2, 2, 2, 959, 951, 3, 2,
+	2, 2, 959, 952, 3, 2, 2, 2, 959, 953, 3, 2, 2, 2, 959, 954, 3, 2, 2, 2,
+	959, 955, 3, 2, 2, 2, 959, 956, 3, 2, 2, 2, 959, 957, 3, 2, 2, 2, 959,
+	958, 3, 2, 2, 2, 960, 260, 3, 2, 2, 2, 961, 962, 7, 49, 2, 2, 962, 963,
+	7, 44, 2, 2, 963, 969, 3, 2, 2, 2, 964, 968, 5, 271, 136, 2, 965, 966,
+	7, 44, 2, 2, 966, 968, 5, 277, 139, 2, 967, 964, 3, 2, 2, 2, 967, 965,
+	3, 2, 2, 2, 968, 971, 3, 2, 2, 2, 969, 967, 3, 2, 2, 2, 969, 970, 3, 2,
+	2, 2, 970, 972, 3, 2, 2, 2, 971, 969, 3, 2, 2, 2, 972, 973, 7, 44, 2, 2,
+	973, 991, 7, 49, 2, 2, 974, 975, 7, 49, 2, 2, 975, 976, 7, 49, 2, 2, 976,
+	980, 3, 2, 2, 2, 977, 979, 5, 275, 138, 2, 978, 977, 3, 2, 2, 2, 979, 982,
+	3, 2, 2, 2, 980, 978, 3, 2, 2, 2, 980, 981, 3, 2, 2, 2, 981, 984, 3, 2,
+	2, 2, 982, 980, 3, 2, 2, 2, 983, 985, 5, 283, 142, 2, 984, 983, 3, 2, 2,
+	2, 984, 985, 3, 2, 2, 2, 985, 988, 3, 2, 2, 2, 986, 989, 5, 295, 148, 2,
+	987, 989, 7, 2, 2, 3, 988, 986, 3, 2, 2, 2, 988, 987, 3, 2, 2, 2, 989,
+	991, 3, 2, 2, 2, 990, 961, 3, 2, 2, 2, 990, 974, 3, 2, 2, 2, 991, 262,
+	3, 2, 2, 2, 992, 993, 9, 29, 2, 2, 993, 264, 3, 2, 2, 2, 994, 995, 10,
+	30, 2, 2, 995, 266, 3, 2, 2, 2, 996, 997, 9, 31, 2, 2, 997, 268, 3, 2,
+	2, 2, 998, 999, 9, 47, 2, 2, 999, 270, 3, 2, 2, 2, 1000, 1001, 10, 32,
+	2, 2, 1001, 272, 3, 2, 2, 2, 1002, 1003, 10, 33, 2, 2, 1003, 274, 3, 2,
+	2, 2, 1004, 1005, 10, 34, 2, 2, 1005, 276, 3, 2, 2, 2, 1006, 1007, 10,
+	35, 2, 2, 1007, 278, 3, 2, 2, 2, 1008, 1009, 9, 36, 2, 2, 1009, 280, 3,
+	2, 2, 2, 1010, 1011, 9, 37, 2, 2, 1011, 282, 3, 2, 2, 2, 1012, 1013, 9,
+	38, 2, 2, 1013, 284, 3, 2, 2, 2, 1014, 1015, 9, 39, 2, 2, 1015, 286, 3,
+	2, 2, 2, 1016, 1017, 9, 40, 2, 2, 1017, 288, 3, 2, 2, 2, 1018, 1019, 9,
+	41, 2, 2, 1019, 290, 3, 2, 2, 2, 1020, 1021, 9, 42, 2, 2, 1021, 292, 3,
+	2, 2, 2, 1022, 1023, 10, 43, 2, 2, 1023, 294, 3, 2, 2, 2, 1024, 1025, 9,
+	44, 2, 2, 1025, 296, 3, 2, 2, 2, 1026, 1027, 9, 45, 2, 2, 1027, 298, 3,
+	2, 2, 2, 1028, 1029, 9, 46, 2, 2, 1029, 300, 3, 2, 2, 2, 1030, 1031, 9,
+	48, 2, 2, 1031, 302, 3, 2, 2, 2, 40, 2, 709, 711, 718, 720, 724, 744, 752,
+	759, 762, 768, 771, 775, 779, 783, 789, 796, 801, 807, 813, 815, 819, 824,
+	829, 836, 919, 924, 928, 934, 940, 945, 959, 967, 969, 980, 984, 988, 990,
+	2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -810,31 +827,32 @@ var lexerModeNames = []string{
 var lexerLiteralNames = []string{
 	"", "';'", "','", "'='", "'+='", "'*'", "'('", "')'", "'['", "']'", "':'",
 	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'", "'<'", "'>'",
-	"'<='", "'>='", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
-	"'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'", "'\u00AD'",
-	"'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'", "'\u2015'",
-	"'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "", "", "", "",
+	"'<='", "'>='", "'=~'", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'",
+	"'\uFE64'", "'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'",
+	"'\u00AD'", "'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'",
+	"'\u2015'", "'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"'0'",
+	"", "", "", "", "", "'0'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL", "MATCH",
-	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
-	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
-	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
-	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
-	"FILTER", "EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
-	"EscapedSymbolicName", "SP", "WHITESPACE", "Comment",
+	"", "", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL",
+	"MATCH", "UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE",
+	"REMOVE", "CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "SHORTESTPATH",
+	"ALLSHORTESTPATHS", "OR", "XOR", "AND", "NOT", "IN", "STARTS", "ENDS",
+	"CONTAINS", "IS", "NULL", "COUNT", "ANY", "NONE", "SINGLE", "TRUE", "FALSE",
+	"EXISTS", "CASE", "ELSE", "END", "WHEN", "THEN", "StringLiteral", "EscapedChar",
+	"HexInteger", "DecimalInteger", "OctalInteger", "HexLetter", "HexDigit",
+	"Digit", "NonZeroDigit", "NonZeroOctDigit", "OctDigit", "ZeroDigit", "ExponentDecimalReal",
+	"RegularDecimalReal", "CONSTRAINT", "DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY",
+	"SCALAR", "OF", "ADD", "DROP", "FILTER", "EXTRACT", "UnescapedSymbolicName",
+	"IdentifierStart", "IdentifierPart", "EscapedSymbolicName", "SP", "WHITESPACE",
+	"Comment",
 }
 
 var lexerRuleNames = []string{
@@ -843,21 +861,21 @@ var lexerRuleNames = []string{
 	"T__17", "T__18", "T__19", "T__20", "T__21", "T__22", "T__23", "T__24",
 	"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "T__32",
 	"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
-	"T__41", "T__42", "T__43", "T__44", "UNION", "ALL", "OPTIONAL", "MATCH",
-	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
-	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
-	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
-	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
-	"FILTER", "EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
-	"EscapedSymbolicName", "SP", "WHITESPACE", "Comment", "FF", "EscapedSymbolicName_0",
-	"RS", "ID_Continue", "Comment_1", "StringLiteral_1", "Comment_3", "Comment_2",
-	"GS", "FS", "CR", "Sc", "SPACE", "Pc", "TAB", "StringLiteral_0", "LF",
-	"VT", "US", "ID_Start",
+	"T__41", "T__42", "T__43", "T__44", "T__45", "UNION", "ALL", "OPTIONAL",
+	"MATCH", "UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE",
+	"REMOVE", "CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "SHORTESTPATH",
+	"ALLSHORTESTPATHS", "OR", "XOR", "AND", "NOT", "IN", "STARTS", "ENDS",
+	"CONTAINS", "IS", "NULL", "COUNT", "ANY", "NONE", "SINGLE", "TRUE", "FALSE",
+	"EXISTS", "CASE", "ELSE", "END", "WHEN", "THEN", "StringLiteral", "EscapedChar",
+	"HexInteger", "DecimalInteger", "OctalInteger", "HexLetter", "HexDigit",
+	"Digit", "NonZeroDigit", "NonZeroOctDigit", "OctDigit", "ZeroDigit", "ExponentDecimalReal",
+	"RegularDecimalReal", "CONSTRAINT", "DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY",
+	"SCALAR", "OF", "ADD", "DROP", "FILTER", "EXTRACT", "UnescapedSymbolicName",
+	"IdentifierStart", "IdentifierPart", "EscapedSymbolicName", "SP", "WHITESPACE",
+	"Comment", "FF", "EscapedSymbolicName_0", "RS", "ID_Continue", "Comment_1",
+	"StringLiteral_1", "Comment_3", "Comment_2", "GS", "FS", "CR", "Sc", "SPACE",
+	"Pc", "TAB", "StringLiteral_0", "LF", "VT", "US", "ID_Start",
 }
 
 type CypherLexer struct {
@@ -940,86 +958,89 @@ const (
 	CypherLexerT__42                 = 43
 	CypherLexerT__43                 = 44
 	CypherLexerT__44                 = 45
-	CypherLexerUNION                 = 46
-	CypherLexerALL                   = 47
-	CypherLexerOPTIONAL              = 48
-	CypherLexerMATCH                 = 49
-	CypherLexerUNWIND                = 50
-	CypherLexerAS                    = 51
-	CypherLexerMERGE                 = 52
-	CypherLexerON                    = 53
-	CypherLexerCREATE                = 54
-	CypherLexerSET                   = 55
-	CypherLexerDETACH                = 56
-	CypherLexerDELETE                = 57
-	CypherLexerREMOVE                = 58
-	CypherLexerCALL                  = 59
-	CypherLexerYIELD                 = 60
-	CypherLexerWITH                  = 61
-	CypherLexerRETURN                = 62
-	CypherLexerDISTINCT              = 63
-	CypherLexerORDER                 = 64
-	CypherLexerBY                    = 65
-	CypherLexerL_SKIP                = 66
-	CypherLexerLIMIT                 = 67
-	CypherLexerASCENDING             = 68
-	CypherLexerASC                   = 69
-	CypherLexerDESCENDING            = 70
-	CypherLexerDESC                  = 71
-	CypherLexerWHERE                 = 72
-	CypherLexerOR                    = 73
-	CypherLexerXOR                   = 74
-	CypherLexerAND                   = 75
-	CypherLexerNOT                   = 76
-	CypherLexerIN                    = 77
-	CypherLexerSTARTS                = 78
-	CypherLexerENDS                  = 79
-	CypherLexerCONTAINS              = 80
-	CypherLexerIS                    = 81
-	CypherLexerNULL                  = 82
-	CypherLexerCOUNT                 = 83
-	CypherLexerANY                   = 84
-	CypherLexerNONE                  = 85
-	CypherLexerSINGLE                = 86
-	CypherLexerTRUE                  = 87
-	CypherLexerFALSE                 = 88
-	CypherLexerEXISTS                = 89
-	CypherLexerCASE                  = 90
-	CypherLexerELSE                  = 91
-	CypherLexerEND                   = 92
-	CypherLexerWHEN                  = 93
-	CypherLexerTHEN                  = 94
-	CypherLexerStringLiteral         = 95
-	CypherLexerEscapedChar           = 96
-	CypherLexerHexInteger            = 97
-	CypherLexerDecimalInteger        = 98
-	CypherLexerOctalInteger          = 99
-	CypherLexerHexLetter             = 100
-	CypherLexerHexDigit              = 101
-	CypherLexerDigit                 = 102
-	CypherLexerNonZeroDigit          = 103
-	CypherLexerNonZeroOctDigit       = 104
-	CypherLexerOctDigit              = 105
-	CypherLexerZeroDigit             = 106
-	CypherLexerExponentDecimalReal   = 107
-	CypherLexerRegularDecimalReal    = 108
-	CypherLexerCONSTRAINT            = 109
-	CypherLexerDO                    = 110
-	CypherLexerFOR                   = 111
-	CypherLexerREQUIRE               = 112
-	CypherLexerUNIQUE                = 113
-	CypherLexerMANDATORY             = 114
-	CypherLexerSCALAR                = 115
-	CypherLexerOF                    = 116
-	CypherLexerADD                   = 117
-	CypherLexerDROP                  = 118
-	CypherLexerFILTER                = 119
-	CypherLexerEXTRACT               = 120
-	CypherLexerUnescapedSymbolicName = 121
-	CypherLexerIdentifierStart       = 122
-	CypherLexerIdentifierPart        = 123
-	CypherLexerEscapedSymbolicName   = 124
-	CypherLexerSP                    = 125
-	CypherLexerWHITESPACE            = 126
-	CypherLexerComment               = 127
+	CypherLexerT__45                 = 46
+	CypherLexerUNION                 = 47
+	CypherLexerALL                   = 48
+	CypherLexerOPTIONAL              = 49
+	CypherLexerMATCH                 = 50
+	CypherLexerUNWIND                = 51
+	CypherLexerAS                    = 52
+	CypherLexerMERGE                 = 53
+	CypherLexerON                    = 54
+	CypherLexerCREATE                = 55
+	CypherLexerSET                   = 56
+	CypherLexerDETACH                = 57
+	CypherLexerDELETE                = 58
+	CypherLexerREMOVE                = 59
+	CypherLexerCALL                  = 60
+	CypherLexerYIELD                 = 61
+	CypherLexerWITH                  = 62
+	CypherLexerRETURN                = 63
+	CypherLexerDISTINCT              = 64
+	CypherLexerORDER                 = 65
+	CypherLexerBY                    = 66
+	CypherLexerL_SKIP                = 67
+	CypherLexerLIMIT                 = 68
+	CypherLexerASCENDING             = 69
+	CypherLexerASC                   = 70
+	CypherLexerDESCENDING            = 71
+	CypherLexerDESC                  = 72
+	CypherLexerWHERE                 = 73
+	CypherLexerSHORTESTPATH          = 74
+	CypherLexerALLSHORTESTPATHS      = 75
+	CypherLexerOR                    = 76
+	CypherLexerXOR                   = 77
+	CypherLexerAND                   = 78
+	CypherLexerNOT                   = 79
+	CypherLexerIN                    = 80
+	CypherLexerSTARTS                = 81
+	CypherLexerENDS                  = 82
+	CypherLexerCONTAINS              = 83
+	CypherLexerIS                    = 84
+	CypherLexerNULL                  = 85
+	CypherLexerCOUNT                 = 86
+	CypherLexerANY                   = 87
+	CypherLexerNONE                  = 88
+	CypherLexerSINGLE                = 89
+	CypherLexerTRUE                  = 90
+	CypherLexerFALSE                 = 91
+	CypherLexerEXISTS                = 92
+	CypherLexerCASE                  = 93
+	CypherLexerELSE                  = 94
+	CypherLexerEND                   = 95
+	CypherLexerWHEN                  = 96
+	CypherLexerTHEN                  = 97
+	CypherLexerStringLiteral         = 98
+	CypherLexerEscapedChar           = 99
+	CypherLexerHexInteger            = 100
+	CypherLexerDecimalInteger        = 101
+	CypherLexerOctalInteger          = 102
+	CypherLexerHexLetter             = 103
+	CypherLexerHexDigit              = 104
+	CypherLexerDigit                 = 105
+	CypherLexerNonZeroDigit          = 106
+	CypherLexerNonZeroOctDigit       = 107
+	CypherLexerOctDigit              = 108
+	CypherLexerZeroDigit             = 109
+	CypherLexerExponentDecimalReal   = 110
+	CypherLexerRegularDecimalReal    = 111
+	CypherLexerCONSTRAINT            = 112
+	CypherLexerDO                    = 113
+	CypherLexerFOR                   = 114
+	CypherLexerREQUIRE               = 115
+	CypherLexerUNIQUE                = 116
+	CypherLexerMANDATORY             = 117
+	CypherLexerSCALAR                = 118
+	CypherLexerOF                    = 119
+	CypherLexerADD                   = 120
+	CypherLexerDROP                  = 121
+	CypherLexerFILTER                = 122
+	CypherLexerEXTRACT               = 123
+	CypherLexerUnescapedSymbolicName = 124
+	CypherLexerIdentifierStart       = 125
+	CypherLexerIdentifierPart        = 126
+	CypherLexerEscapedSymbolicName   = 127
+	CypherLexerSP                    = 128
+	CypherLexerWHITESPACE            = 129
+	CypherLexerComment               = 130
 )
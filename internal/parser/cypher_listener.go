@@ -119,6 +119,9 @@ type CypherListener interface {
 	// EnterOC_AnonymousPatternPart is called when entering the oC_AnonymousPatternPart production.
 	EnterOC_AnonymousPatternPart(c *OC_AnonymousPatternPartContext)
 
+	// EnterOC_ShortestPathPattern is called when entering the oC_ShortestPathPattern production.
+	EnterOC_ShortestPathPattern(c *OC_ShortestPathPatternContext)
+
 	// EnterOC_PatternElement is called when entering the oC_PatternElement production.
 	EnterOC_PatternElement(c *OC_PatternElementContext)
 
@@ -416,6 +419,9 @@ type CypherListener interface {
 	// ExitOC_AnonymousPatternPart is called when exiting the oC_AnonymousPatternPart production.
 	ExitOC_AnonymousPatternPart(c *OC_AnonymousPatternPartContext)
 
+	// ExitOC_ShortestPathPattern is called when exiting the oC_ShortestPathPattern production.
+	ExitOC_ShortestPathPattern(c *OC_ShortestPathPatternContext)
+
 	// ExitOC_PatternElement is called when exiting the oC_PatternElement production.
 	ExitOC_PatternElement(c *OC_PatternElementContext)
 
@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 129, 1543,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 132, 1582,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -35,761 +35,783 @@ var parserATN = []uint16{
 	81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9, 84, 4, 85, 9, 85, 4, 86, 9, 86,
 	4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89, 4, 90, 9, 90, 4, 91, 9, 91, 4,
 	92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96, 4, 97,
-	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 3, 2, 5, 2, 202, 10,
-	2, 3, 2, 3, 2, 5, 2, 206, 10, 2, 3, 2, 5, 2, 209, 10, 2, 3, 2, 5, 2, 212,
-	10, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 5, 4, 220, 10, 4, 3, 5, 3, 5,
-	5, 5, 224, 10, 5, 3, 5, 7, 5, 227, 10, 5, 12, 5, 14, 5, 230, 11, 5, 3,
-	6, 3, 6, 3, 6, 3, 6, 5, 6, 236, 10, 6, 3, 6, 3, 6, 3, 6, 5, 6, 241, 10,
-	6, 3, 6, 5, 6, 244, 10, 6, 3, 7, 3, 7, 5, 7, 248, 10, 7, 3, 8, 3, 8, 5,
-	8, 252, 10, 8, 7, 8, 254, 10, 8, 12, 8, 14, 8, 257, 11, 8, 3, 8, 3, 8,
-	3, 8, 5, 8, 262, 10, 8, 7, 8, 264, 10, 8, 12, 8, 14, 8, 267, 11, 8, 3,
-	8, 3, 8, 5, 8, 271, 10, 8, 3, 8, 7, 8, 274, 10, 8, 12, 8, 14, 8, 277, 11,
-	8, 3, 8, 5, 8, 280, 10, 8, 3, 8, 5, 8, 283, 10, 8, 5, 8, 285, 10, 8, 3,
-	9, 3, 9, 5, 9, 289, 10, 9, 7, 9, 291, 10, 9, 12, 9, 14, 9, 294, 11, 9,
-	3, 9, 3, 9, 5, 9, 298, 10, 9, 7, 9, 300, 10, 9, 12, 9, 14, 9, 303, 11,
-	9, 3, 9, 3, 9, 5, 9, 307, 10, 9, 6, 9, 309, 10, 9, 13, 9, 14, 9, 310, 3,
-	9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 320, 10, 10, 3, 11,
-	3, 11, 3, 11, 5, 11, 325, 10, 11, 3, 12, 3, 12, 5, 12, 329, 10, 12, 3,
-	12, 3, 12, 5, 12, 333, 10, 12, 3, 12, 3, 12, 5, 12, 337, 10, 12, 3, 12,
-	5, 12, 340, 10, 12, 3, 13, 3, 13, 5, 13, 344, 10, 13, 3, 13, 3, 13, 3,
-	13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 5, 14, 354, 10, 14, 3, 14, 3, 14,
-	3, 14, 7, 14, 359, 10, 14, 12, 14, 14, 14, 362, 11, 14, 3, 15, 3, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 374, 10, 15,
-	3, 16, 3, 16, 5, 16, 378, 10, 16, 3, 16, 3, 16, 3, 17, 3, 17, 5, 17, 384,
-	10, 17, 3, 17, 3, 17, 3, 17, 7, 17, 389, 10, 17, 12, 17, 14, 17, 392, 11,
-	17, 3, 18, 3, 18, 5, 18, 396, 10, 18, 3, 18, 3, 18, 5, 18, 400, 10, 18,
-	3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 406, 10, 18, 3, 18, 3, 18, 5, 18, 410,
-	10, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 416, 10, 18, 3, 18, 3, 18, 5,
-	18, 420, 10, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 426, 10, 18, 3, 18,
-	3, 18, 5, 18, 430, 10, 18, 3, 19, 3, 19, 5, 19, 434, 10, 19, 3, 19, 3,
-	19, 5, 19, 438, 10, 19, 3, 19, 3, 19, 5, 19, 442, 10, 19, 3, 19, 3, 19,
-	5, 19, 446, 10, 19, 3, 19, 7, 19, 449, 10, 19, 12, 19, 14, 19, 452, 11,
-	19, 3, 20, 3, 20, 3, 20, 3, 20, 5, 20, 458, 10, 20, 3, 20, 3, 20, 5, 20,
-	462, 10, 20, 3, 20, 7, 20, 465, 10, 20, 12, 20, 14, 20, 468, 11, 20, 3,
-	21, 3, 21, 3, 21, 3, 21, 5, 21, 474, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22,
-	5, 22, 480, 10, 22, 3, 22, 3, 22, 3, 22, 5, 22, 485, 10, 22, 3, 23, 3,
-	23, 3, 23, 3, 23, 5, 23, 491, 10, 23, 3, 23, 3, 23, 3, 23, 3, 23, 5, 23,
-	497, 10, 23, 3, 24, 3, 24, 3, 24, 5, 24, 502, 10, 24, 3, 24, 3, 24, 5,
-	24, 506, 10, 24, 3, 24, 7, 24, 509, 10, 24, 12, 24, 14, 24, 512, 11, 24,
-	5, 24, 514, 10, 24, 3, 24, 5, 24, 517, 10, 24, 3, 24, 5, 24, 520, 10, 24,
-	3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 527, 10, 25, 3, 25, 3, 25, 3,
-	26, 3, 26, 3, 26, 5, 26, 534, 10, 26, 3, 26, 5, 26, 537, 10, 26, 3, 27,
-	3, 27, 3, 27, 3, 28, 5, 28, 543, 10, 28, 3, 28, 5, 28, 546, 10, 28, 3,
-	28, 3, 28, 3, 28, 3, 28, 5, 28, 552, 10, 28, 3, 28, 3, 28, 5, 28, 556,
-	10, 28, 3, 28, 3, 28, 5, 28, 560, 10, 28, 3, 29, 3, 29, 5, 29, 564, 10,
-	29, 3, 29, 3, 29, 5, 29, 568, 10, 29, 3, 29, 7, 29, 571, 10, 29, 12, 29,
-	14, 29, 574, 11, 29, 3, 29, 3, 29, 5, 29, 578, 10, 29, 3, 29, 3, 29, 5,
-	29, 582, 10, 29, 3, 29, 7, 29, 585, 10, 29, 12, 29, 14, 29, 588, 11, 29,
-	5, 29, 590, 10, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 5,
-	30, 599, 10, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 5, 31,
-	608, 10, 31, 3, 31, 7, 31, 611, 10, 31, 12, 31, 14, 31, 614, 11, 31, 3,
-	32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 5, 34,
-	626, 10, 34, 3, 34, 5, 34, 629, 10, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3,
-	36, 3, 36, 5, 36, 637, 10, 36, 3, 36, 3, 36, 5, 36, 641, 10, 36, 3, 36,
-	7, 36, 644, 10, 36, 12, 36, 14, 36, 647, 11, 36, 3, 37, 3, 37, 5, 37, 651,
-	10, 37, 3, 37, 3, 37, 5, 37, 655, 10, 37, 3, 37, 3, 37, 3, 37, 5, 37, 660,
-	10, 37, 3, 38, 3, 38, 3, 39, 3, 39, 5, 39, 666, 10, 39, 3, 39, 7, 39, 669,
-	10, 39, 12, 39, 14, 39, 672, 11, 39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39,
-	678, 10, 39, 3, 40, 3, 40, 5, 40, 682, 10, 40, 3, 40, 3, 40, 5, 40, 686,
-	10, 40, 5, 40, 688, 10, 40, 3, 40, 3, 40, 5, 40, 692, 10, 40, 5, 40, 694,
-	10, 40, 3, 40, 3, 40, 5, 40, 698, 10, 40, 5, 40, 700, 10, 40, 3, 40, 3,
-	40, 3, 41, 3, 41, 5, 41, 706, 10, 41, 3, 41, 3, 41, 3, 42, 3, 42, 5, 42,
-	712, 10, 42, 3, 42, 3, 42, 5, 42, 716, 10, 42, 3, 42, 5, 42, 719, 10, 42,
-	3, 42, 5, 42, 722, 10, 42, 3, 42, 3, 42, 5, 42, 726, 10, 42, 3, 42, 3,
-	42, 3, 42, 3, 42, 5, 42, 732, 10, 42, 3, 42, 3, 42, 5, 42, 736, 10, 42,
-	3, 42, 5, 42, 739, 10, 42, 3, 42, 5, 42, 742, 10, 42, 3, 42, 3, 42, 3,
-	42, 3, 42, 5, 42, 748, 10, 42, 3, 42, 5, 42, 751, 10, 42, 3, 42, 5, 42,
-	754, 10, 42, 3, 42, 3, 42, 5, 42, 758, 10, 42, 3, 42, 3, 42, 3, 42, 3,
-	42, 5, 42, 764, 10, 42, 3, 42, 5, 42, 767, 10, 42, 3, 42, 5, 42, 770, 10,
-	42, 3, 42, 3, 42, 5, 42, 774, 10, 42, 3, 43, 3, 43, 5, 43, 778, 10, 43,
-	3, 43, 3, 43, 5, 43, 782, 10, 43, 5, 43, 784, 10, 43, 3, 43, 3, 43, 5,
-	43, 788, 10, 43, 5, 43, 790, 10, 43, 3, 43, 5, 43, 793, 10, 43, 3, 43,
-	3, 43, 5, 43, 797, 10, 43, 5, 43, 799, 10, 43, 3, 43, 3, 43, 3, 44, 3,
-	44, 5, 44, 805, 10, 44, 3, 45, 3, 45, 5, 45, 809, 10, 45, 3, 45, 3, 45,
-	5, 45, 813, 10, 45, 3, 45, 3, 45, 5, 45, 817, 10, 45, 3, 45, 5, 45, 820,
-	10, 45, 3, 45, 7, 45, 823, 10, 45, 12, 45, 14, 45, 826, 11, 45, 3, 46,
-	3, 46, 5, 46, 830, 10, 46, 3, 46, 7, 46, 833, 10, 46, 12, 46, 14, 46, 836,
-	11, 46, 3, 47, 3, 47, 5, 47, 840, 10, 47, 3, 47, 3, 47, 3, 48, 3, 48, 5,
-	48, 846, 10, 48, 3, 48, 3, 48, 5, 48, 850, 10, 48, 5, 48, 852, 10, 48,
-	3, 48, 3, 48, 5, 48, 856, 10, 48, 3, 48, 3, 48, 5, 48, 860, 10, 48, 5,
-	48, 862, 10, 48, 5, 48, 864, 10, 48, 3, 49, 3, 49, 3, 50, 3, 50, 3, 51,
-	3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 7, 52, 877, 10, 52, 12, 52, 14,
-	52, 880, 11, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 7, 53, 887, 10, 53,
-	12, 53, 14, 53, 890, 11, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 7, 54,
-	897, 10, 54, 12, 54, 14, 54, 900, 11, 54, 3, 55, 3, 55, 5, 55, 904, 10,
-	55, 7, 55, 906, 10, 55, 12, 55, 14, 55, 909, 11, 55, 3, 55, 3, 55, 3, 56,
-	3, 56, 5, 56, 915, 10, 56, 3, 56, 7, 56, 918, 10, 56, 12, 56, 14, 56, 921,
-	11, 56, 3, 57, 3, 57, 5, 57, 925, 10, 57, 3, 57, 3, 57, 5, 57, 929, 10,
-	57, 3, 57, 3, 57, 5, 57, 933, 10, 57, 3, 57, 3, 57, 5, 57, 937, 10, 57,
-	3, 57, 7, 57, 940, 10, 57, 12, 57, 14, 57, 943, 11, 57, 3, 58, 3, 58, 5,
-	58, 947, 10, 58, 3, 58, 3, 58, 5, 58, 951, 10, 58, 3, 58, 3, 58, 5, 58,
-	955, 10, 58, 3, 58, 3, 58, 5, 58, 959, 10, 58, 3, 58, 3, 58, 5, 58, 963,
-	10, 58, 3, 58, 3, 58, 5, 58, 967, 10, 58, 3, 58, 7, 58, 970, 10, 58, 12,
-	58, 14, 58, 973, 11, 58, 3, 59, 3, 59, 5, 59, 977, 10, 59, 3, 59, 3, 59,
-	5, 59, 981, 10, 59, 3, 59, 7, 59, 984, 10, 59, 12, 59, 14, 59, 987, 11,
-	59, 3, 60, 3, 60, 5, 60, 991, 10, 60, 7, 60, 993, 10, 60, 12, 60, 14, 60,
-	996, 11, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 7, 61, 1004, 10,
-	61, 12, 61, 14, 61, 1007, 11, 61, 3, 62, 3, 62, 3, 62, 5, 62, 1012, 10,
-	62, 3, 62, 3, 62, 5, 62, 1016, 10, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62,
-	5, 62, 1023, 10, 62, 3, 62, 3, 62, 5, 62, 1027, 10, 62, 3, 62, 3, 62, 5,
-	62, 1031, 10, 62, 3, 62, 5, 62, 1034, 10, 62, 3, 63, 3, 63, 3, 63, 3, 63,
-	3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 5, 63, 1046, 10, 63, 3, 63, 5,
-	63, 1049, 10, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 5, 64, 1063, 10, 64, 3, 65, 3, 65, 5, 65, 1067,
-	10, 65, 3, 65, 7, 65, 1070, 10, 65, 12, 65, 14, 65, 1073, 11, 65, 3, 65,
-	5, 65, 1076, 10, 65, 3, 65, 5, 65, 1079, 10, 65, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 5, 66, 1086, 10, 66, 3, 66, 3, 66, 5, 66, 1090, 10, 66, 3, 66,
-	3, 66, 5, 66, 1094, 10, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1101,
-	10, 66, 3, 66, 3, 66, 5, 66, 1105, 10, 66, 3, 66, 3, 66, 5, 66, 1109, 10,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1115, 10, 66, 3, 66, 3, 66, 5, 66,
-	1119, 10, 66, 3, 66, 3, 66, 5, 66, 1123, 10, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 5, 66, 1129, 10, 66, 3, 66, 3, 66, 5, 66, 1133, 10, 66, 3, 66, 3, 66,
-	5, 66, 1137, 10, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1143, 10, 66, 3,
-	66, 3, 66, 5, 66, 1147, 10, 66, 3, 66, 3, 66, 5, 66, 1151, 10, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1159, 10, 66, 3, 67, 3, 67, 3,
-	67, 3, 67, 3, 67, 3, 67, 5, 67, 1167, 10, 67, 3, 68, 3, 68, 3, 69, 3, 69,
-	5, 69, 1173, 10, 69, 3, 69, 3, 69, 5, 69, 1177, 10, 69, 3, 69, 3, 69, 5,
-	69, 1181, 10, 69, 3, 69, 3, 69, 5, 69, 1185, 10, 69, 7, 69, 1187, 10, 69,
-	12, 69, 14, 69, 1190, 11, 69, 5, 69, 1192, 10, 69, 3, 69, 3, 69, 3, 70,
-	3, 70, 5, 70, 1198, 10, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1203, 10, 70, 3,
-	70, 3, 70, 3, 70, 5, 70, 1208, 10, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1213,
-	10, 70, 3, 70, 3, 70, 3, 70, 5, 70, 1218, 10, 70, 3, 70, 3, 70, 3, 70,
-	5, 70, 1223, 10, 70, 3, 70, 5, 70, 1226, 10, 70, 3, 71, 3, 71, 5, 71, 1230,
-	10, 71, 3, 71, 3, 71, 5, 71, 1234, 10, 71, 3, 71, 3, 71, 3, 72, 3, 72,
-	5, 72, 1240, 10, 72, 3, 72, 6, 72, 1243, 10, 72, 13, 72, 14, 72, 1244,
-	3, 73, 3, 73, 5, 73, 1249, 10, 73, 3, 73, 5, 73, 1252, 10, 73, 3, 74, 3,
-	74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 5, 75, 1262, 10, 75, 3, 75,
-	3, 75, 5, 75, 1266, 10, 75, 3, 75, 3, 75, 5, 75, 1270, 10, 75, 5, 75, 1272,
-	10, 75, 3, 75, 3, 75, 5, 75, 1276, 10, 75, 3, 75, 3, 75, 5, 75, 1280, 10,
-	75, 3, 75, 3, 75, 5, 75, 1284, 10, 75, 7, 75, 1286, 10, 75, 12, 75, 14,
-	75, 1289, 11, 75, 5, 75, 1291, 10, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76,
-	3, 76, 5, 76, 1299, 10, 76, 3, 77, 3, 77, 5, 77, 1303, 10, 77, 3, 77, 3,
-	77, 5, 77, 1307, 10, 77, 3, 77, 3, 77, 5, 77, 1311, 10, 77, 3, 77, 3, 77,
-	5, 77, 1315, 10, 77, 3, 77, 3, 77, 5, 77, 1319, 10, 77, 7, 77, 1321, 10,
-	77, 12, 77, 14, 77, 1324, 11, 77, 5, 77, 1326, 10, 77, 3, 77, 3, 77, 3,
-	78, 3, 78, 3, 79, 3, 79, 3, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 7, 81,
-	1340, 10, 81, 12, 81, 14, 81, 1343, 11, 81, 3, 82, 3, 82, 5, 82, 1347,
-	10, 82, 3, 82, 3, 82, 5, 82, 1351, 10, 82, 3, 82, 3, 82, 5, 82, 1355, 10,
-	82, 3, 82, 5, 82, 1358, 10, 82, 3, 82, 5, 82, 1361, 10, 82, 3, 82, 3, 82,
-	3, 83, 3, 83, 5, 83, 1367, 10, 83, 3, 83, 3, 83, 5, 83, 1371, 10, 83, 3,
-	83, 3, 83, 5, 83, 1375, 10, 83, 5, 83, 1377, 10, 83, 3, 83, 3, 83, 5, 83,
-	1381, 10, 83, 3, 83, 3, 83, 5, 83, 1385, 10, 83, 3, 83, 3, 83, 5, 83, 1389,
-	10, 83, 5, 83, 1391, 10, 83, 3, 83, 3, 83, 5, 83, 1395, 10, 83, 3, 83,
-	3, 83, 5, 83, 1399, 10, 83, 3, 83, 3, 83, 3, 84, 3, 84, 5, 84, 1405, 10,
-	84, 3, 84, 3, 84, 3, 85, 3, 85, 5, 85, 1411, 10, 85, 3, 85, 6, 85, 1414,
-	10, 85, 13, 85, 14, 85, 1415, 3, 85, 3, 85, 5, 85, 1420, 10, 85, 3, 85,
-	3, 85, 5, 85, 1424, 10, 85, 3, 85, 6, 85, 1427, 10, 85, 13, 85, 14, 85,
-	1428, 5, 85, 1431, 10, 85, 3, 85, 5, 85, 1434, 10, 85, 3, 85, 3, 85, 5,
-	85, 1438, 10, 85, 3, 85, 5, 85, 1441, 10, 85, 3, 85, 5, 85, 1444, 10, 85,
-	3, 85, 3, 85, 3, 86, 3, 86, 5, 86, 1450, 10, 86, 3, 86, 3, 86, 5, 86, 1454,
-	10, 86, 3, 86, 3, 86, 5, 86, 1458, 10, 86, 3, 86, 3, 86, 3, 87, 3, 87,
-	3, 88, 3, 88, 5, 88, 1466, 10, 88, 3, 89, 3, 89, 5, 89, 1470, 10, 89, 3,
-	89, 3, 89, 5, 89, 1474, 10, 89, 3, 89, 3, 89, 5, 89, 1478, 10, 89, 3, 89,
-	3, 89, 5, 89, 1482, 10, 89, 3, 89, 3, 89, 5, 89, 1486, 10, 89, 3, 89, 3,
-	89, 5, 89, 1490, 10, 89, 3, 89, 3, 89, 5, 89, 1494, 10, 89, 3, 89, 3, 89,
-	5, 89, 1498, 10, 89, 7, 89, 1500, 10, 89, 12, 89, 14, 89, 1503, 11, 89,
-	5, 89, 1505, 10, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 5, 90, 1512, 10,
-	90, 3, 91, 3, 91, 5, 91, 1516, 10, 91, 3, 91, 6, 91, 1519, 10, 91, 13,
-	91, 14, 91, 1520, 3, 92, 3, 92, 3, 93, 3, 93, 3, 94, 3, 94, 3, 95, 3, 95,
-	5, 95, 1531, 10, 95, 3, 96, 3, 96, 3, 97, 3, 97, 3, 98, 3, 98, 3, 99, 3,
-	99, 3, 100, 3, 100, 3, 100, 2, 2, 101, 2, 4, 6, 8, 10, 12, 14, 16, 18,
-	20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54,
-	56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90,
-	92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120,
-	122, 124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150,
-	152, 154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180,
-	182, 184, 186, 188, 190, 192, 194, 196, 198, 2, 12, 3, 2, 70, 73, 3, 2,
-	15, 16, 3, 2, 89, 90, 3, 2, 99, 101, 3, 2, 109, 110, 6, 2, 48, 60, 63,
-	84, 89, 96, 111, 120, 6, 2, 85, 88, 102, 102, 121, 123, 126, 126, 4, 2,
-	21, 21, 29, 32, 4, 2, 22, 22, 33, 36, 4, 2, 16, 16, 37, 47, 2, 1758, 2,
-	201, 3, 2, 2, 2, 4, 215, 3, 2, 2, 2, 6, 219, 3, 2, 2, 2, 8, 221, 3, 2,
-	2, 2, 10, 243, 3, 2, 2, 2, 12, 247, 3, 2, 2, 2, 14, 284, 3, 2, 2, 2, 16,
-	308, 3, 2, 2, 2, 18, 319, 3, 2, 2, 2, 20, 324, 3, 2, 2, 2, 22, 328, 3,
-	2, 2, 2, 24, 341, 3, 2, 2, 2, 26, 351, 3, 2, 2, 2, 28, 373, 3, 2, 2, 2,
-	30, 375, 3, 2, 2, 2, 32, 381, 3, 2, 2, 2, 34, 429, 3, 2, 2, 2, 36, 433,
-	3, 2, 2, 2, 38, 453, 3, 2, 2, 2, 40, 473, 3, 2, 2, 2, 42, 475, 3, 2, 2,
-	2, 44, 486, 3, 2, 2, 2, 46, 513, 3, 2, 2, 2, 48, 526, 3, 2, 2, 2, 50, 530,
-	3, 2, 2, 2, 52, 538, 3, 2, 2, 2, 54, 545, 3, 2, 2, 2, 56, 589, 3, 2, 2,
-	2, 58, 598, 3, 2, 2, 2, 60, 600, 3, 2, 2, 2, 62, 615, 3, 2, 2, 2, 64, 619,
-	3, 2, 2, 2, 66, 623, 3, 2, 2, 2, 68, 630, 3, 2, 2, 2, 70, 634, 3, 2, 2,
-	2, 72, 659, 3, 2, 2, 2, 74, 661, 3, 2, 2, 2, 76, 677, 3, 2, 2, 2, 78, 679,
-	3, 2, 2, 2, 80, 703, 3, 2, 2, 2, 82, 773, 3, 2, 2, 2, 84, 775, 3, 2, 2,
-	2, 86, 804, 3, 2, 2, 2, 88, 806, 3, 2, 2, 2, 90, 827, 3, 2, 2, 2, 92, 837,
-	3, 2, 2, 2, 94, 843, 3, 2, 2, 2, 96, 865, 3, 2, 2, 2, 98, 867, 3, 2, 2,
-	2, 100, 869, 3, 2, 2, 2, 102, 871, 3, 2, 2, 2, 104, 881, 3, 2, 2, 2, 106,
-	891, 3, 2, 2, 2, 108, 907, 3, 2, 2, 2, 110, 912, 3, 2, 2, 2, 112, 922,
-	3, 2, 2, 2, 114, 944, 3, 2, 2, 2, 116, 974, 3, 2, 2, 2, 118, 994, 3, 2,
-	2, 2, 120, 999, 3, 2, 2, 2, 122, 1033, 3, 2, 2, 2, 124, 1045, 3, 2, 2,
-	2, 126, 1062, 3, 2, 2, 2, 128, 1064, 3, 2, 2, 2, 130, 1158, 3, 2, 2, 2,
-	132, 1166, 3, 2, 2, 2, 134, 1168, 3, 2, 2, 2, 136, 1170, 3, 2, 2, 2, 138,
-	1225, 3, 2, 2, 2, 140, 1227, 3, 2, 2, 2, 142, 1237, 3, 2, 2, 2, 144, 1246,
-	3, 2, 2, 2, 146, 1253, 3, 2, 2, 2, 148, 1259, 3, 2, 2, 2, 150, 1298, 3,
-	2, 2, 2, 152, 1300, 3, 2, 2, 2, 154, 1329, 3, 2, 2, 2, 156, 1331, 3, 2,
-	2, 2, 158, 1333, 3, 2, 2, 2, 160, 1341, 3, 2, 2, 2, 162, 1344, 3, 2, 2,
-	2, 164, 1364, 3, 2, 2, 2, 166, 1402, 3, 2, 2, 2, 168, 1430, 3, 2, 2, 2,
-	170, 1447, 3, 2, 2, 2, 172, 1461, 3, 2, 2, 2, 174, 1465, 3, 2, 2, 2, 176,
-	1467, 3, 2, 2, 2, 178, 1508, 3, 2, 2, 2, 180, 1513, 3, 2, 2, 2, 182, 1522,
-	3, 2, 2, 2, 184, 1524, 3, 2, 2, 2, 186, 1526, 3, 2, 2, 2, 188, 1530, 3,
-	2, 2, 2, 190, 1532, 3, 2, 2, 2, 192, 1534, 3, 2, 2, 2, 194, 1536, 3, 2,
-	2, 2, 196, 1538, 3, 2, 2, 2, 198, 1540, 3, 2, 2, 2, 200, 202, 7, 127, 2,
-	2, 201, 200, 3, 2, 2, 2, 201, 202, 3, 2, 2, 2, 202, 203, 3, 2, 2, 2, 203,
-	208, 5, 4, 3, 2, 204, 206, 7, 127, 2, 2, 205, 204, 3, 2, 2, 2, 205, 206,
-	3, 2, 2, 2, 206, 207, 3, 2, 2, 2, 207, 209, 7, 3, 2, 2, 208, 205, 3, 2,
-	2, 2, 208, 209, 3, 2, 2, 2, 209, 211, 3, 2, 2, 2, 210, 212, 7, 127, 2,
-	2, 211, 210, 3, 2, 2, 2, 211, 212, 3, 2, 2, 2, 212, 213, 3, 2, 2, 2, 213,
-	214, 7, 2, 2, 3, 214, 3, 3, 2, 2, 2, 215, 216, 5, 6, 4, 2, 216, 5, 3, 2,
-	2, 2, 217, 220, 5, 8, 5, 2, 218, 220, 5, 44, 23, 2, 219, 217, 3, 2, 2,
-	2, 219, 218, 3, 2, 2, 2, 220, 7, 3, 2, 2, 2, 221, 228, 5, 12, 7, 2, 222,
-	224, 7, 127, 2, 2, 223, 222, 3, 2, 2, 2, 223, 224, 3, 2, 2, 2, 224, 225,
-	3, 2, 2, 2, 225, 227, 5, 10, 6, 2, 226, 223, 3, 2, 2, 2, 227, 230, 3, 2,
-	2, 2, 228, 226, 3, 2, 2, 2, 228, 229, 3, 2, 2, 2, 229, 9, 3, 2, 2, 2, 230,
-	228, 3, 2, 2, 2, 231, 232, 7, 48, 2, 2, 232, 233, 7, 127, 2, 2, 233, 235,
-	7, 49, 2, 2, 234, 236, 7, 127, 2, 2, 235, 234, 3, 2, 2, 2, 235, 236, 3,
-	2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 244, 5, 12, 7, 2, 238, 240, 7, 48,
-	2, 2, 239, 241, 7, 127, 2, 2, 240, 239, 3, 2, 2, 2, 240, 241, 3, 2, 2,
-	2, 241, 242, 3, 2, 2, 2, 242, 244, 5, 12, 7, 2, 243, 231, 3, 2, 2, 2, 243,
-	238, 3, 2, 2, 2, 244, 11, 3, 2, 2, 2, 245, 248, 5, 14, 8, 2, 246, 248,
-	5, 16, 9, 2, 247, 245, 3, 2, 2, 2, 247, 246, 3, 2, 2, 2, 248, 13, 3, 2,
-	2, 2, 249, 251, 5, 20, 11, 2, 250, 252, 7, 127, 2, 2, 251, 250, 3, 2, 2,
-	2, 251, 252, 3, 2, 2, 2, 252, 254, 3, 2, 2, 2, 253, 249, 3, 2, 2, 2, 254,
-	257, 3, 2, 2, 2, 255, 253, 3, 2, 2, 2, 255, 256, 3, 2, 2, 2, 256, 258,
-	3, 2, 2, 2, 257, 255, 3, 2, 2, 2, 258, 285, 5, 52, 27, 2, 259, 261, 5,
-	20, 11, 2, 260, 262, 7, 127, 2, 2, 261, 260, 3, 2, 2, 2, 261, 262, 3, 2,
-	2, 2, 262, 264, 3, 2, 2, 2, 263, 259, 3, 2, 2, 2, 264, 267, 3, 2, 2, 2,
-	265, 263, 3, 2, 2, 2, 265, 266, 3, 2, 2, 2, 266, 268, 3, 2, 2, 2, 267,
-	265, 3, 2, 2, 2, 268, 275, 5, 18, 10, 2, 269, 271, 7, 127, 2, 2, 270, 269,
-	3, 2, 2, 2, 270, 271, 3, 2, 2, 2, 271, 272, 3, 2, 2, 2, 272, 274, 5, 18,
-	10, 2, 273, 270, 3, 2, 2, 2, 274, 277, 3, 2, 2, 2, 275, 273, 3, 2, 2, 2,
-	275, 276, 3, 2, 2, 2, 276, 282, 3, 2, 2, 2, 277, 275, 3, 2, 2, 2, 278,
-	280, 7, 127, 2, 2, 279, 278, 3, 2, 2, 2, 279, 280, 3, 2, 2, 2, 280, 281,
-	3, 2, 2, 2, 281, 283, 5, 52, 27, 2, 282, 279, 3, 2, 2, 2, 282, 283, 3,
-	2, 2, 2, 283, 285, 3, 2, 2, 2, 284, 255, 3, 2, 2, 2, 284, 265, 3, 2, 2,
-	2, 285, 15, 3, 2, 2, 2, 286, 288, 5, 20, 11, 2, 287, 289, 7, 127, 2, 2,
-	288, 287, 3, 2, 2, 2, 288, 289, 3, 2, 2, 2, 289, 291, 3, 2, 2, 2, 290,
-	286, 3, 2, 2, 2, 291, 294, 3, 2, 2, 2, 292, 290, 3, 2, 2, 2, 292, 293,
-	3, 2, 2, 2, 293, 301, 3, 2, 2, 2, 294, 292, 3, 2, 2, 2, 295, 297, 5, 18,
-	10, 2, 296, 298, 7, 127, 2, 2, 297, 296, 3, 2, 2, 2, 297, 298, 3, 2, 2,
-	2, 298, 300, 3, 2, 2, 2, 299, 295, 3, 2, 2, 2, 300, 303, 3, 2, 2, 2, 301,
-	299, 3, 2, 2, 2, 301, 302, 3, 2, 2, 2, 302, 304, 3, 2, 2, 2, 303, 301,
-	3, 2, 2, 2, 304, 306, 5, 50, 26, 2, 305, 307, 7, 127, 2, 2, 306, 305, 3,
-	2, 2, 2, 306, 307, 3, 2, 2, 2, 307, 309, 3, 2, 2, 2, 308, 292, 3, 2, 2,
-	2, 309, 310, 3, 2, 2, 2, 310, 308, 3, 2, 2, 2, 310, 311, 3, 2, 2, 2, 311,
-	312, 3, 2, 2, 2, 312, 313, 5, 14, 8, 2, 313, 17, 3, 2, 2, 2, 314, 320,
-	5, 30, 16, 2, 315, 320, 5, 26, 14, 2, 316, 320, 5, 36, 19, 2, 317, 320,
-	5, 32, 17, 2, 318, 320, 5, 38, 20, 2, 319, 314, 3, 2, 2, 2, 319, 315, 3,
-	2, 2, 2, 319, 316, 3, 2, 2, 2, 319, 317, 3, 2, 2, 2, 319, 318, 3, 2, 2,
-	2, 320, 19, 3, 2, 2, 2, 321, 325, 5, 22, 12, 2, 322, 325, 5, 24, 13, 2,
-	323, 325, 5, 42, 22, 2, 324, 321, 3, 2, 2, 2, 324, 322, 3, 2, 2, 2, 324,
-	323, 3, 2, 2, 2, 325, 21, 3, 2, 2, 2, 326, 327, 7, 50, 2, 2, 327, 329,
-	7, 127, 2, 2, 328, 326, 3, 2, 2, 2, 328, 329, 3, 2, 2, 2, 329, 330, 3,
-	2, 2, 2, 330, 332, 7, 51, 2, 2, 331, 333, 7, 127, 2, 2, 332, 331, 3, 2,
-	2, 2, 332, 333, 3, 2, 2, 2, 333, 334, 3, 2, 2, 2, 334, 339, 5, 70, 36,
-	2, 335, 337, 7, 127, 2, 2, 336, 335, 3, 2, 2, 2, 336, 337, 3, 2, 2, 2,
-	337, 338, 3, 2, 2, 2, 338, 340, 5, 68, 35, 2, 339, 336, 3, 2, 2, 2, 339,
-	340, 3, 2, 2, 2, 340, 23, 3, 2, 2, 2, 341, 343, 7, 52, 2, 2, 342, 344,
-	7, 127, 2, 2, 343, 342, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2, 344, 345, 3,
-	2, 2, 2, 345, 346, 5, 100, 51, 2, 346, 347, 7, 127, 2, 2, 347, 348, 7,
-	53, 2, 2, 348, 349, 7, 127, 2, 2, 349, 350, 5, 172, 87, 2, 350, 25, 3,
-	2, 2, 2, 351, 353, 7, 54, 2, 2, 352, 354, 7, 127, 2, 2, 353, 352, 3, 2,
-	2, 2, 353, 354, 3, 2, 2, 2, 354, 355, 3, 2, 2, 2, 355, 360, 5, 72, 37,
-	2, 356, 357, 7, 127, 2, 2, 357, 359, 5, 28, 15, 2, 358, 356, 3, 2, 2, 2,
-	359, 362, 3, 2, 2, 2, 360, 358, 3, 2, 2, 2, 360, 361, 3, 2, 2, 2, 361,
-	27, 3, 2, 2, 2, 362, 360, 3, 2, 2, 2, 363, 364, 7, 55, 2, 2, 364, 365,
-	7, 127, 2, 2, 365, 366, 7, 51, 2, 2, 366, 367, 7, 127, 2, 2, 367, 374,
-	5, 32, 17, 2, 368, 369, 7, 55, 2, 2, 369, 370, 7, 127, 2, 2, 370, 371,
-	7, 56, 2, 2, 371, 372, 7, 127, 2, 2, 372, 374, 5, 32, 17, 2, 373, 363,
-	3, 2, 2, 2, 373, 368, 3, 2, 2, 2, 374, 29, 3, 2, 2, 2, 375, 377, 7, 56,
-	2, 2, 376, 378, 7, 127, 2, 2, 377, 376, 3, 2, 2, 2, 377, 378, 3, 2, 2,
-	2, 378, 379, 3, 2, 2, 2, 379, 380, 5, 70, 36, 2, 380, 31, 3, 2, 2, 2, 381,
-	383, 7, 57, 2, 2, 382, 384, 7, 127, 2, 2, 383, 382, 3, 2, 2, 2, 383, 384,
-	3, 2, 2, 2, 384, 385, 3, 2, 2, 2, 385, 390, 5, 34, 18, 2, 386, 387, 7,
-	4, 2, 2, 387, 389, 5, 34, 18, 2, 388, 386, 3, 2, 2, 2, 389, 392, 3, 2,
-	2, 2, 390, 388, 3, 2, 2, 2, 390, 391, 3, 2, 2, 2, 391, 33, 3, 2, 2, 2,
-	392, 390, 3, 2, 2, 2, 393, 395, 5, 180, 91, 2, 394, 396, 7, 127, 2, 2,
-	395, 394, 3, 2, 2, 2, 395, 396, 3, 2, 2, 2, 396, 397, 3, 2, 2, 2, 397,
-	399, 7, 5, 2, 2, 398, 400, 7, 127, 2, 2, 399, 398, 3, 2, 2, 2, 399, 400,
-	3, 2, 2, 2, 400, 401, 3, 2, 2, 2, 401, 402, 5, 100, 51, 2, 402, 430, 3,
-	2, 2, 2, 403, 405, 5, 172, 87, 2, 404, 406, 7, 127, 2, 2, 405, 404, 3,
-	2, 2, 2, 405, 406, 3, 2, 2, 2, 406, 407, 3, 2, 2, 2, 407, 409, 7, 5, 2,
-	2, 408, 410, 7, 127, 2, 2, 409, 408, 3, 2, 2, 2, 409, 410, 3, 2, 2, 2,
-	410, 411, 3, 2, 2, 2, 411, 412, 5, 100, 51, 2, 412, 430, 3, 2, 2, 2, 413,
-	415, 5, 172, 87, 2, 414, 416, 7, 127, 2, 2, 415, 414, 3, 2, 2, 2, 415,
-	416, 3, 2, 2, 2, 416, 417, 3, 2, 2, 2, 417, 419, 7, 6, 2, 2, 418, 420,
-	7, 127, 2, 2, 419, 418, 3, 2, 2, 2, 419, 420, 3, 2, 2, 2, 420, 421, 3,
-	2, 2, 2, 421, 422, 5, 100, 51, 2, 422, 430, 3, 2, 2, 2, 423, 425, 5, 172,
-	87, 2, 424, 426, 7, 127, 2, 2, 425, 424, 3, 2, 2, 2, 425, 426, 3, 2, 2,
-	2, 426, 427, 3, 2, 2, 2, 427, 428, 5, 90, 46, 2, 428, 430, 3, 2, 2, 2,
-	429, 393, 3, 2, 2, 2, 429, 403, 3, 2, 2, 2, 429, 413, 3, 2, 2, 2, 429,
-	423, 3, 2, 2, 2, 430, 35, 3, 2, 2, 2, 431, 432, 7, 58, 2, 2, 432, 434,
-	7, 127, 2, 2, 433, 431, 3, 2, 2, 2, 433, 434, 3, 2, 2, 2, 434, 435, 3,
-	2, 2, 2, 435, 437, 7, 59, 2, 2, 436, 438, 7, 127, 2, 2, 437, 436, 3, 2,
-	2, 2, 437, 438, 3, 2, 2, 2, 438, 439, 3, 2, 2, 2, 439, 450, 5, 100, 51,
-	2, 440, 442, 7, 127, 2, 2, 441, 440, 3, 2, 2, 2, 441, 442, 3, 2, 2, 2,
-	442, 443, 3, 2, 2, 2, 443, 445, 7, 4, 2, 2, 444, 446, 7, 127, 2, 2, 445,
-	444, 3, 2, 2, 2, 445, 446, 3, 2, 2, 2, 446, 447, 3, 2, 2, 2, 447, 449,
-	5, 100, 51, 2, 448, 441, 3, 2, 2, 2, 449, 452, 3, 2, 2, 2, 450, 448, 3,
-	2, 2, 2, 450, 451, 3, 2, 2, 2, 451, 37, 3, 2, 2, 2, 452, 450, 3, 2, 2,
-	2, 453, 454, 7, 60, 2, 2, 454, 455, 7, 127, 2, 2, 455, 466, 5, 40, 21,
-	2, 456, 458, 7, 127, 2, 2, 457, 456, 3, 2, 2, 2, 457, 458, 3, 2, 2, 2,
-	458, 459, 3, 2, 2, 2, 459, 461, 7, 4, 2, 2, 460, 462, 7, 127, 2, 2, 461,
-	460, 3, 2, 2, 2, 461, 462, 3, 2, 2, 2, 462, 463, 3, 2, 2, 2, 463, 465,
-	5, 40, 21, 2, 464, 457, 3, 2, 2, 2, 465, 468, 3, 2, 2, 2, 466, 464, 3,
-	2, 2, 2, 466, 467, 3, 2, 2, 2, 467, 39, 3, 2, 2, 2, 468, 466, 3, 2, 2,
-	2, 469, 470, 5, 172, 87, 2, 470, 471, 5, 90, 46, 2, 471, 474, 3, 2, 2,
-	2, 472, 474, 5, 180, 91, 2, 473, 469, 3, 2, 2, 2, 473, 472, 3, 2, 2, 2,
-	474, 41, 3, 2, 2, 2, 475, 476, 7, 61, 2, 2, 476, 477, 7, 127, 2, 2, 477,
-	484, 5, 152, 77, 2, 478, 480, 7, 127, 2, 2, 479, 478, 3, 2, 2, 2, 479,
-	480, 3, 2, 2, 2, 480, 481, 3, 2, 2, 2, 481, 482, 7, 62, 2, 2, 482, 483,
-	7, 127, 2, 2, 483, 485, 5, 46, 24, 2, 484, 479, 3, 2, 2, 2, 484, 485, 3,
-	2, 2, 2, 485, 43, 3, 2, 2, 2, 486, 487, 7, 61, 2, 2, 487, 490, 7, 127,
-	2, 2, 488, 491, 5, 152, 77, 2, 489, 491, 5, 154, 78, 2, 490, 488, 3, 2,
-	2, 2, 490, 489, 3, 2, 2, 2, 491, 496, 3, 2, 2, 2, 492, 493, 7, 127, 2,
-	2, 493, 494, 7, 62, 2, 2, 494, 495, 7, 127, 2, 2, 495, 497, 5, 46, 24,
-	2, 496, 492, 3, 2, 2, 2, 496, 497, 3, 2, 2, 2, 497, 45, 3, 2, 2, 2, 498,
-	514, 7, 7, 2, 2, 499, 510, 5, 48, 25, 2, 500, 502, 7, 127, 2, 2, 501, 500,
-	3, 2, 2, 2, 501, 502, 3, 2, 2, 2, 502, 503, 3, 2, 2, 2, 503, 505, 7, 4,
-	2, 2, 504, 506, 7, 127, 2, 2, 505, 504, 3, 2, 2, 2, 505, 506, 3, 2, 2,
-	2, 506, 507, 3, 2, 2, 2, 507, 509, 5, 48, 25, 2, 508, 501, 3, 2, 2, 2,
-	509, 512, 3, 2, 2, 2, 510, 508, 3, 2, 2, 2, 510, 511, 3, 2, 2, 2, 511,
-	514, 3, 2, 2, 2, 512, 510, 3, 2, 2, 2, 513, 498, 3, 2, 2, 2, 513, 499,
-	3, 2, 2, 2, 514, 519, 3, 2, 2, 2, 515, 517, 7, 127, 2, 2, 516, 515, 3,
-	2, 2, 2, 516, 517, 3, 2, 2, 2, 517, 518, 3, 2, 2, 2, 518, 520, 5, 68, 35,
-	2, 519, 516, 3, 2, 2, 2, 519, 520, 3, 2, 2, 2, 520, 47, 3, 2, 2, 2, 521,
-	522, 5, 156, 79, 2, 522, 523, 7, 127, 2, 2, 523, 524, 7, 53, 2, 2, 524,
-	525, 7, 127, 2, 2, 525, 527, 3, 2, 2, 2, 526, 521, 3, 2, 2, 2, 526, 527,
-	3, 2, 2, 2, 527, 528, 3, 2, 2, 2, 528, 529, 5, 172, 87, 2, 529, 49, 3,
-	2, 2, 2, 530, 531, 7, 63, 2, 2, 531, 536, 5, 54, 28, 2, 532, 534, 7, 127,
-	2, 2, 533, 532, 3, 2, 2, 2, 533, 534, 3, 2, 2, 2, 534, 535, 3, 2, 2, 2,
-	535, 537, 5, 68, 35, 2, 536, 533, 3, 2, 2, 2, 536, 537, 3, 2, 2, 2, 537,
-	51, 3, 2, 2, 2, 538, 539, 7, 64, 2, 2, 539, 540, 5, 54, 28, 2, 540, 53,
-	3, 2, 2, 2, 541, 543, 7, 127, 2, 2, 542, 541, 3, 2, 2, 2, 542, 543, 3,
-	2, 2, 2, 543, 544, 3, 2, 2, 2, 544, 546, 7, 65, 2, 2, 545, 542, 3, 2, 2,
-	2, 545, 546, 3, 2, 2, 2, 546, 547, 3, 2, 2, 2, 547, 548, 7, 127, 2, 2,
-	548, 551, 5, 56, 29, 2, 549, 550, 7, 127, 2, 2, 550, 552, 5, 60, 31, 2,
-	551, 549, 3, 2, 2, 2, 551, 552, 3, 2, 2, 2, 552, 555, 3, 2, 2, 2, 553,
-	554, 7, 127, 2, 2, 554, 556, 5, 62, 32, 2, 555, 553, 3, 2, 2, 2, 555, 556,
-	3, 2, 2, 2, 556, 559, 3, 2, 2, 2, 557, 558, 7, 127, 2, 2, 558, 560, 5,
-	64, 33, 2, 559, 557, 3, 2, 2, 2, 559, 560, 3, 2, 2, 2, 560, 55, 3, 2, 2,
-	2, 561, 572, 7, 7, 2, 2, 562, 564, 7, 127, 2, 2, 563, 562, 3, 2, 2, 2,
-	563, 564, 3, 2, 2, 2, 564, 565, 3, 2, 2, 2, 565, 567, 7, 4, 2, 2, 566,
-	568, 7, 127, 2, 2, 567, 566, 3, 2, 2, 2, 567, 568, 3, 2, 2, 2, 568, 569,
-	3, 2, 2, 2, 569, 571, 5, 58, 30, 2, 570, 563, 3, 2, 2, 2, 571, 574, 3,
-	2, 2, 2, 572, 570, 3, 2, 2, 2, 572, 573, 3, 2, 2, 2, 573, 590, 3, 2, 2,
-	2, 574, 572, 3, 2, 2, 2, 575, 586, 5, 58, 30, 2, 576, 578, 7, 127, 2, 2,
-	577, 576, 3, 2, 2, 2, 577, 578, 3, 2, 2, 2, 578, 579, 3, 2, 2, 2, 579,
-	581, 7, 4, 2, 2, 580, 582, 7, 127, 2, 2, 581, 580, 3, 2, 2, 2, 581, 582,
-	3, 2, 2, 2, 582, 583, 3, 2, 2, 2, 583, 585, 5, 58, 30, 2, 584, 577, 3,
-	2, 2, 2, 585, 588, 3, 2, 2, 2, 586, 584, 3, 2, 2, 2, 586, 587, 3, 2, 2,
-	2, 587, 590, 3, 2, 2, 2, 588, 586, 3, 2, 2, 2, 589, 561, 3, 2, 2, 2, 589,
-	575, 3, 2, 2, 2, 590, 57, 3, 2, 2, 2, 591, 592, 5, 100, 51, 2, 592, 593,
-	7, 127, 2, 2, 593, 594, 7, 53, 2, 2, 594, 595, 7, 127, 2, 2, 595, 596,
-	5, 172, 87, 2, 596, 599, 3, 2, 2, 2, 597, 599, 5, 100, 51, 2, 598, 591,
-	3, 2, 2, 2, 598, 597, 3, 2, 2, 2, 599, 59, 3, 2, 2, 2, 600, 601, 7, 66,
-	2, 2, 601, 602, 7, 127, 2, 2, 602, 603, 7, 67, 2, 2, 603, 604, 7, 127,
-	2, 2, 604, 612, 5, 66, 34, 2, 605, 607, 7, 4, 2, 2, 606, 608, 7, 127, 2,
-	2, 607, 606, 3, 2, 2, 2, 607, 608, 3, 2, 2, 2, 608, 609, 3, 2, 2, 2, 609,
-	611, 5, 66, 34, 2, 610, 605, 3, 2, 2, 2, 611, 614, 3, 2, 2, 2, 612, 610,
-	3, 2, 2, 2, 612, 613, 3, 2, 2, 2, 613, 61, 3, 2, 2, 2, 614, 612, 3, 2,
-	2, 2, 615, 616, 7, 68, 2, 2, 616, 617, 7, 127, 2, 2, 617, 618, 5, 100,
-	51, 2, 618, 63, 3, 2, 2, 2, 619, 620, 7, 69, 2, 2, 620, 621, 7, 127, 2,
-	2, 621, 622, 5, 100, 51, 2, 622, 65, 3, 2, 2, 2, 623, 628, 5, 100, 51,
-	2, 624, 626, 7, 127, 2, 2, 625, 624, 3, 2, 2, 2, 625, 626, 3, 2, 2, 2,
-	626, 627, 3, 2, 2, 2, 627, 629, 9, 2, 2, 2, 628, 625, 3, 2, 2, 2, 628,
-	629, 3, 2, 2, 2, 629, 67, 3, 2, 2, 2, 630, 631, 7, 74, 2, 2, 631, 632,
-	7, 127, 2, 2, 632, 633, 5, 100, 51, 2, 633, 69, 3, 2, 2, 2, 634, 645, 5,
-	72, 37, 2, 635, 637, 7, 127, 2, 2, 636, 635, 3, 2, 2, 2, 636, 637, 3, 2,
-	2, 2, 637, 638, 3, 2, 2, 2, 638, 640, 7, 4, 2, 2, 639, 641, 7, 127, 2,
-	2, 640, 639, 3, 2, 2, 2, 640, 641, 3, 2, 2, 2, 641, 642, 3, 2, 2, 2, 642,
-	644, 5, 72, 37, 2, 643, 636, 3, 2, 2, 2, 644, 647, 3, 2, 2, 2, 645, 643,
-	3, 2, 2, 2, 645, 646, 3, 2, 2, 2, 646, 71, 3, 2, 2, 2, 647, 645, 3, 2,
-	2, 2, 648, 650, 5, 172, 87, 2, 649, 651, 7, 127, 2, 2, 650, 649, 3, 2,
-	2, 2, 650, 651, 3, 2, 2, 2, 651, 652, 3, 2, 2, 2, 652, 654, 7, 5, 2, 2,
-	653, 655, 7, 127, 2, 2, 654, 653, 3, 2, 2, 2, 654, 655, 3, 2, 2, 2, 655,
-	656, 3, 2, 2, 2, 656, 657, 5, 74, 38, 2, 657, 660, 3, 2, 2, 2, 658, 660,
-	5, 74, 38, 2, 659, 648, 3, 2, 2, 2, 659, 658, 3, 2, 2, 2, 660, 73, 3, 2,
-	2, 2, 661, 662, 5, 76, 39, 2, 662, 75, 3, 2, 2, 2, 663, 670, 5, 78, 40,
-	2, 664, 666, 7, 127, 2, 2, 665, 664, 3, 2, 2, 2, 665, 666, 3, 2, 2, 2,
-	666, 667, 3, 2, 2, 2, 667, 669, 5, 80, 41, 2, 668, 665, 3, 2, 2, 2, 669,
-	672, 3, 2, 2, 2, 670, 668, 3, 2, 2, 2, 670, 671, 3, 2, 2, 2, 671, 678,
-	3, 2, 2, 2, 672, 670, 3, 2, 2, 2, 673, 674, 7, 8, 2, 2, 674, 675, 5, 76,
-	39, 2, 675, 676, 7, 9, 2, 2, 676, 678, 3, 2, 2, 2, 677, 663, 3, 2, 2, 2,
-	677, 673, 3, 2, 2, 2, 678, 77, 3, 2, 2, 2, 679, 681, 7, 8, 2, 2, 680, 682,
-	7, 127, 2, 2, 681, 680, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682, 687, 3,
-	2, 2, 2, 683, 685, 5, 172, 87, 2, 684, 686, 7, 127, 2, 2, 685, 684, 3,
-	2, 2, 2, 685, 686, 3, 2, 2, 2, 686, 688, 3, 2, 2, 2, 687, 683, 3, 2, 2,
-	2, 687, 688, 3, 2, 2, 2, 688, 693, 3, 2, 2, 2, 689, 691, 5, 90, 46, 2,
-	690, 692, 7, 127, 2, 2, 691, 690, 3, 2, 2, 2, 691, 692, 3, 2, 2, 2, 692,
-	694, 3, 2, 2, 2, 693, 689, 3, 2, 2, 2, 693, 694, 3, 2, 2, 2, 694, 699,
-	3, 2, 2, 2, 695, 697, 5, 86, 44, 2, 696, 698, 7, 127, 2, 2, 697, 696, 3,
-	2, 2, 2, 697, 698, 3, 2, 2, 2, 698, 700, 3, 2, 2, 2, 699, 695, 3, 2, 2,
-	2, 699, 700, 3, 2, 2, 2, 700, 701, 3, 2, 2, 2, 701, 702, 7, 9, 2, 2, 702,
-	79, 3, 2, 2, 2, 703, 705, 5, 82, 42, 2, 704, 706, 7, 127, 2, 2, 705, 704,
-	3, 2, 2, 2, 705, 706, 3, 2, 2, 2, 706, 707, 3, 2, 2, 2, 707, 708, 5, 78,
-	40, 2, 708, 81, 3, 2, 2, 2, 709, 711, 5, 194, 98, 2, 710, 712, 7, 127,
-	2, 2, 711, 710, 3, 2, 2, 2, 711, 712, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2,
-	713, 715, 5, 198, 100, 2, 714, 716, 7, 127, 2, 2, 715, 714, 3, 2, 2, 2,
-	715, 716, 3, 2, 2, 2, 716, 718, 3, 2, 2, 2, 717, 719, 5, 84, 43, 2, 718,
-	717, 3, 2, 2, 2, 718, 719, 3, 2, 2, 2, 719, 721, 3, 2, 2, 2, 720, 722,
-	7, 127, 2, 2, 721, 720, 3, 2, 2, 2, 721, 722, 3, 2, 2, 2, 722, 723, 3,
-	2, 2, 2, 723, 725, 5, 198, 100, 2, 724, 726, 7, 127, 2, 2, 725, 724, 3,
-	2, 2, 2, 725, 726, 3, 2, 2, 2, 726, 727, 3, 2, 2, 2, 727, 728, 5, 196,
-	99, 2, 728, 774, 3, 2, 2, 2, 729, 731, 5, 194, 98, 2, 730, 732, 7, 127,
-	2, 2, 731, 730, 3, 2, 2, 2, 731, 732, 3, 2, 2, 2, 732, 733, 3, 2, 2, 2,
-	733, 735, 5, 198, 100, 2, 734, 736, 7, 127, 2, 2, 735, 734, 3, 2, 2, 2,
-	735, 736, 3, 2, 2, 2, 736, 738, 3, 2, 2, 2, 737, 739, 5, 84, 43, 2, 738,
-	737, 3, 2, 2, 2, 738, 739, 3, 2, 2, 2, 739, 741, 3, 2, 2, 2, 740, 742,
-	7, 127, 2, 2, 741, 740, 3, 2, 2, 2, 741, 742, 3, 2, 2, 2, 742, 743, 3,
-	2, 2, 2, 743, 744, 5, 198, 100, 2, 744, 774, 3, 2, 2, 2, 745, 747, 5, 198,
-	100, 2, 746, 748, 7, 127, 2, 2, 747, 746, 3, 2, 2, 2, 747, 748, 3, 2, 2,
-	2, 748, 750, 3, 2, 2, 2, 749, 751, 5, 84, 43, 2, 750, 749, 3, 2, 2, 2,
-	750, 751, 3, 2, 2, 2, 751, 753, 3, 2, 2, 2, 752, 754, 7, 127, 2, 2, 753,
-	752, 3, 2, 2, 2, 753, 754, 3, 2, 2, 2, 754, 755, 3, 2, 2, 2, 755, 757,
-	5, 198, 100, 2, 756, 758, 7, 127, 2, 2, 757, 756, 3, 2, 2, 2, 757, 758,
-	3, 2, 2, 2, 758, 759, 3, 2, 2, 2, 759, 760, 5, 196, 99, 2, 760, 774, 3,
-	2, 2, 2, 761, 763, 5, 198, 100, 2, 762, 764, 7, 127, 2, 2, 763, 762, 3,
-	2, 2, 2, 763, 764, 3, 2, 2, 2, 764, 766, 3, 2, 2, 2, 765, 767, 5, 84, 43,
-	2, 766, 765, 3, 2, 2, 2, 766, 767, 3, 2, 2, 2, 767, 769, 3, 2, 2, 2, 768,
-	770, 7, 127, 2, 2, 769, 768, 3, 2, 2, 2, 769, 770, 3, 2, 2, 2, 770, 771,
-	3, 2, 2, 2, 771, 772, 5, 198, 100, 2, 772, 774, 3, 2, 2, 2, 773, 709, 3,
-	2, 2, 2, 773, 729, 3, 2, 2, 2, 773, 745, 3, 2, 2, 2, 773, 761, 3, 2, 2,
-	2, 774, 83, 3, 2, 2, 2, 775, 777, 7, 10, 2, 2, 776, 778, 7, 127, 2, 2,
-	777, 776, 3, 2, 2, 2, 777, 778, 3, 2, 2, 2, 778, 783, 3, 2, 2, 2, 779,
-	781, 5, 172, 87, 2, 780, 782, 7, 127, 2, 2, 781, 780, 3, 2, 2, 2, 781,
-	782, 3, 2, 2, 2, 782, 784, 3, 2, 2, 2, 783, 779, 3, 2, 2, 2, 783, 784,
-	3, 2, 2, 2, 784, 789, 3, 2, 2, 2, 785, 787, 5, 88, 45, 2, 786, 788, 7,
-	127, 2, 2, 787, 786, 3, 2, 2, 2, 787, 788, 3, 2, 2, 2, 788, 790, 3, 2,
-	2, 2, 789, 785, 3, 2, 2, 2, 789, 790, 3, 2, 2, 2, 790, 792, 3, 2, 2, 2,
-	791, 793, 5, 94, 48, 2, 792, 791, 3, 2, 2, 2, 792, 793, 3, 2, 2, 2, 793,
-	798, 3, 2, 2, 2, 794, 796, 5, 86, 44, 2, 795, 797, 7, 127, 2, 2, 796, 795,
-	3, 2, 2, 2, 796, 797, 3, 2, 2, 2, 797, 799, 3, 2, 2, 2, 798, 794, 3, 2,
-	2, 2, 798, 799, 3, 2, 2, 2, 799, 800, 3, 2, 2, 2, 800, 801, 7, 11, 2, 2,
-	801, 85, 3, 2, 2, 2, 802, 805, 5, 176, 89, 2, 803, 805, 5, 178, 90, 2,
-	804, 802, 3, 2, 2, 2, 804, 803, 3, 2, 2, 2, 805, 87, 3, 2, 2, 2, 806, 808,
-	7, 12, 2, 2, 807, 809, 7, 127, 2, 2, 808, 807, 3, 2, 2, 2, 808, 809, 3,
-	2, 2, 2, 809, 810, 3, 2, 2, 2, 810, 824, 5, 98, 50, 2, 811, 813, 7, 127,
-	2, 2, 812, 811, 3, 2, 2, 2, 812, 813, 3, 2, 2, 2, 813, 814, 3, 2, 2, 2,
-	814, 816, 7, 13, 2, 2, 815, 817, 7, 12, 2, 2, 816, 815, 3, 2, 2, 2, 816,
-	817, 3, 2, 2, 2, 817, 819, 3, 2, 2, 2, 818, 820, 7, 127, 2, 2, 819, 818,
-	3, 2, 2, 2, 819, 820, 3, 2, 2, 2, 820, 821, 3, 2, 2, 2, 821, 823, 5, 98,
-	50, 2, 822, 812, 3, 2, 2, 2, 823, 826, 3, 2, 2, 2, 824, 822, 3, 2, 2, 2,
-	824, 825, 3, 2, 2, 2, 825, 89, 3, 2, 2, 2, 826, 824, 3, 2, 2, 2, 827, 834,
-	5, 92, 47, 2, 828, 830, 7, 127, 2, 2, 829, 828, 3, 2, 2, 2, 829, 830, 3,
-	2, 2, 2, 830, 831, 3, 2, 2, 2, 831, 833, 5, 92, 47, 2, 832, 829, 3, 2,
-	2, 2, 833, 836, 3, 2, 2, 2, 834, 832, 3, 2, 2, 2, 834, 835, 3, 2, 2, 2,
-	835, 91, 3, 2, 2, 2, 836, 834, 3, 2, 2, 2, 837, 839, 7, 12, 2, 2, 838,
-	840, 7, 127, 2, 2, 839, 838, 3, 2, 2, 2, 839, 840, 3, 2, 2, 2, 840, 841,
-	3, 2, 2, 2, 841, 842, 5, 96, 49, 2, 842, 93, 3, 2, 2, 2, 843, 845, 7, 7,
-	2, 2, 844, 846, 7, 127, 2, 2, 845, 844, 3, 2, 2, 2, 845, 846, 3, 2, 2,
-	2, 846, 851, 3, 2, 2, 2, 847, 849, 5, 184, 93, 2, 848, 850, 7, 127, 2,
-	2, 849, 848, 3, 2, 2, 2, 849, 850, 3, 2, 2, 2, 850, 852, 3, 2, 2, 2, 851,
-	847, 3, 2, 2, 2, 851, 852, 3, 2, 2, 2, 852, 863, 3, 2, 2, 2, 853, 855,
-	7, 14, 2, 2, 854, 856, 7, 127, 2, 2, 855, 854, 3, 2, 2, 2, 855, 856, 3,
-	2, 2, 2, 856, 861, 3, 2, 2, 2, 857, 859, 5, 184, 93, 2, 858, 860, 7, 127,
-	2, 2, 859, 858, 3, 2, 2, 2, 859, 860, 3, 2, 2, 2, 860, 862, 3, 2, 2, 2,
-	861, 857, 3, 2, 2, 2, 861, 862, 3, 2, 2, 2, 862, 864, 3, 2, 2, 2, 863,
-	853, 3, 2, 2, 2, 863, 864, 3, 2, 2, 2, 864, 95, 3, 2, 2, 2, 865, 866, 5,
-	188, 95, 2, 866, 97, 3, 2, 2, 2, 867, 868, 5, 188, 95, 2, 868, 99, 3, 2,
-	2, 2, 869, 870, 5, 102, 52, 2, 870, 101, 3, 2, 2, 2, 871, 878, 5, 104,
-	53, 2, 872, 873, 7, 127, 2, 2, 873, 874, 7, 75, 2, 2, 874, 875, 7, 127,
-	2, 2, 875, 877, 5, 104, 53, 2, 876, 872, 3, 2, 2, 2, 877, 880, 3, 2, 2,
-	2, 878, 876, 3, 2, 2, 2, 878, 879, 3, 2, 2, 2, 879, 103, 3, 2, 2, 2, 880,
-	878, 3, 2, 2, 2, 881, 888, 5, 106, 54, 2, 882, 883, 7, 127, 2, 2, 883,
-	884, 7, 76, 2, 2, 884, 885, 7, 127, 2, 2, 885, 887, 5, 106, 54, 2, 886,
-	882, 3, 2, 2, 2, 887, 890, 3, 2, 2, 2, 888, 886, 3, 2, 2, 2, 888, 889,
-	3, 2, 2, 2, 889, 105, 3, 2, 2, 2, 890, 888, 3, 2, 2, 2, 891, 898, 5, 108,
-	55, 2, 892, 893, 7, 127, 2, 2, 893, 894, 7, 77, 2, 2, 894, 895, 7, 127,
-	2, 2, 895, 897, 5, 108, 55, 2, 896, 892, 3, 2, 2, 2, 897, 900, 3, 2, 2,
-	2, 898, 896, 3, 2, 2, 2, 898, 899, 3, 2, 2, 2, 899, 107, 3, 2, 2, 2, 900,
-	898, 3, 2, 2, 2, 901, 903, 7, 78, 2, 2, 902, 904, 7, 127, 2, 2, 903, 902,
-	3, 2, 2, 2, 903, 904, 3, 2, 2, 2, 904, 906, 3, 2, 2, 2, 905, 901, 3, 2,
-	2, 2, 906, 909, 3, 2, 2, 2, 907, 905, 3, 2, 2, 2, 907, 908, 3, 2, 2, 2,
-	908, 910, 3, 2, 2, 2, 909, 907, 3, 2, 2, 2, 910, 911, 5, 110, 56, 2, 911,
-	109, 3, 2, 2, 2, 912, 919, 5, 112, 57, 2, 913, 915, 7, 127, 2, 2, 914,
-	913, 3, 2, 2, 2, 914, 915, 3, 2, 2, 2, 915, 916, 3, 2, 2, 2, 916, 918,
-	5, 138, 70, 2, 917, 914, 3, 2, 2, 2, 918, 921, 3, 2, 2, 2, 919, 917, 3,
-	2, 2, 2, 919, 920, 3, 2, 2, 2, 920, 111, 3, 2, 2, 2, 921, 919, 3, 2, 2,
-	2, 922, 941, 5, 114, 58, 2, 923, 925, 7, 127, 2, 2, 924, 923, 3, 2, 2,
-	2, 924, 925, 3, 2, 2, 2, 925, 926, 3, 2, 2, 2, 926, 928, 7, 15, 2, 2, 927,
-	929, 7, 127, 2, 2, 928, 927, 3, 2, 2, 2, 928, 929, 3, 2, 2, 2, 929, 930,
-	3, 2, 2, 2, 930, 940, 5, 114, 58, 2, 931, 933, 7, 127, 2, 2, 932, 931,
-	3, 2, 2, 2, 932, 933, 3, 2, 2, 2, 933, 934, 3, 2, 2, 2, 934, 936, 7, 16,
-	2, 2, 935, 937, 7, 127, 2, 2, 936, 935, 3, 2, 2, 2, 936, 937, 3, 2, 2,
-	2, 937, 938, 3, 2, 2, 2, 938, 940, 5, 114, 58, 2, 939, 924, 3, 2, 2, 2,
-	939, 932, 3, 2, 2, 2, 940, 943, 3, 2, 2, 2, 941, 939, 3, 2, 2, 2, 941,
-	942, 3, 2, 2, 2, 942, 113, 3, 2, 2, 2, 943, 941, 3, 2, 2, 2, 944, 971,
-	5, 116, 59, 2, 945, 947, 7, 127, 2, 2, 946, 945, 3, 2, 2, 2, 946, 947,
-	3, 2, 2, 2, 947, 948, 3, 2, 2, 2, 948, 950, 7, 7, 2, 2, 949, 951, 7, 127,
-	2, 2, 950, 949, 3, 2, 2, 2, 950, 951, 3, 2, 2, 2, 951, 952, 3, 2, 2, 2,
-	952, 970, 5, 116, 59, 2, 953, 955, 7, 127, 2, 2, 954, 953, 3, 2, 2, 2,
-	954, 955, 3, 2, 2, 2, 955, 956, 3, 2, 2, 2, 956, 958, 7, 17, 2, 2, 957,
-	959, 7, 127, 2, 2, 958, 957, 3, 2, 2, 2, 958, 959, 3, 2, 2, 2, 959, 960,
-	3, 2, 2, 2, 960, 970, 5, 116, 59, 2, 961, 963, 7, 127, 2, 2, 962, 961,
-	3, 2, 2, 2, 962, 963, 3, 2, 2, 2, 963, 964, 3, 2, 2, 2, 964, 966, 7, 18,
-	2, 2, 965, 967, 7, 127, 2, 2, 966, 965, 3, 2, 2, 2, 966, 967, 3, 2, 2,
-	2, 967, 968, 3, 2, 2, 2, 968, 970, 5, 116, 59, 2, 969, 946, 3, 2, 2, 2,
-	969, 954, 3, 2, 2, 2, 969, 962, 3, 2, 2, 2, 970, 973, 3, 2, 2, 2, 971,
-	969, 3, 2, 2, 2, 971, 972, 3, 2, 2, 2, 972, 115, 3, 2, 2, 2, 973, 971,
-	3, 2, 2, 2, 974, 985, 5, 118, 60, 2, 975, 977, 7, 127, 2, 2, 976, 975,
-	3, 2, 2, 2, 976, 977, 3, 2, 2, 2, 977, 978, 3, 2, 2, 2, 978, 980, 7, 19,
-	2, 2, 979, 981, 7, 127, 2, 2, 980, 979, 3, 2, 2, 2, 980, 981, 3, 2, 2,
-	2, 981, 982, 3, 2, 2, 2, 982, 984, 5, 118, 60, 2, 983, 976, 3, 2, 2, 2,
-	984, 987, 3, 2, 2, 2, 985, 983, 3, 2, 2, 2, 985, 986, 3, 2, 2, 2, 986,
-	117, 3, 2, 2, 2, 987, 985, 3, 2, 2, 2, 988, 990, 9, 3, 2, 2, 989, 991,
-	7, 127, 2, 2, 990, 989, 3, 2, 2, 2, 990, 991, 3, 2, 2, 2, 991, 993, 3,
-	2, 2, 2, 992, 988, 3, 2, 2, 2, 993, 996, 3, 2, 2, 2, 994, 992, 3, 2, 2,
-	2, 994, 995, 3, 2, 2, 2, 995, 997, 3, 2, 2, 2, 996, 994, 3, 2, 2, 2, 997,
-	998, 5, 120, 61, 2, 998, 119, 3, 2, 2, 2, 999, 1005, 5, 128, 65, 2, 1000,
-	1004, 5, 124, 63, 2, 1001, 1004, 5, 122, 62, 2, 1002, 1004, 5, 126, 64,
-	2, 1003, 1000, 3, 2, 2, 2, 1003, 1001, 3, 2, 2, 2, 1003, 1002, 3, 2, 2,
-	2, 1004, 1007, 3, 2, 2, 2, 1005, 1003, 3, 2, 2, 2, 1005, 1006, 3, 2, 2,
-	2, 1006, 121, 3, 2, 2, 2, 1007, 1005, 3, 2, 2, 2, 1008, 1009, 7, 127, 2,
-	2, 1009, 1011, 7, 79, 2, 2, 1010, 1012, 7, 127, 2, 2, 1011, 1010, 3, 2,
-	2, 2, 1011, 1012, 3, 2, 2, 2, 1012, 1013, 3, 2, 2, 2, 1013, 1034, 5, 128,
-	65, 2, 1014, 1016, 7, 127, 2, 2, 1015, 1014, 3, 2, 2, 2, 1015, 1016, 3,
-	2, 2, 2, 1016, 1017, 3, 2, 2, 2, 1017, 1018, 7, 10, 2, 2, 1018, 1019, 5,
-	100, 51, 2, 1019, 1020, 7, 11, 2, 2, 1020, 1034, 3, 2, 2, 2, 1021, 1023,
-	7, 127, 2, 2, 1022, 1021, 3, 2, 2, 2, 1022, 1023, 3, 2, 2, 2, 1023, 1024,
-	3, 2, 2, 2, 1024, 1026, 7, 10, 2, 2, 1025, 1027, 5, 100, 51, 2, 1026, 1025,
-	3, 2, 2, 2, 1026, 1027, 3, 2, 2, 2, 1027, 1028, 3, 2, 2, 2, 1028, 1030,
-	7, 14, 2, 2, 1029, 1031, 5, 100, 51, 2, 1030, 1029, 3, 2, 2, 2, 1030, 1031,
-	3, 2, 2, 2, 1031, 1032, 3, 2, 2, 2, 1032, 1034, 7, 11, 2, 2, 1033, 1008,
-	3, 2, 2, 2, 1033, 1015, 3, 2, 2, 2, 1033, 1022, 3, 2, 2, 2, 1034, 123,
-	3, 2, 2, 2, 1035, 1036, 7, 127, 2, 2, 1036, 1037, 7, 80, 2, 2, 1037, 1038,
-	7, 127, 2, 2, 1038, 1046, 7, 63, 2, 2, 1039, 1040, 7, 127, 2, 2, 1040,
-	1041, 7, 81, 2, 2, 1041, 1042, 7, 127, 2, 2, 1042, 1046, 7, 63, 2, 2, 1043,
-	1044, 7, 127, 2, 2, 1044, 1046, 7, 82, 2, 2, 1045, 1035, 3, 2, 2, 2, 1045,
-	1039, 3, 2, 2, 2, 1045, 1043, 3, 2, 2, 2, 1046, 1048, 3, 2, 2, 2, 1047,
-	1049, 7, 127, 2, 2, 1048, 1047, 3, 2, 2, 2, 1048, 1049, 3, 2, 2, 2, 1049,
-	1050, 3, 2, 2, 2, 1050, 1051, 5, 128, 65, 2, 1051, 125, 3, 2, 2, 2, 1052,
-	1053, 7, 127, 2, 2, 1053, 1054, 7, 83, 2, 2, 1054, 1055, 7, 127, 2, 2,
-	1055, 1063, 7, 84, 2, 2, 1056, 1057, 7, 127, 2, 2, 1057, 1058, 7, 83, 2,
-	2, 1058, 1059, 7, 127, 2, 2, 1059, 1060, 7, 78, 2, 2, 1060, 1061, 7, 127,
-	2, 2, 1061, 1063, 7, 84, 2, 2, 1062, 1052, 3, 2, 2, 2, 1062, 1056, 3, 2,
-	2, 2, 1063, 127, 3, 2, 2, 2, 1064, 1071, 5, 130, 66, 2, 1065, 1067, 7,
-	127, 2, 2, 1066, 1065, 3, 2, 2, 2, 1066, 1067, 3, 2, 2, 2, 1067, 1068,
-	3, 2, 2, 2, 1068, 1070, 5, 166, 84, 2, 1069, 1066, 3, 2, 2, 2, 1070, 1073,
-	3, 2, 2, 2, 1071, 1069, 3, 2, 2, 2, 1071, 1072, 3, 2, 2, 2, 1072, 1078,
-	3, 2, 2, 2, 1073, 1071, 3, 2, 2, 2, 1074, 1076, 7, 127, 2, 2, 1075, 1074,
-	3, 2, 2, 2, 1075, 1076, 3, 2, 2, 2, 1076, 1077, 3, 2, 2, 2, 1077, 1079,
-	5, 90, 46, 2, 1078, 1075, 3, 2, 2, 2, 1078, 1079, 3, 2, 2, 2, 1079, 129,
-	3, 2, 2, 2, 1080, 1159, 5, 132, 67, 2, 1081, 1159, 5, 178, 90, 2, 1082,
-	1159, 5, 168, 85, 2, 1083, 1085, 7, 85, 2, 2, 1084, 1086, 7, 127, 2, 2,
-	1085, 1084, 3, 2, 2, 2, 1085, 1086, 3, 2, 2, 2, 1086, 1087, 3, 2, 2, 2,
-	1087, 1089, 7, 8, 2, 2, 1088, 1090, 7, 127, 2, 2, 1089, 1088, 3, 2, 2,
-	2, 1089, 1090, 3, 2, 2, 2, 1090, 1091, 3, 2, 2, 2, 1091, 1093, 7, 7, 2,
-	2, 1092, 1094, 7, 127, 2, 2, 1093, 1092, 3, 2, 2, 2, 1093, 1094, 3, 2,
-	2, 2, 1094, 1095, 3, 2, 2, 2, 1095, 1159, 7, 9, 2, 2, 1096, 1159, 5, 162,
-	82, 2, 1097, 1159, 5, 164, 83, 2, 1098, 1100, 7, 49, 2, 2, 1099, 1101,
-	7, 127, 2, 2, 1100, 1099, 3, 2, 2, 2, 1100, 1101, 3, 2, 2, 2, 1101, 1102,
-	3, 2, 2, 2, 1102, 1104, 7, 8, 2, 2, 1103, 1105, 7, 127, 2, 2, 1104, 1103,
-	3, 2, 2, 2, 1104, 1105, 3, 2, 2, 2, 1105, 1106, 3, 2, 2, 2, 1106, 1108,
-	5, 144, 73, 2, 1107, 1109, 7, 127, 2, 2, 1108, 1107, 3, 2, 2, 2, 1108,
-	1109, 3, 2, 2, 2, 1109, 1110, 3, 2, 2, 2, 1110, 1111, 7, 9, 2, 2, 1111,
-	1159, 3, 2, 2, 2, 1112, 1114, 7, 86, 2, 2, 1113, 1115, 7, 127, 2, 2, 1114,
-	1113, 3, 2, 2, 2, 1114, 1115, 3, 2, 2, 2, 1115, 1116, 3, 2, 2, 2, 1116,
-	1118, 7, 8, 2, 2, 1117, 1119, 7, 127, 2, 2, 1118, 1117, 3, 2, 2, 2, 1118,
-	1119, 3, 2, 2, 2, 1119, 1120, 3, 2, 2, 2, 1120, 1122, 5, 144, 73, 2, 1121,
-	1123, 7, 127, 2, 2, 1122, 1121, 3, 2, 2, 2, 1122, 1123, 3, 2, 2, 2, 1123,
-	1124, 3, 2, 2, 2, 1124, 1125, 7, 9, 2, 2, 1125, 1159, 3, 2, 2, 2, 1126,
-	1128, 7, 87, 2, 2, 1127, 1129, 7, 127, 2, 2, 1128, 1127, 3, 2, 2, 2, 1128,
-	1129, 3, 2, 2, 2, 1129, 1130, 3, 2, 2, 2, 1130, 1132, 7, 8, 2, 2, 1131,
-	1133, 7, 127, 2, 2, 1132, 1131, 3, 2, 2, 2, 1132, 1133, 3, 2, 2, 2, 1133,
-	1134, 3, 2, 2, 2, 1134, 1136, 5, 144, 73, 2, 1135, 1137, 7, 127, 2, 2,
-	1136, 1135, 3, 2, 2, 2, 1136, 1137, 3, 2, 2, 2, 1137, 1138, 3, 2, 2, 2,
-	1138, 1139, 7, 9, 2, 2, 1139, 1159, 3, 2, 2, 2, 1140, 1142, 7, 88, 2, 2,
-	1141, 1143, 7, 127, 2, 2, 1142, 1141, 3, 2, 2, 2, 1142, 1143, 3, 2, 2,
-	2, 1143, 1144, 3, 2, 2, 2, 1144, 1146, 7, 8, 2, 2, 1145, 1147, 7, 127,
-	2, 2, 1146, 1145, 3, 2, 2, 2, 1146, 1147, 3, 2, 2, 2, 1147, 1148, 3, 2,
-	2, 2, 1148, 1150, 5, 144, 73, 2, 1149, 1151, 7, 127, 2, 2, 1150, 1149,
-	3, 2, 2, 2, 1150, 1151, 3, 2, 2, 2, 1151, 1152, 3, 2, 2, 2, 1152, 1153,
-	7, 9, 2, 2, 1153, 1159, 3, 2, 2, 2, 1154, 1159, 5, 142, 72, 2, 1155, 1159,
-	5, 140, 71, 2, 1156, 1159, 5, 148, 75, 2, 1157, 1159, 5, 172, 87, 2, 1158,
-	1080, 3, 2, 2, 2, 1158, 1081, 3, 2, 2, 2, 1158, 1082, 3, 2, 2, 2, 1158,
-	1083, 3, 2, 2, 2, 1158, 1096, 3, 2, 2, 2, 1158, 1097, 3, 2, 2, 2, 1158,
-	1098, 3, 2, 2, 2, 1158, 1112, 3, 2, 2, 2, 1158, 1126, 3, 2, 2, 2, 1158,
-	1140, 3, 2, 2, 2, 1158, 1154, 3, 2, 2, 2, 1158, 1155, 3, 2, 2, 2, 1158,
-	1156, 3, 2, 2, 2, 1158, 1157, 3, 2, 2, 2, 1159, 131, 3, 2, 2, 2, 1160,
-	1167, 5, 174, 88, 2, 1161, 1167, 7, 97, 2, 2, 1162, 1167, 5, 134, 68, 2,
-	1163, 1167, 7, 84, 2, 2, 1164, 1167, 5, 176, 89, 2, 1165, 1167, 5, 136,
-	69, 2, 1166, 1160, 3, 2, 2, 2, 1166, 1161, 3, 2, 2, 2, 1166, 1162, 3, 2,
-	2, 2, 1166, 1163, 3, 2, 2, 2, 1166, 1164, 3, 2, 2, 2, 1166, 1165, 3, 2,
-	2, 2, 1167, 133, 3, 2, 2, 2, 1168, 1169, 9, 4, 2, 2, 1169, 135, 3, 2, 2,
-	2, 1170, 1172, 7, 10, 2, 2, 1171, 1173, 7, 127, 2, 2, 1172, 1171, 3, 2,
-	2, 2, 1172, 1173, 3, 2, 2, 2, 1173, 1191, 3, 2, 2, 2, 1174, 1176, 5, 100,
-	51, 2, 1175, 1177, 7, 127, 2, 2, 1176, 1175, 3, 2, 2, 2, 1176, 1177, 3,
-	2, 2, 2, 1177, 1188, 3, 2, 2, 2, 1178, 1180, 7, 4, 2, 2, 1179, 1181, 7,
-	127, 2, 2, 1180, 1179, 3, 2, 2, 2, 1180, 1181, 3, 2, 2, 2, 1181, 1182,
-	3, 2, 2, 2, 1182, 1184, 5, 100, 51, 2, 1183, 1185, 7, 127, 2, 2, 1184,
-	1183, 3, 2, 2, 2, 1184, 1185, 3, 2, 2, 2, 1185, 1187, 3, 2, 2, 2, 1186,
-	1178, 3, 2, 2, 2, 1187, 1190, 3, 2, 2, 2, 1188, 1186, 3, 2, 2, 2, 1188,
-	1189, 3, 2, 2, 2, 1189, 1192, 3, 2, 2, 2, 1190, 1188, 3, 2, 2, 2, 1191,
-	1174, 3, 2, 2, 2, 1191, 1192, 3, 2, 2, 2, 1192, 1193, 3, 2, 2, 2, 1193,
-	1194, 7, 11, 2, 2, 1194, 137, 3, 2, 2, 2, 1195, 1197, 7, 5, 2, 2, 1196,
-	1198, 7, 127, 2, 2, 1197, 1196, 3, 2, 2, 2, 1197, 1198, 3, 2, 2, 2, 1198,
-	1199, 3, 2, 2, 2, 1199, 1226, 5, 112, 57, 2, 1200, 1202, 7, 20, 2, 2, 1201,
-	1203, 7, 127, 2, 2, 1202, 1201, 3, 2, 2, 2, 1202, 1203, 3, 2, 2, 2, 1203,
-	1204, 3, 2, 2, 2, 1204, 1226, 5, 112, 57, 2, 1205, 1207, 7, 21, 2, 2, 1206,
-	1208, 7, 127, 2, 2, 1207, 1206, 3, 2, 2, 2, 1207, 1208, 3, 2, 2, 2, 1208,
-	1209, 3, 2, 2, 2, 1209, 1226, 5, 112, 57, 2, 1210, 1212, 7, 22, 2, 2, 1211,
-	1213, 7, 127, 2, 2, 1212, 1211, 3, 2, 2, 2, 1212, 1213, 3, 2, 2, 2, 1213,
-	1214, 3, 2, 2, 2, 1214, 1226, 5, 112, 57, 2, 1215, 1217, 7, 23, 2, 2, 1216,
-	1218, 7, 127, 2, 2, 1217, 1216, 3, 2, 2, 2, 1217, 1218, 3, 2, 2, 2, 1218,
-	1219, 3, 2, 2, 2, 1219, 1226, 5, 112, 57, 2, 1220, 1222, 7, 24, 2, 2, 1221,
-	1223, 7, 127, 2, 2, 1222, 1221, 3, 2, 2, 2, 1222, 1223, 3, 2, 2, 2, 1223,
-	1224, 3, 2, 2, 2, 1224, 1226, 5, 112, 57, 2, 1225, 1195, 3, 2, 2, 2, 1225,
-	1200, 3, 2, 2, 2, 1225, 1205, 3, 2, 2, 2, 1225, 1210, 3, 2, 2, 2, 1225,
-	1215, 3, 2, 2, 2, 1225, 1220, 3, 2, 2, 2, 1226, 139, 3, 2, 2, 2, 1227,
-	1229, 7, 8, 2, 2, 1228, 1230, 7, 127, 2, 2, 1229, 1228, 3, 2, 2, 2, 1229,
-	1230, 3, 2, 2, 2, 1230, 1231, 3, 2, 2, 2, 1231, 1233, 5, 100, 51, 2, 1232,
-	1234, 7, 127, 2, 2, 1233, 1232, 3, 2, 2, 2, 1233, 1234, 3, 2, 2, 2, 1234,
-	1235, 3, 2, 2, 2, 1235, 1236, 7, 9, 2, 2, 1236, 141, 3, 2, 2, 2, 1237,
-	1242, 5, 78, 40, 2, 1238, 1240, 7, 127, 2, 2, 1239, 1238, 3, 2, 2, 2, 1239,
-	1240, 3, 2, 2, 2, 1240, 1241, 3, 2, 2, 2, 1241, 1243, 5, 80, 41, 2, 1242,
-	1239, 3, 2, 2, 2, 1243, 1244, 3, 2, 2, 2, 1244, 1242, 3, 2, 2, 2, 1244,
-	1245, 3, 2, 2, 2, 1245, 143, 3, 2, 2, 2, 1246, 1251, 5, 146, 74, 2, 1247,
-	1249, 7, 127, 2, 2, 1248, 1247, 3, 2, 2, 2, 1248, 1249, 3, 2, 2, 2, 1249,
-	1250, 3, 2, 2, 2, 1250, 1252, 5, 68, 35, 2, 1251, 1248, 3, 2, 2, 2, 1251,
-	1252, 3, 2, 2, 2, 1252, 145, 3, 2, 2, 2, 1253, 1254, 5, 172, 87, 2, 1254,
-	1255, 7, 127, 2, 2, 1255, 1256, 7, 79, 2, 2, 1256, 1257, 7, 127, 2, 2,
-	1257, 1258, 5, 100, 51, 2, 1258, 147, 3, 2, 2, 2, 1259, 1261, 5, 150, 76,
-	2, 1260, 1262, 7, 127, 2, 2, 1261, 1260, 3, 2, 2, 2, 1261, 1262, 3, 2,
-	2, 2, 1262, 1263, 3, 2, 2, 2, 1263, 1265, 7, 8, 2, 2, 1264, 1266, 7, 127,
-	2, 2, 1265, 1264, 3, 2, 2, 2, 1265, 1266, 3, 2, 2, 2, 1266, 1271, 3, 2,
-	2, 2, 1267, 1269, 7, 65, 2, 2, 1268, 1270, 7, 127, 2, 2, 1269, 1268, 3,
-	2, 2, 2, 1269, 1270, 3, 2, 2, 2, 1270, 1272, 3, 2, 2, 2, 1271, 1267, 3,
-	2, 2, 2, 1271, 1272, 3, 2, 2, 2, 1272, 1290, 3, 2, 2, 2, 1273, 1275, 5,
-	100, 51, 2, 1274, 1276, 7, 127, 2, 2, 1275, 1274, 3, 2, 2, 2, 1275, 1276,
-	3, 2, 2, 2, 1276, 1287, 3, 2, 2, 2, 1277, 1279, 7, 4, 2, 2, 1278, 1280,
-	7, 127, 2, 2, 1279, 1278, 3, 2, 2, 2, 1279, 1280, 3, 2, 2, 2, 1280, 1281,
-	3, 2, 2, 2, 1281, 1283, 5, 100, 51, 2, 1282, 1284, 7, 127, 2, 2, 1283,
-	1282, 3, 2, 2, 2, 1283, 1284, 3, 2, 2, 2, 1284, 1286, 3, 2, 2, 2, 1285,
-	1277, 3, 2, 2, 2, 1286, 1289, 3, 2, 2, 2, 1287, 1285, 3, 2, 2, 2, 1287,
-	1288, 3, 2, 2, 2, 1288, 1291, 3, 2, 2, 2, 1289, 1287, 3, 2, 2, 2, 1290,
-	1273, 3, 2, 2, 2, 1290, 1291, 3, 2, 2, 2, 1291, 1292, 3, 2, 2, 2, 1292,
-	1293, 7, 9, 2, 2, 1293, 149, 3, 2, 2, 2, 1294, 1295, 5, 160, 81, 2, 1295,
-	1296, 5, 192, 97, 2, 1296, 1299, 3, 2, 2, 2, 1297, 1299, 7, 91, 2, 2, 1298,
-	1294, 3, 2, 2, 2, 1298, 1297, 3, 2, 2, 2, 1299, 151, 3, 2, 2, 2, 1300,
-	1302, 5, 158, 80, 2, 1301, 1303, 7, 127, 2, 2, 1302, 1301, 3, 2, 2, 2,
-	1302, 1303, 3, 2, 2, 2, 1303, 1304, 3, 2, 2, 2, 1304, 1306, 7, 8, 2, 2,
-	1305, 1307, 7, 127, 2, 2, 1306, 1305, 3, 2, 2, 2, 1306, 1307, 3, 2, 2,
-	2, 1307, 1325, 3, 2, 2, 2, 1308, 1310, 5, 100, 51, 2, 1309, 1311, 7, 127,
-	2, 2, 1310, 1309, 3, 2, 2, 2, 1310, 1311, 3, 2, 2, 2, 1311, 1322, 3, 2,
-	2, 2, 1312, 1314, 7, 4, 2, 2, 1313, 1315, 7, 127, 2, 2, 1314, 1313, 3,
-	2, 2, 2, 1314, 1315, 3, 2, 2, 2, 1315, 1316, 3, 2, 2, 2, 1316, 1318, 5,
-	100, 51, 2, 1317, 1319, 7, 127, 2, 2, 1318, 1317, 3, 2, 2, 2, 1318, 1319,
-	3, 2, 2, 2, 1319, 1321, 3, 2, 2, 2, 1320, 1312, 3, 2, 2, 2, 1321, 1324,
-	3, 2, 2, 2, 1322, 1320, 3, 2, 2, 2, 1322, 1323, 3, 2, 2, 2, 1323, 1326,
-	3, 2, 2, 2, 1324, 1322, 3, 2, 2, 2, 1325, 1308, 3, 2, 2, 2, 1325, 1326,
-	3, 2, 2, 2, 1326, 1327, 3, 2, 2, 2, 1327, 1328, 7, 9, 2, 2, 1328, 153,
-	3, 2, 2, 2, 1329, 1330, 5, 158, 80, 2, 1330, 155, 3, 2, 2, 2, 1331, 1332,
-	5, 192, 97, 2, 1332, 157, 3, 2, 2, 2, 1333, 1334, 5, 160, 81, 2, 1334,
-	1335, 5, 192, 97, 2, 1335, 159, 3, 2, 2, 2, 1336, 1337, 5, 192, 97, 2,
-	1337, 1338, 7, 25, 2, 2, 1338, 1340, 3, 2, 2, 2, 1339, 1336, 3, 2, 2, 2,
-	1340, 1343, 3, 2, 2, 2, 1341, 1339, 3, 2, 2, 2, 1341, 1342, 3, 2, 2, 2,
-	1342, 161, 3, 2, 2, 2, 1343, 1341, 3, 2, 2, 2, 1344, 1346, 7, 10, 2, 2,
-	1345, 1347, 7, 127, 2, 2, 1346, 1345, 3, 2, 2, 2, 1346, 1347, 3, 2, 2,
-	2, 1347, 1348, 3, 2, 2, 2, 1348, 1357, 5, 144, 73, 2, 1349, 1351, 7, 127,
-	2, 2, 1350, 1349, 3, 2, 2, 2, 1350, 1351, 3, 2, 2, 2, 1351, 1352, 3, 2,
-	2, 2, 1352, 1354, 7, 13, 2, 2, 1353, 1355, 7, 127, 2, 2, 1354, 1353, 3,
-	2, 2, 2, 1354, 1355, 3, 2, 2, 2, 1355, 1356, 3, 2, 2, 2, 1356, 1358, 5,
-	100, 51, 2, 1357, 1350, 3, 2, 2, 2, 1357, 1358, 3, 2, 2, 2, 1358, 1360,
-	3, 2, 2, 2, 1359, 1361, 7, 127, 2, 2, 1360, 1359, 3, 2, 2, 2, 1360, 1361,
-	3, 2, 2, 2, 1361, 1362, 3, 2, 2, 2, 1362, 1363, 7, 11, 2, 2, 1363, 163,
-	3, 2, 2, 2, 1364, 1366, 7, 10, 2, 2, 1365, 1367, 7, 127, 2, 2, 1366, 1365,
-	3, 2, 2, 2, 1366, 1367, 3, 2, 2, 2, 1367, 1376, 3, 2, 2, 2, 1368, 1370,
-	5, 172, 87, 2, 1369, 1371, 7, 127, 2, 2, 1370, 1369, 3, 2, 2, 2, 1370,
-	1371, 3, 2, 2, 2, 1371, 1372, 3, 2, 2, 2, 1372, 1374, 7, 5, 2, 2, 1373,
-	1375, 7, 127, 2, 2, 1374, 1373, 3, 2, 2, 2, 1374, 1375, 3, 2, 2, 2, 1375,
-	1377, 3, 2, 2, 2, 1376, 1368, 3, 2, 2, 2, 1376, 1377, 3, 2, 2, 2, 1377,
-	1378, 3, 2, 2, 2, 1378, 1380, 5, 142, 72, 2, 1379, 1381, 7, 127, 2, 2,
-	1380, 1379, 3, 2, 2, 2, 1380, 1381, 3, 2, 2, 2, 1381, 1390, 3, 2, 2, 2,
-	1382, 1384, 7, 74, 2, 2, 1383, 1385, 7, 127, 2, 2, 1384, 1383, 3, 2, 2,
-	2, 1384, 1385, 3, 2, 2, 2, 1385, 1386, 3, 2, 2, 2, 1386, 1388, 5, 100,
-	51, 2, 1387, 1389, 7, 127, 2, 2, 1388, 1387, 3, 2, 2, 2, 1388, 1389, 3,
-	2, 2, 2, 1389, 1391, 3, 2, 2, 2, 1390, 1382, 3, 2, 2, 2, 1390, 1391, 3,
-	2, 2, 2, 1391, 1392, 3, 2, 2, 2, 1392, 1394, 7, 13, 2, 2, 1393, 1395, 7,
-	127, 2, 2, 1394, 1393, 3, 2, 2, 2, 1394, 1395, 3, 2, 2, 2, 1395, 1396,
-	3, 2, 2, 2, 1396, 1398, 5, 100, 51, 2, 1397, 1399, 7, 127, 2, 2, 1398,
-	1397, 3, 2, 2, 2, 1398, 1399, 3, 2, 2, 2, 1399, 1400, 3, 2, 2, 2, 1400,
-	1401, 7, 11, 2, 2, 1401, 165, 3, 2, 2, 2, 1402, 1404, 7, 25, 2, 2, 1403,
-	1405, 7, 127, 2, 2, 1404, 1403, 3, 2, 2, 2, 1404, 1405, 3, 2, 2, 2, 1405,
-	1406, 3, 2, 2, 2, 1406, 1407, 5, 182, 92, 2, 1407, 167, 3, 2, 2, 2, 1408,
-	1413, 7, 92, 2, 2, 1409, 1411, 7, 127, 2, 2, 1410, 1409, 3, 2, 2, 2, 1410,
-	1411, 3, 2, 2, 2, 1411, 1412, 3, 2, 2, 2, 1412, 1414, 5, 170, 86, 2, 1413,
-	1410, 3, 2, 2, 2, 1414, 1415, 3, 2, 2, 2, 1415, 1413, 3, 2, 2, 2, 1415,
-	1416, 3, 2, 2, 2, 1416, 1431, 3, 2, 2, 2, 1417, 1419, 7, 92, 2, 2, 1418,
-	1420, 7, 127, 2, 2, 1419, 1418, 3, 2, 2, 2, 1419, 1420, 3, 2, 2, 2, 1420,
-	1421, 3, 2, 2, 2, 1421, 1426, 5, 100, 51, 2, 1422, 1424, 7, 127, 2, 2,
-	1423, 1422, 3, 2, 2, 2, 1423, 1424, 3, 2, 2, 2, 1424, 1425, 3, 2, 2, 2,
-	1425, 1427, 5, 170, 86, 2, 1426, 1423, 3, 2, 2, 2, 1427, 1428, 3, 2, 2,
-	2, 1428, 1426, 3, 2, 2, 2, 1428, 1429, 3, 2, 2, 2, 1429, 1431, 3, 2, 2,
-	2, 1430, 1408, 3, 2, 2, 2, 1430, 1417, 3, 2, 2, 2, 1431, 1440, 3, 2, 2,
-	2, 1432, 1434, 7, 127, 2, 2, 1433, 1432, 3, 2, 2, 2, 1433, 1434, 3, 2,
-	2, 2, 1434, 1435, 3, 2, 2, 2, 1435, 1437, 7, 93, 2, 2, 1436, 1438, 7, 127,
-	2, 2, 1437, 1436, 3, 2, 2, 2, 1437, 1438, 3, 2, 2, 2, 1438, 1439, 3, 2,
-	2, 2, 1439, 1441, 5, 100, 51, 2, 1440, 1433, 3, 2, 2, 2, 1440, 1441, 3,
-	2, 2, 2, 1441, 1443, 3, 2, 2, 2, 1442, 1444, 7, 127, 2, 2, 1443, 1442,
-	3, 2, 2, 2, 1443, 1444, 3, 2, 2, 2, 1444, 1445, 3, 2, 2, 2, 1445, 1446,
-	7, 94, 2, 2, 1446, 169, 3, 2, 2, 2, 1447, 1449, 7, 95, 2, 2, 1448, 1450,
-	7, 127, 2, 2, 1449, 1448, 3, 2, 2, 2, 1449, 1450, 3, 2, 2, 2, 1450, 1451,
-	3, 2, 2, 2, 1451, 1453, 5, 100, 51, 2, 1452, 1454, 7, 127, 2, 2, 1453,
-	1452, 3, 2, 2, 2, 1453, 1454, 3, 2, 2, 2, 1454, 1455, 3, 2, 2, 2, 1455,
-	1457, 7, 96, 2, 2, 1456, 1458, 7, 127, 2, 2, 1457, 1456, 3, 2, 2, 2, 1457,
-	1458, 3, 2, 2, 2, 1458, 1459, 3, 2, 2, 2, 1459, 1460, 5, 100, 51, 2, 1460,
-	171, 3, 2, 2, 2, 1461, 1462, 5, 192, 97, 2, 1462, 173, 3, 2, 2, 2, 1463,
-	1466, 5, 186, 94, 2, 1464, 1466, 5, 184, 93, 2, 1465, 1463, 3, 2, 2, 2,
-	1465, 1464, 3, 2, 2, 2, 1466, 175, 3, 2, 2, 2, 1467, 1469, 7, 26, 2, 2,
-	1468, 1470, 7, 127, 2, 2, 1469, 1468, 3, 2, 2, 2, 1469, 1470, 3, 2, 2,
-	2, 1470, 1504, 3, 2, 2, 2, 1471, 1473, 5, 182, 92, 2, 1472, 1474, 7, 127,
-	2, 2, 1473, 1472, 3, 2, 2, 2, 1473, 1474, 3, 2, 2, 2, 1474, 1475, 3, 2,
-	2, 2, 1475, 1477, 7, 12, 2, 2, 1476, 1478, 7, 127, 2, 2, 1477, 1476, 3,
-	2, 2, 2, 1477, 1478, 3, 2, 2, 2, 1478, 1479, 3, 2, 2, 2, 1479, 1481, 5,
-	100, 51, 2, 1480, 1482, 7, 127, 2, 2, 1481, 1480, 3, 2, 2, 2, 1481, 1482,
-	3, 2, 2, 2, 1482, 1501, 3, 2, 2, 2, 1483, 1485, 7, 4, 2, 2, 1484, 1486,
-	7, 127, 2, 2, 1485, 1484, 3, 2, 2, 2, 1485, 1486, 3, 2, 2, 2, 1486, 1487,
-	3, 2, 2, 2, 1487, 1489, 5, 182, 92, 2, 1488, 1490, 7, 127, 2, 2, 1489,
-	1488, 3, 2, 2, 2, 1489, 1490, 3, 2, 2, 2, 1490, 1491, 3, 2, 2, 2, 1491,
-	1493, 7, 12, 2, 2, 1492, 1494, 7, 127, 2, 2, 1493, 1492, 3, 2, 2, 2, 1493,
-	1494, 3, 2, 2, 2, 1494, 1495, 3, 2, 2, 2, 1495, 1497, 5, 100, 51, 2, 1496,
-	1498, 7, 127, 2, 2, 1497, 1496, 3, 2, 2, 2, 1497, 1498, 3, 2, 2, 2, 1498,
-	1500, 3, 2, 2, 2, 1499, 1483, 3, 2, 2, 2, 1500, 1503, 3, 2, 2, 2, 1501,
-	1499, 3, 2, 2, 2, 1501, 1502, 3, 2, 2, 2, 1502, 1505, 3, 2, 2, 2, 1503,
-	1501, 3, 2, 2, 2, 1504, 1471, 3, 2, 2, 2, 1504, 1505, 3, 2, 2, 2, 1505,
-	1506, 3, 2, 2, 2, 1506, 1507, 7, 27, 2, 2, 1507, 177, 3, 2, 2, 2, 1508,
-	1511, 7, 28, 2, 2, 1509, 1512, 5, 192, 97, 2, 1510, 1512, 7, 100, 2, 2,
-	1511, 1509, 3, 2, 2, 2, 1511, 1510, 3, 2, 2, 2, 1512, 179, 3, 2, 2, 2,
-	1513, 1518, 5, 130, 66, 2, 1514, 1516, 7, 127, 2, 2, 1515, 1514, 3, 2,
-	2, 2, 1515, 1516, 3, 2, 2, 2, 1516, 1517, 3, 2, 2, 2, 1517, 1519, 5, 166,
-	84, 2, 1518, 1515, 3, 2, 2, 2, 1519, 1520, 3, 2, 2, 2, 1520, 1518, 3, 2,
-	2, 2, 1520, 1521, 3, 2, 2, 2, 1521, 181, 3, 2, 2, 2, 1522, 1523, 5, 188,
-	95, 2, 1523, 183, 3, 2, 2, 2, 1524, 1525, 9, 5, 2, 2, 1525, 185, 3, 2,
-	2, 2, 1526, 1527, 9, 6, 2, 2, 1527, 187, 3, 2, 2, 2, 1528, 1531, 5, 192,
-	97, 2, 1529, 1531, 5, 190, 96, 2, 1530, 1528, 3, 2, 2, 2, 1530, 1529, 3,
-	2, 2, 2, 1531, 189, 3, 2, 2, 2, 1532, 1533, 9, 7, 2, 2, 1533, 191, 3, 2,
-	2, 2, 1534, 1535, 9, 8, 2, 2, 1535, 193, 3, 2, 2, 2, 1536, 1537, 9, 9,
-	2, 2, 1537, 195, 3, 2, 2, 2, 1538, 1539, 9, 10, 2, 2, 1539, 197, 3, 2,
-	2, 2, 1540, 1541, 9, 11, 2, 2, 1541, 199, 3, 2, 2, 2, 285, 201, 205, 208,
-	211, 219, 223, 228, 235, 240, 243, 247, 251, 255, 261, 265, 270, 275, 279,
-	282, 284, 288, 292, 297, 301, 306, 310, 319, 324, 328, 332, 336, 339, 343,
-	353, 360, 373, 377, 383, 390, 395, 399, 405, 409, 415, 419, 425, 429, 433,
-	437, 441, 445, 450, 457, 461, 466, 473, 479, 484, 490, 496, 501, 505, 510,
-	513, 516, 519, 526, 533, 536, 542, 545, 551, 555, 559, 563, 567, 572, 577,
-	581, 586, 589, 598, 607, 612, 625, 628, 636, 640, 645, 650, 654, 659, 665,
-	670, 677, 681, 685, 687, 691, 693, 697, 699, 705, 711, 715, 718, 721, 725,
-	731, 735, 738, 741, 747, 750, 753, 757, 763, 766, 769, 773, 777, 781, 783,
-	787, 789, 792, 796, 798, 804, 808, 812, 816, 819, 824, 829, 834, 839, 845,
-	849, 851, 855, 859, 861, 863, 878, 888, 898, 903, 907, 914, 919, 924, 928,
-	932, 936, 939, 941, 946, 950, 954, 958, 962, 966, 969, 971, 976, 980, 985,
-	990, 994, 1003, 1005, 1011, 1015, 1022, 1026, 1030, 1033, 1045, 1048, 1062,
-	1066, 1071, 1075, 1078, 1085, 1089, 1093, 1100, 1104, 1108, 1114, 1118,
-	1122, 1128, 1132, 1136, 1142, 1146, 1150, 1158, 1166, 1172, 1176, 1180,
-	1184, 1188, 1191, 1197, 1202, 1207, 1212, 1217, 1222, 1225, 1229, 1233,
-	1239, 1244, 1248, 1251, 1261, 1265, 1269, 1271, 1275, 1279, 1283, 1287,
-	1290, 1298, 1302, 1306, 1310, 1314, 1318, 1322, 1325, 1341, 1346, 1350,
-	1354, 1357, 1360, 1366, 1370, 1374, 1376, 1380, 1384, 1388, 1390, 1394,
-	1398, 1404, 1410, 1415, 1419, 1423, 1428, 1430, 1433, 1437, 1440, 1443,
-	1449, 1453, 1457, 1465, 1469, 1473, 1477, 1481, 1485, 1489, 1493, 1497,
-	1501, 1504, 1511, 1515, 1520, 1530,
+	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101, 3, 2,
+	5, 2, 204, 10, 2, 3, 2, 3, 2, 5, 2, 208, 10, 2, 3, 2, 5, 2, 211, 10, 2,
+	3, 2, 5, 2, 214, 10, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 5, 4, 222,
+	10, 4, 3, 5, 3, 5, 5, 5, 226, 10, 5, 3, 5, 7, 5, 229, 10, 5, 12, 5, 14,
+	5, 232, 11, 5, 3, 6, 3, 6, 3, 6, 3, 6, 5, 6, 238, 10, 6, 3, 6, 3, 6, 3,
+	6, 5, 6, 243, 10, 6, 3, 6, 5, 6, 246, 10, 6, 3, 7, 3, 7, 5, 7, 250, 10,
+	7, 3, 8, 3, 8, 5, 8, 254, 10, 8, 7, 8, 256, 10, 8, 12, 8, 14, 8, 259, 11,
+	8, 3, 8, 3, 8, 3, 8, 5, 8, 264, 10, 8, 7, 8, 266, 10, 8, 12, 8, 14, 8,
+	269, 11, 8, 3, 8, 3, 8, 5, 8, 273, 10, 8, 3, 8, 7, 8, 276, 10, 8, 12, 8,
+	14, 8, 279, 11, 8, 3, 8, 5, 8, 282, 10, 8, 3, 8, 5, 8, 285, 10, 8, 5, 8,
+	287, 10, 8, 3, 9, 3, 9, 5, 9, 291, 10, 9, 7, 9, 293, 10, 9, 12, 9, 14,
+	9, 296, 11, 9, 3, 9, 3, 9, 5, 9, 300, 10, 9, 7, 9, 302, 10, 9, 12, 9, 14,
+	9, 305, 11, 9, 3, 9, 3, 9, 5, 9, 309, 10, 9, 6, 9, 311, 10, 9, 13, 9, 14,
+	9, 312, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 322, 10,
+	10, 3, 11, 3, 11, 3, 11, 5, 11, 327, 10, 11, 3, 12, 3, 12, 5, 12, 331,
+	10, 12, 3, 12, 3, 12, 5, 12, 335, 10, 12, 3, 12, 3, 12, 5, 12, 339, 10,
+	12, 3, 12, 5, 12, 342, 10, 12, 3, 13, 3, 13, 5, 13, 346, 10, 13, 3, 13,
+	3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 5, 14, 356, 10, 14, 3,
+	14, 3, 14, 3, 14, 7, 14, 361, 10, 14, 12, 14, 14, 14, 364, 11, 14, 3, 15,
+	3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 5, 15, 376,
+	10, 15, 3, 16, 3, 16, 5, 16, 380, 10, 16, 3, 16, 3, 16, 3, 17, 3, 17, 5,
+	17, 386, 10, 17, 3, 17, 3, 17, 3, 17, 7, 17, 391, 10, 17, 12, 17, 14, 17,
+	394, 11, 17, 3, 18, 3, 18, 5, 18, 398, 10, 18, 3, 18, 3, 18, 5, 18, 402,
+	10, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 408, 10, 18, 3, 18, 3, 18, 5,
+	18, 412, 10, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 418, 10, 18, 3, 18,
+	3, 18, 5, 18, 422, 10, 18, 3, 18, 3, 18, 3, 18, 3, 18, 5, 18, 428, 10,
+	18, 3, 18, 3, 18, 5, 18, 432, 10, 18, 3, 19, 3, 19, 5, 19, 436, 10, 19,
+	3, 19, 3, 19, 5, 19, 440, 10, 19, 3, 19, 3, 19, 5, 19, 444, 10, 19, 3,
+	19, 3, 19, 5, 19, 448, 10, 19, 3, 19, 7, 19, 451, 10, 19, 12, 19, 14, 19,
+	454, 11, 19, 3, 20, 3, 20, 3, 20, 3, 20, 5, 20, 460, 10, 20, 3, 20, 3,
+	20, 5, 20, 464, 10, 20, 3, 20, 7, 20, 467, 10, 20, 12, 20, 14, 20, 470,
+	11, 20, 3, 21, 3, 21, 3, 21, 3, 21, 5, 21, 476, 10, 21, 3, 22, 3, 22, 3,
+	22, 3, 22, 5, 22, 482, 10, 22, 3, 22, 3, 22, 3, 22, 5, 22, 487, 10, 22,
+	3, 23, 3, 23, 3, 23, 3, 23, 5, 23, 493, 10, 23, 3, 23, 3, 23, 3, 23, 3,
+	23, 5, 23, 499, 10, 23, 3, 24, 3, 24, 3, 24, 5, 24, 504, 10, 24, 3, 24,
+	3, 24, 5, 24, 508, 10, 24, 3, 24, 7, 24, 511, 10, 24, 12, 24, 14, 24, 514,
+	11, 24, 5, 24, 516, 10, 24, 3, 24, 5, 24, 519, 10, 24, 3, 24, 5, 24, 522,
+	10, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 5, 25, 529, 10, 25, 3, 25, 3,
+	25, 3, 26, 3, 26, 3, 26, 5, 26, 536, 10, 26, 3, 26, 5, 26, 539, 10, 26,
+	3, 27, 3, 27, 3, 27, 3, 28, 5, 28, 545, 10, 28, 3, 28, 5, 28, 548, 10,
+	28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 554, 10, 28, 3, 28, 3, 28, 5, 28,
+	558, 10, 28, 3, 28, 3, 28, 5, 28, 562, 10, 28, 3, 29, 3, 29, 5, 29, 566,
+	10, 29, 3, 29, 3, 29, 5, 29, 570, 10, 29, 3, 29, 7, 29, 573, 10, 29, 12,
+	29, 14, 29, 576, 11, 29, 3, 29, 3, 29, 5, 29, 580, 10, 29, 3, 29, 3, 29,
+	5, 29, 584, 10, 29, 3, 29, 7, 29, 587, 10, 29, 12, 29, 14, 29, 590, 11,
+	29, 5, 29, 592, 10, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30,
+	5, 30, 601, 10, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 5,
+	31, 610, 10, 31, 3, 31, 7, 31, 613, 10, 31, 12, 31, 14, 31, 616, 11, 31,
+	3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 5,
+	34, 628, 10, 34, 3, 34, 5, 34, 631, 10, 34, 3, 35, 3, 35, 3, 35, 3, 35,
+	3, 36, 3, 36, 5, 36, 639, 10, 36, 3, 36, 3, 36, 5, 36, 643, 10, 36, 3,
+	36, 7, 36, 646, 10, 36, 12, 36, 14, 36, 649, 11, 36, 3, 37, 3, 37, 5, 37,
+	653, 10, 37, 3, 37, 3, 37, 5, 37, 657, 10, 37, 3, 37, 3, 37, 3, 37, 5,
+	37, 662, 10, 37, 3, 38, 3, 38, 5, 38, 666, 10, 38, 3, 39, 3, 39, 5, 39,
+	670, 10, 39, 3, 39, 3, 39, 5, 39, 674, 10, 39, 3, 39, 3, 39, 5, 39, 678,
+	10, 39, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 684, 10, 39, 3, 39, 3, 39, 5,
+	39, 688, 10, 39, 3, 39, 3, 39, 5, 39, 692, 10, 39, 3, 39, 3, 39, 5, 39,
+	696, 10, 39, 3, 40, 3, 40, 5, 40, 700, 10, 40, 3, 40, 7, 40, 703, 10, 40,
+	12, 40, 14, 40, 706, 11, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 712, 10,
+	40, 3, 41, 3, 41, 5, 41, 716, 10, 41, 3, 41, 3, 41, 5, 41, 720, 10, 41,
+	5, 41, 722, 10, 41, 3, 41, 3, 41, 5, 41, 726, 10, 41, 5, 41, 728, 10, 41,
+	3, 41, 3, 41, 5, 41, 732, 10, 41, 5, 41, 734, 10, 41, 3, 41, 3, 41, 3,
+	42, 3, 42, 5, 42, 740, 10, 42, 3, 42, 3, 42, 3, 43, 3, 43, 5, 43, 746,
+	10, 43, 3, 43, 3, 43, 5, 43, 750, 10, 43, 3, 43, 5, 43, 753, 10, 43, 3,
+	43, 5, 43, 756, 10, 43, 3, 43, 3, 43, 5, 43, 760, 10, 43, 3, 43, 3, 43,
+	3, 43, 3, 43, 5, 43, 766, 10, 43, 3, 43, 3, 43, 5, 43, 770, 10, 43, 3,
+	43, 5, 43, 773, 10, 43, 3, 43, 5, 43, 776, 10, 43, 3, 43, 3, 43, 3, 43,
+	3, 43, 5, 43, 782, 10, 43, 3, 43, 5, 43, 785, 10, 43, 3, 43, 5, 43, 788,
+	10, 43, 3, 43, 3, 43, 5, 43, 792, 10, 43, 3, 43, 3, 43, 3, 43, 3, 43, 5,
+	43, 798, 10, 43, 3, 43, 5, 43, 801, 10, 43, 3, 43, 5, 43, 804, 10, 43,
+	3, 43, 3, 43, 5, 43, 808, 10, 43, 3, 44, 3, 44, 5, 44, 812, 10, 44, 3,
+	44, 3, 44, 5, 44, 816, 10, 44, 5, 44, 818, 10, 44, 3, 44, 3, 44, 5, 44,
+	822, 10, 44, 5, 44, 824, 10, 44, 3, 44, 5, 44, 827, 10, 44, 3, 44, 3, 44,
+	5, 44, 831, 10, 44, 5, 44, 833, 10, 44, 3, 44, 3, 44, 3, 45, 3, 45, 5,
+	45, 839, 10, 45, 3, 46, 3, 46, 5, 46, 843, 10, 46, 3, 46, 3, 46, 5, 46,
+	847, 10, 46, 3, 46, 3, 46, 5, 46, 851, 10, 46, 3, 46, 5, 46, 854, 10, 46,
+	3, 46, 7, 46, 857, 10, 46, 12, 46, 14, 46, 860, 11, 46, 3, 47, 3, 47, 5,
+	47, 864, 10, 47, 3, 47, 7, 47, 867, 10, 47, 12, 47, 14, 47, 870, 11, 47,
+	3, 48, 3, 48, 5, 48, 874, 10, 48, 3, 48, 3, 48, 3, 49, 3, 49, 5, 49, 880,
+	10, 49, 3, 49, 3, 49, 5, 49, 884, 10, 49, 5, 49, 886, 10, 49, 3, 49, 3,
+	49, 5, 49, 890, 10, 49, 3, 49, 3, 49, 5, 49, 894, 10, 49, 5, 49, 896, 10,
+	49, 5, 49, 898, 10, 49, 3, 50, 3, 50, 3, 51, 3, 51, 3, 52, 3, 52, 3, 53,
+	3, 53, 3, 53, 3, 53, 3, 53, 7, 53, 911, 10, 53, 12, 53, 14, 53, 914, 11,
+	53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 7, 54, 921, 10, 54, 12, 54, 14,
+	54, 924, 11, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 7, 55, 931, 10, 55,
+	12, 55, 14, 55, 934, 11, 55, 3, 56, 3, 56, 5, 56, 938, 10, 56, 7, 56, 940,
+	10, 56, 12, 56, 14, 56, 943, 11, 56, 3, 56, 3, 56, 3, 57, 3, 57, 5, 57,
+	949, 10, 57, 3, 57, 7, 57, 952, 10, 57, 12, 57, 14, 57, 955, 11, 57, 3,
+	58, 3, 58, 5, 58, 959, 10, 58, 3, 58, 3, 58, 5, 58, 963, 10, 58, 3, 58,
+	3, 58, 5, 58, 967, 10, 58, 3, 58, 3, 58, 5, 58, 971, 10, 58, 3, 58, 7,
+	58, 974, 10, 58, 12, 58, 14, 58, 977, 11, 58, 3, 59, 3, 59, 5, 59, 981,
+	10, 59, 3, 59, 3, 59, 5, 59, 985, 10, 59, 3, 59, 3, 59, 5, 59, 989, 10,
+	59, 3, 59, 3, 59, 5, 59, 993, 10, 59, 3, 59, 3, 59, 5, 59, 997, 10, 59,
+	3, 59, 3, 59, 5, 59, 1001, 10, 59, 3, 59, 7, 59, 1004, 10, 59, 12, 59,
+	14, 59, 1007, 11, 59, 3, 60, 3, 60, 5, 60, 1011, 10, 60, 3, 60, 3, 60,
+	5, 60, 1015, 10, 60, 3, 60, 7, 60, 1018, 10, 60, 12, 60, 14, 60, 1021,
+	11, 60, 3, 61, 3, 61, 5, 61, 1025, 10, 61, 7, 61, 1027, 10, 61, 12, 61,
+	14, 61, 1030, 11, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 7, 62,
+	1038, 10, 62, 12, 62, 14, 62, 1041, 11, 62, 3, 63, 3, 63, 3, 63, 5, 63,
+	1046, 10, 63, 3, 63, 3, 63, 5, 63, 1050, 10, 63, 3, 63, 3, 63, 3, 63, 3,
+	63, 3, 63, 5, 63, 1057, 10, 63, 3, 63, 3, 63, 5, 63, 1061, 10, 63, 3, 63,
+	3, 63, 5, 63, 1065, 10, 63, 3, 63, 5, 63, 1068, 10, 63, 3, 64, 3, 64, 3,
+	64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 5, 64, 1080, 10, 64,
+	3, 64, 5, 64, 1083, 10, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3,
+	65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 5, 65, 1097, 10, 65, 3, 66, 3, 66,
+	5, 66, 1101, 10, 66, 3, 66, 7, 66, 1104, 10, 66, 12, 66, 14, 66, 1107,
+	11, 66, 3, 66, 5, 66, 1110, 10, 66, 3, 66, 5, 66, 1113, 10, 66, 3, 67,
+	3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1120, 10, 67, 3, 67, 3, 67, 5, 67, 1124,
+	10, 67, 3, 67, 3, 67, 5, 67, 1128, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67,
+	3, 67, 5, 67, 1135, 10, 67, 3, 67, 3, 67, 5, 67, 1139, 10, 67, 3, 67, 3,
+	67, 5, 67, 1143, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1149, 10, 67,
+	3, 67, 3, 67, 5, 67, 1153, 10, 67, 3, 67, 3, 67, 5, 67, 1157, 10, 67, 3,
+	67, 3, 67, 3, 67, 3, 67, 5, 67, 1163, 10, 67, 3, 67, 3, 67, 5, 67, 1167,
+	10, 67, 3, 67, 3, 67, 5, 67, 1171, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67,
+	5, 67, 1177, 10, 67, 3, 67, 3, 67, 5, 67, 1181, 10, 67, 3, 67, 3, 67, 5,
+	67, 1185, 10, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 5, 67, 1193,
+	10, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 5, 68, 1201, 10, 68,
+	3, 69, 3, 69, 3, 70, 3, 70, 5, 70, 1207, 10, 70, 3, 70, 3, 70, 5, 70, 1211,
+	10, 70, 3, 70, 3, 70, 5, 70, 1215, 10, 70, 3, 70, 3, 70, 5, 70, 1219, 10,
+	70, 7, 70, 1221, 10, 70, 12, 70, 14, 70, 1224, 11, 70, 5, 70, 1226, 10,
+	70, 3, 70, 3, 70, 3, 71, 3, 71, 5, 71, 1232, 10, 71, 3, 71, 3, 71, 3, 71,
+	5, 71, 1237, 10, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1242, 10, 71, 3, 71, 3,
+	71, 3, 71, 5, 71, 1247, 10, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1252, 10, 71,
+	3, 71, 3, 71, 3, 71, 5, 71, 1257, 10, 71, 3, 71, 3, 71, 3, 71, 5, 71, 1262,
+	10, 71, 3, 71, 5, 71, 1265, 10, 71, 3, 72, 3, 72, 5, 72, 1269, 10, 72,
+	3, 72, 3, 72, 5, 72, 1273, 10, 72, 3, 72, 3, 72, 3, 73, 3, 73, 5, 73, 1279,
+	10, 73, 3, 73, 6, 73, 1282, 10, 73, 13, 73, 14, 73, 1283, 3, 74, 3, 74,
+	5, 74, 1288, 10, 74, 3, 74, 5, 74, 1291, 10, 74, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 76, 3, 76, 5, 76, 1301, 10, 76, 3, 76, 3, 76, 5, 76,
+	1305, 10, 76, 3, 76, 3, 76, 5, 76, 1309, 10, 76, 5, 76, 1311, 10, 76, 3,
+	76, 3, 76, 5, 76, 1315, 10, 76, 3, 76, 3, 76, 5, 76, 1319, 10, 76, 3, 76,
+	3, 76, 5, 76, 1323, 10, 76, 7, 76, 1325, 10, 76, 12, 76, 14, 76, 1328,
+	11, 76, 5, 76, 1330, 10, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 3, 77,
+	5, 77, 1338, 10, 77, 3, 78, 3, 78, 5, 78, 1342, 10, 78, 3, 78, 3, 78, 5,
+	78, 1346, 10, 78, 3, 78, 3, 78, 5, 78, 1350, 10, 78, 3, 78, 3, 78, 5, 78,
+	1354, 10, 78, 3, 78, 3, 78, 5, 78, 1358, 10, 78, 7, 78, 1360, 10, 78, 12,
+	78, 14, 78, 1363, 11, 78, 5, 78, 1365, 10, 78, 3, 78, 3, 78, 3, 79, 3,
+	79, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 7, 82, 1379,
+	10, 82, 12, 82, 14, 82, 1382, 11, 82, 3, 83, 3, 83, 5, 83, 1386, 10, 83,
+	3, 83, 3, 83, 5, 83, 1390, 10, 83, 3, 83, 3, 83, 5, 83, 1394, 10, 83, 3,
+	83, 5, 83, 1397, 10, 83, 3, 83, 5, 83, 1400, 10, 83, 3, 83, 3, 83, 3, 84,
+	3, 84, 5, 84, 1406, 10, 84, 3, 84, 3, 84, 5, 84, 1410, 10, 84, 3, 84, 3,
+	84, 5, 84, 1414, 10, 84, 5, 84, 1416, 10, 84, 3, 84, 3, 84, 5, 84, 1420,
+	10, 84, 3, 84, 3, 84, 5, 84, 1424, 10, 84, 3, 84, 3, 84, 5, 84, 1428, 10,
+	84, 5, 84, 1430, 10, 84, 3, 84, 3, 84, 5, 84, 1434, 10, 84, 3, 84, 3, 84,
+	5, 84, 1438, 10, 84, 3, 84, 3, 84, 3, 85, 3, 85, 5, 85, 1444, 10, 85, 3,
+	85, 3, 85, 3, 86, 3, 86, 5, 86, 1450, 10, 86, 3, 86, 6, 86, 1453, 10, 86,
+	13, 86, 14, 86, 1454, 3, 86, 3, 86, 5, 86, 1459, 10, 86, 3, 86, 3, 86,
+	5, 86, 1463, 10, 86, 3, 86, 6, 86, 1466, 10, 86, 13, 86, 14, 86, 1467,
+	5, 86, 1470, 10, 86, 3, 86, 5, 86, 1473, 10, 86, 3, 86, 3, 86, 5, 86, 1477,
+	10, 86, 3, 86, 5, 86, 1480, 10, 86, 3, 86, 5, 86, 1483, 10, 86, 3, 86,
+	3, 86, 3, 87, 3, 87, 5, 87, 1489, 10, 87, 3, 87, 3, 87, 5, 87, 1493, 10,
+	87, 3, 87, 3, 87, 5, 87, 1497, 10, 87, 3, 87, 3, 87, 3, 88, 3, 88, 3, 89,
+	3, 89, 5, 89, 1505, 10, 89, 3, 90, 3, 90, 5, 90, 1509, 10, 90, 3, 90, 3,
+	90, 5, 90, 1513, 10, 90, 3, 90, 3, 90, 5, 90, 1517, 10, 90, 3, 90, 3, 90,
+	5, 90, 1521, 10, 90, 3, 90, 3, 90, 5, 90, 1525, 10, 90, 3, 90, 3, 90, 5,
+	90, 1529, 10, 90, 3, 90, 3, 90, 5, 90, 1533, 10, 90, 3, 90, 3, 90, 5, 90,
+	1537, 10, 90, 7, 90, 1539, 10, 90, 12, 90, 14, 90, 1542, 11, 90, 5, 90,
+	1544, 10, 90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 5, 91, 1551, 10, 91, 3,
+	92, 3, 92, 5, 92, 1555, 10, 92, 3, 92, 6, 92, 1558, 10, 92, 13, 92, 14,
+	92, 1559, 3, 93, 3, 93, 3, 94, 3, 94, 3, 95, 3, 95, 3, 96, 3, 96, 5, 96,
+	1570, 10, 96, 3, 97, 3, 97, 3, 98, 3, 98, 3, 99, 3, 99, 3, 100, 3, 100,
+	3, 101, 3, 101, 3, 101, 2, 2, 102, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20,
+	22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56,
+	58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92,
+	94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122,
+	124, 126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152,
+	154, 156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182,
+	184, 186, 188, 190, 192, 194, 196, 198, 200, 2, 12, 3, 2, 71, 74, 3, 2,
+	15, 16, 3, 2, 92, 93, 3, 2, 102, 104, 3, 2, 112, 113, 7, 2, 49, 61, 64,
+	75, 78, 87, 92, 99, 114, 123, 7, 2, 76, 77, 88, 91, 105, 105, 124, 126,
+	129, 129, 4, 2, 21, 21, 30, 33, 4, 2, 22, 22, 34, 37, 4, 2, 16, 16, 38,
+	48, 2, 1806, 2, 203, 3, 2, 2, 2, 4, 217, 3, 2, 2, 2, 6, 221, 3, 2, 2, 2,
+	8, 223, 3, 2, 2, 2, 10, 245, 3, 2, 2, 2, 12, 249, 3, 2, 2, 2, 14, 286,
+	3, 2, 2, 2, 16, 310, 3, 2, 2, 2, 18, 321, 3, 2, 2, 2, 20, 326, 3, 2, 2,
+	2, 22, 330, 3, 2, 2, 2, 24, 343, 3, 2, 2, 2, 26, 353, 3, 2, 2, 2, 28, 375,
+	3, 2, 2, 2, 30, 377, 3, 2, 2, 2, 32, 383, 3, 2, 2, 2, 34, 431, 3, 2, 2,
+	2, 36, 435, 3, 2, 2, 2, 38, 455, 3, 2, 2, 2, 40, 475, 3, 2, 2, 2, 42, 477,
+	3, 2, 2, 2, 44, 488, 3, 2, 2, 2, 46, 515, 3, 2, 2, 2, 48, 528, 3, 2, 2,
+	2, 50, 532, 3, 2, 2, 2, 52, 540, 3, 2, 2, 2, 54, 547, 3, 2, 2, 2, 56, 591,
+	3, 2, 2, 2, 58, 600, 3, 2, 2, 2, 60, 602, 3, 2, 2, 2, 62, 617, 3, 2, 2,
+	2, 64, 621, 3, 2, 2, 2, 66, 625, 3, 2, 2, 2, 68, 632, 3, 2, 2, 2, 70, 636,
+	3, 2, 2, 2, 72, 661, 3, 2, 2, 2, 74, 665, 3, 2, 2, 2, 76, 695, 3, 2, 2,
+	2, 78, 711, 3, 2, 2, 2, 80, 713, 3, 2, 2, 2, 82, 737, 3, 2, 2, 2, 84, 807,
+	3, 2, 2, 2, 86, 809, 3, 2, 2, 2, 88, 838, 3, 2, 2, 2, 90, 840, 3, 2, 2,
+	2, 92, 861, 3, 2, 2, 2, 94, 871, 3, 2, 2, 2, 96, 877, 3, 2, 2, 2, 98, 899,
+	3, 2, 2, 2, 100, 901, 3, 2, 2, 2, 102, 903, 3, 2, 2, 2, 104, 905, 3, 2,
+	2, 2, 106, 915, 3, 2, 2, 2, 108, 925, 3, 2, 2, 2, 110, 941, 3, 2, 2, 2,
+	112, 946, 3, 2, 2, 2, 114, 956, 3, 2, 2, 2, 116, 978, 3, 2, 2, 2, 118,
+	1008, 3, 2, 2, 2, 120, 1028, 3, 2, 2, 2, 122, 1033, 3, 2, 2, 2, 124, 1067,
+	3, 2, 2, 2, 126, 1079, 3, 2, 2, 2, 128, 1096, 3, 2, 2, 2, 130, 1098, 3,
+	2, 2, 2, 132, 1192, 3, 2, 2, 2, 134, 1200, 3, 2, 2, 2, 136, 1202, 3, 2,
+	2, 2, 138, 1204, 3, 2, 2, 2, 140, 1264, 3, 2, 2, 2, 142, 1266, 3, 2, 2,
+	2, 144, 1276, 3, 2, 2, 2, 146, 1285, 3, 2, 2, 2, 148, 1292, 3, 2, 2, 2,
+	150, 1298, 3, 2, 2, 2, 152, 1337, 3, 2, 2, 2, 154, 1339, 3, 2, 2, 2, 156,
+	1368, 3, 2, 2, 2, 158, 1370, 3, 2, 2, 2, 160, 1372, 3, 2, 2, 2, 162, 1380,
+	3, 2, 2, 2, 164, 1383, 3, 2, 2, 2, 166, 1403, 3, 2, 2, 2, 168, 1441, 3,
+	2, 2, 2, 170, 1469, 3, 2, 2, 2, 172, 1486, 3, 2, 2, 2, 174, 1500, 3, 2,
+	2, 2, 176, 1504, 3, 2, 2, 2, 178, 1506, 3, 2, 2, 2, 180, 1547, 3, 2, 2,
+	2, 182, 1552, 3, 2, 2, 2, 184, 1561, 3, 2, 2, 2, 186, 1563, 3, 2, 2, 2,
+	188, 1565, 3, 2, 2, 2, 190, 1569, 3, 2, 2, 2, 192, 1571, 3, 2, 2, 2, 194,
+	1573, 3, 2, 2, 2, 196, 1575, 3, 2, 2, 2, 198, 1577, 3, 2, 2, 2, 200, 1579,
+	3, 2, 2, 2, 202, 204, 7, 130, 2, 2, 203, 202, 3, 2, 2, 2, 203, 204, 3,
+	2, 2, 2, 204, 205, 3, 2, 2, 2, 205, 210, 5, 4, 3, 2, 206, 208, 7, 130,
+	2, 2, 207, 206, 3, 2, 2, 2, 207, 208, 3, 2, 2, 2, 208, 209, 3, 2, 2, 2,
+	209, 211, 7, 3, 2, 2, 210, 207, 3, 2, 2, 2, 210, 211, 3, 2, 2, 2, 211,
+	213, 3, 2, 2, 2, 212, 214, 7, 130, 2, 2, 213, 212, 3, 2, 2, 2, 213, 214,
+	3, 2, 2, 2, 214, 215, 3, 2, 2, 2, 215, 216, 7, 2, 2, 3, 216, 3, 3, 2, 2,
+	2, 217, 218, 5, 6, 4, 2, 218, 5, 3, 2, 2, 2, 219, 222, 5, 8, 5, 2, 220,
+	222, 5, 44, 23, 2, 221, 219, 3, 2, 2, 2, 221, 220, 3, 2, 2, 2, 222, 7,
+	3, 2, 2, 2, 223, 230, 5, 12, 7, 2, 224, 226, 7, 130, 2, 2, 225, 224, 3,
+	2, 2, 2, 225, 226, 3, 2, 2, 2, 226, 227, 3, 2, 2, 2, 227, 229, 5, 10, 6,
+	2, 228, 225, 3, 2, 2, 2, 229, 232, 3, 2, 2, 2, 230, 228, 3, 2, 2, 2, 230,
+	231, 3, 2, 2, 2, 231, 9, 3, 2, 2, 2, 232, 230, 3, 2, 2, 2, 233, 234, 7,
+	49, 2, 2, 234, 235, 7, 130, 2, 2, 235, 237, 7, 50, 2, 2, 236, 238, 7, 130,
+	2, 2, 237, 236, 3, 2, 2, 2, 237, 238, 3, 2, 2, 2, 238, 239, 3, 2, 2, 2,
+	239, 246, 5, 12, 7, 2, 240, 242, 7, 49, 2, 2, 241, 243, 7, 130, 2, 2, 242,
+	241, 3, 2, 2, 2, 242, 243, 3, 2, 2, 2, 243, 244, 3, 2, 2, 2, 244, 246,
+	5, 12, 7, 2, 245, 233, 3, 2, 2, 2, 245, 240, 3, 2, 2, 2, 246, 11, 3, 2,
+	2, 2, 247, 250, 5, 14, 8, 2, 248, 250, 5, 16, 9, 2, 249, 247, 3, 2, 2,
+	2, 249, 248, 3, 2, 2, 2, 250, 13, 3, 2, 2, 2, 251, 253, 5, 20, 11, 2, 252,
+	254, 7, 130, 2, 2, 253, 252, 3, 2, 2, 2, 253, 254, 3, 2, 2, 2, 254, 256,
+	3, 2, 2, 2, 255, 251, 3, 2, 2, 2, 256, 259, 3, 2, 2, 2, 257, 255, 3, 2,
+	2, 2, 257, 258, 3, 2, 2, 2, 258, 260, 3, 2, 2, 2, 259, 257, 3, 2, 2, 2,
+	260, 287, 5, 52, 27, 2, 261, 263, 5, 20, 11, 2, 262, 264, 7, 130, 2, 2,
+	263, 262, 3, 2, 2, 2, 263, 264, 3, 2, 2, 2, 264, 266, 3, 2, 2, 2, 265,
+	261, 3, 2, 2, 2, 266, 269, 3, 2, 2, 2, 267, 265, 3, 2, 2, 2, 267, 268,
+	3, 2, 2, 2, 268, 270, 3, 2, 2, 2, 269, 267, 3, 2, 2, 2, 270, 277, 5, 18,
+	10, 2, 271, 273, 7, 130, 2, 2, 272, 271, 3, 2, 2, 2, 272, 273, 3, 2, 2,
+	2, 273, 274, 3, 2, 2, 2, 274, 276, 5, 18, 10, 2, 275, 272, 3, 2, 2, 2,
+	276, 279, 3, 2, 2, 2, 277, 275, 3, 2, 2, 2, 277, 278, 3, 2, 2, 2, 278,
+	284, 3, 2, 2, 2, 279, 277, 3, 2, 2, 2, 280, 282, 7, 130, 2, 2, 281, 280,
+	3, 2, 2, 2, 281, 282, 3, 2, 2, 2, 282, 283, 3, 2, 2, 2, 283, 285, 5, 52,
+	27, 2, 284, 281, 3, 2, 2, 2, 284, 285, 3, 2, 2, 2, 285, 287, 3, 2, 2, 2,
+	286, 257, 3, 2, 2, 2, 286, 267, 3, 2, 2, 2, 287, 15, 3, 2, 2, 2, 288, 290,
+	5, 20, 11, 2, 289, 291, 7, 130, 2, 2, 290, 289, 3, 2, 2, 2, 290, 291, 3,
+	2, 2, 2, 291, 293, 3, 2, 2, 2, 292, 288, 3, 2, 2, 2, 293, 296, 3, 2, 2,
+	2, 294, 292, 3, 2, 2, 2, 294, 295, 3, 2, 2, 2, 295, 303, 3, 2, 2, 2, 296,
+	294, 3, 2, 2, 2, 297, 299, 5, 18, 10, 2, 298, 300, 7, 130, 2, 2, 299, 298,
+	3, 2, 2, 2, 299, 300, 3, 2, 2, 2, 300, 302, 3, 2, 2, 2, 301, 297, 3, 2,
+	2, 2, 302, 305, 3, 2, 2, 2, 303, 301, 3, 2, 2, 2, 303, 304, 3, 2, 2, 2,
+	304, 306, 3, 2, 2, 2, 305, 303, 3, 2, 2, 2, 306, 308, 5, 50, 26, 2, 307,
+	309, 7, 130, 2, 2, 308, 307, 3, 2, 2, 2, 308, 309, 3, 2, 2, 2, 309, 311,
+	3, 2, 2, 2, 310, 294, 3, 2, 2, 2, 311, 312, 3, 2, 2, 2, 312, 310, 3, 2,
+	2, 2, 312, 313, 3, 2, 2, 2, 313, 314, 3, 2, 2, 2, 314, 315, 5, 14, 8, 2,
+	315, 17, 3, 2, 2, 2, 316, 322, 5, 30, 16, 2, 317, 322, 5, 26, 14, 2, 318,
+	322, 5, 36, 19, 2, 319, 322, 5, 32, 17, 2, 320, 322, 5, 38, 20, 2, 321,
+	316, 3, 2, 2, 2, 321, 317, 3, 2, 2, 2, 321, 318, 3, 2, 2, 2, 321, 319,
+	3, 2, 2, 2, 321, 320, 3, 2, 2, 2, 322, 19, 3, 2, 2, 2, 323, 327, 5, 22,
+	12, 2, 324, 327, 5, 24, 13, 2, 325, 327, 5, 42, 22, 2, 326, 323, 3, 2,
+	2, 2, 326, 324, 3, 2, 2, 2, 326, 325, 3, 2, 2, 2, 327, 21, 3, 2, 2, 2,
+	328, 329, 7, 51, 2, 2, 329, 331, 7, 130, 2, 2, 330, 328, 3, 2, 2, 2, 330,
+	331, 3, 2, 2, 2, 331, 332, 3, 2, 2, 2, 332, 334, 7, 52, 2, 2, 333, 335,
+	7, 130, 2, 2, 334, 333, 3, 2, 2, 2, 334, 335, 3, 2, 2, 2, 335, 336, 3,
+	2, 2, 2, 336, 341, 5, 70, 36, 2, 337, 339, 7, 130, 2, 2, 338, 337, 3, 2,
+	2, 2, 338, 339, 3, 2, 2, 2, 339, 340, 3, 2, 2, 2, 340, 342, 5, 68, 35,
+	2, 341, 338, 3, 2, 2, 2, 341, 342, 3, 2, 2, 2, 342, 23, 3, 2, 2, 2, 343,
+	345, 7, 53, 2, 2, 344, 346, 7, 130, 2, 2, 345, 344, 3, 2, 2, 2, 345, 346,
+	3, 2, 2, 2, 346, 347, 3, 2, 2, 2, 347, 348, 5, 102, 52, 2, 348, 349, 7,
+	130, 2, 2, 349, 350, 7, 54, 2, 2, 350, 351, 7, 130, 2, 2, 351, 352, 5,
+	174, 88, 2, 352, 25, 3, 2, 2, 2, 353, 355, 7, 55, 2, 2, 354, 356, 7, 130,
+	2, 2, 355, 354, 3, 2, 2, 2, 355, 356, 3, 2, 2, 2, 356, 357, 3, 2, 2, 2,
+	357, 362, 5, 72, 37, 2, 358, 359, 7, 130, 2, 2, 359, 361, 5, 28, 15, 2,
+	360, 358, 3, 2, 2, 2, 361, 364, 3, 2, 2, 2, 362, 360, 3, 2, 2, 2, 362,
+	363, 3, 2, 2, 2, 363, 27, 3, 2, 2, 2, 364, 362, 3, 2, 2, 2, 365, 366, 7,
+	56, 2, 2, 366, 367, 7, 130, 2, 2, 367, 368, 7, 52, 2, 2, 368, 369, 7, 130,
+	2, 2, 369, 376, 5, 32, 17, 2, 370, 371, 7, 56, 2, 2, 371, 372, 7, 130,
+	2, 2, 372, 373, 7, 57, 2, 2, 373, 374, 7, 130, 2, 2, 374, 376, 5, 32, 17,
+	2, 375, 365, 3, 2, 2, 2, 375, 370, 3, 2, 2, 2, 376, 29, 3, 2, 2, 2, 377,
+	379, 7, 57, 2, 2, 378, 380, 7, 130, 2, 2, 379, 378, 3, 2, 2, 2, 379, 380,
+	3, 2, 2, 2, 380, 381, 3, 2, 2, 2, 381, 382, 5, 70, 36, 2, 382, 31, 3, 2,
+	2, 2, 383, 385, 7, 58, 2, 2, 384, 386, 7, 130, 2, 2, 385, 384, 3, 2, 2,
+	2, 385, 386, 3, 2, 2, 2, 386, 387, 3, 2, 2, 2, 387, 392, 5, 34, 18, 2,
+	388, 389, 7, 4, 2, 2, 389, 391, 5, 34, 18, 2, 390, 388, 3, 2, 2, 2, 391,
+	394, 3, 2, 2, 2, 392, 390, 3, 2, 2, 2, 392, 393, 3, 2, 2, 2, 393, 33, 3,
+	2, 2, 2, 394, 392, 3, 2, 2, 2, 395, 397, 5, 182, 92, 2, 396, 398, 7, 130,
+	2, 2, 397, 396, 3, 2, 2, 2, 397, 398, 3, 2, 2, 2, 398, 399, 3, 2, 2, 2,
+	399, 401, 7, 5, 2, 2, 400, 402, 7, 130, 2, 2, 401, 400, 3, 2, 2, 2, 401,
+	402, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403, 404, 5, 102, 52, 2, 404, 432,
+	3, 2, 2, 2, 405, 407, 5, 174, 88, 2, 406, 408, 7, 130, 2, 2, 407, 406,
+	3, 2, 2, 2, 407, 408, 3, 2, 2, 2, 408, 409, 3, 2, 2, 2, 409, 411, 7, 5,
+	2, 2, 410, 412, 7, 130, 2, 2, 411, 410, 3, 2, 2, 2, 411, 412, 3, 2, 2,
+	2, 412, 413, 3, 2, 2, 2, 413, 414, 5, 102, 52, 2, 414, 432, 3, 2, 2, 2,
+	415, 417, 5, 174, 88, 2, 416, 418, 7, 130, 2, 2, 417, 416, 3, 2, 2, 2,
+	417, 418, 3, 2, 2, 2, 418, 419, 3, 2, 2, 2, 419, 421, 7, 6, 2, 2, 420,
+	422, 7, 130, 2, 2, 421, 420, 3, 2, 2, 2, 421, 422, 3, 2, 2, 2, 422, 423,
+	3, 2, 2, 2, 423, 424, 5, 102, 52, 2, 424, 432, 3, 2, 2, 2, 425, 427, 5,
+	174, 88, 2, 426, 428, 7, 130, 2, 2, 427, 426, 3, 2, 2, 2, 427, 428, 3,
+	2, 2, 2, 428, 429, 3, 2, 2, 2, 429, 430, 5, 92, 47, 2, 430, 432, 3, 2,
+	2, 2, 431, 395, 3, 2, 2, 2, 431, 405, 3, 2, 2, 2, 431, 415, 3, 2, 2, 2,
+	431, 425, 3, 2, 2, 2, 432, 35, 3, 2, 2, 2, 433, 434, 7, 59, 2, 2, 434,
+	436, 7, 130, 2, 2, 435, 433, 3, 2, 2, 2, 435, 436, 3, 2, 2, 2, 436, 437,
+	3, 2, 2, 2, 437, 439, 7, 60, 2, 2, 438, 440, 7, 130, 2, 2, 439, 438, 3,
+	2, 2, 2, 439, 440, 3, 2, 2, 2, 440, 441, 3, 2, 2, 2, 441, 452, 5, 102,
+	52, 2, 442, 444, 7, 130, 2, 2, 443, 442, 3, 2, 2, 2, 443, 444, 3, 2, 2,
+	2, 444, 445, 3, 2, 2, 2, 445, 447, 7, 4, 2, 2, 446, 448, 7, 130, 2, 2,
+	447, 446, 3, 2, 2, 2, 447, 448, 3, 2, 2, 2, 448, 449, 3, 2, 2, 2, 449,
+	451, 5, 102, 52, 2, 450, 443, 3, 2, 2, 2, 451, 454, 3, 2, 2, 2, 452, 450,
+	3, 2, 2, 2, 452, 453, 3, 2, 2, 2, 453, 37, 3, 2, 2, 2, 454, 452, 3, 2,
+	2, 2, 455, 456, 7, 61, 2, 2, 456, 457, 7, 130, 2, 2, 457, 468, 5, 40, 21,
+	2, 458, 460, 7, 130, 2, 2, 459, 458, 3, 2, 2, 2, 459, 460, 3, 2, 2, 2,
+	460, 461, 3, 2, 2, 2, 461, 463, 7, 4, 2, 2, 462, 464, 7, 130, 2, 2, 463,
+	462, 3, 2, 2, 2, 463, 464, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465, 467,
+	5, 40, 21, 2, 466, 459, 3, 2, 2, 2, 467, 470, 3, 2, 2, 2, 468, 466, 3,
+	2, 2, 2, 468, 469, 3, 2, 2, 2, 469, 39, 3, 2, 2, 2, 470, 468, 3, 2, 2,
+	2, 471, 472, 5, 174, 88, 2, 472, 473, 5, 92, 47, 2, 473, 476, 3, 2, 2,
+	2, 474, 476, 5, 182, 92, 2, 475, 471, 3, 2, 2, 2, 475, 474, 3, 2, 2, 2,
+	476, 41, 3, 2, 2, 2, 477, 478, 7, 62, 2, 2, 478, 479, 7, 130, 2, 2, 479,
+	486, 5, 154, 78, 2, 480, 482, 7, 130, 2, 2, 481, 480, 3, 2, 2, 2, 481,
+	482, 3, 2, 2, 2, 482, 483, 3, 2, 2, 2, 483, 484, 7, 63, 2, 2, 484, 485,
+	7, 130, 2, 2, 485, 487, 5, 46, 24, 2, 486, 481, 3, 2, 2, 2, 486, 487, 3,
+	2, 2, 2, 487, 43, 3, 2, 2, 2, 488, 489, 7, 62, 2, 2, 489, 492, 7, 130,
+	2, 2, 490, 493, 5, 154, 78, 2, 491, 493, 5, 156, 79, 2, 492, 490, 3, 2,
+	2, 2, 492, 491, 3, 2, 2, 2, 493, 498, 3, 2, 2, 2, 494, 495, 7, 130, 2,
+	2, 495, 496, 7, 63, 2, 2, 496, 497, 7, 130, 2, 2, 497, 499, 5, 46, 24,
+	2, 498, 494, 3, 2, 2, 2, 498, 499, 3, 2, 2, 2, 499, 45, 3, 2, 2, 2, 500,
+	516, 7, 7, 2, 2, 501, 512, 5, 48, 25, 2, 502, 504, 7, 130, 2, 2, 503, 502,
+	3, 2, 2, 2, 503, 504, 3, 2, 2, 2, 504, 505, 3, 2, 2, 2, 505, 507, 7, 4,
+	2, 2, 506, 508, 7, 130, 2, 2, 507, 506, 3, 2, 2, 2, 507, 508, 3, 2, 2,
+	2, 508, 509, 3, 2, 2, 2, 509, 511, 5, 48, 25, 2, 510, 503, 3, 2, 2, 2,
+	511, 514, 3, 2, 2, 2, 512, 510, 3, 2, 2, 2, 512, 513, 3, 2, 2, 2, 513,
+	516, 3, 2, 2, 2, 514, 512, 3, 2, 2, 2, 515, 500, 3, 2, 2, 2, 515, 501,
+	3, 2, 2, 2, 516, 521, 3, 2, 2, 2, 517, 519, 7, 130, 2, 2, 518, 517, 3,
+	2, 2, 2, 518, 519, 3, 2, 2, 2, 519, 520, 3, 2, 2, 2, 520, 522, 5, 68, 35,
+	2, 521, 518, 3, 2, 2, 2, 521, 522, 3, 2, 2, 2, 522, 47, 3, 2, 2, 2, 523,
+	524, 5, 158, 80, 2, 524, 525, 7, 130, 2, 2, 525, 526, 7, 54, 2, 2, 526,
+	527, 7, 130, 2, 2, 527, 529, 3, 2, 2, 2, 528, 523, 3, 2, 2, 2, 528, 529,
+	3, 2, 2, 2, 529, 530, 3, 2, 2, 2, 530, 531, 5, 174, 88, 2, 531, 49, 3,
+	2, 2, 2, 532, 533, 7, 64, 2, 2, 533, 538, 5, 54, 28, 2, 534, 536, 7, 130,
+	2, 2, 535, 534, 3, 2, 2, 2, 535, 536, 3, 2, 2, 2, 536, 537, 3, 2, 2, 2,
+	537, 539, 5, 68, 35, 2, 538, 535, 3, 2, 2, 2, 538, 539, 3, 2, 2, 2, 539,
+	51, 3, 2, 2, 2, 540, 541, 7, 65, 2, 2, 541, 542, 5, 54, 28, 2, 542, 53,
+	3, 2, 2, 2, 543, 545, 7, 130, 2, 2, 544, 543, 3, 2, 2, 2, 544, 545, 3,
+	2, 2, 2, 545, 546, 3, 2, 2, 2, 546, 548, 7, 66, 2, 2, 547, 544, 3, 2, 2,
+	2, 547, 548, 3, 2, 2, 2, 548, 549, 3, 2, 2, 2, 549, 550, 7, 130, 2, 2,
+	550, 553, 5, 56, 29, 2, 551, 552, 7, 130, 2, 2, 552, 554, 5, 60, 31, 2,
+	553, 551, 3, 2, 2, 2, 553, 554, 3, 2, 2, 2, 554, 557, 3, 2, 2, 2, 555,
+	556, 7, 130, 2, 2, 556, 558, 5, 62, 32, 2, 557, 555, 3, 2, 2, 2, 557, 558,
+	3, 2, 2, 2, 558, 561, 3, 2, 2, 2, 559, 560, 7, 130, 2, 2, 560, 562, 5,
+	64, 33, 2, 561, 559, 3, 2, 2, 2, 561, 562, 3, 2, 2, 2, 562, 55, 3, 2, 2,
+	2, 563, 574, 7, 7, 2, 2, 564, 566, 7, 130, 2, 2, 565, 564, 3, 2, 2, 2,
+	565, 566, 3, 2, 2, 2, 566, 567, 3, 2, 2, 2, 567, 569, 7, 4, 2, 2, 568,
+	570, 7, 130, 2, 2, 569, 568, 3, 2, 2, 2, 569, 570, 3, 2, 2, 2, 570, 571,
+	3, 2, 2, 2, 571, 573, 5, 58, 30, 2, 572, 565, 3, 2, 2, 2, 573, 576, 3,
+	2, 2, 2, 574, 572, 3, 2, 2, 2, 574, 575, 3, 2, 2, 2, 575, 592, 3, 2, 2,
+	2, 576, 574, 3, 2, 2, 2, 577, 588, 5, 58, 30, 2, 578, 580, 7, 130, 2, 2,
+	579, 578, 3, 2, 2, 2, 579, 580, 3, 2, 2, 2, 580, 581, 3, 2, 2, 2, 581,
+	583, 7, 4, 2, 2, 582, 584, 7, 130, 2, 2, 583, 582, 3, 2, 2, 2, 583, 584,
+	3, 2, 2, 2, 584, 585, 3, 2, 2, 2, 585, 587, 5, 58, 30, 2, 586, 579, 3,
+	2, 2, 2, 587, 590, 3, 2, 2, 2, 588, 586, 3, 2, 2, 2, 588, 589, 3, 2, 2,
+	2, 589, 592, 3, 2, 2, 2, 590, 588, 3, 2, 2, 2, 591, 563, 3, 2, 2, 2, 591,
+	577, 3, 2, 2, 2, 592, 57, 3, 2, 2, 2, 593, 594, 5, 102, 52, 2, 594, 595,
+	7, 130, 2, 2, 595, 596, 7, 54, 2, 2, 596, 597, 7, 130, 2, 2, 597, 598,
+	5, 174, 88, 2, 598, 601, 3, 2, 2, 2, 599, 601, 5, 102, 52, 2, 600, 593,
+	3, 2, 2, 2, 600, 599, 3, 2, 2, 2, 601, 59, 3, 2, 2, 2, 602, 603, 7, 67,
+	2, 2, 603, 604, 7, 130, 2, 2, 604, 605, 7, 68, 2, 2, 605, 606, 7, 130,
+	2, 2, 606, 614, 5, 66, 34, 2, 607, 609, 7, 4, 2, 2, 608, 610, 7, 130, 2,
+	2, 609, 608, 3, 2, 2, 2, 609, 610, 3, 2, 2, 2, 610, 611, 3, 2, 2, 2, 611,
+	613, 5, 66, 34, 2, 612, 607, 3, 2, 2, 2, 613, 616, 3, 2, 2, 2, 614, 612,
+	3, 2, 2, 2, 614, 615, 3, 2, 2, 2, 615, 61, 3, 2, 2, 2, 616, 614, 3, 2,
+	2, 2, 617, 618, 7, 69, 2, 2, 618, 619, 7, 130, 2, 2, 619, 620, 5, 102,
+	52, 2, 620, 63, 3, 2, 2, 2, 621, 622, 7, 70, 2, 2, 622, 623, 7, 130, 2,
+	2, 623, 624, 5, 102, 52, 2, 624, 65, 3, 2, 2, 2, 625, 630, 5, 102, 52,
+	2, 626, 628, 7, 130, 2, 2, 627, 626, 3, 2, 2, 2, 627, 628, 3, 2, 2, 2,
+	628, 629, 3, 2, 2, 2, 629, 631, 9, 2, 2, 2, 630, 627, 3, 2, 2, 2, 630,
+	631, 3, 2, 2, 2, 631, 67, 3, 2, 2, 2, 632, 633, 7, 75, 2, 2, 633, 634,
+	7, 130, 2, 2, 634, 635, 5, 102, 52, 2, 635, 69, 3, 2, 2, 2, 636, 647, 5,
+	72, 37, 2, 637, 639, 7, 130, 2, 2, 638, 637, 3, 2, 2, 2, 638, 639, 3, 2,
+	2, 2, 639, 640, 3, 2, 2, 2, 640, 642, 7, 4, 2, 2, 641, 643, 7, 130, 2,
+	2, 642, 641, 3, 2, 2, 2, 642, 643, 3, 2, 2, 2, 643, 644, 3, 2, 2, 2, 644,
+	646, 5, 72, 37, 2, 645, 638, 3, 2, 2, 2, 646, 649, 3, 2, 2, 2, 647, 645,
+	3, 2, 2, 2, 647, 648, 3, 2, 2, 2, 648, 71, 3, 2, 2, 2, 649, 647, 3, 2,
+	2, 2, 650, 652, 5, 174, 88, 2, 651, 653, 7, 130, 2, 2, 652, 651, 3, 2,
+	2, 2, 652, 653, 3, 2, 2, 2, 653, 654, 3, 2, 2, 2, 654, 656, 7, 5, 2, 2,
+	655, 657, 7, 130, 2, 2, 656, 655, 3, 2, 2, 2, 656, 657, 3, 2, 2, 2, 657,
+	658, 3, 2, 2, 2, 658, 659, 5, 74, 38, 2, 659, 662, 3, 2, 2, 2, 660, 662,
+	5, 74, 38, 2, 661, 650, 3, 2, 2, 2, 661, 660, 3, 2, 2, 2, 662, 73, 3, 2,
+	2, 2, 663, 666, 5, 76, 39, 2, 664, 666, 5, 78, 40, 2, 665, 663, 3, 2, 2,
+	2, 665, 664, 3, 2, 2, 2, 666, 75, 3, 2, 2, 2, 667, 669, 7, 76, 2, 2, 668,
+	670, 7, 130, 2, 2, 669, 668, 3, 2, 2, 2, 669, 670, 3, 2, 2, 2, 670, 671,
+	3, 2, 2, 2, 671, 673, 7, 8, 2, 2, 672, 674, 7, 130, 2, 2, 673, 672, 3,
+	2, 2, 2, 673, 674, 3, 2, 2, 2, 674, 675, 3, 2, 2, 2, 675, 677, 5, 78, 40,
+	2, 676, 678, 7, 130, 2, 2, 677, 676, 3, 2, 2, 2, 677, 678, 3, 2, 2, 2,
+	678, 679, 3, 2, 2, 2, 679, 680, 7, 9, 2, 2, 680, 696, 3, 2, 2, 2, 681,
+	683, 7, 77, 2, 2, 682, 684, 7, 130, 2, 2, 683, 682, 3, 2, 2, 2, 683, 684,
+	3, 2, 2, 2, 684, 685, 3, 2, 2, 2, 685, 687, 7, 8, 2, 2, 686, 688, 7, 130,
+	2, 2, 687, 686, 3, 2, 2, 2, 687, 688, 3, 2, 2, 2, 688, 689, 3, 2, 2, 2,
+	689, 691, 5, 78, 40, 2, 690, 692, 7, 130, 2, 2, 691, 690, 3, 2, 2, 2, 691,
+	692, 3, 2, 2, 2, 692, 693, 3, 2, 2, 2, 693, 694, 7, 9, 2, 2, 694, 696,
+	3, 2, 2, 2, 695, 667, 3, 2, 2, 2, 695, 681, 3, 2, 2, 2, 696, 77, 3, 2,
+	2, 2, 697, 704, 5, 80, 41, 2, 698, 700, 7, 130, 2, 2, 699, 698, 3, 2, 2,
+	2, 699, 700, 3, 2, 2, 2, 700, 701, 3, 2, 2, 2, 701, 703, 5, 82, 42, 2,
+	702, 699, 3, 2, 2, 2, 703, 706, 3, 2, 2, 2, 704, 702, 3, 2, 2, 2, 704,
+	705, 3, 2, 2, 2, 705, 712, 3, 2, 2, 2, 706, 704, 3, 2, 2, 2, 707, 708,
+	7, 8, 2, 2, 708, 709, 5, 78, 40, 2, 709, 710, 7, 9, 2, 2, 710, 712, 3,
+	2, 2, 2, 711, 697, 3, 2, 2, 2, 711, 707, 3, 2, 2, 2, 712, 79, 3, 2, 2,
+	2, 713, 715, 7, 8, 2, 2, 714, 716, 7, 130, 2, 2, 715, 714, 3, 2, 2, 2,
+	715, 716, 3, 2, 2, 2, 716, 721, 3, 2, 2, 2, 717, 719, 5, 174, 88, 2, 718,
+	720, 7, 130, 2, 2, 719, 718, 3, 2, 2, 2, 719, 720, 3, 2, 2, 2, 720, 722,
+	3, 2, 2, 2, 721, 717, 3, 2, 2, 2, 721, 722, 3, 2, 2, 2, 722, 727, 3, 2,
+	2, 2, 723, 725, 5, 92, 47, 2, 724, 726, 7, 130, 2, 2, 725, 724, 3, 2, 2,
+	2, 725, 726, 3, 2, 2, 2, 726, 728, 3, 2, 2, 2, 727, 723, 3, 2, 2, 2, 727,
+	728, 3, 2, 2, 2, 728, 733, 3, 2, 2, 2, 729, 731, 5, 88, 45, 2, 730, 732,
+	7, 130, 2, 2, 731, 730, 3, 2, 2, 2, 731, 732, 3, 2, 2, 2, 732, 734, 3,
+	2, 2, 2, 733, 729, 3, 2, 2, 2, 733, 734, 3, 2, 2, 2, 734, 735, 3, 2, 2,
+	2, 735, 736, 7, 9, 2, 2, 736, 81, 3, 2, 2, 2, 737, 739, 5, 84, 43, 2, 738,
+	740, 7, 130, 2, 2, 739, 738, 3, 2, 2, 2, 739, 740, 3, 2, 2, 2, 740, 741,
+	3, 2, 2, 2, 741, 742, 5, 80, 41, 2, 742, 83, 3, 2, 2, 2, 743, 745, 5, 196,
+	99, 2, 744, 746, 7, 130, 2, 2, 745, 744, 3, 2, 2, 2, 745, 746, 3, 2, 2,
+	2, 746, 747, 3, 2, 2, 2, 747, 749, 5, 200, 101, 2, 748, 750, 7, 130, 2,
+	2, 749, 748, 3, 2, 2, 2, 749, 750, 3, 2, 2, 2, 750, 752, 3, 2, 2, 2, 751,
+	753, 5, 86, 44, 2, 752, 751, 3, 2, 2, 2, 752, 753, 3, 2, 2, 2, 753, 755,
+	3, 2, 2, 2, 754, 756, 7, 130, 2, 2, 755, 754, 3, 2, 2, 2, 755, 756, 3,
+	2, 2, 2, 756, 757, 3, 2, 2, 2, 757, 759, 5, 200, 101, 2, 758, 760, 7, 130,
+	2, 2, 759, 758, 3, 2, 2, 2, 759, 760, 3, 2, 2, 2, 760, 761, 3, 2, 2, 2,
+	761, 762, 5, 198, 100, 2, 762, 808, 3, 2, 2, 2, 763, 765, 5, 196, 99, 2,
+	764, 766, 7, 130, 2, 2, 765, 764, 3, 2, 2, 2, 765, 766, 3, 2, 2, 2, 766,
+	767, 3, 2, 2, 2, 767, 769, 5, 200, 101, 2, 768, 770, 7, 130, 2, 2, 769,
+	768, 3, 2, 2, 2, 769, 770, 3, 2, 2, 2, 770, 772, 3, 2, 2, 2, 771, 773,
+	5, 86, 44, 2, 772, 771, 3, 2, 2, 2, 772, 773, 3, 2, 2, 2, 773, 775, 3,
+	2, 2, 2, 774, 776, 7, 130, 2, 2, 775, 774, 3, 2, 2, 2, 775, 776, 3, 2,
+	2, 2, 776, 777, 3, 2, 2, 2, 777, 778, 5, 200, 101, 2, 778, 808, 3, 2, 2,
+	2, 779, 781, 5, 200, 101, 2, 780, 782, 7, 130, 2, 2, 781, 780, 3, 2, 2,
+	2, 781, 782, 3, 2, 2, 2, 782, 784, 3, 2, 2, 2, 783, 785, 5, 86, 44, 2,
+	784, 783, 3, 2, 2, 2, 784, 785, 3, 2, 2, 2, 785, 787, 3, 2, 2, 2, 786,
+	788, 7, 130, 2, 2, 787, 786, 3, 2, 2, 2, 787, 788, 3, 2, 2, 2, 788, 789,
+	3, 2, 2, 2, 789, 791, 5, 200, 101, 2, 790, 792, 7, 130, 2, 2, 791, 790,
+	3, 2, 2, 2, 791, 792, 3, 2, 2, 2, 792, 793, 3, 2, 2, 2, 793, 794, 5, 198,
+	100, 2, 794, 808, 3, 2, 2, 2, 795, 797, 5, 200, 101, 2, 796, 798, 7, 130,
+	2, 2, 797, 796, 3, 2, 2, 2, 797, 798, 3, 2, 2, 2, 798, 800, 3, 2, 2, 2,
+	799, 801, 5, 86, 44, 2, 800, 799, 3, 2, 2, 2, 800, 801, 3, 2, 2, 2, 801,
+	803, 3, 2, 2, 2, 802, 804, 7, 130, 2, 2, 803, 802, 3, 2, 2, 2, 803, 804,
+	3, 2, 2, 2, 804, 805, 3, 2, 2, 2, 805, 806, 5, 200, 101, 2, 806, 808, 3,
+	2, 2, 2, 807, 743, 3, 2, 2, 2, 807, 763, 3, 2, 2, 2, 807, 779, 3, 2, 2,
+	2, 807, 795, 3, 2, 2, 2, 808, 85, 3, 2, 2, 2, 809, 811, 7, 10, 2, 2, 810,
+	812, 7, 130, 2, 2, 811, 810, 3, 2, 2, 2, 811, 812, 3, 2, 2, 2, 812, 817,
+	3, 2, 2, 2, 813, 815, 5, 174, 88, 2, 814, 816, 7, 130, 2, 2, 815, 814,
+	3, 2, 2, 2, 815, 816, 3, 2, 2, 2, 816, 818, 3, 2, 2, 2, 817, 813, 3, 2,
+	2, 2, 817, 818, 3, 2, 2, 2, 818, 823, 3, 2, 2, 2, 819, 821, 5, 90, 46,
+	2, 820, 822, 7, 130, 2, 2, 821, 820, 3, 2, 2, 2, 821, 822, 3, 2, 2, 2,
+	822, 824, 3, 2, 2, 2, 823, 819, 3, 2, 2, 2, 823, 824, 3, 2, 2, 2, 824,
+	826, 3, 2, 2, 2, 825, 827, 5, 96, 49, 2, 826, 825, 3, 2, 2, 2, 826, 827,
+	3, 2, 2, 2, 827, 832, 3, 2, 2, 2, 828, 830, 5, 88, 45, 2, 829, 831, 7,
+	130, 2, 2, 830, 829, 3, 2, 2, 2, 830, 831, 3, 2, 2, 2, 831, 833, 3, 2,
+	2, 2, 832, 828, 3, 2, 2, 2, 832, 833, 3, 2, 2, 2, 833, 834, 3, 2, 2, 2,
+	834, 835, 7, 11, 2, 2, 835, 87, 3, 2, 2, 2, 836, 839, 5, 178, 90, 2, 837,
+	839, 5, 180, 91, 2, 838, 836, 3, 2, 2, 2, 838, 837, 3, 2, 2, 2, 839, 89,
+	3, 2, 2, 2, 840, 842, 7, 12, 2, 2, 841, 843, 7, 130, 2, 2, 842, 841, 3,
+	2, 2, 2, 842, 843, 3, 2, 2, 2, 843, 844, 3, 2, 2, 2, 844, 858, 5, 100,
+	51, 2, 845, 847, 7, 130, 2, 2, 846, 845, 3, 2, 2, 2, 846, 847, 3, 2, 2,
+	2, 847, 848, 3, 2, 2, 2, 848, 850, 7, 13, 2, 2, 849, 851, 7, 12, 2, 2,
+	850, 849, 3, 2, 2, 2, 850, 851, 3, 2, 2, 2, 851, 853, 3, 2, 2, 2, 852,
+	854, 7, 130, 2, 2, 853, 852, 3, 2, 2, 2, 853, 854, 3, 2, 2, 2, 854, 855,
+	3, 2, 2, 2, 855, 857, 5, 100, 51, 2, 856, 846, 3, 2, 2, 2, 857, 860, 3,
+	2, 2, 2, 858, 856, 3, 2, 2, 2, 858, 859, 3, 2, 2, 2, 859, 91, 3, 2, 2,
+	2, 860, 858, 3, 2, 2, 2, 861, 868, 5, 94, 48, 2, 862, 864, 7, 130, 2, 2,
+	863, 862, 3, 2, 2, 2, 863, 864, 3, 2, 2, 2, 864, 865, 3, 2, 2, 2, 865,
+	867, 5, 94, 48, 2, 866, 863, 3, 2, 2, 2, 867, 870, 3, 2, 2, 2, 868, 866,
+	3, 2, 2, 2, 868, 869, 3, 2, 2, 2, 869, 93, 3, 2, 2, 2, 870, 868, 3, 2,
+	2, 2, 871, 873, 7, 12, 2, 2, 872, 874, 7, 130, 2, 2, 873, 872, 3, 2, 2,
+	2, 873, 874, 3, 2, 2, 2, 874, 875, 3, 2, 2, 2, 875, 876, 5, 98, 50, 2,
+	876, 95, 3, 2, 2, 2, 877, 879, 7, 7, 2, 2, 878, 880, 7, 130, 2, 2, 879,
+	878, 3, 2, 2, 2, 879, 880, 3, 2, 2, 2, 880, 885, 3, 2, 2, 2, 881, 883,
+	5, 186, 94, 2, 882, 884, 7, 130, 2, 2, 883, 882, 3, 2, 2, 2, 883, 884,
+	3, 2, 2, 2, 884, 886, 3, 2, 2, 2, 885, 881, 3, 2, 2, 2, 885, 886, 3, 2,
+	2, 2, 886, 897, 3, 2, 2, 2, 887, 889, 7, 14, 2, 2, 888, 890, 7, 130, 2,
+	2, 889, 888, 3, 2, 2, 2, 889, 890, 3, 2, 2, 2, 890, 895, 3, 2, 2, 2, 891,
+	893, 5, 186, 94, 2, 892, 894, 7, 130, 2, 2, 893, 892, 3, 2, 2, 2, 893,
+	894, 3, 2, 2, 2, 894, 896, 3, 2, 2, 2, 895, 891, 3, 2, 2, 2, 895, 896,
+	3, 2, 2, 2, 896, 898, 3, 2, 2, 2, 897, 887, 3, 2, 2, 2, 897, 898, 3, 2,
+	2, 2, 898, 97, 3, 2, 2, 2, 899, 900, 5, 190, 96, 2, 900, 99, 3, 2, 2, 2,
+	901, 902, 5, 190, 96, 2, 902, 101, 3, 2, 2, 2, 903, 904, 5, 104, 53, 2,
+	904, 103, 3, 2, 2, 2, 905, 912, 5, 106, 54, 2, 906, 907, 7, 130, 2, 2,
+	907, 908, 7, 78, 2, 2, 908, 909, 7, 130, 2, 2, 909, 911, 5, 106, 54, 2,
+	910, 906, 3, 2, 2, 2, 911, 914, 3, 2, 2, 2, 912, 910, 3, 2, 2, 2, 912,
+	913, 3, 2, 2, 2, 913, 105, 3, 2, 2, 2, 914, 912, 3, 2, 2, 2, 915, 922,
+	5, 108, 55, 2, 916, 917, 7, 130, 2, 2, 917, 918, 7, 79, 2, 2, 918, 919,
+	7, 130, 2, 2, 919, 921, 5, 108, 55, 2, 920, 916, 3, 2, 2, 2, 921, 924,
+	3, 2, 2, 2, 922, 920, 3, 2, 2, 2, 922, 923, 3, 2, 2, 2, 923, 107, 3, 2,
+	2, 2, 924, 922, 3, 2, 2, 2, 925, 932, 5, 110, 56, 2, 926, 927, 7, 130,
+	2, 2, 927, 928, 7, 80, 2, 2, 928, 929, 7, 130, 2, 2, 929, 931, 5, 110,
+	56, 2, 930, 926, 3, 2, 2, 2, 931, 934, 3, 2, 2, 2, 932, 930, 3, 2, 2, 2,
+	932, 933, 3, 2, 2, 2, 933, 109, 3, 2, 2, 2, 934, 932, 3, 2, 2, 2, 935,
+	937, 7, 81, 2, 2, 936, 938, 7, 130, 2, 2, 937, 936, 3, 2, 2, 2, 937, 938,
+	3, 2, 2, 2, 938, 940, 3, 2, 2, 2, 939, 935, 3, 2, 2, 2, 940, 943, 3, 2,
+	2, 2, 941, 939, 3, 2, 2, 2, 941, 942, 3, 2, 2, 2, 942, 944, 3, 2, 2, 2,
+	943, 941, 3, 2, 2, 2, 944, 945, 5, 112, 57, 2, 945, 111, 3, 2, 2, 2, 946,
+	953, 5, 114, 58, 2, 947, 949, 7, 130, 2, 2, 948, 947, 3, 2, 2, 2, 948,
+	949, 3, 2, 2, 2, 949, 950, 3, 2, 2, 2, 950, 952, 5, 140, 71, 2, 951, 948,
+	3, 2, 2, 2, 952, 955, 3, 2, 2, 2, 953, 951, 3, 2, 2, 2, 953, 954, 3, 2,
+	2, 2, 954, 113, 3, 2, 2, 2, 955, 953, 3, 2, 2, 2, 956, 975, 5, 116, 59,
+	2, 957, 959, 7, 130, 2, 2, 958, 957, 3, 2, 2, 2, 958, 959, 3, 2, 2, 2,
+	959, 960, 3, 2, 2, 2, 960, 962, 7, 15, 2, 2, 961, 963, 7, 130, 2, 2, 962,
+	961, 3, 2, 2, 2, 962, 963, 3, 2, 2, 2, 963, 964, 3, 2, 2, 2, 964, 974,
+	5, 116, 59, 2, 965, 967, 7, 130, 2, 2, 966, 965, 3, 2, 2, 2, 966, 967,
+	3, 2, 2, 2, 967, 968, 3, 2, 2, 2, 968, 970, 7, 16, 2, 2, 969, 971, 7, 130,
+	2, 2, 970, 969, 3, 2, 2, 2, 970, 971, 3, 2, 2, 2, 971, 972, 3, 2, 2, 2,
+	972, 974, 5, 116, 59, 2, 973, 958, 3, 2, 2, 2, 973, 966, 3, 2, 2, 2, 974,
+	977, 3, 2, 2, 2, 975, 973, 3, 2, 2, 2, 975, 976, 3, 2, 2, 2, 976, 115,
+	3, 2, 2, 2, 977, 975, 3, 2, 2, 2, 978, 1005, 5, 118, 60, 2, 979, 981, 7,
+	130, 2, 2, 980, 979, 3, 2, 2, 2, 980, 981, 3, 2, 2, 2, 981, 982, 3, 2,
+	2, 2, 982, 984, 7, 7, 2, 2, 983, 985, 7, 130, 2, 2, 984, 983, 3, 2, 2,
+	2, 984, 985, 3, 2, 2, 2, 985, 986, 3, 2, 2, 2, 986, 1004, 5, 118, 60, 2,
+	987, 989, 7, 130, 2, 2, 988, 987, 3, 2, 2, 2, 988, 989, 3, 2, 2, 2, 989,
+	990, 3, 2, 2, 2, 990, 992, 7, 17, 2, 2, 991, 993, 7, 130, 2, 2, 992, 991,
+	3, 2, 2, 2, 992, 993, 3, 2, 2, 2, 993, 994, 3, 2, 2, 2, 994, 1004, 5, 118,
+	60, 2, 995, 997, 7, 130, 2, 2, 996, 995, 3, 2, 2, 2, 996, 997, 3, 2, 2,
+	2, 997, 998, 3, 2, 2, 2, 998, 1000, 7, 18, 2, 2, 999, 1001, 7, 130, 2,
+	2, 1000, 999, 3, 2, 2, 2, 1000, 1001, 3, 2, 2, 2, 1001, 1002, 3, 2, 2,
+	2, 1002, 1004, 5, 118, 60, 2, 1003, 980, 3, 2, 2, 2, 1003, 988, 3, 2, 2,
+	2, 1003, 996, 3, 2, 2, 2, 1004, 1007, 3, 2, 2, 2, 1005, 1003, 3, 2, 2,
+	2, 1005, 1006, 3, 2, 2, 2, 1006, 117, 3, 2, 2, 2, 1007, 1005, 3, 2, 2,
+	2, 1008, 1019, 5, 120, 61, 2, 1009, 1011, 7, 130, 2, 2, 1010, 1009, 3,
+	2, 2, 2, 1010, 1011, 3, 2, 2, 2, 1011, 1012, 3, 2, 2, 2, 1012, 1014, 7,
+	19, 2, 2, 1013, 1015, 7, 130, 2, 2, 1014, 1013, 3, 2, 2, 2, 1014, 1015,
+	3, 2, 2, 2, 1015, 1016, 3, 2, 2, 2, 1016, 1018, 5, 120, 61, 2, 1017, 1010,
+	3, 2, 2, 2, 1018, 1021, 3, 2, 2, 2, 1019, 1017, 3, 2, 2, 2, 1019, 1020,
+	3, 2, 2, 2, 1020, 119, 3, 2, 2, 2, 1021, 1019, 3, 2, 2, 2, 1022, 1024,
+	9, 3, 2, 2, 1023, 1025, 7, 130, 2, 2, 1024, 1023, 3, 2, 2, 2, 1024, 1025,
+	3, 2, 2, 2, 1025, 1027, 3, 2, 2, 2, 1026, 1022, 3, 2, 2, 2, 1027, 1030,
+	3, 2, 2, 2, 1028, 1026, 3, 2, 2, 2, 1028, 1029, 3, 2, 2, 2, 1029, 1031,
+	3, 2, 2, 2, 1030, 1028, 3, 2, 2, 2, 1031, 1032, 5, 122, 62, 2, 1032, 121,
+	3, 2, 2, 2, 1033, 1039, 5, 130, 66, 2, 1034, 1038, 5, 126, 64, 2, 1035,
+	1038, 5, 124, 63, 2, 1036, 1038, 5, 128, 65, 2, 1037, 1034, 3, 2, 2, 2,
+	1037, 1035, 3, 2, 2, 2, 1037, 1036, 3, 2, 2, 2, 1038, 1041, 3, 2, 2, 2,
+	1039, 1037, 3, 2, 2, 2, 1039, 1040, 3, 2, 2, 2, 1040, 123, 3, 2, 2, 2,
+	1041, 1039, 3, 2, 2, 2, 1042, 1043, 7, 130, 2, 2, 1043, 1045, 7, 82, 2,
+	2, 1044, 1046, 7, 130, 2, 2, 1045, 1044, 3, 2, 2, 2, 1045, 1046, 3, 2,
+	2, 2, 1046, 1047, 3, 2, 2, 2, 1047, 1068, 5, 130, 66, 2, 1048, 1050, 7,
+	130, 2, 2, 1049, 1048, 3, 2, 2, 2, 1049, 1050, 3, 2, 2, 2, 1050, 1051,
+	3, 2, 2, 2, 1051, 1052, 7, 10, 2, 2, 1052, 1053, 5, 102, 52, 2, 1053, 1054,
+	7, 11, 2, 2, 1054, 1068, 3, 2, 2, 2, 1055, 1057, 7, 130, 2, 2, 1056, 1055,
+	3, 2, 2, 2, 1056, 1057, 3, 2, 2, 2, 1057, 1058, 3, 2, 2, 2, 1058, 1060,
+	7, 10, 2, 2, 1059, 1061, 5, 102, 52, 2, 1060, 1059, 3, 2, 2, 2, 1060, 1061,
+	3, 2, 2, 2, 1061, 1062, 3, 2, 2, 2, 1062, 1064, 7, 14, 2, 2, 1063, 1065,
+	5, 102, 52, 2, 1064, 1063, 3, 2, 2, 2, 1064, 1065, 3, 2, 2, 2, 1065, 1066,
+	3, 2, 2, 2, 1066, 1068, 7, 11, 2, 2, 1067, 1042, 3, 2, 2, 2, 1067, 1049,
+	3, 2, 2, 2, 1067, 1056, 3, 2, 2, 2, 1068, 125, 3, 2, 2, 2, 1069, 1070,
+	7, 130, 2, 2, 1070, 1071, 7, 83, 2, 2, 1071, 1072, 7, 130, 2, 2, 1072,
+	1080, 7, 64, 2, 2, 1073, 1074, 7, 130, 2, 2, 1074, 1075, 7, 84, 2, 2, 1075,
+	1076, 7, 130, 2, 2, 1076, 1080, 7, 64, 2, 2, 1077, 1078, 7, 130, 2, 2,
+	1078, 1080, 7, 85, 2, 2, 1079, 1069, 3, 2, 2, 2, 1079, 1073, 3, 2, 2, 2,
+	1079, 1077, 3, 2, 2, 2, 1080, 1082, 3, 2, 2, 2, 1081, 1083, 7, 130, 2,
+	2, 1082, 1081, 3, 2, 2, 2, 1082, 1083, 3, 2, 2, 2, 1083, 1084, 3, 2, 2,
+	2, 1084, 1085, 5, 130, 66, 2, 1085, 127, 3, 2, 2, 2, 1086, 1087, 7, 130,
+	2, 2, 1087, 1088, 7, 86, 2, 2, 1088, 1089, 7, 130, 2, 2, 1089, 1097, 7,
+	87, 2, 2, 1090, 1091, 7, 130, 2, 2, 1091, 1092, 7, 86, 2, 2, 1092, 1093,
+	7, 130, 2, 2, 1093, 1094, 7, 81, 2, 2, 1094, 1095, 7, 130, 2, 2, 1095,
+	1097, 7, 87, 2, 2, 1096, 1086, 3, 2, 2, 2, 1096, 1090, 3, 2, 2, 2, 1097,
+	129, 3, 2, 2, 2, 1098, 1105, 5, 132, 67, 2, 1099, 1101, 7, 130, 2, 2, 1100,
+	1099, 3, 2, 2, 2, 1100, 1101, 3, 2, 2, 2, 1101, 1102, 3, 2, 2, 2, 1102,
+	1104, 5, 168, 85, 2, 1103, 1100, 3, 2, 2, 2, 1104, 1107, 3, 2, 2, 2, 1105,
+	1103, 3, 2, 2, 2, 1105, 1106, 3, 2, 2, 2, 1106, 1112, 3, 2, 2, 2, 1107,
+	1105, 3, 2, 2, 2, 1108, 1110, 7, 130, 2, 2, 1109, 1108, 3, 2, 2, 2, 1109,
+	1110, 3, 2, 2, 2, 1110, 1111, 3, 2, 2, 2, 1111, 1113, 5, 92, 47, 2, 1112,
+	1109, 3, 2, 2, 2, 1112, 1113, 3, 2, 2, 2, 1113, 131, 3, 2, 2, 2, 1114,
+	1193, 5, 134, 68, 2, 1115, 1193, 5, 180, 91, 2, 1116, 1193, 5, 170, 86,
+	2, 1117, 1119, 7, 88, 2, 2, 1118, 1120, 7, 130, 2, 2, 1119, 1118, 3, 2,
+	2, 2, 1119, 1120, 3, 2, 2, 2, 1120, 1121, 3, 2, 2, 2, 1121, 1123, 7, 8,
+	2, 2, 1122, 1124, 7, 130, 2, 2, 1123, 1122, 3, 2, 2, 2, 1123, 1124, 3,
+	2, 2, 2, 1124, 1125, 3, 2, 2, 2, 1125, 1127, 7, 7, 2, 2, 1126, 1128, 7,
+	130, 2, 2, 1127, 1126, 3, 2, 2, 2, 1127, 1128, 3, 2, 2, 2, 1128, 1129,
+	3, 2, 2, 2, 1129, 1193, 7, 9, 2, 2, 1130, 1193, 5, 164, 83, 2, 1131, 1193,
+	5, 166, 84, 2, 1132, 1134, 7, 50, 2, 2, 1133, 1135, 7, 130, 2, 2, 1134,
+	1133, 3, 2, 2, 2, 1134, 1135, 3, 2, 2, 2, 1135, 1136, 3, 2, 2, 2, 1136,
+	1138, 7, 8, 2, 2, 1137, 1139, 7, 130, 2, 2, 1138, 1137, 3, 2, 2, 2, 1138,
+	1139, 3, 2, 2, 2, 1139, 1140, 3, 2, 2, 2, 1140, 1142, 5, 146, 74, 2, 1141,
+	1143, 7, 130, 2, 2, 1142, 1141, 3, 2, 2, 2, 1142, 1143, 3, 2, 2, 2, 1143,
+	1144, 3, 2, 2, 2, 1144, 1145, 7, 9, 2, 2, 1145, 1193, 3, 2, 2, 2, 1146,
+	1148, 7, 89, 2, 2, 1147, 1149, 7, 130, 2, 2, 1148, 1147, 3, 2, 2, 2, 1148,
+	1149, 3, 2, 2, 2, 1149, 1150, 3, 2, 2, 2, 1150, 1152, 7, 8, 2, 2, 1151,
+	1153, 7, 130, 2, 2, 1152, 1151, 3, 2, 2, 2, 1152, 1153, 3, 2, 2, 2, 1153,
+	1154, 3, 2, 2, 2, 1154, 1156, 5, 146, 74, 2, 1155, 1157, 7, 130, 2, 2,
+	1156, 1155, 3, 2, 2, 2, 1156, 1157, 3, 2, 2, 2, 1157, 1158, 3, 2, 2, 2,
+	1158, 1159, 7, 9, 2, 2, 1159, 1193, 3, 2, 2, 2, 1160, 1162, 7, 90, 2, 2,
+	1161, 1163, 7, 130, 2, 2, 1162, 1161, 3, 2, 2, 2, 1162, 1163, 3, 2, 2,
+	2, 1163, 1164, 3, 2, 2, 2, 1164, 1166, 7, 8, 2, 2, 1165, 1167, 7, 130,
+	2, 2, 1166, 1165, 3, 2, 2, 2, 1166, 1167, 3, 2, 2, 2, 1167, 1168, 3, 2,
+	2, 2, 1168, 1170, 5, 146, 74, 2, 1169, 1171, 7, 130, 2, 2, 1170, 1169,
+	3, 2, 2, 2, 1170, 1171, 3, 2, 2, 2, 1171, 1172, 3, 2, 2, 2, 1172, 1173,
+	7, 9, 2, 2, 1173, 1193, 3, 2, 2, 2, 1174, 1176, 7, 91, 2, 2, 1175, 1177,
+	7, 130, 2, 2, 1176, 1175, 3, 2, 2, 2, 1176, 1177, 3, 2, 2, 2, 1177, 1178,
+	3, 2, 2, 2, 1178, 1180, 7, 8, 2, 2, 1179, 1181, 7, 130, 2, 2, 1180, 1179,
+	3, 2, 2, 2, 1180, 1181, 3, 2, 2, 2, 1181, 1182, 3, 2, 2, 2, 1182, 1184,
+	5, 146, 74, 2, 1183, 1185, 7, 130, 2, 2, 1184, 1183, 3, 2, 2, 2, 1184,
+	1185, 3, 2, 2, 2, 1185, 1186, 3, 2, 2, 2, 1186, 1187, 7, 9, 2, 2, 1187,
+	1193, 3, 2, 2, 2, 1188, 1193, 5, 144, 73, 2, 1189, 1193, 5, 142, 72, 2,
+	1190, 1193, 5, 150, 76, 2, 1191, 1193, 5, 174, 88, 2, 1192, 1114, 3, 2,
+	2, 2, 1192, 1115, 3, 2, 2, 2, 1192, 1116, 3, 2, 2, 2, 1192, 1117, 3, 2,
+	2, 2, 1192, 1130, 3, 2, 2, 2, 1192, 1131, 3, 2, 2, 2, 1192, 1132, 3, 2,
+	2, 2, 1192, 1146, 3, 2, 2, 2, 1192, 1160, 3, 2, 2, 2, 1192, 1174, 3, 2,
+	2, 2, 1192, 1188, 3, 2, 2, 2, 1192, 1189, 3, 2, 2, 2, 1192, 1190, 3, 2,
+	2, 2, 1192, 1191, 3, 2, 2, 2, 1193, 133, 3, 2, 2, 2, 1194, 1201, 5, 176,
+	89, 2, 1195, 1201, 7, 100, 2, 2, 1196, 1201, 5, 136, 69, 2, 1197, 1201,
+	7, 87, 2, 2, 1198, 1201, 5, 178, 90, 2, 1199, 1201, 5, 138, 70, 2, 1200,
+	1194, 3, 2, 2, 2, 1200, 1195, 3, 2, 2, 2, 1200, 1196, 3, 2, 2, 2, 1200,
+	1197, 3, 2, 2, 2, 1200, 1198, 3, 2, 2, 2, 1200, 1199, 3, 2, 2, 2, 1201,
+	135, 3, 2, 2, 2, 1202, 1203, 9, 4, 2, 2, 1203, 137, 3, 2, 2, 2, 1204, 1206,
+	7, 10, 2, 2, 1205, 1207, 7, 130, 2, 2, 1206, 1205, 3, 2, 2, 2, 1206, 1207,
+	3, 2, 2, 2, 1207, 1225, 3, 2, 2, 2, 1208, 1210, 5, 102, 52, 2, 1209, 1211,
+	7, 130, 2, 2, 1210, 1209, 3, 2, 2, 2, 1210, 1211, 3, 2, 2, 2, 1211, 1222,
+	3, 2, 2, 2, 1212, 1214, 7, 4, 2, 2, 1213, 1215, 7, 130, 2, 2, 1214, 1213,
+	3, 2, 2, 2, 1214, 1215, 3, 2, 2, 2, 1215, 1216, 3, 2, 2, 2, 1216, 1218,
+	5, 102, 52, 2, 1217, 1219, 7, 130, 2, 2, 1218, 1217, 3, 2, 2, 2, 1218,
+	1219, 3, 2, 2, 2, 1219, 1221, 3, 2, 2, 2, 1220, 1212, 3, 2, 2, 2, 1221,
+	1224, 3, 2, 2, 2, 1222, 1220, 3, 2, 2, 2, 1222, 1223, 3, 2, 2, 2, 1223,
+	1226, 3, 2, 2, 2, 1224, 1222, 3, 2, 2, 2, 1225, 1208, 3, 2, 2, 2, 1225,
+	1226, 3, 2, 2, 2, 1226, 1227, 3, 2, 2, 2, 1227, 1228, 7, 11, 2, 2, 1228,
+	139, 3, 2, 2, 2, 1229, 1231, 7, 5, 2, 2, 1230, 1232, 7, 130, 2, 2, 1231,
+	1230, 3, 2, 2, 2, 1231, 1232, 3, 2, 2, 2, 1232, 1233, 3, 2, 2, 2, 1233,
+	1265, 5, 114, 58, 2, 1234, 1236, 7, 20, 2, 2, 1235, 1237, 7, 130, 2, 2,
+	1236, 1235, 3, 2, 2, 2, 1236, 1237, 3, 2, 2, 2, 1237, 1238, 3, 2, 2, 2,
+	1238, 1265, 5, 114, 58, 2, 1239, 1241, 7, 21, 2, 2, 1240, 1242, 7, 130,
+	2, 2, 1241, 1240, 3, 2, 2, 2, 1241, 1242, 3, 2, 2, 2, 1242, 1243, 3, 2,
+	2, 2, 1243, 1265, 5, 114, 58, 2, 1244, 1246, 7, 22, 2, 2, 1245, 1247, 7,
+	130, 2, 2, 1246, 1245, 3, 2, 2, 2, 1246, 1247, 3, 2, 2, 2, 1247, 1248,
+	3, 2, 2, 2, 1248, 1265, 5, 114, 58, 2, 1249, 1251, 7, 23, 2, 2, 1250, 1252,
+	7, 130, 2, 2, 1251, 1250, 3, 2, 2, 2, 1251, 1252, 3, 2, 2, 2, 1252, 1253,
+	3, 2, 2, 2, 1253, 1265, 5, 114, 58, 2, 1254, 1256, 7, 24, 2, 2, 1255, 1257,
+	7, 130, 2, 2, 1256, 1255, 3, 2, 2, 2, 1256, 1257, 3, 2, 2, 2, 1257, 1258,
+	3, 2, 2, 2, 1258, 1265, 5, 114, 58, 2, 1259, 1261, 7, 25, 2, 2, 1260, 1262,
+	7, 130, 2, 2, 1261, 1260, 3, 2, 2, 2, 1261, 1262, 3, 2, 2, 2, 1262, 1263,
+	3, 2, 2, 2, 1263, 1265, 5, 114, 58, 2, 1264, 1229, 3, 2, 2, 2, 1264, 1234,
+	3, 2, 2, 2, 1264, 1239, 3, 2, 2, 2, 1264, 1244, 3, 2, 2, 2, 1264, 1249,
+	3, 2, 2, 2, 1264, 1254, 3, 2, 2, 2, 1264, 1259, 3, 2, 2, 2, 1265, 141,
+	3, 2, 2, 2, 1266, 1268, 7, 8, 2, 2, 1267, 1269, 7, 130, 2, 2, 1268, 1267,
+	3, 2, 2, 2, 1268, 1269, 3, 2, 2, 2, 1269, 1270, 3, 2, 2, 2, 1270, 1272,
+	5, 102, 52, 2, 1271, 1273, 7, 130, 2, 2, 1272, 1271, 3, 2, 2, 2, 1272,
+	1273, 3, 2, 2, 2, 1273, 1274, 3, 2, 2, 2, 1274, 1275, 7, 9, 2, 2, 1275,
+	143, 3, 2, 2, 2, 1276, 1281, 5, 80, 41, 2, 1277, 1279, 7, 130, 2, 2, 1278,
+	1277, 3, 2, 2, 2, 1278, 1279, 3, 2, 2, 2, 1279, 1280, 3, 2, 2, 2, 1280,
+	1282, 5, 82, 42, 2, 1281, 1278, 3, 2, 2, 2, 1282, 1283, 3, 2, 2, 2, 1283,
+	1281, 3, 2, 2, 2, 1283, 1284, 3, 2, 2, 2, 1284, 145, 3, 2, 2, 2, 1285,
+	1290, 5, 148, 75, 2, 1286, 1288, 7, 130, 2, 2, 1287, 1286, 3, 2, 2, 2,
+	1287, 1288, 3, 2, 2, 2, 1288, 1289, 3, 2, 2, 2, 1289, 1291, 5, 68, 35,
+	2, 1290, 1287, 3, 2, 2, 2, 1290, 1291, 3, 2, 2, 2, 1291, 147, 3, 2, 2,
+	2, 1292, 1293, 5, 174, 88, 2, 1293, 1294, 7, 130, 2, 2, 1294, 1295, 7,
+	82, 2, 2, 1295, 1296, 7, 130, 2, 2, 1296, 1297, 5, 102, 52, 2, 1297, 149,
+	3, 2, 2, 2, 1298, 1300, 5, 152, 77, 2, 1299, 1301, 7, 130, 2, 2, 1300,
+	1299, 3, 2, 2, 2, 1300, 1301, 3, 2, 2, 2, 1301, 1302, 3, 2, 2, 2, 1302,
+	1304, 7, 8, 2, 2, 1303, 1305, 7, 130, 2, 2, 1304, 1303, 3, 2, 2, 2, 1304,
+	1305, 3, 2, 2, 2, 1305, 1310, 3, 2, 2, 2, 1306, 1308, 7, 66, 2, 2, 1307,
+	1309, 7, 130, 2, 2, 1308, 1307, 3, 2, 2, 2, 1308, 1309, 3, 2, 2, 2, 1309,
+	1311, 3, 2, 2, 2, 1310, 1306, 3, 2, 2, 2, 1310, 1311, 3, 2, 2, 2, 1311,
+	1329, 3, 2, 2, 2, 1312, 1314, 5, 102, 52, 2, 1313, 1315, 7, 130, 2, 2,
+	1314, 1313, 3, 2, 2, 2, 1314, 1315, 3, 2, 2, 2, 1315, 1326, 3, 2, 2, 2,
+	1316, 1318, 7, 4, 2, 2, 1317, 1319, 7, 130, 2, 2, 1318, 1317, 3, 2, 2,
+	2, 1318, 1319, 3, 2, 2, 2, 1319, 1320, 3, 2, 2, 2, 1320, 1322, 5, 102,
+	52, 2, 1321, 1323, 7, 130, 2, 2, 1322, 1321, 3, 2, 2, 2, 1322, 1323, 3,
+	2, 2, 2, 1323, 1325, 3, 2, 2, 2, 1324, 1316, 3, 2, 2, 2, 1325, 1328, 3,
+	2, 2, 2, 1326, 1324, 3, 2, 2, 2, 1326, 1327, 3, 2, 2, 2, 1327, 1330, 3,
+	2, 2, 2, 1328, 1326, 3, 2, 2, 2, 1329, 1312, 3, 2, 2, 2, 1329, 1330, 3,
+	2, 2, 2, 1330, 1331, 3, 2, 2, 2, 1331, 1332, 7, 9, 2, 2, 1332, 151, 3,
+	2, 2, 2, 1333, 1334, 5, 162, 82, 2, 1334, 1335, 5, 194, 98, 2, 1335, 1338,
+	3, 2, 2, 2, 1336, 1338, 7, 94, 2, 2, 1337, 1333, 3, 2, 2, 2, 1337, 1336,
+	3, 2, 2, 2, 1338, 153, 3, 2, 2, 2, 1339, 1341, 5, 160, 81, 2, 1340, 1342,
+	7, 130, 2, 2, 1341, 1340, 3, 2, 2, 2, 1341, 1342, 3, 2, 2, 2, 1342, 1343,
+	3, 2, 2, 2, 1343, 1345, 7, 8, 2, 2, 1344, 1346, 7, 130, 2, 2, 1345, 1344,
+	3, 2, 2, 2, 1345, 1346, 3, 2, 2, 2, 1346, 1364, 3, 2, 2, 2, 1347, 1349,
+	5, 102, 52, 2, 1348, 1350, 7, 130, 2, 2, 1349, 1348, 3, 2, 2, 2, 1349,
+	1350, 3, 2, 2, 2, 1350, 1361, 3, 2, 2, 2, 1351, 1353, 7, 4, 2, 2, 1352,
+	1354, 7, 130, 2, 2, 1353, 1352, 3, 2, 2, 2, 1353, 1354, 3, 2, 2, 2, 1354,
+	1355, 3, 2, 2, 2, 1355, 1357, 5, 102, 52, 2, 1356, 1358, 7, 130, 2, 2,
+	1357, 1356, 3, 2, 2, 2, 1357, 1358, 3, 2, 2, 2, 1358, 1360, 3, 2, 2, 2,
+	1359, 1351, 3, 2, 2, 2, 1360, 1363, 3, 2, 2, 2, 1361, 1359, 3, 2, 2, 2,
+	1361, 1362, 3, 2, 2, 2, 1362, 1365, 3, 2, 2, 2, 1363, 1361, 3, 2, 2, 2,
+	1364, 1347, 3, 2, 2, 2, 1364, 1365, 3, 2, 2, 2, 1365, 1366, 3, 2, 2, 2,
+	1366, 1367, 7, 9, 2, 2, 1367, 155, 3, 2, 2, 2, 1368, 1369, 5, 160, 81,
+	2, 1369, 157, 3, 2, 2, 2, 1370, 1371, 5, 194, 98, 2, 1371, 159, 3, 2, 2,
+	2, 1372, 1373, 5, 162, 82, 2, 1373, 1374, 5, 194, 98, 2, 1374, 161, 3,
+	2, 2, 2, 1375, 1376, 5, 194, 98, 2, 1376, 1377, 7, 26, 2, 2, 1377, 1379,
+	3, 2, 2, 2, 1378, 1375, 3, 2, 2, 2, 1379, 1382, 3, 2, 2, 2, 1380, 1378,
+	3, 2, 2, 2, 1380, 1381, 3, 2, 2, 2, 1381, 163, 3, 2, 2, 2, 1382, 1380,
+	3, 2, 2, 2, 1383, 1385, 7, 10, 2, 2, 1384, 1386, 7, 130, 2, 2, 1385, 1384,
+	3, 2, 2, 2, 1385, 1386, 3, 2, 2, 2, 1386, 1387, 3, 2, 2, 2, 1387, 1396,
+	5, 146, 74, 2, 1388, 1390, 7, 130, 2, 2, 1389, 1388, 3, 2, 2, 2, 1389,
+	1390, 3, 2, 2, 2, 1390, 1391, 3, 2, 2, 2, 1391, 1393, 7, 13, 2, 2, 1392,
+	1394, 7, 130, 2, 2, 1393, 1392, 3, 2, 2, 2, 1393, 1394, 3, 2, 2, 2, 1394,
+	1395, 3, 2, 2, 2, 1395, 1397, 5, 102, 52, 2, 1396, 1389, 3, 2, 2, 2, 1396,
+	1397, 3, 2, 2, 2, 1397, 1399, 3, 2, 2, 2, 1398, 1400, 7, 130, 2, 2, 1399,
+	1398, 3, 2, 2, 2, 1399, 1400, 3, 2, 2, 2, 1400, 1401, 3, 2, 2, 2, 1401,
+	1402, 7, 11, 2, 2, 1402, 165, 3, 2, 2, 2, 1403, 1405, 7, 10, 2, 2, 1404,
+	1406, 7, 130, 2, 2, 1405, 1404, 3, 2, 2, 2, 1405, 1406, 3, 2, 2, 2, 1406,
+	1415, 3, 2, 2, 2, 1407, 1409, 5, 174, 88, 2, 1408, 1410, 7, 130, 2, 2,
+	1409, 1408, 3, 2, 2, 2, 1409, 1410, 3, 2, 2, 2, 1410, 1411, 3, 2, 2, 2,
+	1411, 1413, 7, 5, 2, 2, 1412, 1414, 7, 130, 2, 2, 1413, 1412, 3, 2, 2,
+	2, 1413, 1414, 3, 2, 2, 2, 1414, 1416, 3, 2, 2, 2, 1415, 1407, 3, 2, 2,
+	2, 1415, 1416, 3, 2, 2, 2, 1416, 1417, 3, 2, 2, 2, 1417, 1419, 5, 144,
+	73, 2, 1418, 1420, 7, 130, 2, 2, 1419, 1418, 3, 2, 2, 2, 1419, 1420, 3,
+	2, 2, 2, 1420, 1429, 3, 2, 2, 2, 1421, 1423, 7, 75, 2, 2, 1422, 1424, 7,
+	130, 2, 2, 1423, 1422, 3, 2, 2, 2, 1423, 1424, 3, 2, 2, 2, 1424, 1425,
+	3, 2, 2, 2, 1425, 1427, 5, 102, 52, 2, 1426, 1428, 7, 130, 2, 2, 1427,
+	1426, 3, 2, 2, 2, 1427, 1428, 3, 2, 2, 2, 1428, 1430, 3, 2, 2, 2, 1429,
+	1421, 3, 2, 2, 2, 1429, 1430, 3, 2, 2, 2, 1430, 1431, 3, 2, 2, 2, 1431,
+	1433, 7, 13, 2, 2, 1432, 1434, 7, 130, 2, 2, 1433, 1432, 3, 2, 2, 2, 1433,
+	1434, 3, 2, 2, 2, 1434, 1435, 3, 2, 2, 2, 1435, 1437, 5, 102, 52, 2, 1436,
+	1438, 7, 130, 2, 2, 1437, 1436, 3, 2, 2, 2, 1437, 1438, 3, 2, 2, 2, 1438,
+	1439, 3, 2, 2, 2, 1439, 1440, 7, 11, 2, 2, 1440, 167, 3, 2, 2, 2, 1441,
+	1443, 7, 26, 2, 2, 1442, 1444, 7, 130, 2, 2, 1443, 1442, 3, 2, 2, 2, 1443,
+	1444, 3, 2, 2, 2, 1444, 1445, 3, 2, 2, 2, 1445, 1446, 5, 184, 93, 2, 1446,
+	169, 3, 2, 2, 2, 1447, 1452, 7, 95, 2, 2, 1448, 1450, 7, 130, 2, 2, 1449,
+	1448, 3, 2, 2, 2, 1449, 1450, 3, 2, 2, 2, 1450, 1451, 3, 2, 2, 2, 1451,
+	1453, 5, 172, 87, 2, 1452, 1449, 3, 2, 2, 2, 1453, 1454, 3, 2, 2, 2, 1454,
+	1452, 3, 2, 2, 2, 1454, 1455, 3, 2, 2, 2, 1455, 1470, 3, 2, 2, 2, 1456,
+	1458, 7, 95, 2, 2, 1457, 1459, 7, 130, 2, 2, 1458, 1457, 3, 2, 2, 2, 1458,
+	1459, 3, 2, 2, 2, 1459, 1460, 3, 2, 2, 2, 1460, 1465, 5, 102, 52, 2, 1461,
+	1463, 7, 130, 2, 2, 1462, 1461, 3, 2, 2, 2, 1462, 1463, 3, 2, 2, 2, 1463,
+	1464, 3, 2, 2, 2, 1464, 1466, 5, 172, 87, 2, 1465, 1462, 3, 2, 2, 2, 1466,
+	1467, 3, 2, 2, 2, 1467, 1465, 3, 2, 2, 2, 1467, 1468, 3, 2, 2, 2, 1468,
+	1470, 3, 2, 2, 2, 1469, 1447, 3, 2, 2, 2, 1469, 1456, 3, 2, 2, 2, 1470,
+	1479, 3, 2, 2, 2, 1471, 1473, 7, 130, 2, 2, 1472, 1471, 3, 2, 2, 2, 1472,
+	1473, 3, 2, 2, 2, 1473, 1474, 3, 2, 2, 2, 1474, 1476, 7, 96, 2, 2, 1475,
+	1477, 7, 130, 2, 2, 1476, 1475, 3, 2, 2, 2, 1476, 1477, 3, 2, 2, 2, 1477,
+	1478, 3, 2, 2, 2, 1478, 1480, 5, 102, 52, 2, 1479, 1472, 3, 2, 2, 2, 1479,
+	1480, 3, 2, 2, 2, 1480, 1482, 3, 2, 2, 2, 1481, 1483, 7, 130, 2, 2, 1482,
+	1481, 3, 2, 2, 2, 1482, 1483, 3, 2, 2, 2, 1483, 1484, 3, 2, 2, 2, 1484,
+	1485, 7, 97, 2, 2, 1485, 171, 3, 2, 2, 2, 1486, 1488, 7, 98, 2, 2, 1487,
+	1489, 7, 130, 2, 2, 1488, 1487, 3, 2, 2, 2, 1488, 1489, 3, 2, 2, 2, 1489,
+	1490, 3, 2, 2, 2, 1490, 1492, 5, 102, 52, 2, 1491, 1493, 7, 130, 2, 2,
+	1492, 1491, 3, 2, 2, 2, 1492, 1493, 3, 2, 2, 2, 1493, 1494, 3, 2, 2, 2,
+	1494, 1496, 7, 99, 2, 2, 1495, 1497, 7, 130, 2, 2, 1496, 1495, 3, 2, 2,
+	2, 1496, 1497, 3, 2, 2, 2, 1497, 1498, 3, 2, 2, 2, 1498, 1499, 5, 102,
+	52, 2, 1499, 173, 3, 2, 2, 2, 1500, 1501, 5, 194, 98, 2, 1501, 175, 3,
+	2, 2, 2, 1502, 1505, 5, 188, 95, 2, 1503, 1505, 5, 186, 94, 2, 1504, 1502,
+	3, 2, 2, 2, 1504, 1503, 3, 2, 2, 2, 1505, 177, 3, 2, 2, 2, 1506, 1508,
+	7, 27, 2, 2, 1507, 1509, 7, 130, 2, 2, 1508, 1507, 3, 2, 2, 2, 1508, 1509,
+	3, 2, 2, 2, 1509, 1543, 3, 2, 2, 2, 1510, 1512, 5, 184, 93, 2, 1511, 1513,
+	7, 130, 2, 2, 1512, 1511, 3, 2, 2, 2, 1512, 1513, 3, 2, 2, 2, 1513, 1514,
+	3, 2, 2, 2, 1514, 1516, 7, 12, 2, 2, 1515, 1517, 7, 130, 2, 2, 1516, 1515,
+	3, 2, 2, 2, 1516, 1517, 3, 2, 2, 2, 1517, 1518, 3, 2, 2, 2, 1518, 1520,
+	5, 102, 52, 2, 1519, 1521, 7, 130, 2, 2, 1520, 1519, 3, 2, 2, 2, 1520,
+	1521, 3, 2, 2, 2, 1521, 1540, 3, 2, 2, 2, 1522, 1524, 7, 4, 2, 2, 1523,
+	1525, 7, 130, 2, 2, 1524, 1523, 3, 2, 2, 2, 1524, 1525, 3, 2, 2, 2, 1525,
+	1526, 3, 2, 2, 2, 1526, 1528, 5, 184, 93, 2, 1527, 1529, 7, 130, 2, 2,
+	1528, 1527, 3, 2, 2, 2, 1528, 1529, 3, 2, 2, 2, 1529, 1530, 3, 2, 2, 2,
+	1530, 1532, 7, 12, 2, 2, 1531, 1533, 7, 130, 2, 2, 1532, 1531, 3, 2, 2,
+	2, 1532, 1533, 3, 2, 2, 2, 1533, 1534, 3, 2, 2, 2, 1534, 1536, 5, 102,
+	52, 2, 1535, 1537, 7, 130, 2, 2, 1536, 1535, 3, 2, 2, 2, 1536, 1537, 3,
+	2, 2, 2, 1537, 1539, 3, 2, 2, 2, 1538, 1522, 3, 2, 2, 2, 1539, 1542, 3,
+	2, 2, 2, 1540, 1538, 3, 2, 2, 2, 1540, 1541, 3, 2, 2, 2, 1541, 1544, 3,
+	2, 2, 2, 1542, 1540, 3, 2, 2, 2, 1543, 1510, 3, 2, 2, 2, 1543, 1544, 3,
+	2, 2, 2, 1544, 1545, 3, 2, 2, 2, 1545, 1546, 7, 28, 2, 2, 1546, 179, 3,
+	2, 2, 2, 1547, 1550, 7, 29, 2, 2, 1548, 1551, 5, 194, 98, 2, 1549, 1551,
+	7, 103, 2, 2, 1550, 1548, 3, 2, 2, 2, 1550, 1549, 3, 2, 2, 2, 1551, 181,
+	3, 2, 2, 2, 1552, 1557, 5, 132, 67, 2, 1553, 1555, 7, 130, 2, 2, 1554,
+	1553, 3, 2, 2, 2, 1554, 1555, 3, 2, 2, 2, 1555, 1556, 3, 2, 2, 2, 1556,
+	1558, 5, 168, 85, 2, 1557, 1554, 3, 2, 2, 2, 1558, 1559, 3, 2, 2, 2, 1559,
+	1557, 3, 2, 2, 2, 1559, 1560, 3, 2, 2, 2, 1560, 183, 3, 2, 2, 2, 1561,
+	1562, 5, 190, 96, 2, 1562, 185, 3, 2, 2, 2, 1563, 1564, 9, 5, 2, 2, 1564,
+	187, 3, 2, 2, 2, 1565, 1566, 9, 6, 2, 2, 1566, 189, 3, 2, 2, 2, 1567, 1570,
+	5, 194, 98, 2, 1568, 1570, 5, 192, 97, 2, 1569, 1567, 3, 2, 2, 2, 1569,
+	1568, 3, 2, 2, 2, 1570, 191, 3, 2, 2, 2, 1571, 1572, 9, 7, 2, 2, 1572,
+	193, 3, 2, 2, 2, 1573, 1574, 9, 8, 2, 2, 1574, 195, 3, 2, 2, 2, 1575, 1576,
+	9, 9, 2, 2, 1576, 197, 3, 2, 2, 2, 1577, 1578, 9, 10, 2, 2, 1578, 199,
+	3, 2, 2, 2, 1579, 1580, 9, 11, 2, 2, 1580, 201, 3, 2, 2, 2, 294, 203, 207,
+	210, 213, 221, 225, 230, 237, 242, 245, 249, 253, 257, 263, 267, 272, 277,
+	281, 284, 286, 290, 294, 299, 303, 308, 312, 321, 326, 330, 334, 338, 341,
+	345, 355, 362, 375, 379, 385, 392, 397, 401, 407, 411, 417, 421, 427, 431,
+	435, 439, 443, 447, 452, 459, 463, 468, 475, 481, 486, 492, 498, 503, 507,
+	512, 515, 518, 521, 528, 535, 538, 544, 547, 553, 557, 561, 565, 569, 574,
+	579, 583, 588, 591, 600, 609, 614, 627, 630, 638, 642, 647, 652, 656, 661,
+	665, 669, 673, 677, 683, 687, 691, 695, 699, 704, 711, 715, 719, 721, 725,
+	727, 731, 733, 739, 745, 749, 752, 755, 759, 765, 769, 772, 775, 781, 784,
+	787, 791, 797, 800, 803, 807, 811, 815, 817, 821, 823, 826, 830, 832, 838,
+	842, 846, 850, 853, 858, 863, 868, 873, 879, 883, 885, 889, 893, 895, 897,
+	912, 922, 932, 937, 941, 948, 953, 958, 962, 966, 970, 973, 975, 980, 984,
+	988, 992, 996, 1000, 1003, 1005, 1010, 1014, 1019, 1024, 1028, 1037, 1039,
+	1045, 1049, 1056, 1060, 1064, 1067, 1079, 1082, 1096, 1100, 1105, 1109,
+	1112, 1119, 1123, 1127, 1134, 1138, 1142, 1148, 1152, 1156, 1162, 1166,
+	1170, 1176, 1180, 1184, 1192, 1200, 1206, 1210, 1214, 1218, 1222, 1225,
+	1231, 1236, 1241, 1246, 1251, 1256, 1261, 1264, 1268, 1272, 1278, 1283,
+	1287, 1290, 1300, 1304, 1308, 1310, 1314, 1318, 1322, 1326, 1329, 1337,
+	1341, 1345, 1349, 1353, 1357, 1361, 1364, 1380, 1385, 1389, 1393, 1396,
+	1399, 1405, 1409, 1413, 1415, 1419, 1423, 1427, 1429, 1433, 1437, 1443,
+	1449, 1454, 1458, 1462, 1467, 1469, 1472, 1476, 1479, 1482, 1488, 1492,
+	1496, 1504, 1508, 1512, 1516, 1520, 1524, 1528, 1532, 1536, 1540, 1543,
+	1550, 1554, 1559, 1569,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -797,30 +819,31 @@ var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 var literalNames = []string{
 	"", "';'", "','", "'='", "'+='", "'*'", "'('", "')'", "'['", "']'", "':'",
 	"'|'", "'..'", "'+'", "'-'", "'/'", "'%'", "'^'", "'<>'", "'<'", "'>'",
-	"'<='", "'>='", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'", "'\uFE64'",
-	"'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'", "'\u00AD'",
-	"'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'", "'\u2015'",
-	"'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "", "", "", "",
+	"'<='", "'>='", "'=~'", "'.'", "'{'", "'}'", "'$'", "'\u27E8'", "'\u3008'",
+	"'\uFE64'", "'\uFF1C'", "'\u27E9'", "'\u3009'", "'\uFE65'", "'\uFF1E'",
+	"'\u00AD'", "'\u2010'", "'\u2011'", "'\u2012'", "'\u2013'", "'\u2014'",
+	"'\u2015'", "'\u2212'", "'\uFE58'", "'\uFE63'", "'\uFF0D'", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"'0'",
+	"", "", "", "", "", "'0'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL", "MATCH",
-	"UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE", "REMOVE",
-	"CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY", "L_SKIP",
-	"LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "OR", "XOR",
-	"AND", "NOT", "IN", "STARTS", "ENDS", "CONTAINS", "IS", "NULL", "COUNT",
-	"ANY", "NONE", "SINGLE", "TRUE", "FALSE", "EXISTS", "CASE", "ELSE", "END",
-	"WHEN", "THEN", "StringLiteral", "EscapedChar", "HexInteger", "DecimalInteger",
-	"OctalInteger", "HexLetter", "HexDigit", "Digit", "NonZeroDigit", "NonZeroOctDigit",
-	"OctDigit", "ZeroDigit", "ExponentDecimalReal", "RegularDecimalReal", "CONSTRAINT",
-	"DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY", "SCALAR", "OF", "ADD", "DROP",
-	"FILTER", "EXTRACT", "UnescapedSymbolicName", "IdentifierStart", "IdentifierPart",
-	"EscapedSymbolicName", "SP", "WHITESPACE", "Comment",
+	"", "", "", "", "", "", "", "", "", "", "", "UNION", "ALL", "OPTIONAL",
+	"MATCH", "UNWIND", "AS", "MERGE", "ON", "CREATE", "SET", "DETACH", "DELETE",
+	"REMOVE", "CALL", "YIELD", "WITH", "RETURN", "DISTINCT", "ORDER", "BY",
+	"L_SKIP", "LIMIT", "ASCENDING", "ASC", "DESCENDING", "DESC", "WHERE", "SHORTESTPATH",
+	"ALLSHORTESTPATHS", "OR", "XOR", "AND", "NOT", "IN", "STARTS", "ENDS",
+	"CONTAINS", "IS", "NULL", "COUNT", "ANY", "NONE", "SINGLE", "TRUE", "FALSE",
+	"EXISTS", "CASE", "ELSE", "END", "WHEN", "THEN", "StringLiteral", "EscapedChar",
+	"HexInteger", "DecimalInteger", "OctalInteger", "HexLetter", "HexDigit",
+	"Digit", "NonZeroDigit", "NonZeroOctDigit", "OctDigit", "ZeroDigit", "ExponentDecimalReal",
+	"RegularDecimalReal", "CONSTRAINT", "DO", "FOR", "REQUIRE", "UNIQUE", "MANDATORY",
+	"SCALAR", "OF", "ADD", "DROP", "FILTER", "EXTRACT", "UnescapedSymbolicName",
+	"IdentifierStart", "IdentifierPart", "EscapedSymbolicName", "SP", "WHITESPACE",
+	"Comment",
 }
 
 var ruleNames = []string{
@@ -831,13 +854,13 @@ var ruleNames = []string{
 	"oC_InQueryCall", "oC_StandaloneCall", "oC_YieldItems", "oC_YieldItem",
 	"oC_With", "oC_Return", "oC_ProjectionBody", "oC_ProjectionItems", "oC_ProjectionItem",
 	"oC_Order", "oC_Skip", "oC_Limit", "oC_SortItem", "oC_Where", "oC_Pattern",
-	"oC_PatternPart", "oC_AnonymousPatternPart", "oC_PatternElement", "oC_NodePattern",
-	"oC_PatternElementChain", "oC_RelationshipPattern", "oC_RelationshipDetail",
-	"oC_Properties", "oC_RelationshipTypes", "oC_NodeLabels", "oC_NodeLabel",
-	"oC_RangeLiteral", "oC_LabelName", "oC_RelTypeName", "oC_Expression", "oC_OrExpression",
-	"oC_XorExpression", "oC_AndExpression", "oC_NotExpression", "oC_ComparisonExpression",
-	"oC_AddOrSubtractExpression", "oC_MultiplyDivideModuloExpression", "oC_PowerOfExpression",
-	"oC_UnaryAddOrSubtractExpression", "oC_StringListNullOperatorExpression",
+	"oC_PatternPart", "oC_AnonymousPatternPart", "oC_ShortestPathPattern",
+	"oC_PatternElement", "oC_NodePattern", "oC_PatternElementChain", "oC_RelationshipPattern",
+	"oC_RelationshipDetail", "oC_Properties", "oC_RelationshipTypes", "oC_NodeLabels",
+	"oC_NodeLabel", "oC_RangeLiteral", "oC_LabelName", "oC_RelTypeName", "oC_Expression",
+	"oC_OrExpression", "oC_XorExpression", "oC_AndExpression", "oC_NotExpression",
+	"oC_ComparisonExpression", "oC_AddOrSubtractExpression", "oC_MultiplyDivideModuloExpression",
+	"oC_PowerOfExpression", "oC_UnaryAddOrSubtractExpression", "oC_StringListNullOperatorExpression",
 	"oC_ListOperatorExpression", "oC_StringOperatorExpression", "oC_NullOperatorExpression",
 	"oC_PropertyOrLabelsExpression", "oC_Atom", "oC_Literal", "oC_BooleanLiteral",
 	"oC_ListLiteral", "oC_PartialComparisonExpression", "oC_ParenthesizedExpression",
@@ -924,88 +947,91 @@ const (
 	CypherParserT__42                 = 43
 	CypherParserT__43                 = 44
 	CypherParserT__44                 = 45
-	CypherParserUNION                 = 46
-	CypherParserALL                   = 47
-	CypherParserOPTIONAL              = 48
-	CypherParserMATCH                 = 49
-	CypherParserUNWIND                = 50
-	CypherParserAS                    = 51
-	CypherParserMERGE                 = 52
-	CypherParserON                    = 53
-	CypherParserCREATE                = 54
-	CypherParserSET                   = 55
-	CypherParserDETACH                = 56
-	CypherParserDELETE                = 57
-	CypherParserREMOVE                = 58
-	CypherParserCALL                  = 59
-	CypherParserYIELD                 = 60
-	CypherParserWITH                  = 61
-	CypherParserRETURN                = 62
-	CypherParserDISTINCT              = 63
-	CypherParserORDER                 = 64
-	CypherParserBY                    = 65
-	CypherParserL_SKIP                = 66
-	CypherParserLIMIT                 = 67
-	CypherParserASCENDING             = 68
-	CypherParserASC                   = 69
-	CypherParserDESCENDING            = 70
-	CypherParserDESC                  = 71
-	CypherParserWHERE                 = 72
-	CypherParserOR                    = 73
-	CypherParserXOR                   = 74
-	CypherParserAND                   = 75
-	CypherParserNOT                   = 76
-	CypherParserIN                    = 77
-	CypherParserSTARTS                = 78
-	CypherParserENDS                  = 79
-	CypherParserCONTAINS              = 80
-	CypherParserIS                    = 81
-	CypherParserNULL                  = 82
-	CypherParserCOUNT                 = 83
-	CypherParserANY                   = 84
-	CypherParserNONE                  = 85
-	CypherParserSINGLE                = 86
-	CypherParserTRUE                  = 87
-	CypherParserFALSE                 = 88
-	CypherParserEXISTS                = 89
-	CypherParserCASE                  = 90
-	CypherParserELSE                  = 91
-	CypherParserEND                   = 92
-	CypherParserWHEN                  = 93
-	CypherParserTHEN                  = 94
-	CypherParserStringLiteral         = 95
-	CypherParserEscapedChar           = 96
-	CypherParserHexInteger            = 97
-	CypherParserDecimalInteger        = 98
-	CypherParserOctalInteger          = 99
-	CypherParserHexLetter             = 100
-	CypherParserHexDigit              = 101
-	CypherParserDigit                 = 102
-	CypherParserNonZeroDigit          = 103
-	CypherParserNonZeroOctDigit       = 104
-	CypherParserOctDigit              = 105
-	CypherParserZeroDigit             = 106
-	CypherParserExponentDecimalReal   = 107
-	CypherParserRegularDecimalReal    = 108
-	CypherParserCONSTRAINT            = 109
-	CypherParserDO                    = 110
-	CypherParserFOR                   = 111
-	CypherParserREQUIRE               = 112
-	CypherParserUNIQUE                = 113
-	CypherParserMANDATORY             = 114
-	CypherParserSCALAR                = 115
-	CypherParserOF                    = 116
-	CypherParserADD                   = 117
-	CypherParserDROP                  = 118
-	CypherParserFILTER                = 119
-	CypherParserEXTRACT               = 120
-	CypherParserUnescapedSymbolicName = 121
-	CypherParserIdentifierStart       = 122
-	CypherParserIdentifierPart        = 123
-	CypherParserEscapedSymbolicName   = 124
-	CypherParserSP                    = 125
-	CypherParserWHITESPACE            = 126
-	CypherParserComment               = 127
+	CypherParserT__45                 = 46
+	CypherParserUNION                 = 47
+	CypherParserALL                   = 48
+	CypherParserOPTIONAL              = 49
+	CypherParserMATCH                 = 50
+	CypherParserUNWIND                = 51
+	CypherParserAS                    = 52
+	CypherParserMERGE                 = 53
+	CypherParserON                    = 54
+	CypherParserCREATE                = 55
+	CypherParserSET                   = 56
+	CypherParserDETACH                = 57
+	CypherParserDELETE                = 58
+	CypherParserREMOVE                = 59
+	CypherParserCALL                  = 60
+	CypherParserYIELD                 = 61
+	CypherParserWITH                  = 62
+	CypherParserRETURN                = 63
+	CypherParserDISTINCT              = 64
+	CypherParserORDER                 = 65
+	CypherParserBY                    = 66
+	CypherParserL_SKIP                = 67
+	CypherParserLIMIT                 = 68
+	CypherParserASCENDING             = 69
+	CypherParserASC                   = 70
+	CypherParserDESCENDING            = 71
+	CypherParserDESC                  = 72
+	CypherParserWHERE                 = 73
+	CypherParserSHORTESTPATH          = 74
+	CypherParserALLSHORTESTPATHS      = 75
+	CypherParserOR                    = 76
+	CypherParserXOR                   = 77
+	CypherParserAND                   = 78
+	CypherParserNOT                   = 79
+	CypherParserIN                    = 80
+	CypherParserSTARTS                = 81
+	CypherParserENDS                  = 82
+	CypherParserCONTAINS              = 83
+	CypherParserIS                    = 84
+	CypherParserNULL                  = 85
+	CypherParserCOUNT                 = 86
+	CypherParserANY                   = 87
+	CypherParserNONE                  = 88
+	CypherParserSINGLE                = 89
+	CypherParserTRUE                  = 90
+	CypherParserFALSE                 = 91
+	CypherParserEXISTS                = 92
+	CypherParserCASE                  = 93
+	CypherParserELSE                  = 94
+	CypherParserEND                   = 95
+	CypherParserWHEN                  = 96
+	CypherParserTHEN                  = 97
+	CypherParserStringLiteral         = 98
+	CypherParserEscapedChar           = 99
+	CypherParserHexInteger            = 100
+	CypherParserDecimalInteger        = 101
+	CypherParserOctalInteger          = 102
+	CypherParserHexLetter             = 103
+	CypherParserHexDigit              = 104
+	CypherParserDigit                 = 105
+	CypherParserNonZeroDigit          = 106
+	CypherParserNonZeroOctDigit       = 107
+	CypherParserOctDigit              = 108
+	CypherParserZeroDigit             = 109
+	CypherParserExponentDecimalReal   = 110
+	CypherParserRegularDecimalReal    = 111
+	CypherParserCONSTRAINT            = 112
+	CypherParserDO                    = 113
+	CypherParserFOR                   = 114
+	CypherParserREQUIRE               = 115
+	CypherParserUNIQUE                = 116
+	CypherParserMANDATORY             = 117
+	CypherParserSCALAR                = 118
+	CypherParserOF                    = 119
+	CypherParserADD                   = 120
+	CypherParserDROP                  = 121
+	CypherParserFILTER                = 122
+	CypherParserEXTRACT               = 123
+	CypherParserUnescapedSymbolicName = 124
+	CypherParserIdentifierStart       = 125
+	CypherParserIdentifierPart        = 126
+	CypherParserEscapedSymbolicName   = 127
+	CypherParserSP                    = 128
+	CypherParserWHITESPACE            = 129
+	CypherParserComment               = 130
 )
 
 // CypherParser rules.
@@ -1047,68 +1073,69 @@ const (
 	CypherParserRULE_oC_Pattern                          = 34
 	CypherParserRULE_oC_PatternPart                      = 35
 	CypherParserRULE_oC_AnonymousPatternPart             = 36
-	CypherParserRULE_oC_PatternElement                   = 37
-	CypherParserRULE_oC_NodePattern                      = 38
-	CypherParserRULE_oC_PatternElementChain              = 39
-	CypherParserRULE_oC_RelationshipPattern              = 40
-	CypherParserRULE_oC_RelationshipDetail               = 41
-	CypherParserRULE_oC_Properties                       = 42
-	CypherParserRULE_oC_RelationshipTypes                = 43
-	CypherParserRULE_oC_NodeLabels                       = 44
-	CypherParserRULE_oC_NodeLabel                        = 45
-	CypherParserRULE_oC_RangeLiteral                     = 46
-	CypherParserRULE_oC_LabelName                        = 47
-	CypherParserRULE_oC_RelTypeName                      = 48
-	CypherParserRULE_oC_Expression                       = 49
-	CypherParserRULE_oC_OrExpression                     = 50
-	CypherParserRULE_oC_XorExpression                    = 51
-	CypherParserRULE_oC_AndExpression                    = 52
-	CypherParserRULE_oC_NotExpression                    = 53
-	CypherParserRULE_oC_ComparisonExpression             = 54
-	CypherParserRULE_oC_AddOrSubtractExpression          = 55
-	CypherParserRULE_oC_MultiplyDivideModuloExpression   = 56
-	CypherParserRULE_oC_PowerOfExpression                = 57
-	CypherParserRULE_oC_UnaryAddOrSubtractExpression     = 58
-	CypherParserRULE_oC_StringListNullOperatorExpression = 59
-	CypherParserRULE_oC_ListOperatorExpression           = 60
-	CypherParserRULE_oC_StringOperatorExpression         = 61
-	CypherParserRULE_oC_NullOperatorExpression           = 62
-	CypherParserRULE_oC_PropertyOrLabelsExpression       = 63
-	CypherParserRULE_oC_Atom                             = 64
-	CypherParserRULE_oC_Literal                          = 65
-	CypherParserRULE_oC_BooleanLiteral                   = 66
-	CypherParserRULE_oC_ListLiteral                      = 67
-	CypherParserRULE_oC_PartialComparisonExpression      = 68
-	CypherParserRULE_oC_ParenthesizedExpression          = 69
-	CypherParserRULE_oC_RelationshipsPattern             = 70
-	CypherParserRULE_oC_FilterExpression                 = 71
-	CypherParserRULE_oC_IdInColl                         = 72
-	CypherParserRULE_oC_FunctionInvocation               = 73
-	CypherParserRULE_oC_FunctionName                     = 74
-	CypherParserRULE_oC_ExplicitProcedureInvocation      = 75
-	CypherParserRULE_oC_ImplicitProcedureInvocation      = 76
-	CypherParserRULE_oC_ProcedureResultField             = 77
-	CypherParserRULE_oC_ProcedureName                    = 78
-	CypherParserRULE_oC_Namespace                        = 79
-	CypherParserRULE_oC_ListComprehension                = 80
-	CypherParserRULE_oC_PatternComprehension             = 81
-	CypherParserRULE_oC_PropertyLookup                   = 82
-	CypherParserRULE_oC_CaseExpression                   = 83
-	CypherParserRULE_oC_CaseAlternatives                 = 84
-	CypherParserRULE_oC_Variable                         = 85
-	CypherParserRULE_oC_NumberLiteral                    = 86
-	CypherParserRULE_oC_MapLiteral                       = 87
-	CypherParserRULE_oC_Parameter                        = 88
-	CypherParserRULE_oC_PropertyExpression               = 89
-	CypherParserRULE_oC_PropertyKeyName                  = 90
-	CypherParserRULE_oC_IntegerLiteral                   = 91
-	CypherParserRULE_oC_DoubleLiteral                    = 92
-	CypherParserRULE_oC_SchemaName                       = 93
-	CypherParserRULE_oC_ReservedWord                     = 94
-	CypherParserRULE_oC_SymbolicName                     = 95
-	CypherParserRULE_oC_LeftArrowHead                    = 96
-	CypherParserRULE_oC_RightArrowHead                   = 97
-	CypherParserRULE_oC_Dash                             = 98
+	CypherParserRULE_oC_ShortestPathPattern              = 37
+	CypherParserRULE_oC_PatternElement                   = 38
+	CypherParserRULE_oC_NodePattern                      = 39
+	CypherParserRULE_oC_PatternElementChain              = 40
+	CypherParserRULE_oC_RelationshipPattern              = 41
+	CypherParserRULE_oC_RelationshipDetail               = 42
+	CypherParserRULE_oC_Properties                       = 43
+	CypherParserRULE_oC_RelationshipTypes                = 44
+	CypherParserRULE_oC_NodeLabels                       = 45
+	CypherParserRULE_oC_NodeLabel                        = 46
+	CypherParserRULE_oC_RangeLiteral                     = 47
+	CypherParserRULE_oC_LabelName                        = 48
+	CypherParserRULE_oC_RelTypeName                      = 49
+	CypherParserRULE_oC_Expression                       = 50
+	CypherParserRULE_oC_OrExpression                     = 51
+	CypherParserRULE_oC_XorExpression                    = 52
+	CypherParserRULE_oC_AndExpression                    = 53
+	CypherParserRULE_oC_NotExpression                    = 54
+	CypherParserRULE_oC_ComparisonExpression             = 55
+	CypherParserRULE_oC_AddOrSubtractExpression          = 56
+	CypherParserRULE_oC_MultiplyDivideModuloExpression   = 57
+	CypherParserRULE_oC_PowerOfExpression                = 58
+	CypherParserRULE_oC_UnaryAddOrSubtractExpression     = 59
+	CypherParserRULE_oC_StringListNullOperatorExpression = 60
+	CypherParserRULE_oC_ListOperatorExpression           = 61
+	CypherParserRULE_oC_StringOperatorExpression         = 62
+	CypherParserRULE_oC_NullOperatorExpression           = 63
+	CypherParserRULE_oC_PropertyOrLabelsExpression       = 64
+	CypherParserRULE_oC_Atom                             = 65
+	CypherParserRULE_oC_Literal                          = 66
+	CypherParserRULE_oC_BooleanLiteral                   = 67
+	CypherParserRULE_oC_ListLiteral                      = 68
+	CypherParserRULE_oC_PartialComparisonExpression      = 69
+	CypherParserRULE_oC_ParenthesizedExpression          = 70
+	CypherParserRULE_oC_RelationshipsPattern             = 71
+	CypherParserRULE_oC_FilterExpression                 = 72
+	CypherParserRULE_oC_IdInColl                         = 73
+	CypherParserRULE_oC_FunctionInvocation               = 74
+	CypherParserRULE_oC_FunctionName                     = 75
+	CypherParserRULE_oC_ExplicitProcedureInvocation      = 76
+	CypherParserRULE_oC_ImplicitProcedureInvocation      = 77
+	CypherParserRULE_oC_ProcedureResultField             = 78
+	CypherParserRULE_oC_ProcedureName                    = 79
+	CypherParserRULE_oC_Namespace                        = 80
+	CypherParserRULE_oC_ListComprehension                = 81
+	CypherParserRULE_oC_PatternComprehension             = 82
+	CypherParserRULE_oC_PropertyLookup                   = 83
+	CypherParserRULE_oC_CaseExpression                   = 84
+	CypherParserRULE_oC_CaseAlternatives                 = 85
+	CypherParserRULE_oC_Variable                         = 86
+	CypherParserRULE_oC_NumberLiteral                    = 87
+	CypherParserRULE_oC_MapLiteral                       = 88
+	CypherParserRULE_oC_Parameter                        = 89
+	CypherParserRULE_oC_PropertyExpression               = 90
+	CypherParserRULE_oC_PropertyKeyName                  = 91
+	CypherParserRULE_oC_IntegerLiteral                   = 92
+	CypherParserRULE_oC_DoubleLiteral                    = 93
+	CypherParserRULE_oC_SchemaName                       = 94
+	CypherParserRULE_oC_ReservedWord                     = 95
+	CypherParserRULE_oC_SymbolicName                     = 96
+	CypherParserRULE_oC_LeftArrowHead                    = 97
+	CypherParserRULE_oC_RightArrowHead                   = 98
+	CypherParserRULE_oC_Dash                             = 99
 )
 
 // IOC_CypherContext is an interface to support dynamic dispatch.
@@ -1223,55 +1250,55 @@ func (p *CypherParser) OC_Cypher() (localctx IOC_CypherContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(199)
+	p.SetState(201)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(198)
+			p.SetState(200)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(201)
+		p.SetState(203)
 		p.OC_Statement()
 	}
-	p.SetState(206)
+	p.SetState(208)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) == 1 {
-		p.SetState(203)
+		p.SetState(205)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(202)
+				p.SetState(204)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(205)
+			p.SetState(207)
 			p.Match(CypherParserT__0)
 		}
 
 	}
-	p.SetState(209)
+	p.SetState(211)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(208)
+			p.SetState(210)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(211)
+		p.SetState(213)
 		p.Match(CypherParserEOF)
 	}
 
@@ -1378,7 +1405,7 @@ func (p *CypherParser) OC_Statement() (localctx IOC_StatementContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
+		p.SetState(215)
 		p.OC_Query()
 	}
 
@@ -1493,20 +1520,20 @@ func (p *CypherParser) OC_Query() (localctx IOC_QueryContext) {
 		}
 	}()
 
-	p.SetState(217)
+	p.SetState(219)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 4, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(215)
+			p.SetState(217)
 			p.OC_RegularQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(216)
+			p.SetState(218)
 			p.OC_StandaloneCall()
 		}
 
@@ -1649,33 +1676,33 @@ func (p *CypherParser) OC_RegularQuery() (localctx IOC_RegularQueryContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(219)
+		p.SetState(221)
 		p.OC_SingleQuery()
 	}
-	p.SetState(226)
+	p.SetState(228)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(221)
+			p.SetState(223)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(220)
+					p.SetState(222)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(223)
+				p.SetState(225)
 				p.OC_Union()
 			}
 
 		}
-		p.SetState(228)
+		p.SetState(230)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext())
 	}
@@ -1798,58 +1825,58 @@ func (p *CypherParser) OC_Union() (localctx IOC_UnionContext) {
 		}
 	}()
 
-	p.SetState(241)
+	p.SetState(243)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(229)
+			p.SetState(231)
 			p.Match(CypherParserUNION)
 		}
 		{
-			p.SetState(230)
+			p.SetState(232)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(231)
+			p.SetState(233)
 			p.Match(CypherParserALL)
 		}
-		p.SetState(233)
+		p.SetState(235)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(232)
+				p.SetState(234)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(235)
+			p.SetState(237)
 			p.OC_SingleQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(236)
+			p.SetState(238)
 			p.Match(CypherParserUNION)
 		}
-		p.SetState(238)
+		p.SetState(240)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(237)
+				p.SetState(239)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(240)
+			p.SetState(242)
 			p.OC_SingleQuery()
 		}
 
@@ -1966,20 +1993,20 @@ func (p *CypherParser) OC_SingleQuery() (localctx IOC_SingleQueryContext) {
 		}
 	}()
 
-	p.SetState(245)
+	p.SetState(247)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(243)
+			p.SetState(245)
 			p.OC_SinglePartQuery()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(244)
+			p.SetState(246)
 			p.OC_MultiPartQuery()
 		}
 
@@ -2143,116 +2170,116 @@ func (p *CypherParser) OC_SinglePartQuery() (localctx IOC_SinglePartQueryContext
 
 	var _alt int
 
-	p.SetState(282)
+	p.SetState(284)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(253)
+		p.SetState(255)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
+		for ((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(CypherParserOPTIONAL-49))|(1<<(CypherParserMATCH-49))|(1<<(CypherParserUNWIND-49))|(1<<(CypherParserCALL-49)))) != 0 {
 			{
-				p.SetState(247)
+				p.SetState(249)
 				p.OC_ReadingClause()
 			}
-			p.SetState(249)
+			p.SetState(251)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(248)
+					p.SetState(250)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(255)
+			p.SetState(257)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(256)
+			p.SetState(258)
 			p.OC_Return()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(263)
+		p.SetState(265)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
+		for ((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(CypherParserOPTIONAL-49))|(1<<(CypherParserMATCH-49))|(1<<(CypherParserUNWIND-49))|(1<<(CypherParserCALL-49)))) != 0 {
 			{
-				p.SetState(257)
+				p.SetState(259)
 				p.OC_ReadingClause()
 			}
-			p.SetState(259)
+			p.SetState(261)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(258)
+					p.SetState(260)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(265)
+			p.SetState(267)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(266)
+			p.SetState(268)
 			p.OC_UpdatingClause()
 		}
-		p.SetState(273)
+		p.SetState(275)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(268)
+				p.SetState(270)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(267)
+						p.SetState(269)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(270)
+					p.SetState(272)
 					p.OC_UpdatingClause()
 				}
 
 			}
-			p.SetState(275)
+			p.SetState(277)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext())
 		}
-		p.SetState(280)
+		p.SetState(282)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) == 1 {
-			p.SetState(277)
+			p.SetState(279)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(276)
+					p.SetState(278)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(279)
+				p.SetState(281)
 				p.OC_Return()
 			}
 
@@ -2442,73 +2469,73 @@ func (p *CypherParser) OC_MultiPartQuery() (localctx IOC_MultiPartQueryContext)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(306)
+	p.SetState(308)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(290)
+			p.SetState(292)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			for ((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserOPTIONAL-48))|(1<<(CypherParserMATCH-48))|(1<<(CypherParserUNWIND-48))|(1<<(CypherParserCALL-48)))) != 0 {
+			for ((_la-49)&-(0x1f+1)) == 0 && ((1<<uint((_la-49)))&((1<<(CypherParserOPTIONAL-49))|(1<<(CypherParserMATCH-49))|(1<<(CypherParserUNWIND-49))|(1<<(CypherParserCALL-49)))) != 0 {
 				{
-					p.SetState(284)
+					p.SetState(286)
 					p.OC_ReadingClause()
 				}
-				p.SetState(286)
+				p.SetState(288)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(285)
+						p.SetState(287)
 						p.Match(CypherParserSP)
 					}
 
 				}
 
-				p.SetState(292)
+				p.SetState(294)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
-			p.SetState(299)
+			p.SetState(301)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			for ((_la-52)&-(0x1f+1)) == 0 && ((1<<uint((_la-52)))&((1<<(CypherParserMERGE-52))|(1<<(CypherParserCREATE-52))|(1<<(CypherParserSET-52))|(1<<(CypherParserDETACH-52))|(1<<(CypherParserDELETE-52))|(1<<(CypherParserREMOVE-52)))) != 0 {
+			for ((_la-53)&-(0x1f+1)) == 0 && ((1<<uint((_la-53)))&((1<<(CypherParserMERGE-53))|(1<<(CypherParserCREATE-53))|(1<<(CypherParserSET-53))|(1<<(CypherParserDETACH-53))|(1<<(CypherParserDELETE-53))|(1<<(CypherParserREMOVE-53)))) != 0 {
 				{
-					p.SetState(293)
+					p.SetState(295)
 					p.OC_UpdatingClause()
 				}
-				p.SetState(295)
+				p.SetState(297)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(294)
+						p.SetState(296)
 						p.Match(CypherParserSP)
 					}
 
 				}
 
-				p.SetState(301)
+				p.SetState(303)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(302)
+				p.SetState(304)
 				p.OC_With()
 			}
-			p.SetState(304)
+			p.SetState(306)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(303)
+					p.SetState(305)
 					p.Match(CypherParserSP)
 				}
 
@@ -2518,12 +2545,12 @@ func (p *CypherParser) OC_MultiPartQuery() (localctx IOC_MultiPartQueryContext)
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(308)
+		p.SetState(310)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(310)
+		p.SetState(312)
 		p.OC_SinglePartQuery()
 	}
 
@@ -2668,42 +2695,42 @@ func (p *CypherParser) OC_UpdatingClause() (localctx IOC_UpdatingClauseContext)
 		}
 	}()
 
-	p.SetState(317)
+	p.SetState(319)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserCREATE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(312)
+			p.SetState(314)
 			p.OC_Create()
 		}
 
 	case CypherParserMERGE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(313)
+			p.SetState(315)
 			p.OC_Merge()
 		}
 
 	case CypherParserDETACH, CypherParserDELETE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(314)
+			p.SetState(316)
 			p.OC_Delete()
 		}
 
 	case CypherParserSET:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(315)
+			p.SetState(317)
 			p.OC_Set()
 		}
 
 	case CypherParserREMOVE:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(316)
+			p.SetState(318)
 			p.OC_Remove()
 		}
 
@@ -2832,28 +2859,28 @@ func (p *CypherParser) OC_ReadingClause() (localctx IOC_ReadingClauseContext) {
 		}
 	}()
 
-	p.SetState(322)
+	p.SetState(324)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserOPTIONAL, CypherParserMATCH:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(319)
+			p.SetState(321)
 			p.OC_Match()
 		}
 
 	case CypherParserUNWIND:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(320)
+			p.SetState(322)
 			p.OC_Unwind()
 		}
 
 	case CypherParserCALL:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(321)
+			p.SetState(323)
 			p.OC_InQueryCall()
 		}
 
@@ -2990,57 +3017,57 @@ func (p *CypherParser) OC_Match() (localctx IOC_MatchContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(326)
+	p.SetState(328)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserOPTIONAL {
 		{
-			p.SetState(324)
+			p.SetState(326)
 			p.Match(CypherParserOPTIONAL)
 		}
 		{
-			p.SetState(325)
+			p.SetState(327)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(328)
+		p.SetState(330)
 		p.Match(CypherParserMATCH)
 	}
-	p.SetState(330)
+	p.SetState(332)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(329)
+			p.SetState(331)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(332)
+		p.SetState(334)
 		p.OC_Pattern()
 	}
-	p.SetState(337)
+	p.SetState(339)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
-		p.SetState(334)
+		p.SetState(336)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(333)
+				p.SetState(335)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(336)
+			p.SetState(338)
 			p.OC_Where()
 		}
 
@@ -3176,38 +3203,38 @@ func (p *CypherParser) OC_Unwind() (localctx IOC_UnwindContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(339)
+		p.SetState(341)
 		p.Match(CypherParserUNWIND)
 	}
-	p.SetState(341)
+	p.SetState(343)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(340)
+			p.SetState(342)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(343)
+		p.SetState(345)
 		p.OC_Expression()
 	}
 	{
-		p.SetState(344)
+		p.SetState(346)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(345)
+		p.SetState(347)
 		p.Match(CypherParserAS)
 	}
 	{
-		p.SetState(346)
+		p.SetState(348)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(347)
+		p.SetState(349)
 		p.OC_Variable()
 	}
 
@@ -3352,41 +3379,41 @@ func (p *CypherParser) OC_Merge() (localctx IOC_MergeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(349)
+		p.SetState(351)
 		p.Match(CypherParserMERGE)
 	}
-	p.SetState(351)
+	p.SetState(353)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(350)
+			p.SetState(352)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(353)
+		p.SetState(355)
 		p.OC_PatternPart()
 	}
-	p.SetState(358)
+	p.SetState(360)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(354)
+				p.SetState(356)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(355)
+				p.SetState(357)
 				p.OC_MergeAction()
 			}
 
 		}
-		p.SetState(360)
+		p.SetState(362)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext())
 	}
@@ -3512,52 +3539,52 @@ func (p *CypherParser) OC_MergeAction() (localctx IOC_MergeActionContext) {
 		}
 	}()
 
-	p.SetState(371)
+	p.SetState(373)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(361)
+			p.SetState(363)
 			p.Match(CypherParserON)
 		}
 		{
-			p.SetState(362)
+			p.SetState(364)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(363)
+			p.SetState(365)
 			p.Match(CypherParserMATCH)
 		}
 		{
-			p.SetState(364)
+			p.SetState(366)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(365)
+			p.SetState(367)
 			p.OC_Set()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(366)
+			p.SetState(368)
 			p.Match(CypherParserON)
 		}
 		{
-			p.SetState(367)
+			p.SetState(369)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(368)
+			p.SetState(370)
 			p.Match(CypherParserCREATE)
 		}
 		{
-			p.SetState(369)
+			p.SetState(371)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(370)
+			p.SetState(372)
 			p.OC_Set()
 		}
 
@@ -3675,22 +3702,22 @@ func (p *CypherParser) OC_Create() (localctx IOC_CreateContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(373)
+		p.SetState(375)
 		p.Match(CypherParserCREATE)
 	}
-	p.SetState(375)
+	p.SetState(377)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(374)
+			p.SetState(376)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(377)
+		p.SetState(379)
 		p.OC_Pattern()
 	}
 
@@ -3819,39 +3846,39 @@ func (p *CypherParser) OC_Set() (localctx IOC_SetContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(379)
+		p.SetState(381)
 		p.Match(CypherParserSET)
 	}
-	p.SetState(381)
+	p.SetState(383)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(380)
+			p.SetState(382)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(383)
+		p.SetState(385)
 		p.OC_SetItem()
 	}
-	p.SetState(388)
+	p.SetState(390)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserT__1 {
 		{
-			p.SetState(384)
+			p.SetState(386)
 			p.Match(CypherParserT__1)
 		}
 		{
-			p.SetState(385)
+			p.SetState(387)
 			p.OC_SetItem()
 		}
 
-		p.SetState(390)
+		p.SetState(392)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -3996,139 +4023,139 @@ func (p *CypherParser) OC_SetItem() (localctx IOC_SetItemContext) {
 		}
 	}()
 
-	p.SetState(427)
+	p.SetState(429)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(391)
+			p.SetState(393)
 			p.OC_PropertyExpression()
 		}
-		p.SetState(393)
+		p.SetState(395)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(392)
+				p.SetState(394)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(395)
+			p.SetState(397)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(397)
+		p.SetState(399)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(396)
+				p.SetState(398)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(399)
+			p.SetState(401)
 			p.OC_Expression()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(401)
+			p.SetState(403)
 			p.OC_Variable()
 		}
-		p.SetState(403)
+		p.SetState(405)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(402)
+				p.SetState(404)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(405)
+			p.SetState(407)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(407)
+		p.SetState(409)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(406)
+				p.SetState(408)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(409)
+			p.SetState(411)
 			p.OC_Expression()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(411)
+			p.SetState(413)
 			p.OC_Variable()
 		}
-		p.SetState(413)
+		p.SetState(415)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(412)
+				p.SetState(414)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(415)
+			p.SetState(417)
 			p.Match(CypherParserT__3)
 		}
-		p.SetState(417)
+		p.SetState(419)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(416)
+				p.SetState(418)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(419)
+			p.SetState(421)
 			p.OC_Expression()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(421)
+			p.SetState(423)
 			p.OC_Variable()
 		}
-		p.SetState(423)
+		p.SetState(425)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(422)
+				p.SetState(424)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(425)
+			p.SetState(427)
 			p.OC_NodeLabels()
 		}
 
@@ -4268,79 +4295,79 @@ func (p *CypherParser) OC_Delete() (localctx IOC_DeleteContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(431)
+	p.SetState(433)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserDETACH {
 		{
-			p.SetState(429)
+			p.SetState(431)
 			p.Match(CypherParserDETACH)
 		}
 		{
-			p.SetState(430)
+			p.SetState(432)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(433)
+		p.SetState(435)
 		p.Match(CypherParserDELETE)
 	}
-	p.SetState(435)
+	p.SetState(437)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(434)
+			p.SetState(436)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(437)
+		p.SetState(439)
 		p.OC_Expression()
 	}
-	p.SetState(448)
+	p.SetState(450)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(439)
+			p.SetState(441)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(438)
+					p.SetState(440)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(441)
+				p.SetState(443)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(443)
+			p.SetState(445)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(442)
+					p.SetState(444)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(445)
+				p.SetState(447)
 				p.OC_Expression()
 			}
 
 		}
-		p.SetState(450)
+		p.SetState(452)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext())
 	}
@@ -4476,56 +4503,56 @@ func (p *CypherParser) OC_Remove() (localctx IOC_RemoveContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(451)
+		p.SetState(453)
 		p.Match(CypherParserREMOVE)
 	}
 	{
-		p.SetState(452)
+		p.SetState(454)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(453)
+		p.SetState(455)
 		p.OC_RemoveItem()
 	}
-	p.SetState(464)
+	p.SetState(466)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(455)
+			p.SetState(457)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(454)
+					p.SetState(456)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(457)
+				p.SetState(459)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(459)
+			p.SetState(461)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(458)
+					p.SetState(460)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(461)
+				p.SetState(463)
 				p.OC_RemoveItem()
 			}
 
 		}
-		p.SetState(466)
+		p.SetState(468)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext())
 	}
@@ -4651,24 +4678,24 @@ func (p *CypherParser) OC_RemoveItem() (localctx IOC_RemoveItemContext) {
 		}
 	}()
 
-	p.SetState(471)
+	p.SetState(473)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(467)
+			p.SetState(469)
 			p.OC_Variable()
 		}
 		{
-			p.SetState(468)
+			p.SetState(470)
 			p.OC_NodeLabels()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(470)
+			p.SetState(472)
 			p.OC_PropertyExpression()
 		}
 
@@ -4804,42 +4831,42 @@ func (p *CypherParser) OC_InQueryCall() (localctx IOC_InQueryCallContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(473)
+		p.SetState(475)
 		p.Match(CypherParserCALL)
 	}
 	{
-		p.SetState(474)
+		p.SetState(476)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(475)
+		p.SetState(477)
 		p.OC_ExplicitProcedureInvocation()
 	}
-	p.SetState(482)
+	p.SetState(484)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
-		p.SetState(477)
+		p.SetState(479)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(476)
+				p.SetState(478)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(479)
+			p.SetState(481)
 			p.Match(CypherParserYIELD)
 		}
 		{
-			p.SetState(480)
+			p.SetState(482)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(481)
+			p.SetState(483)
 			p.OC_YieldItems()
 		}
 
@@ -4984,47 +5011,47 @@ func (p *CypherParser) OC_StandaloneCall() (localctx IOC_StandaloneCallContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(484)
+		p.SetState(486)
 		p.Match(CypherParserCALL)
 	}
 	{
-		p.SetState(485)
+		p.SetState(487)
 		p.Match(CypherParserSP)
 	}
-	p.SetState(488)
+	p.SetState(490)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(486)
+			p.SetState(488)
 			p.OC_ExplicitProcedureInvocation()
 		}
 
 	case 2:
 		{
-			p.SetState(487)
+			p.SetState(489)
 			p.OC_ImplicitProcedureInvocation()
 		}
 
 	}
-	p.SetState(494)
+	p.SetState(496)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(490)
+			p.SetState(492)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(491)
+			p.SetState(493)
 			p.Match(CypherParserYIELD)
 		}
 		{
-			p.SetState(492)
+			p.SetState(494)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(493)
+			p.SetState(495)
 			p.OC_YieldItems()
 		}
 
@@ -5166,60 +5193,60 @@ func (p *CypherParser) OC_YieldItems() (localctx IOC_YieldItemsContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(511)
+	p.SetState(513)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__4:
 		{
-			p.SetState(496)
+			p.SetState(498)
 			p.Match(CypherParserT__4)
 		}
 
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		{
-			p.SetState(497)
+			p.SetState(499)
 			p.OC_YieldItem()
 		}
-		p.SetState(508)
+		p.SetState(510)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(499)
+				p.SetState(501)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(498)
+						p.SetState(500)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(501)
+					p.SetState(503)
 					p.Match(CypherParserT__1)
 				}
-				p.SetState(503)
+				p.SetState(505)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(502)
+						p.SetState(504)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(505)
+					p.SetState(507)
 					p.OC_YieldItem()
 				}
 
 			}
-			p.SetState(510)
+			p.SetState(512)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext())
 		}
@@ -5227,23 +5254,23 @@ func (p *CypherParser) OC_YieldItems() (localctx IOC_YieldItemsContext) {
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(517)
+	p.SetState(519)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) == 1 {
-		p.SetState(514)
+		p.SetState(516)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(513)
+				p.SetState(515)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(516)
+			p.SetState(518)
 			p.OC_Where()
 		}
 
@@ -5373,30 +5400,30 @@ func (p *CypherParser) OC_YieldItem() (localctx IOC_YieldItemContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(524)
+	p.SetState(526)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(519)
+			p.SetState(521)
 			p.OC_ProcedureResultField()
 		}
 		{
-			p.SetState(520)
+			p.SetState(522)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(521)
+			p.SetState(523)
 			p.Match(CypherParserAS)
 		}
 		{
-			p.SetState(522)
+			p.SetState(524)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(526)
+		p.SetState(528)
 		p.OC_Variable()
 	}
 
@@ -5522,30 +5549,30 @@ func (p *CypherParser) OC_With() (localctx IOC_WithContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
+		p.SetState(530)
 		p.Match(CypherParserWITH)
 	}
 	{
-		p.SetState(529)
+		p.SetState(531)
 		p.OC_ProjectionBody()
 	}
-	p.SetState(534)
+	p.SetState(536)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) == 1 {
-		p.SetState(531)
+		p.SetState(533)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(530)
+				p.SetState(532)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(533)
+			p.SetState(535)
 			p.OC_Where()
 		}
 
@@ -5658,11 +5685,11 @@ func (p *CypherParser) OC_Return() (localctx IOC_ReturnContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(536)
+		p.SetState(538)
 		p.Match(CypherParserRETURN)
 	}
 	{
-		p.SetState(537)
+		p.SetState(539)
 		p.OC_ProjectionBody()
 	}
 
@@ -5811,73 +5838,73 @@ func (p *CypherParser) OC_ProjectionBody() (localctx IOC_ProjectionBodyContext)
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(543)
+	p.SetState(545)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) == 1 {
-		p.SetState(540)
+		p.SetState(542)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(539)
+				p.SetState(541)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(542)
+			p.SetState(544)
 			p.Match(CypherParserDISTINCT)
 		}
 
 	}
 	{
-		p.SetState(545)
+		p.SetState(547)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(546)
+		p.SetState(548)
 		p.OC_ProjectionItems()
 	}
-	p.SetState(549)
+	p.SetState(551)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(547)
+			p.SetState(549)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(548)
+			p.SetState(550)
 			p.OC_Order()
 		}
 
 	}
-	p.SetState(553)
+	p.SetState(555)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(551)
+			p.SetState(553)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(552)
+			p.SetState(554)
 			p.OC_Skip()
 		}
 
 	}
-	p.SetState(557)
+	p.SetState(559)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(555)
+			p.SetState(557)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(556)
+			p.SetState(558)
 			p.OC_Limit()
 		}
 
@@ -6008,104 +6035,104 @@ func (p *CypherParser) OC_ProjectionItems() (localctx IOC_ProjectionItemsContext
 
 	var _alt int
 
-	p.SetState(587)
+	p.SetState(589)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__4:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(559)
+			p.SetState(561)
 			p.Match(CypherParserT__4)
 		}
-		p.SetState(570)
+		p.SetState(572)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(561)
+				p.SetState(563)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(560)
+						p.SetState(562)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(563)
+					p.SetState(565)
 					p.Match(CypherParserT__1)
 				}
-				p.SetState(565)
+				p.SetState(567)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(564)
+						p.SetState(566)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(567)
+					p.SetState(569)
 					p.OC_ProjectionItem()
 				}
 
 			}
-			p.SetState(572)
+			p.SetState(574)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext())
 		}
 
-	case CypherParserT__5, CypherParserT__7, CypherParserT__12, CypherParserT__13, CypherParserT__23, CypherParserT__25, CypherParserALL, CypherParserNOT, CypherParserNULL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserStringLiteral, CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserHexLetter, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserT__5, CypherParserT__7, CypherParserT__12, CypherParserT__13, CypherParserT__24, CypherParserT__26, CypherParserALL, CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserNOT, CypherParserNULL, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserStringLiteral, CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserHexLetter, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(573)
+			p.SetState(575)
 			p.OC_ProjectionItem()
 		}
-		p.SetState(584)
+		p.SetState(586)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(575)
+				p.SetState(577)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(574)
+						p.SetState(576)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(577)
+					p.SetState(579)
 					p.Match(CypherParserT__1)
 				}
-				p.SetState(579)
+				p.SetState(581)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(578)
+						p.SetState(580)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(581)
+					p.SetState(583)
 					p.OC_ProjectionItem()
 				}
 
 			}
-			p.SetState(586)
+			p.SetState(588)
 			p.GetErrorHandler().Sync(p)
 			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext())
 		}
@@ -6237,36 +6264,36 @@ func (p *CypherParser) OC_ProjectionItem() (localctx IOC_ProjectionItemContext)
 		}
 	}()
 
-	p.SetState(596)
+	p.SetState(598)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(589)
+			p.SetState(591)
 			p.OC_Expression()
 		}
 		{
-			p.SetState(590)
+			p.SetState(592)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(591)
+			p.SetState(593)
 			p.Match(CypherParserAS)
 		}
 		{
-			p.SetState(592)
+			p.SetState(594)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(593)
+			p.SetState(595)
 			p.OC_Variable()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(595)
+			p.SetState(597)
 			p.OC_Expression()
 		}
 
@@ -6405,51 +6432,51 @@ func (p *CypherParser) OC_Order() (localctx IOC_OrderContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(598)
+		p.SetState(600)
 		p.Match(CypherParserORDER)
 	}
 	{
-		p.SetState(599)
+		p.SetState(601)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(600)
+		p.SetState(602)
 		p.Match(CypherParserBY)
 	}
 	{
-		p.SetState(601)
+		p.SetState(603)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(602)
+		p.SetState(604)
 		p.OC_SortItem()
 	}
-	p.SetState(610)
+	p.SetState(612)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserT__1 {
 		{
-			p.SetState(603)
+			p.SetState(605)
 			p.Match(CypherParserT__1)
 		}
-		p.SetState(605)
+		p.SetState(607)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(604)
+				p.SetState(606)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(607)
+			p.SetState(609)
 			p.OC_SortItem()
 		}
 
-		p.SetState(612)
+		p.SetState(614)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -6565,15 +6592,15 @@ func (p *CypherParser) OC_Skip() (localctx IOC_SkipContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(613)
+		p.SetState(615)
 		p.Match(CypherParserL_SKIP)
 	}
 	{
-		p.SetState(614)
+		p.SetState(616)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(615)
+		p.SetState(617)
 		p.OC_Expression()
 	}
 
@@ -6688,15 +6715,15 @@ func (p *CypherParser) OC_Limit() (localctx IOC_LimitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(617)
+		p.SetState(619)
 		p.Match(CypherParserLIMIT)
 	}
 	{
-		p.SetState(618)
+		p.SetState(620)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(619)
+		p.SetState(621)
 		p.OC_Expression()
 	}
 
@@ -6824,29 +6851,29 @@ func (p *CypherParser) OC_SortItem() (localctx IOC_SortItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(621)
+		p.SetState(623)
 		p.OC_Expression()
 	}
-	p.SetState(626)
+	p.SetState(628)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 85, p.GetParserRuleContext()) == 1 {
-		p.SetState(623)
+		p.SetState(625)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(622)
+				p.SetState(624)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(625)
+			p.SetState(627)
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la-68)&-(0x1f+1)) == 0 && ((1<<uint((_la-68)))&((1<<(CypherParserASCENDING-68))|(1<<(CypherParserASC-68))|(1<<(CypherParserDESCENDING-68))|(1<<(CypherParserDESC-68)))) != 0) {
+			if !(((_la-69)&-(0x1f+1)) == 0 && ((1<<uint((_la-69)))&((1<<(CypherParserASCENDING-69))|(1<<(CypherParserASC-69))|(1<<(CypherParserDESCENDING-69))|(1<<(CypherParserDESC-69)))) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -6967,15 +6994,15 @@ func (p *CypherParser) OC_Where() (localctx IOC_WhereContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(628)
+		p.SetState(630)
 		p.Match(CypherParserWHERE)
 	}
 	{
-		p.SetState(629)
+		p.SetState(631)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(630)
+		p.SetState(632)
 		p.OC_Expression()
 	}
 
@@ -7106,48 +7133,48 @@ func (p *CypherParser) OC_Pattern() (localctx IOC_PatternContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(632)
+		p.SetState(634)
 		p.OC_PatternPart()
 	}
-	p.SetState(643)
+	p.SetState(645)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(634)
+			p.SetState(636)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(633)
+					p.SetState(635)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(636)
+				p.SetState(638)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(638)
+			p.SetState(640)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(637)
+					p.SetState(639)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(640)
+				p.SetState(642)
 				p.OC_PatternPart()
 			}
 
 		}
-		p.SetState(645)
+		p.SetState(647)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
 	}
@@ -7272,56 +7299,53 @@ func (p *CypherParser) OC_PatternPart() (localctx IOC_PatternPartContext) {
 		}
 	}()
 
-	p.SetState(657)
+	p.SetState(659)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(646)
+			p.SetState(648)
 			p.OC_Variable()
 		}
-		p.SetState(648)
+		p.SetState(650)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(647)
+				p.SetState(649)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(650)
+			p.SetState(652)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(652)
+		p.SetState(654)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(651)
+				p.SetState(653)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(654)
+			p.SetState(656)
 			p.OC_AnonymousPatternPart()
 		}
 
-	case CypherParserT__5:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(656)
+			p.SetState(658)
 			p.OC_AnonymousPatternPart()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -7365,6 +7389,16 @@ func NewOC_AnonymousPatternPartContext(parser antlr.Parser, parent antlr.ParserR
 
 func (s *OC_AnonymousPatternPartContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *OC_AnonymousPatternPartContext) OC_ShortestPathPattern() IOC_ShortestPathPatternContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_ShortestPathPatternContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_ShortestPathPatternContext)
+}
+
 func (s *OC_AnonymousPatternPartContext) OC_PatternElement() IOC_PatternElementContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_PatternElementContext)(nil)).Elem(), 0)
 
@@ -7425,10 +7459,256 @@ func (p *CypherParser) OC_AnonymousPatternPart() (localctx IOC_AnonymousPatternP
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(659)
-		p.OC_PatternElement()
+	p.SetState(663)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(661)
+			p.OC_ShortestPathPattern()
+		}
+
+	case CypherParserT__5:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(662)
+			p.OC_PatternElement()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IOC_ShortestPathPatternContext is an interface to support dynamic dispatch.
+type IOC_ShortestPathPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOC_ShortestPathPatternContext differentiates from other interfaces.
+	IsOC_ShortestPathPatternContext()
+}
+
+type OC_ShortestPathPatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOC_ShortestPathPatternContext() *OC_ShortestPathPatternContext {
+	var p = new(OC_ShortestPathPatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = CypherParserRULE_oC_ShortestPathPattern
+	return p
+}
+
+func (*OC_ShortestPathPatternContext) IsOC_ShortestPathPatternContext() {}
+
+func NewOC_ShortestPathPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OC_ShortestPathPatternContext {
+	var p = new(OC_ShortestPathPatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = CypherParserRULE_oC_ShortestPathPattern
+
+	return p
+}
+
+func (s *OC_ShortestPathPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OC_ShortestPathPatternContext) SHORTESTPATH() antlr.TerminalNode {
+	return s.GetToken(CypherParserSHORTESTPATH, 0)
+}
+
+func (s *OC_ShortestPathPatternContext) OC_PatternElement() IOC_PatternElementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOC_PatternElementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOC_PatternElementContext)
+}
+
+func (s *OC_ShortestPathPatternContext) AllSP() []antlr.TerminalNode {
+	return s.GetTokens(CypherParserSP)
+}
+
+func (s *OC_ShortestPathPatternContext) SP(i int) antlr.TerminalNode {
+	return s.GetToken(CypherParserSP, i)
+}
+
+func (s *OC_ShortestPathPatternContext) ALLSHORTESTPATHS() antlr.TerminalNode {
+	return s.GetToken(CypherParserALLSHORTESTPATHS, 0)
+}
+
+func (s *OC_ShortestPathPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OC_ShortestPathPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OC_ShortestPathPatternContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.EnterOC_ShortestPathPattern(s)
+	}
+}
+
+func (s *OC_ShortestPathPatternContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(CypherListener); ok {
+		listenerT.ExitOC_ShortestPathPattern(s)
+	}
+}
+
+func (s *OC_ShortestPathPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case CypherVisitor:
+		return t.VisitOC_ShortestPathPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *CypherParser) OC_ShortestPathPattern() (localctx IOC_ShortestPathPatternContext) {
+	localctx = NewOC_ShortestPathPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, CypherParserRULE_oC_ShortestPathPattern)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(693)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case CypherParserSHORTESTPATH:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(665)
+			p.Match(CypherParserSHORTESTPATH)
+		}
+		p.SetState(667)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(666)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(669)
+			p.Match(CypherParserT__5)
+		}
+		p.SetState(671)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(670)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(673)
+			p.OC_PatternElement()
+		}
+		p.SetState(675)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(674)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(677)
+			p.Match(CypherParserT__6)
+		}
+
+	case CypherParserALLSHORTESTPATHS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(679)
+			p.Match(CypherParserALLSHORTESTPATHS)
+		}
+		p.SetState(681)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(680)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(683)
+			p.Match(CypherParserT__5)
+		}
+		p.SetState(685)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(684)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(687)
+			p.OC_PatternElement()
+		}
+		p.SetState(689)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(688)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(691)
+			p.Match(CypherParserT__6)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -7555,7 +7835,7 @@ func (s *OC_PatternElementContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) OC_PatternElement() (localctx IOC_PatternElementContext) {
 	localctx = NewOC_PatternElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, CypherParserRULE_oC_PatternElement)
+	p.EnterRule(localctx, 76, CypherParserRULE_oC_PatternElement)
 	var _la int
 
 	defer func() {
@@ -7576,55 +7856,55 @@ func (p *CypherParser) OC_PatternElement() (localctx IOC_PatternElementContext)
 
 	var _alt int
 
-	p.SetState(675)
+	p.SetState(709)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(661)
+			p.SetState(695)
 			p.OC_NodePattern()
 		}
-		p.SetState(668)
+		p.SetState(702)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
-				p.SetState(663)
+				p.SetState(697)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(662)
+						p.SetState(696)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(665)
+					p.SetState(699)
 					p.OC_PatternElementChain()
 				}
 
 			}
-			p.SetState(670)
+			p.SetState(704)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext())
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(671)
+			p.SetState(705)
 			p.Match(CypherParserT__5)
 		}
 		{
-			p.SetState(672)
+			p.SetState(706)
 			p.OC_PatternElement()
 		}
 		{
-			p.SetState(673)
+			p.SetState(707)
 			p.Match(CypherParserT__6)
 		}
 
@@ -7741,7 +8021,7 @@ func (s *OC_NodePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) OC_NodePattern() (localctx IOC_NodePatternContext) {
 	localctx = NewOC_NodePatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, CypherParserRULE_oC_NodePattern)
+	p.EnterRule(localctx, 78, CypherParserRULE_oC_NodePattern)
 	var _la int
 
 	defer func() {
@@ -7762,80 +8042,80 @@ func (p *CypherParser) OC_NodePattern() (localctx IOC_NodePatternContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(677)
+		p.SetState(711)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(679)
+	p.SetState(713)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(678)
+			p.SetState(712)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(685)
+	p.SetState(719)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 		{
-			p.SetState(681)
+			p.SetState(715)
 			p.OC_Variable()
 		}
-		p.SetState(683)
+		p.SetState(717)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(682)
+				p.SetState(716)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(691)
+	p.SetState(725)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__9 {
 		{
-			p.SetState(687)
+			p.SetState(721)
 			p.OC_NodeLabels()
 		}
-		p.SetState(689)
+		p.SetState(723)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(688)
+				p.SetState(722)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(697)
+	p.SetState(731)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__23 || _la == CypherParserT__25 {
+	if _la == CypherParserT__24 || _la == CypherParserT__26 {
 		{
-			p.SetState(693)
+			p.SetState(727)
 			p.OC_Properties()
 		}
-		p.SetState(695)
+		p.SetState(729)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(694)
+				p.SetState(728)
 				p.Match(CypherParserSP)
 			}
 
@@ -7843,7 +8123,7 @@ func (p *CypherParser) OC_NodePattern() (localctx IOC_NodePatternContext) {
 
 	}
 	{
-		p.SetState(699)
+		p.SetState(733)
 		p.Match(CypherParserT__6)
 	}
 
@@ -7944,7 +8224,7 @@ func (s *OC_PatternElementChainContext) Accept(visitor antlr.ParseTreeVisitor) i
 
 func (p *CypherParser) OC_PatternElementChain() (localctx IOC_PatternElementChainContext) {
 	localctx = NewOC_PatternElementChainContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, CypherParserRULE_oC_PatternElementChain)
+	p.EnterRule(localctx, 80, CypherParserRULE_oC_PatternElementChain)
 	var _la int
 
 	defer func() {
@@ -7965,22 +8245,22 @@ func (p *CypherParser) OC_PatternElementChain() (localctx IOC_PatternElementChai
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(701)
+		p.SetState(735)
 		p.OC_RelationshipPattern()
 	}
-	p.SetState(703)
+	p.SetState(737)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(702)
+			p.SetState(736)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(705)
+		p.SetState(739)
 		p.OC_NodePattern()
 	}
 
@@ -8118,7 +8398,7 @@ func (s *OC_RelationshipPatternContext) Accept(visitor antlr.ParseTreeVisitor) i
 
 func (p *CypherParser) OC_RelationshipPattern() (localctx IOC_RelationshipPatternContext) {
 	localctx = NewOC_RelationshipPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, CypherParserRULE_oC_RelationshipPattern)
+	p.EnterRule(localctx, 82, CypherParserRULE_oC_RelationshipPattern)
 	var _la int
 
 	defer func() {
@@ -8137,238 +8417,238 @@ func (p *CypherParser) OC_RelationshipPattern() (localctx IOC_RelationshipPatter
 		}
 	}()
 
-	p.SetState(771)
+	p.SetState(805)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(707)
+			p.SetState(741)
 			p.OC_LeftArrowHead()
 		}
-		p.SetState(709)
+		p.SetState(743)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(708)
+				p.SetState(742)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(711)
+			p.SetState(745)
 			p.OC_Dash()
 		}
-		p.SetState(713)
+		p.SetState(747)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 112, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(712)
+				p.SetState(746)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(716)
+		p.SetState(750)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(715)
+				p.SetState(749)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(719)
+		p.SetState(753)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(718)
+				p.SetState(752)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(721)
+			p.SetState(755)
 			p.OC_Dash()
 		}
-		p.SetState(723)
+		p.SetState(757)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(722)
+				p.SetState(756)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(725)
+			p.SetState(759)
 			p.OC_RightArrowHead()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(727)
+			p.SetState(761)
 			p.OC_LeftArrowHead()
 		}
-		p.SetState(729)
+		p.SetState(763)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(728)
+				p.SetState(762)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(731)
+			p.SetState(765)
 			p.OC_Dash()
 		}
-		p.SetState(733)
+		p.SetState(767)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 109, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(732)
+				p.SetState(766)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(736)
+		p.SetState(770)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(735)
+				p.SetState(769)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(739)
+		p.SetState(773)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(738)
+				p.SetState(772)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(741)
+			p.SetState(775)
 			p.OC_Dash()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(743)
+			p.SetState(777)
 			p.OC_Dash()
 		}
-		p.SetState(745)
+		p.SetState(779)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 112, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 120, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(744)
+				p.SetState(778)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(748)
+		p.SetState(782)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(747)
+				p.SetState(781)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(751)
+		p.SetState(785)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(750)
+				p.SetState(784)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(753)
+			p.SetState(787)
 			p.OC_Dash()
 		}
-		p.SetState(755)
+		p.SetState(789)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(754)
+				p.SetState(788)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(757)
+			p.SetState(791)
 			p.OC_RightArrowHead()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(759)
+			p.SetState(793)
 			p.OC_Dash()
 		}
-		p.SetState(761)
+		p.SetState(795)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 124, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(760)
+				p.SetState(794)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(764)
+		p.SetState(798)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserT__7 {
 			{
-				p.SetState(763)
+				p.SetState(797)
 				p.OC_RelationshipDetail()
 			}
 
 		}
-		p.SetState(767)
+		p.SetState(801)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(766)
+				p.SetState(800)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(769)
+			p.SetState(803)
 			p.OC_Dash()
 		}
 
@@ -8495,7 +8775,7 @@ func (s *OC_RelationshipDetailContext) Accept(visitor antlr.ParseTreeVisitor) in
 
 func (p *CypherParser) OC_RelationshipDetail() (localctx IOC_RelationshipDetailContext) {
 	localctx = NewOC_RelationshipDetailContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, CypherParserRULE_oC_RelationshipDetail)
+	p.EnterRule(localctx, 84, CypherParserRULE_oC_RelationshipDetail)
 	var _la int
 
 	defer func() {
@@ -8516,91 +8796,91 @@ func (p *CypherParser) OC_RelationshipDetail() (localctx IOC_RelationshipDetailC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(773)
+		p.SetState(807)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(775)
+	p.SetState(809)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(774)
+			p.SetState(808)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(781)
+	p.SetState(815)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 		{
-			p.SetState(777)
+			p.SetState(811)
 			p.OC_Variable()
 		}
-		p.SetState(779)
+		p.SetState(813)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(778)
+				p.SetState(812)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(787)
+	p.SetState(821)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__9 {
 		{
-			p.SetState(783)
+			p.SetState(817)
 			p.OC_RelationshipTypes()
 		}
-		p.SetState(785)
+		p.SetState(819)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(784)
+				p.SetState(818)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(790)
+	p.SetState(824)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__4 {
 		{
-			p.SetState(789)
+			p.SetState(823)
 			p.OC_RangeLiteral()
 		}
 
 	}
-	p.SetState(796)
+	p.SetState(830)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == CypherParserT__23 || _la == CypherParserT__25 {
+	if _la == CypherParserT__24 || _la == CypherParserT__26 {
 		{
-			p.SetState(792)
+			p.SetState(826)
 			p.OC_Properties()
 		}
-		p.SetState(794)
+		p.SetState(828)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(793)
+				p.SetState(827)
 				p.Match(CypherParserSP)
 			}
 
@@ -8608,7 +8888,7 @@ func (p *CypherParser) OC_RelationshipDetail() (localctx IOC_RelationshipDetailC
 
 	}
 	{
-		p.SetState(798)
+		p.SetState(832)
 		p.Match(CypherParserT__8)
 	}
 
@@ -8705,7 +8985,7 @@ func (s *OC_PropertiesContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) OC_Properties() (localctx IOC_PropertiesContext) {
 	localctx = NewOC_PropertiesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, CypherParserRULE_oC_Properties)
+	p.EnterRule(localctx, 86, CypherParserRULE_oC_Properties)
 
 	defer func() {
 		p.ExitRule()
@@ -8723,21 +9003,21 @@ func (p *CypherParser) OC_Properties() (localctx IOC_PropertiesContext) {
 		}
 	}()
 
-	p.SetState(802)
+	p.SetState(836)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserT__23:
+	case CypherParserT__24:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(800)
+			p.SetState(834)
 			p.OC_MapLiteral()
 		}
 
-	case CypherParserT__25:
+	case CypherParserT__26:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(801)
+			p.SetState(835)
 			p.OC_Parameter()
 		}
 
@@ -8849,7 +9129,7 @@ func (s *OC_RelationshipTypesContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) OC_RelationshipTypes() (localctx IOC_RelationshipTypesContext) {
 	localctx = NewOC_RelationshipTypesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, CypherParserRULE_oC_RelationshipTypes)
+	p.EnterRule(localctx, 88, CypherParserRULE_oC_RelationshipTypes)
 	var _la int
 
 	defer func() {
@@ -8872,76 +9152,76 @@ func (p *CypherParser) OC_RelationshipTypes() (localctx IOC_RelationshipTypesCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(804)
+		p.SetState(838)
 		p.Match(CypherParserT__9)
 	}
-	p.SetState(806)
+	p.SetState(840)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(805)
+			p.SetState(839)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(808)
+		p.SetState(842)
 		p.OC_RelTypeName()
 	}
-	p.SetState(822)
+	p.SetState(856)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 133, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 141, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(810)
+			p.SetState(844)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(809)
+					p.SetState(843)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(812)
+				p.SetState(846)
 				p.Match(CypherParserT__10)
 			}
-			p.SetState(814)
+			p.SetState(848)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserT__9 {
 				{
-					p.SetState(813)
+					p.SetState(847)
 					p.Match(CypherParserT__9)
 				}
 
 			}
-			p.SetState(817)
+			p.SetState(851)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(816)
+					p.SetState(850)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(819)
+				p.SetState(853)
 				p.OC_RelTypeName()
 			}
 
 		}
-		p.SetState(824)
+		p.SetState(858)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 133, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 141, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9048,7 +9328,7 @@ func (s *OC_NodeLabelsContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) OC_NodeLabels() (localctx IOC_NodeLabelsContext) {
 	localctx = NewOC_NodeLabelsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, CypherParserRULE_oC_NodeLabels)
+	p.EnterRule(localctx, 90, CypherParserRULE_oC_NodeLabels)
 	var _la int
 
 	defer func() {
@@ -9071,35 +9351,35 @@ func (p *CypherParser) OC_NodeLabels() (localctx IOC_NodeLabelsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(825)
+		p.SetState(859)
 		p.OC_NodeLabel()
 	}
-	p.SetState(832)
+	p.SetState(866)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 135, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 143, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(827)
+			p.SetState(861)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(826)
+					p.SetState(860)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(829)
+				p.SetState(863)
 				p.OC_NodeLabel()
 			}
 
 		}
-		p.SetState(834)
+		p.SetState(868)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 135, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 143, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -9189,7 +9469,7 @@ func (s *OC_NodeLabelContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) OC_NodeLabel() (localctx IOC_NodeLabelContext) {
 	localctx = NewOC_NodeLabelContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, CypherParserRULE_oC_NodeLabel)
+	p.EnterRule(localctx, 92, CypherParserRULE_oC_NodeLabel)
 	var _la int
 
 	defer func() {
@@ -9210,22 +9490,22 @@ func (p *CypherParser) OC_NodeLabel() (localctx IOC_NodeLabelContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(835)
+		p.SetState(869)
 		p.Match(CypherParserT__9)
 	}
-	p.SetState(837)
+	p.SetState(871)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(836)
+			p.SetState(870)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(839)
+		p.SetState(873)
 		p.OC_LabelName()
 	}
 
@@ -9333,7 +9613,7 @@ func (s *OC_RangeLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *CypherParser) OC_RangeLiteral() (localctx IOC_RangeLiteralContext) {
 	localctx = NewOC_RangeLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, CypherParserRULE_oC_RangeLiteral)
+	p.EnterRule(localctx, 94, CypherParserRULE_oC_RangeLiteral)
 	var _la int
 
 	defer func() {
@@ -9354,78 +9634,78 @@ func (p *CypherParser) OC_RangeLiteral() (localctx IOC_RangeLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(841)
+		p.SetState(875)
 		p.Match(CypherParserT__4)
 	}
-	p.SetState(843)
+	p.SetState(877)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(842)
+			p.SetState(876)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(849)
+	p.SetState(883)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0 {
+	if ((_la-100)&-(0x1f+1)) == 0 && ((1<<uint((_la-100)))&((1<<(CypherParserHexInteger-100))|(1<<(CypherParserDecimalInteger-100))|(1<<(CypherParserOctalInteger-100)))) != 0 {
 		{
-			p.SetState(845)
+			p.SetState(879)
 			p.OC_IntegerLiteral()
 		}
-		p.SetState(847)
+		p.SetState(881)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(846)
+				p.SetState(880)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(861)
+	p.SetState(895)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserT__11 {
 		{
-			p.SetState(851)
+			p.SetState(885)
 			p.Match(CypherParserT__11)
 		}
-		p.SetState(853)
+		p.SetState(887)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(852)
+				p.SetState(886)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(859)
+		p.SetState(893)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if ((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0 {
+		if ((_la-100)&-(0x1f+1)) == 0 && ((1<<uint((_la-100)))&((1<<(CypherParserHexInteger-100))|(1<<(CypherParserDecimalInteger-100))|(1<<(CypherParserOctalInteger-100)))) != 0 {
 			{
-				p.SetState(855)
+				p.SetState(889)
 				p.OC_IntegerLiteral()
 			}
-			p.SetState(857)
+			p.SetState(891)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(856)
+					p.SetState(890)
 					p.Match(CypherParserSP)
 				}
 
@@ -9518,7 +9798,7 @@ func (s *OC_LabelNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) OC_LabelName() (localctx IOC_LabelNameContext) {
 	localctx = NewOC_LabelNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, CypherParserRULE_oC_LabelName)
+	p.EnterRule(localctx, 96, CypherParserRULE_oC_LabelName)
 
 	defer func() {
 		p.ExitRule()
@@ -9538,7 +9818,7 @@ func (p *CypherParser) OC_LabelName() (localctx IOC_LabelNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(863)
+		p.SetState(897)
 		p.OC_SchemaName()
 	}
 
@@ -9625,7 +9905,7 @@ func (s *OC_RelTypeNameContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) OC_RelTypeName() (localctx IOC_RelTypeNameContext) {
 	localctx = NewOC_RelTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, CypherParserRULE_oC_RelTypeName)
+	p.EnterRule(localctx, 98, CypherParserRULE_oC_RelTypeName)
 
 	defer func() {
 		p.ExitRule()
@@ -9645,7 +9925,7 @@ func (p *CypherParser) OC_RelTypeName() (localctx IOC_RelTypeNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(865)
+		p.SetState(899)
 		p.OC_SchemaName()
 	}
 
@@ -9732,7 +10012,7 @@ func (s *OC_ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) OC_Expression() (localctx IOC_ExpressionContext) {
 	localctx = NewOC_ExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, CypherParserRULE_oC_Expression)
+	p.EnterRule(localctx, 100, CypherParserRULE_oC_Expression)
 
 	defer func() {
 		p.ExitRule()
@@ -9752,7 +10032,7 @@ func (p *CypherParser) OC_Expression() (localctx IOC_ExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(867)
+		p.SetState(901)
 		p.OC_OrExpression()
 	}
 
@@ -9868,7 +10148,7 @@ func (s *OC_OrExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *CypherParser) OC_OrExpression() (localctx IOC_OrExpressionContext) {
 	localctx = NewOC_OrExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, CypherParserRULE_oC_OrExpression)
+	p.EnterRule(localctx, 102, CypherParserRULE_oC_OrExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -9890,36 +10170,36 @@ func (p *CypherParser) OC_OrExpression() (localctx IOC_OrExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(869)
+		p.SetState(903)
 		p.OC_XorExpression()
 	}
-	p.SetState(876)
+	p.SetState(910)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(870)
+				p.SetState(904)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(871)
+				p.SetState(905)
 				p.Match(CypherParserOR)
 			}
 			{
-				p.SetState(872)
+				p.SetState(906)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(873)
+				p.SetState(907)
 				p.OC_XorExpression()
 			}
 
 		}
-		p.SetState(878)
+		p.SetState(912)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 144, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 152, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10034,7 +10314,7 @@ func (s *OC_XorExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_XorExpression() (localctx IOC_XorExpressionContext) {
 	localctx = NewOC_XorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, CypherParserRULE_oC_XorExpression)
+	p.EnterRule(localctx, 104, CypherParserRULE_oC_XorExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -10056,36 +10336,36 @@ func (p *CypherParser) OC_XorExpression() (localctx IOC_XorExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(879)
+		p.SetState(913)
 		p.OC_AndExpression()
 	}
-	p.SetState(886)
+	p.SetState(920)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 145, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 153, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(880)
+				p.SetState(914)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(881)
+				p.SetState(915)
 				p.Match(CypherParserXOR)
 			}
 			{
-				p.SetState(882)
+				p.SetState(916)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(883)
+				p.SetState(917)
 				p.OC_AndExpression()
 			}
 
 		}
-		p.SetState(888)
+		p.SetState(922)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 145, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 153, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10200,7 +10480,7 @@ func (s *OC_AndExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_AndExpression() (localctx IOC_AndExpressionContext) {
 	localctx = NewOC_AndExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, CypherParserRULE_oC_AndExpression)
+	p.EnterRule(localctx, 106, CypherParserRULE_oC_AndExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -10222,36 +10502,36 @@ func (p *CypherParser) OC_AndExpression() (localctx IOC_AndExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(889)
+		p.SetState(923)
 		p.OC_NotExpression()
 	}
-	p.SetState(896)
+	p.SetState(930)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 154, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(890)
+				p.SetState(924)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(891)
+				p.SetState(925)
 				p.Match(CypherParserAND)
 			}
 			{
-				p.SetState(892)
+				p.SetState(926)
 				p.Match(CypherParserSP)
 			}
 			{
-				p.SetState(893)
+				p.SetState(927)
 				p.OC_NotExpression()
 			}
 
 		}
-		p.SetState(898)
+		p.SetState(932)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 146, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 154, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10353,7 +10633,7 @@ func (s *OC_NotExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_NotExpression() (localctx IOC_NotExpressionContext) {
 	localctx = NewOC_NotExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, CypherParserRULE_oC_NotExpression)
+	p.EnterRule(localctx, 108, CypherParserRULE_oC_NotExpression)
 	var _la int
 
 	defer func() {
@@ -10373,33 +10653,33 @@ func (p *CypherParser) OC_NotExpression() (localctx IOC_NotExpressionContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(905)
+	p.SetState(939)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserNOT {
 		{
-			p.SetState(899)
+			p.SetState(933)
 			p.Match(CypherParserNOT)
 		}
-		p.SetState(901)
+		p.SetState(935)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(900)
+				p.SetState(934)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(907)
+		p.SetState(941)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(908)
+		p.SetState(942)
 		p.OC_ComparisonExpression()
 	}
 
@@ -10517,7 +10797,7 @@ func (s *OC_ComparisonExpressionContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *CypherParser) OC_ComparisonExpression() (localctx IOC_ComparisonExpressionContext) {
 	localctx = NewOC_ComparisonExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, CypherParserRULE_oC_ComparisonExpression)
+	p.EnterRule(localctx, 110, CypherParserRULE_oC_ComparisonExpression)
 	var _la int
 
 	defer func() {
@@ -10540,35 +10820,35 @@ func (p *CypherParser) OC_ComparisonExpression() (localctx IOC_ComparisonExpress
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(910)
+		p.SetState(944)
 		p.OC_AddOrSubtractExpression()
 	}
-	p.SetState(917)
+	p.SetState(951)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 150, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(912)
+			p.SetState(946)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(911)
+					p.SetState(945)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(914)
+				p.SetState(948)
 				p.OC_PartialComparisonExpression()
 			}
 
 		}
-		p.SetState(919)
+		p.SetState(953)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 150, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 158, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10675,7 +10955,7 @@ func (s *OC_AddOrSubtractExpressionContext) Accept(visitor antlr.ParseTreeVisito
 
 func (p *CypherParser) OC_AddOrSubtractExpression() (localctx IOC_AddOrSubtractExpressionContext) {
 	localctx = NewOC_AddOrSubtractExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, CypherParserRULE_oC_AddOrSubtractExpression)
+	p.EnterRule(localctx, 112, CypherParserRULE_oC_AddOrSubtractExpression)
 	var _la int
 
 	defer func() {
@@ -10698,88 +10978,88 @@ func (p *CypherParser) OC_AddOrSubtractExpression() (localctx IOC_AddOrSubtractE
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(920)
+		p.SetState(954)
 		p.OC_MultiplyDivideModuloExpression()
 	}
-	p.SetState(939)
+	p.SetState(973)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 164, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(937)
+			p.SetState(971)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 155, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 163, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(922)
+				p.SetState(956)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(921)
+						p.SetState(955)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(924)
+					p.SetState(958)
 					p.Match(CypherParserT__12)
 				}
-				p.SetState(926)
+				p.SetState(960)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(925)
+						p.SetState(959)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(928)
+					p.SetState(962)
 					p.OC_MultiplyDivideModuloExpression()
 				}
 
 			case 2:
-				p.SetState(930)
+				p.SetState(964)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(929)
+						p.SetState(963)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(932)
+					p.SetState(966)
 					p.Match(CypherParserT__13)
 				}
-				p.SetState(934)
+				p.SetState(968)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(933)
+						p.SetState(967)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(936)
+					p.SetState(970)
 					p.OC_MultiplyDivideModuloExpression()
 				}
 
 			}
 
 		}
-		p.SetState(941)
+		p.SetState(975)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 156, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 164, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -10886,7 +11166,7 @@ func (s *OC_MultiplyDivideModuloExpressionContext) Accept(visitor antlr.ParseTre
 
 func (p *CypherParser) OC_MultiplyDivideModuloExpression() (localctx IOC_MultiplyDivideModuloExpressionContext) {
 	localctx = NewOC_MultiplyDivideModuloExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, CypherParserRULE_oC_MultiplyDivideModuloExpression)
+	p.EnterRule(localctx, 114, CypherParserRULE_oC_MultiplyDivideModuloExpression)
 	var _la int
 
 	defer func() {
@@ -10909,120 +11189,120 @@ func (p *CypherParser) OC_MultiplyDivideModuloExpression() (localctx IOC_Multipl
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(942)
+		p.SetState(976)
 		p.OC_PowerOfExpression()
 	}
-	p.SetState(969)
+	p.SetState(1003)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 164, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 172, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(967)
+			p.SetState(1001)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 163, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 171, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(944)
+				p.SetState(978)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(943)
+						p.SetState(977)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(946)
+					p.SetState(980)
 					p.Match(CypherParserT__4)
 				}
-				p.SetState(948)
+				p.SetState(982)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(947)
+						p.SetState(981)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(950)
+					p.SetState(984)
 					p.OC_PowerOfExpression()
 				}
 
 			case 2:
-				p.SetState(952)
+				p.SetState(986)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(951)
+						p.SetState(985)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(954)
+					p.SetState(988)
 					p.Match(CypherParserT__14)
 				}
-				p.SetState(956)
+				p.SetState(990)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(955)
+						p.SetState(989)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(958)
+					p.SetState(992)
 					p.OC_PowerOfExpression()
 				}
 
 			case 3:
-				p.SetState(960)
+				p.SetState(994)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(959)
+						p.SetState(993)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(962)
+					p.SetState(996)
 					p.Match(CypherParserT__15)
 				}
-				p.SetState(964)
+				p.SetState(998)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(963)
+						p.SetState(997)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(966)
+					p.SetState(1000)
 					p.OC_PowerOfExpression()
 				}
 
 			}
 
 		}
-		p.SetState(971)
+		p.SetState(1005)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 164, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 172, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11129,7 +11409,7 @@ func (s *OC_PowerOfExpressionContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) OC_PowerOfExpression() (localctx IOC_PowerOfExpressionContext) {
 	localctx = NewOC_PowerOfExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, CypherParserRULE_oC_PowerOfExpression)
+	p.EnterRule(localctx, 116, CypherParserRULE_oC_PowerOfExpression)
 	var _la int
 
 	defer func() {
@@ -11152,50 +11432,50 @@ func (p *CypherParser) OC_PowerOfExpression() (localctx IOC_PowerOfExpressionCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(972)
+		p.SetState(1006)
 		p.OC_UnaryAddOrSubtractExpression()
 	}
-	p.SetState(983)
+	p.SetState(1017)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 167, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 175, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(974)
+			p.SetState(1008)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(973)
+					p.SetState(1007)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(976)
+				p.SetState(1010)
 				p.Match(CypherParserT__16)
 			}
-			p.SetState(978)
+			p.SetState(1012)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(977)
+					p.SetState(1011)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(980)
+				p.SetState(1014)
 				p.OC_UnaryAddOrSubtractExpression()
 			}
 
 		}
-		p.SetState(985)
+		p.SetState(1019)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 167, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 175, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11289,7 +11569,7 @@ func (s *OC_UnaryAddOrSubtractExpressionContext) Accept(visitor antlr.ParseTreeV
 
 func (p *CypherParser) OC_UnaryAddOrSubtractExpression() (localctx IOC_UnaryAddOrSubtractExpressionContext) {
 	localctx = NewOC_UnaryAddOrSubtractExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, CypherParserRULE_oC_UnaryAddOrSubtractExpression)
+	p.EnterRule(localctx, 118, CypherParserRULE_oC_UnaryAddOrSubtractExpression)
 	var _la int
 
 	defer func() {
@@ -11309,13 +11589,13 @@ func (p *CypherParser) OC_UnaryAddOrSubtractExpression() (localctx IOC_UnaryAddO
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(992)
+	p.SetState(1026)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == CypherParserT__12 || _la == CypherParserT__13 {
 		{
-			p.SetState(986)
+			p.SetState(1020)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == CypherParserT__12 || _la == CypherParserT__13) {
@@ -11325,24 +11605,24 @@ func (p *CypherParser) OC_UnaryAddOrSubtractExpression() (localctx IOC_UnaryAddO
 				p.Consume()
 			}
 		}
-		p.SetState(988)
+		p.SetState(1022)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(987)
+				p.SetState(1021)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
-		p.SetState(994)
+		p.SetState(1028)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(995)
+		p.SetState(1029)
 		p.OC_StringListNullOperatorExpression()
 	}
 
@@ -11498,7 +11778,7 @@ func (s *OC_StringListNullOperatorExpressionContext) Accept(visitor antlr.ParseT
 
 func (p *CypherParser) OC_StringListNullOperatorExpression() (localctx IOC_StringListNullOperatorExpressionContext) {
 	localctx = NewOC_StringListNullOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, CypherParserRULE_oC_StringListNullOperatorExpression)
+	p.EnterRule(localctx, 120, CypherParserRULE_oC_StringListNullOperatorExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -11520,42 +11800,42 @@ func (p *CypherParser) OC_StringListNullOperatorExpression() (localctx IOC_Strin
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(997)
+		p.SetState(1031)
 		p.OC_PropertyOrLabelsExpression()
 	}
-	p.SetState(1003)
+	p.SetState(1037)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 171, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 179, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1001)
+			p.SetState(1035)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 170, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 178, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(998)
+					p.SetState(1032)
 					p.OC_StringOperatorExpression()
 				}
 
 			case 2:
 				{
-					p.SetState(999)
+					p.SetState(1033)
 					p.OC_ListOperatorExpression()
 				}
 
 			case 3:
 				{
-					p.SetState(1000)
+					p.SetState(1034)
 					p.OC_NullOperatorExpression()
 				}
 
 			}
 
 		}
-		p.SetState(1005)
+		p.SetState(1039)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 171, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 179, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11676,7 +11956,7 @@ func (s *OC_ListOperatorExpressionContext) Accept(visitor antlr.ParseTreeVisitor
 
 func (p *CypherParser) OC_ListOperatorExpression() (localctx IOC_ListOperatorExpressionContext) {
 	localctx = NewOC_ListOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, CypherParserRULE_oC_ListOperatorExpression)
+	p.EnterRule(localctx, 122, CypherParserRULE_oC_ListOperatorExpression)
 	var _la int
 
 	defer func() {
@@ -11695,106 +11975,106 @@ func (p *CypherParser) OC_ListOperatorExpression() (localctx IOC_ListOperatorExp
 		}
 	}()
 
-	p.SetState(1031)
+	p.SetState(1065)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 177, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 185, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1006)
+			p.SetState(1040)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1007)
+			p.SetState(1041)
 			p.Match(CypherParserIN)
 		}
-		p.SetState(1009)
+		p.SetState(1043)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1008)
+				p.SetState(1042)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1011)
+			p.SetState(1045)
 			p.OC_PropertyOrLabelsExpression()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(1013)
+		p.SetState(1047)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1012)
+				p.SetState(1046)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1015)
+			p.SetState(1049)
 			p.Match(CypherParserT__7)
 		}
 		{
-			p.SetState(1016)
+			p.SetState(1050)
 			p.OC_Expression()
 		}
 		{
-			p.SetState(1017)
+			p.SetState(1051)
 			p.Match(CypherParserT__8)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(1020)
+		p.SetState(1054)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1019)
+				p.SetState(1053)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1022)
+			p.SetState(1056)
 			p.Match(CypherParserT__7)
 		}
-		p.SetState(1024)
+		p.SetState(1058)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__24)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 			{
-				p.SetState(1023)
+				p.SetState(1057)
 				p.OC_Expression()
 			}
 
 		}
 		{
-			p.SetState(1026)
+			p.SetState(1060)
 			p.Match(CypherParserT__11)
 		}
-		p.SetState(1028)
+		p.SetState(1062)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__24)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 			{
-				p.SetState(1027)
+				p.SetState(1061)
 				p.OC_Expression()
 			}
 
 		}
 		{
-			p.SetState(1030)
+			p.SetState(1064)
 			p.Match(CypherParserT__8)
 		}
 
@@ -11907,7 +12187,7 @@ func (s *OC_StringOperatorExpressionContext) Accept(visitor antlr.ParseTreeVisit
 
 func (p *CypherParser) OC_StringOperatorExpression() (localctx IOC_StringOperatorExpressionContext) {
 	localctx = NewOC_StringOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, CypherParserRULE_oC_StringOperatorExpression)
+	p.EnterRule(localctx, 124, CypherParserRULE_oC_StringOperatorExpression)
 	var _la int
 
 	defer func() {
@@ -11927,69 +12207,69 @@ func (p *CypherParser) OC_StringOperatorExpression() (localctx IOC_StringOperato
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1043)
+	p.SetState(1077)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 178, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 186, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(1033)
+			p.SetState(1067)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1034)
+			p.SetState(1068)
 			p.Match(CypherParserSTARTS)
 		}
 		{
-			p.SetState(1035)
+			p.SetState(1069)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1036)
+			p.SetState(1070)
 			p.Match(CypherParserWITH)
 		}
 
 	case 2:
 		{
-			p.SetState(1037)
+			p.SetState(1071)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1038)
+			p.SetState(1072)
 			p.Match(CypherParserENDS)
 		}
 		{
-			p.SetState(1039)
+			p.SetState(1073)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1040)
+			p.SetState(1074)
 			p.Match(CypherParserWITH)
 		}
 
 	case 3:
 		{
-			p.SetState(1041)
+			p.SetState(1075)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1042)
+			p.SetState(1076)
 			p.Match(CypherParserCONTAINS)
 		}
 
 	}
-	p.SetState(1046)
+	p.SetState(1080)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1045)
+			p.SetState(1079)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1048)
+		p.SetState(1082)
 		p.OC_PropertyOrLabelsExpression()
 	}
 
@@ -12086,7 +12366,7 @@ func (s *OC_NullOperatorExpressionContext) Accept(visitor antlr.ParseTreeVisitor
 
 func (p *CypherParser) OC_NullOperatorExpression() (localctx IOC_NullOperatorExpressionContext) {
 	localctx = NewOC_NullOperatorExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, CypherParserRULE_oC_NullOperatorExpression)
+	p.EnterRule(localctx, 126, CypherParserRULE_oC_NullOperatorExpression)
 
 	defer func() {
 		p.ExitRule()
@@ -12104,52 +12384,52 @@ func (p *CypherParser) OC_NullOperatorExpression() (localctx IOC_NullOperatorExp
 		}
 	}()
 
-	p.SetState(1060)
+	p.SetState(1094)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 180, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 188, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1050)
+			p.SetState(1084)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1051)
+			p.SetState(1085)
 			p.Match(CypherParserIS)
 		}
 		{
-			p.SetState(1052)
+			p.SetState(1086)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1053)
+			p.SetState(1087)
 			p.Match(CypherParserNULL)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1054)
+			p.SetState(1088)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1055)
+			p.SetState(1089)
 			p.Match(CypherParserIS)
 		}
 		{
-			p.SetState(1056)
+			p.SetState(1090)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1057)
+			p.SetState(1091)
 			p.Match(CypherParserNOT)
 		}
 		{
-			p.SetState(1058)
+			p.SetState(1092)
 			p.Match(CypherParserSP)
 		}
 		{
-			p.SetState(1059)
+			p.SetState(1093)
 			p.Match(CypherParserNULL)
 		}
 
@@ -12279,7 +12559,7 @@ func (s *OC_PropertyOrLabelsExpressionContext) Accept(visitor antlr.ParseTreeVis
 
 func (p *CypherParser) OC_PropertyOrLabelsExpression() (localctx IOC_PropertyOrLabelsExpressionContext) {
 	localctx = NewOC_PropertyOrLabelsExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, CypherParserRULE_oC_PropertyOrLabelsExpression)
+	p.EnterRule(localctx, 128, CypherParserRULE_oC_PropertyOrLabelsExpression)
 	var _la int
 
 	defer func() {
@@ -12302,53 +12582,53 @@ func (p *CypherParser) OC_PropertyOrLabelsExpression() (localctx IOC_PropertyOrL
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1062)
+		p.SetState(1096)
 		p.OC_Atom()
 	}
-	p.SetState(1069)
+	p.SetState(1103)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 182, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 190, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(1064)
+			p.SetState(1098)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1063)
+					p.SetState(1097)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1066)
+				p.SetState(1100)
 				p.OC_PropertyLookup()
 			}
 
 		}
-		p.SetState(1071)
+		p.SetState(1105)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 182, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 190, p.GetParserRuleContext())
 	}
-	p.SetState(1076)
+	p.SetState(1110)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 184, p.GetParserRuleContext()) == 1 {
-		p.SetState(1073)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 192, p.GetParserRuleContext()) == 1 {
+		p.SetState(1107)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1072)
+				p.SetState(1106)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1075)
+			p.SetState(1109)
 			p.OC_NodeLabels()
 		}
 
@@ -12555,7 +12835,7 @@ func (s *OC_AtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) OC_Atom() (localctx IOC_AtomContext) {
 	localctx = NewOC_AtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, CypherParserRULE_oC_Atom)
+	p.EnterRule(localctx, 130, CypherParserRULE_oC_Atom)
 	var _la int
 
 	defer func() {
@@ -12574,329 +12854,329 @@ func (p *CypherParser) OC_Atom() (localctx IOC_AtomContext) {
 		}
 	}()
 
-	p.SetState(1156)
+	p.SetState(1190)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 200, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 208, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1078)
+			p.SetState(1112)
 			p.OC_Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1079)
+			p.SetState(1113)
 			p.OC_Parameter()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1080)
+			p.SetState(1114)
 			p.OC_CaseExpression()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1081)
+			p.SetState(1115)
 			p.Match(CypherParserCOUNT)
 		}
-		p.SetState(1083)
+		p.SetState(1117)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1082)
+				p.SetState(1116)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1085)
+			p.SetState(1119)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1087)
+		p.SetState(1121)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1086)
+				p.SetState(1120)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1089)
+			p.SetState(1123)
 			p.Match(CypherParserT__4)
 		}
-		p.SetState(1091)
+		p.SetState(1125)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1090)
+				p.SetState(1124)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1093)
+			p.SetState(1127)
 			p.Match(CypherParserT__6)
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1094)
+			p.SetState(1128)
 			p.OC_ListComprehension()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1095)
+			p.SetState(1129)
 			p.OC_PatternComprehension()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(1096)
+			p.SetState(1130)
 			p.Match(CypherParserALL)
 		}
-		p.SetState(1098)
+		p.SetState(1132)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1097)
+				p.SetState(1131)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1100)
+			p.SetState(1134)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1102)
+		p.SetState(1136)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1101)
+				p.SetState(1135)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1104)
+			p.SetState(1138)
 			p.OC_FilterExpression()
 		}
-		p.SetState(1106)
+		p.SetState(1140)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1105)
+				p.SetState(1139)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1108)
+			p.SetState(1142)
 			p.Match(CypherParserT__6)
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(1110)
+			p.SetState(1144)
 			p.Match(CypherParserANY)
 		}
-		p.SetState(1112)
+		p.SetState(1146)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1111)
+				p.SetState(1145)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1114)
+			p.SetState(1148)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1116)
+		p.SetState(1150)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1115)
+				p.SetState(1149)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1118)
+			p.SetState(1152)
 			p.OC_FilterExpression()
 		}
-		p.SetState(1120)
+		p.SetState(1154)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1119)
+				p.SetState(1153)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1122)
+			p.SetState(1156)
 			p.Match(CypherParserT__6)
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(1124)
+			p.SetState(1158)
 			p.Match(CypherParserNONE)
 		}
-		p.SetState(1126)
+		p.SetState(1160)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1125)
+				p.SetState(1159)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1128)
+			p.SetState(1162)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1130)
+		p.SetState(1164)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1129)
+				p.SetState(1163)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1132)
+			p.SetState(1166)
 			p.OC_FilterExpression()
 		}
-		p.SetState(1134)
+		p.SetState(1168)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1133)
+				p.SetState(1167)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1136)
+			p.SetState(1170)
 			p.Match(CypherParserT__6)
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(1138)
+			p.SetState(1172)
 			p.Match(CypherParserSINGLE)
 		}
-		p.SetState(1140)
+		p.SetState(1174)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1139)
+				p.SetState(1173)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1142)
+			p.SetState(1176)
 			p.Match(CypherParserT__5)
 		}
-		p.SetState(1144)
+		p.SetState(1178)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1143)
+				p.SetState(1177)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1146)
+			p.SetState(1180)
 			p.OC_FilterExpression()
 		}
-		p.SetState(1148)
+		p.SetState(1182)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1147)
+				p.SetState(1181)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1150)
+			p.SetState(1184)
 			p.Match(CypherParserT__6)
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(1152)
+			p.SetState(1186)
 			p.OC_RelationshipsPattern()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(1153)
+			p.SetState(1187)
 			p.OC_ParenthesizedExpression()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(1154)
+			p.SetState(1188)
 			p.OC_FunctionInvocation()
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(1155)
+			p.SetState(1189)
 			p.OC_Variable()
 		}
 
@@ -13023,7 +13303,7 @@ func (s *OC_LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) OC_Literal() (localctx IOC_LiteralContext) {
 	localctx = NewOC_LiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, CypherParserRULE_oC_Literal)
+	p.EnterRule(localctx, 132, CypherParserRULE_oC_Literal)
 
 	defer func() {
 		p.ExitRule()
@@ -13041,49 +13321,49 @@ func (p *CypherParser) OC_Literal() (localctx IOC_LiteralContext) {
 		}
 	}()
 
-	p.SetState(1164)
+	p.SetState(1198)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger, CypherParserExponentDecimalReal, CypherParserRegularDecimalReal:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1158)
+			p.SetState(1192)
 			p.OC_NumberLiteral()
 		}
 
 	case CypherParserStringLiteral:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1159)
+			p.SetState(1193)
 			p.Match(CypherParserStringLiteral)
 		}
 
 	case CypherParserTRUE, CypherParserFALSE:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1160)
+			p.SetState(1194)
 			p.OC_BooleanLiteral()
 		}
 
 	case CypherParserNULL:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1161)
+			p.SetState(1195)
 			p.Match(CypherParserNULL)
 		}
 
-	case CypherParserT__23:
+	case CypherParserT__24:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1162)
+			p.SetState(1196)
 			p.OC_MapLiteral()
 		}
 
 	case CypherParserT__7:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1163)
+			p.SetState(1197)
 			p.OC_ListLiteral()
 		}
 
@@ -13172,7 +13452,7 @@ func (s *OC_BooleanLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) OC_BooleanLiteral() (localctx IOC_BooleanLiteralContext) {
 	localctx = NewOC_BooleanLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, CypherParserRULE_oC_BooleanLiteral)
+	p.EnterRule(localctx, 134, CypherParserRULE_oC_BooleanLiteral)
 	var _la int
 
 	defer func() {
@@ -13193,7 +13473,7 @@ func (p *CypherParser) OC_BooleanLiteral() (localctx IOC_BooleanLiteralContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1166)
+		p.SetState(1200)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == CypherParserTRUE || _la == CypherParserFALSE) {
@@ -13308,7 +13588,7 @@ func (s *OC_ListLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 func (p *CypherParser) OC_ListLiteral() (localctx IOC_ListLiteralContext) {
 	localctx = NewOC_ListLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, CypherParserRULE_oC_ListLiteral)
+	p.EnterRule(localctx, 136, CypherParserRULE_oC_ListLiteral)
 	var _la int
 
 	defer func() {
@@ -13329,84 +13609,84 @@ func (p *CypherParser) OC_ListLiteral() (localctx IOC_ListLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1168)
+		p.SetState(1202)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(1170)
+	p.SetState(1204)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1169)
+			p.SetState(1203)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1189)
+	p.SetState(1223)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__24)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 		{
-			p.SetState(1172)
+			p.SetState(1206)
 			p.OC_Expression()
 		}
-		p.SetState(1174)
+		p.SetState(1208)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1173)
+				p.SetState(1207)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1186)
+		p.SetState(1220)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1176)
+				p.SetState(1210)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1178)
+			p.SetState(1212)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1177)
+					p.SetState(1211)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1180)
+				p.SetState(1214)
 				p.OC_Expression()
 			}
-			p.SetState(1182)
+			p.SetState(1216)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1181)
+					p.SetState(1215)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1188)
+			p.SetState(1222)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1191)
+		p.SetState(1225)
 		p.Match(CypherParserT__8)
 	}
 
@@ -13497,7 +13777,7 @@ func (s *OC_PartialComparisonExpressionContext) Accept(visitor antlr.ParseTreeVi
 
 func (p *CypherParser) OC_PartialComparisonExpression() (localctx IOC_PartialComparisonExpressionContext) {
 	localctx = NewOC_PartialComparisonExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, CypherParserRULE_oC_PartialComparisonExpression)
+	p.EnterRule(localctx, 138, CypherParserRULE_oC_PartialComparisonExpression)
 	var _la int
 
 	defer func() {
@@ -13516,139 +13796,161 @@ func (p *CypherParser) OC_PartialComparisonExpression() (localctx IOC_PartialCom
 		}
 	}()
 
-	p.SetState(1223)
+	p.SetState(1262)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserT__2:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1193)
+			p.SetState(1227)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(1195)
+		p.SetState(1229)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1194)
+				p.SetState(1228)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1197)
+			p.SetState(1231)
 			p.OC_AddOrSubtractExpression()
 		}
 
 	case CypherParserT__17:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1198)
+			p.SetState(1232)
 			p.Match(CypherParserT__17)
 		}
-		p.SetState(1200)
+		p.SetState(1234)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1199)
+				p.SetState(1233)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1202)
+			p.SetState(1236)
 			p.OC_AddOrSubtractExpression()
 		}
 
 	case CypherParserT__18:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(1203)
+			p.SetState(1237)
 			p.Match(CypherParserT__18)
 		}
-		p.SetState(1205)
+		p.SetState(1239)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1204)
+				p.SetState(1238)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1207)
+			p.SetState(1241)
 			p.OC_AddOrSubtractExpression()
 		}
 
 	case CypherParserT__19:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(1208)
+			p.SetState(1242)
 			p.Match(CypherParserT__19)
 		}
-		p.SetState(1210)
+		p.SetState(1244)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1209)
+				p.SetState(1243)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1212)
+			p.SetState(1246)
 			p.OC_AddOrSubtractExpression()
 		}
 
 	case CypherParserT__20:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(1213)
+			p.SetState(1247)
 			p.Match(CypherParserT__20)
 		}
-		p.SetState(1215)
+		p.SetState(1249)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1214)
+				p.SetState(1248)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1217)
+			p.SetState(1251)
 			p.OC_AddOrSubtractExpression()
 		}
 
 	case CypherParserT__21:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(1218)
+			p.SetState(1252)
 			p.Match(CypherParserT__21)
 		}
-		p.SetState(1220)
+		p.SetState(1254)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1219)
+				p.SetState(1253)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1222)
+			p.SetState(1256)
+			p.OC_AddOrSubtractExpression()
+		}
+
+	case CypherParserT__22:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(1257)
+			p.Match(CypherParserT__22)
+		}
+		p.SetState(1259)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == CypherParserSP {
+			{
+				p.SetState(1258)
+				p.Match(CypherParserSP)
+			}
+
+		}
+		{
+			p.SetState(1261)
 			p.OC_AddOrSubtractExpression()
 		}
 
@@ -13747,7 +14049,7 @@ func (s *OC_ParenthesizedExpressionContext) Accept(visitor antlr.ParseTreeVisito
 
 func (p *CypherParser) OC_ParenthesizedExpression() (localctx IOC_ParenthesizedExpressionContext) {
 	localctx = NewOC_ParenthesizedExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, CypherParserRULE_oC_ParenthesizedExpression)
+	p.EnterRule(localctx, 140, CypherParserRULE_oC_ParenthesizedExpression)
 	var _la int
 
 	defer func() {
@@ -13768,37 +14070,37 @@ func (p *CypherParser) OC_ParenthesizedExpression() (localctx IOC_ParenthesizedE
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1225)
+		p.SetState(1264)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(1227)
+	p.SetState(1266)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1226)
+			p.SetState(1265)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1229)
+		p.SetState(1268)
 		p.OC_Expression()
 	}
-	p.SetState(1231)
+	p.SetState(1270)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1230)
+			p.SetState(1269)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1233)
+		p.SetState(1272)
 		p.Match(CypherParserT__6)
 	}
 
@@ -13916,7 +14218,7 @@ func (s *OC_RelationshipsPatternContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *CypherParser) OC_RelationshipsPattern() (localctx IOC_RelationshipsPatternContext) {
 	localctx = NewOC_RelationshipsPatternContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, CypherParserRULE_oC_RelationshipsPattern)
+	p.EnterRule(localctx, 142, CypherParserRULE_oC_RelationshipsPattern)
 	var _la int
 
 	defer func() {
@@ -13939,28 +14241,28 @@ func (p *CypherParser) OC_RelationshipsPattern() (localctx IOC_RelationshipsPatt
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1235)
+		p.SetState(1274)
 		p.OC_NodePattern()
 	}
-	p.SetState(1240)
+	p.SetState(1279)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(1237)
+			p.SetState(1276)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1236)
+					p.SetState(1275)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1239)
+				p.SetState(1278)
 				p.OC_PatternElementChain()
 			}
 
@@ -13968,9 +14270,9 @@ func (p *CypherParser) OC_RelationshipsPattern() (localctx IOC_RelationshipsPatt
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(1242)
+		p.SetState(1281)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 218, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 227, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -14070,7 +14372,7 @@ func (s *OC_FilterExpressionContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *CypherParser) OC_FilterExpression() (localctx IOC_FilterExpressionContext) {
 	localctx = NewOC_FilterExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, CypherParserRULE_oC_FilterExpression)
+	p.EnterRule(localctx, 144, CypherParserRULE_oC_FilterExpression)
 	var _la int
 
 	defer func() {
@@ -14091,26 +14393,26 @@ func (p *CypherParser) OC_FilterExpression() (localctx IOC_FilterExpressionConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1244)
+		p.SetState(1283)
 		p.OC_IdInColl()
 	}
-	p.SetState(1249)
+	p.SetState(1288)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 220, p.GetParserRuleContext()) == 1 {
-		p.SetState(1246)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 229, p.GetParserRuleContext()) == 1 {
+		p.SetState(1285)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1245)
+				p.SetState(1284)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1248)
+			p.SetState(1287)
 			p.OC_Where()
 		}
 
@@ -14221,7 +14523,7 @@ func (s *OC_IdInCollContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) OC_IdInColl() (localctx IOC_IdInCollContext) {
 	localctx = NewOC_IdInCollContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, CypherParserRULE_oC_IdInColl)
+	p.EnterRule(localctx, 146, CypherParserRULE_oC_IdInColl)
 
 	defer func() {
 		p.ExitRule()
@@ -14241,23 +14543,23 @@ func (p *CypherParser) OC_IdInColl() (localctx IOC_IdInCollContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1251)
+		p.SetState(1290)
 		p.OC_Variable()
 	}
 	{
-		p.SetState(1252)
+		p.SetState(1291)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(1253)
+		p.SetState(1292)
 		p.Match(CypherParserIN)
 	}
 	{
-		p.SetState(1254)
+		p.SetState(1293)
 		p.Match(CypherParserSP)
 	}
 	{
-		p.SetState(1255)
+		p.SetState(1294)
 		p.OC_Expression()
 	}
 
@@ -14379,7 +14681,7 @@ func (s *OC_FunctionInvocationContext) Accept(visitor antlr.ParseTreeVisitor) in
 
 func (p *CypherParser) OC_FunctionInvocation() (localctx IOC_FunctionInvocationContext) {
 	localctx = NewOC_FunctionInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, CypherParserRULE_oC_FunctionInvocation)
+	p.EnterRule(localctx, 148, CypherParserRULE_oC_FunctionInvocation)
 	var _la int
 
 	defer func() {
@@ -14400,121 +14702,121 @@ func (p *CypherParser) OC_FunctionInvocation() (localctx IOC_FunctionInvocationC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1257)
+		p.SetState(1296)
 		p.OC_FunctionName()
 	}
-	p.SetState(1259)
+	p.SetState(1298)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1258)
+			p.SetState(1297)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1261)
+		p.SetState(1300)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(1263)
+	p.SetState(1302)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1262)
+			p.SetState(1301)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1269)
+	p.SetState(1308)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserDISTINCT {
 		{
-			p.SetState(1265)
+			p.SetState(1304)
 			p.Match(CypherParserDISTINCT)
 		}
-		p.SetState(1267)
+		p.SetState(1306)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1266)
+				p.SetState(1305)
 				p.Match(CypherParserSP)
 			}
 
 		}
 
 	}
-	p.SetState(1288)
+	p.SetState(1327)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__24)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 		{
-			p.SetState(1271)
+			p.SetState(1310)
 			p.OC_Expression()
 		}
-		p.SetState(1273)
+		p.SetState(1312)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1272)
+				p.SetState(1311)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1285)
+		p.SetState(1324)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1275)
+				p.SetState(1314)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1277)
+			p.SetState(1316)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1276)
+					p.SetState(1315)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1279)
+				p.SetState(1318)
 				p.OC_Expression()
 			}
-			p.SetState(1281)
+			p.SetState(1320)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1280)
+					p.SetState(1319)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1287)
+			p.SetState(1326)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1290)
+		p.SetState(1329)
 		p.Match(CypherParserT__6)
 	}
 
@@ -14615,7 +14917,7 @@ func (s *OC_FunctionNameContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *CypherParser) OC_FunctionName() (localctx IOC_FunctionNameContext) {
 	localctx = NewOC_FunctionNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, CypherParserRULE_oC_FunctionName)
+	p.EnterRule(localctx, 150, CypherParserRULE_oC_FunctionName)
 
 	defer func() {
 		p.ExitRule()
@@ -14633,25 +14935,25 @@ func (p *CypherParser) OC_FunctionName() (localctx IOC_FunctionNameContext) {
 		}
 	}()
 
-	p.SetState(1296)
+	p.SetState(1335)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1292)
+			p.SetState(1331)
 			p.OC_Namespace()
 		}
 		{
-			p.SetState(1293)
+			p.SetState(1332)
 			p.OC_SymbolicName()
 		}
 
 	case CypherParserEXISTS:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1295)
+			p.SetState(1334)
 			p.Match(CypherParserEXISTS)
 		}
 
@@ -14773,7 +15075,7 @@ func (s *OC_ExplicitProcedureInvocationContext) Accept(visitor antlr.ParseTreeVi
 
 func (p *CypherParser) OC_ExplicitProcedureInvocation() (localctx IOC_ExplicitProcedureInvocationContext) {
 	localctx = NewOC_ExplicitProcedureInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, CypherParserRULE_oC_ExplicitProcedureInvocation)
+	p.EnterRule(localctx, 152, CypherParserRULE_oC_ExplicitProcedureInvocation)
 	var _la int
 
 	defer func() {
@@ -14794,99 +15096,99 @@ func (p *CypherParser) OC_ExplicitProcedureInvocation() (localctx IOC_ExplicitPr
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1298)
+		p.SetState(1337)
 		p.OC_ProcedureName()
 	}
-	p.SetState(1300)
+	p.SetState(1339)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1299)
+			p.SetState(1338)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1302)
+		p.SetState(1341)
 		p.Match(CypherParserT__5)
 	}
-	p.SetState(1304)
+	p.SetState(1343)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1303)
+			p.SetState(1342)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1323)
+	p.SetState(1362)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__23)|(1<<CypherParserT__25))) != 0) || _la == CypherParserALL || _la == CypherParserNOT || (((_la-82)&-(0x1f+1)) == 0 && ((1<<uint((_la-82)))&((1<<(CypherParserNULL-82))|(1<<(CypherParserCOUNT-82))|(1<<(CypherParserANY-82))|(1<<(CypherParserNONE-82))|(1<<(CypherParserSINGLE-82))|(1<<(CypherParserTRUE-82))|(1<<(CypherParserFALSE-82))|(1<<(CypherParserEXISTS-82))|(1<<(CypherParserCASE-82))|(1<<(CypherParserStringLiteral-82))|(1<<(CypherParserHexInteger-82))|(1<<(CypherParserDecimalInteger-82))|(1<<(CypherParserOctalInteger-82))|(1<<(CypherParserHexLetter-82))|(1<<(CypherParserExponentDecimalReal-82))|(1<<(CypherParserRegularDecimalReal-82)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__5)|(1<<CypherParserT__7)|(1<<CypherParserT__12)|(1<<CypherParserT__13)|(1<<CypherParserT__24)|(1<<CypherParserT__26))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(CypherParserALL-48))|(1<<(CypherParserSHORTESTPATH-48))|(1<<(CypherParserALLSHORTESTPATHS-48))|(1<<(CypherParserNOT-48)))) != 0) || (((_la-85)&-(0x1f+1)) == 0 && ((1<<uint((_la-85)))&((1<<(CypherParserNULL-85))|(1<<(CypherParserCOUNT-85))|(1<<(CypherParserANY-85))|(1<<(CypherParserNONE-85))|(1<<(CypherParserSINGLE-85))|(1<<(CypherParserTRUE-85))|(1<<(CypherParserFALSE-85))|(1<<(CypherParserEXISTS-85))|(1<<(CypherParserCASE-85))|(1<<(CypherParserStringLiteral-85))|(1<<(CypherParserHexInteger-85))|(1<<(CypherParserDecimalInteger-85))|(1<<(CypherParserOctalInteger-85))|(1<<(CypherParserHexLetter-85))|(1<<(CypherParserExponentDecimalReal-85))|(1<<(CypherParserRegularDecimalReal-85)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 		{
-			p.SetState(1306)
+			p.SetState(1345)
 			p.OC_Expression()
 		}
-		p.SetState(1308)
+		p.SetState(1347)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1307)
+				p.SetState(1346)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1320)
+		p.SetState(1359)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1310)
+				p.SetState(1349)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1312)
+			p.SetState(1351)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1311)
+					p.SetState(1350)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1314)
+				p.SetState(1353)
 				p.OC_Expression()
 			}
-			p.SetState(1316)
+			p.SetState(1355)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1315)
+					p.SetState(1354)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1322)
+			p.SetState(1361)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1325)
+		p.SetState(1364)
 		p.Match(CypherParserT__6)
 	}
 
@@ -14973,7 +15275,7 @@ func (s *OC_ImplicitProcedureInvocationContext) Accept(visitor antlr.ParseTreeVi
 
 func (p *CypherParser) OC_ImplicitProcedureInvocation() (localctx IOC_ImplicitProcedureInvocationContext) {
 	localctx = NewOC_ImplicitProcedureInvocationContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, CypherParserRULE_oC_ImplicitProcedureInvocation)
+	p.EnterRule(localctx, 154, CypherParserRULE_oC_ImplicitProcedureInvocation)
 
 	defer func() {
 		p.ExitRule()
@@ -14993,7 +15295,7 @@ func (p *CypherParser) OC_ImplicitProcedureInvocation() (localctx IOC_ImplicitPr
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1327)
+		p.SetState(1366)
 		p.OC_ProcedureName()
 	}
 
@@ -15080,7 +15382,7 @@ func (s *OC_ProcedureResultFieldContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *CypherParser) OC_ProcedureResultField() (localctx IOC_ProcedureResultFieldContext) {
 	localctx = NewOC_ProcedureResultFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, CypherParserRULE_oC_ProcedureResultField)
+	p.EnterRule(localctx, 156, CypherParserRULE_oC_ProcedureResultField)
 
 	defer func() {
 		p.ExitRule()
@@ -15100,7 +15402,7 @@ func (p *CypherParser) OC_ProcedureResultField() (localctx IOC_ProcedureResultFi
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1329)
+		p.SetState(1368)
 		p.OC_SymbolicName()
 	}
 
@@ -15197,7 +15499,7 @@ func (s *OC_ProcedureNameContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_ProcedureName() (localctx IOC_ProcedureNameContext) {
 	localctx = NewOC_ProcedureNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, CypherParserRULE_oC_ProcedureName)
+	p.EnterRule(localctx, 158, CypherParserRULE_oC_ProcedureName)
 
 	defer func() {
 		p.ExitRule()
@@ -15217,11 +15519,11 @@ func (p *CypherParser) OC_ProcedureName() (localctx IOC_ProcedureNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1331)
+		p.SetState(1370)
 		p.OC_Namespace()
 	}
 	{
-		p.SetState(1332)
+		p.SetState(1371)
 		p.OC_SymbolicName()
 	}
 
@@ -15321,7 +15623,7 @@ func (s *OC_NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) OC_Namespace() (localctx IOC_NamespaceContext) {
 	localctx = NewOC_NamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, CypherParserRULE_oC_Namespace)
+	p.EnterRule(localctx, 160, CypherParserRULE_oC_Namespace)
 
 	defer func() {
 		p.ExitRule()
@@ -15342,25 +15644,25 @@ func (p *CypherParser) OC_Namespace() (localctx IOC_NamespaceContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1339)
+	p.SetState(1378)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 238, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 247, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(1334)
+				p.SetState(1373)
 				p.OC_SymbolicName()
 			}
 			{
-				p.SetState(1335)
-				p.Match(CypherParserT__22)
+				p.SetState(1374)
+				p.Match(CypherParserT__23)
 			}
 
 		}
-		p.SetState(1341)
+		p.SetState(1380)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 238, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 247, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -15464,7 +15766,7 @@ func (s *OC_ListComprehensionContext) Accept(visitor antlr.ParseTreeVisitor) int
 
 func (p *CypherParser) OC_ListComprehension() (localctx IOC_ListComprehensionContext) {
 	localctx = NewOC_ListComprehensionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, CypherParserRULE_oC_ListComprehension)
+	p.EnterRule(localctx, 162, CypherParserRULE_oC_ListComprehension)
 	var _la int
 
 	defer func() {
@@ -15485,73 +15787,73 @@ func (p *CypherParser) OC_ListComprehension() (localctx IOC_ListComprehensionCon
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1342)
+		p.SetState(1381)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(1344)
+	p.SetState(1383)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1343)
+			p.SetState(1382)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1346)
+		p.SetState(1385)
 		p.OC_FilterExpression()
 	}
-	p.SetState(1355)
+	p.SetState(1394)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 242, p.GetParserRuleContext()) == 1 {
-		p.SetState(1348)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 251, p.GetParserRuleContext()) == 1 {
+		p.SetState(1387)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1347)
+				p.SetState(1386)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1350)
+			p.SetState(1389)
 			p.Match(CypherParserT__10)
 		}
-		p.SetState(1352)
+		p.SetState(1391)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1351)
+				p.SetState(1390)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1354)
+			p.SetState(1393)
 			p.OC_Expression()
 		}
 
 	}
-	p.SetState(1358)
+	p.SetState(1397)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1357)
+			p.SetState(1396)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1360)
+		p.SetState(1399)
 		p.Match(CypherParserT__8)
 	}
 
@@ -15683,7 +15985,7 @@ func (s *OC_PatternComprehensionContext) Accept(visitor antlr.ParseTreeVisitor)
 
 func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehensionContext) {
 	localctx = NewOC_PatternComprehensionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, CypherParserRULE_oC_PatternComprehension)
+	p.EnterRule(localctx, 164, CypherParserRULE_oC_PatternComprehension)
 	var _la int
 
 	defer func() {
@@ -15704,51 +16006,51 @@ func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehens
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1362)
+		p.SetState(1401)
 		p.Match(CypherParserT__7)
 	}
-	p.SetState(1364)
+	p.SetState(1403)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1363)
+			p.SetState(1402)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1374)
+	p.SetState(1413)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0) {
+	if (((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0) {
 		{
-			p.SetState(1366)
+			p.SetState(1405)
 			p.OC_Variable()
 		}
-		p.SetState(1368)
+		p.SetState(1407)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1367)
+				p.SetState(1406)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1370)
+			p.SetState(1409)
 			p.Match(CypherParserT__2)
 		}
-		p.SetState(1372)
+		p.SetState(1411)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1371)
+				p.SetState(1410)
 				p.Match(CypherParserSP)
 			}
 
@@ -15756,51 +16058,51 @@ func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehens
 
 	}
 	{
-		p.SetState(1376)
+		p.SetState(1415)
 		p.OC_RelationshipsPattern()
 	}
-	p.SetState(1378)
+	p.SetState(1417)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1377)
+			p.SetState(1416)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1388)
+	p.SetState(1427)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserWHERE {
 		{
-			p.SetState(1380)
+			p.SetState(1419)
 			p.Match(CypherParserWHERE)
 		}
-		p.SetState(1382)
+		p.SetState(1421)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1381)
+				p.SetState(1420)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1384)
+			p.SetState(1423)
 			p.OC_Expression()
 		}
-		p.SetState(1386)
+		p.SetState(1425)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1385)
+				p.SetState(1424)
 				p.Match(CypherParserSP)
 			}
 
@@ -15808,37 +16110,37 @@ func (p *CypherParser) OC_PatternComprehension() (localctx IOC_PatternComprehens
 
 	}
 	{
-		p.SetState(1390)
+		p.SetState(1429)
 		p.Match(CypherParserT__10)
 	}
-	p.SetState(1392)
+	p.SetState(1431)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1391)
+			p.SetState(1430)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1394)
+		p.SetState(1433)
 		p.OC_Expression()
 	}
-	p.SetState(1396)
+	p.SetState(1435)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1395)
+			p.SetState(1434)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1398)
+		p.SetState(1437)
 		p.Match(CypherParserT__8)
 	}
 
@@ -15929,7 +16231,7 @@ func (s *OC_PropertyLookupContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) OC_PropertyLookup() (localctx IOC_PropertyLookupContext) {
 	localctx = NewOC_PropertyLookupContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, CypherParserRULE_oC_PropertyLookup)
+	p.EnterRule(localctx, 166, CypherParserRULE_oC_PropertyLookup)
 	var _la int
 
 	defer func() {
@@ -15950,23 +16252,23 @@ func (p *CypherParser) OC_PropertyLookup() (localctx IOC_PropertyLookupContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1400)
-		p.Match(CypherParserT__22)
+		p.SetState(1439)
+		p.Match(CypherParserT__23)
 	}
-	p.SetState(1402)
+	p.SetState(1441)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1401)
+			p.SetState(1440)
 			p.Match(CypherParserSP)
 		}
 
 	}
 
 	{
-		p.SetState(1404)
+		p.SetState(1443)
 		p.OC_PropertyKeyName()
 	}
 
@@ -16109,7 +16411,7 @@ func (s *OC_CaseExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext) {
 	localctx = NewOC_CaseExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, CypherParserRULE_oC_CaseExpression)
+	p.EnterRule(localctx, 168, CypherParserRULE_oC_CaseExpression)
 	var _la int
 
 	defer func() {
@@ -16131,33 +16433,33 @@ func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext)
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(1428)
+	p.SetState(1467)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 260, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 269, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(1406)
+			p.SetState(1445)
 			p.Match(CypherParserCASE)
 		}
-		p.SetState(1411)
+		p.SetState(1450)
 		p.GetErrorHandler().Sync(p)
 		_alt = 1
 		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			switch _alt {
 			case 1:
-				p.SetState(1408)
+				p.SetState(1447)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(1407)
+						p.SetState(1446)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(1410)
+					p.SetState(1449)
 					p.OC_CaseAlternatives()
 				}
 
@@ -16165,50 +16467,50 @@ func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext)
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(1413)
+			p.SetState(1452)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 256, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 265, p.GetParserRuleContext())
 		}
 
 	case 2:
 		{
-			p.SetState(1415)
+			p.SetState(1454)
 			p.Match(CypherParserCASE)
 		}
-		p.SetState(1417)
+		p.SetState(1456)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1416)
+				p.SetState(1455)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1419)
+			p.SetState(1458)
 			p.OC_Expression()
 		}
-		p.SetState(1424)
+		p.SetState(1463)
 		p.GetErrorHandler().Sync(p)
 		_alt = 1
 		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			switch _alt {
 			case 1:
-				p.SetState(1421)
+				p.SetState(1460)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == CypherParserSP {
 					{
-						p.SetState(1420)
+						p.SetState(1459)
 						p.Match(CypherParserSP)
 					}
 
 				}
 				{
-					p.SetState(1423)
+					p.SetState(1462)
 					p.OC_CaseAlternatives()
 				}
 
@@ -16216,61 +16518,61 @@ func (p *CypherParser) OC_CaseExpression() (localctx IOC_CaseExpressionContext)
 				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-			p.SetState(1426)
+			p.SetState(1465)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 259, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 268, p.GetParserRuleContext())
 		}
 
 	}
-	p.SetState(1438)
+	p.SetState(1477)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 263, p.GetParserRuleContext()) == 1 {
-		p.SetState(1431)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 272, p.GetParserRuleContext()) == 1 {
+		p.SetState(1470)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1430)
+				p.SetState(1469)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1433)
+			p.SetState(1472)
 			p.Match(CypherParserELSE)
 		}
-		p.SetState(1435)
+		p.SetState(1474)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1434)
+				p.SetState(1473)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1437)
+			p.SetState(1476)
 			p.OC_Expression()
 		}
 
 	}
-	p.SetState(1441)
+	p.SetState(1480)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1440)
+			p.SetState(1479)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1443)
+		p.SetState(1482)
 		p.Match(CypherParserEND)
 	}
 
@@ -16386,7 +16688,7 @@ func (s *OC_CaseAlternativesContext) Accept(visitor antlr.ParseTreeVisitor) inte
 
 func (p *CypherParser) OC_CaseAlternatives() (localctx IOC_CaseAlternativesContext) {
 	localctx = NewOC_CaseAlternativesContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, CypherParserRULE_oC_CaseAlternatives)
+	p.EnterRule(localctx, 170, CypherParserRULE_oC_CaseAlternatives)
 	var _la int
 
 	defer func() {
@@ -16407,52 +16709,52 @@ func (p *CypherParser) OC_CaseAlternatives() (localctx IOC_CaseAlternativesConte
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1445)
+		p.SetState(1484)
 		p.Match(CypherParserWHEN)
 	}
-	p.SetState(1447)
+	p.SetState(1486)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1446)
+			p.SetState(1485)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1449)
+		p.SetState(1488)
 		p.OC_Expression()
 	}
-	p.SetState(1451)
+	p.SetState(1490)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1450)
+			p.SetState(1489)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1453)
+		p.SetState(1492)
 		p.Match(CypherParserTHEN)
 	}
-	p.SetState(1455)
+	p.SetState(1494)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1454)
+			p.SetState(1493)
 			p.Match(CypherParserSP)
 		}
 
 	}
 	{
-		p.SetState(1457)
+		p.SetState(1496)
 		p.OC_Expression()
 	}
 
@@ -16539,7 +16841,7 @@ func (s *OC_VariableContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) OC_Variable() (localctx IOC_VariableContext) {
 	localctx = NewOC_VariableContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, CypherParserRULE_oC_Variable)
+	p.EnterRule(localctx, 172, CypherParserRULE_oC_Variable)
 
 	defer func() {
 		p.ExitRule()
@@ -16559,7 +16861,7 @@ func (p *CypherParser) OC_Variable() (localctx IOC_VariableContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1459)
+		p.SetState(1498)
 		p.OC_SymbolicName()
 	}
 
@@ -16656,7 +16958,7 @@ func (s *OC_NumberLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_NumberLiteral() (localctx IOC_NumberLiteralContext) {
 	localctx = NewOC_NumberLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, CypherParserRULE_oC_NumberLiteral)
+	p.EnterRule(localctx, 174, CypherParserRULE_oC_NumberLiteral)
 
 	defer func() {
 		p.ExitRule()
@@ -16674,21 +16976,21 @@ func (p *CypherParser) OC_NumberLiteral() (localctx IOC_NumberLiteralContext) {
 		}
 	}()
 
-	p.SetState(1463)
+	p.SetState(1502)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case CypherParserExponentDecimalReal, CypherParserRegularDecimalReal:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1461)
+			p.SetState(1500)
 			p.OC_DoubleLiteral()
 		}
 
 	case CypherParserHexInteger, CypherParserDecimalInteger, CypherParserOctalInteger:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1462)
+			p.SetState(1501)
 			p.OC_IntegerLiteral()
 		}
 
@@ -16823,7 +17125,7 @@ func (s *OC_MapLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) OC_MapLiteral() (localctx IOC_MapLiteralContext) {
 	localctx = NewOC_MapLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, CypherParserRULE_oC_MapLiteral)
+	p.EnterRule(localctx, 176, CypherParserRULE_oC_MapLiteral)
 	var _la int
 
 	defer func() {
@@ -16844,145 +17146,145 @@ func (p *CypherParser) OC_MapLiteral() (localctx IOC_MapLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1465)
-		p.Match(CypherParserT__23)
+		p.SetState(1504)
+		p.Match(CypherParserT__24)
 	}
-	p.SetState(1467)
+	p.SetState(1506)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == CypherParserSP {
 		{
-			p.SetState(1466)
+			p.SetState(1505)
 			p.Match(CypherParserSP)
 		}
 
 	}
-	p.SetState(1502)
+	p.SetState(1541)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46))|(1<<(CypherParserDESCENDING-46))|(1<<(CypherParserDESC-46))|(1<<(CypherParserWHERE-46))|(1<<(CypherParserOR-46))|(1<<(CypherParserXOR-46))|(1<<(CypherParserAND-46))|(1<<(CypherParserNOT-46))|(1<<(CypherParserIN-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserCOUNT-78))|(1<<(CypherParserANY-78))|(1<<(CypherParserNONE-78))|(1<<(CypherParserSINGLE-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserHexLetter-78))|(1<<(CypherParserCONSTRAINT-78)))) != 0) || (((_la-110)&-(0x1f+1)) == 0 && ((1<<uint((_la-110)))&((1<<(CypherParserDO-110))|(1<<(CypherParserFOR-110))|(1<<(CypherParserREQUIRE-110))|(1<<(CypherParserUNIQUE-110))|(1<<(CypherParserMANDATORY-110))|(1<<(CypherParserSCALAR-110))|(1<<(CypherParserOF-110))|(1<<(CypherParserADD-110))|(1<<(CypherParserDROP-110))|(1<<(CypherParserFILTER-110))|(1<<(CypherParserEXTRACT-110))|(1<<(CypherParserUnescapedSymbolicName-110))|(1<<(CypherParserEscapedSymbolicName-110)))) != 0) {
+	if (((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserUNION-47))|(1<<(CypherParserALL-47))|(1<<(CypherParserOPTIONAL-47))|(1<<(CypherParserMATCH-47))|(1<<(CypherParserUNWIND-47))|(1<<(CypherParserAS-47))|(1<<(CypherParserMERGE-47))|(1<<(CypherParserON-47))|(1<<(CypherParserCREATE-47))|(1<<(CypherParserSET-47))|(1<<(CypherParserDETACH-47))|(1<<(CypherParserDELETE-47))|(1<<(CypherParserREMOVE-47))|(1<<(CypherParserWITH-47))|(1<<(CypherParserRETURN-47))|(1<<(CypherParserDISTINCT-47))|(1<<(CypherParserORDER-47))|(1<<(CypherParserBY-47))|(1<<(CypherParserL_SKIP-47))|(1<<(CypherParserLIMIT-47))|(1<<(CypherParserASCENDING-47))|(1<<(CypherParserASC-47))|(1<<(CypherParserDESCENDING-47))|(1<<(CypherParserDESC-47))|(1<<(CypherParserWHERE-47))|(1<<(CypherParserSHORTESTPATH-47))|(1<<(CypherParserALLSHORTESTPATHS-47))|(1<<(CypherParserOR-47))|(1<<(CypherParserXOR-47))|(1<<(CypherParserAND-47)))) != 0) || (((_la-79)&-(0x1f+1)) == 0 && ((1<<uint((_la-79)))&((1<<(CypherParserNOT-79))|(1<<(CypherParserIN-79))|(1<<(CypherParserSTARTS-79))|(1<<(CypherParserENDS-79))|(1<<(CypherParserCONTAINS-79))|(1<<(CypherParserIS-79))|(1<<(CypherParserNULL-79))|(1<<(CypherParserCOUNT-79))|(1<<(CypherParserANY-79))|(1<<(CypherParserNONE-79))|(1<<(CypherParserSINGLE-79))|(1<<(CypherParserTRUE-79))|(1<<(CypherParserFALSE-79))|(1<<(CypherParserEXISTS-79))|(1<<(CypherParserCASE-79))|(1<<(CypherParserELSE-79))|(1<<(CypherParserEND-79))|(1<<(CypherParserWHEN-79))|(1<<(CypherParserTHEN-79))|(1<<(CypherParserHexLetter-79)))) != 0) || (((_la-112)&-(0x1f+1)) == 0 && ((1<<uint((_la-112)))&((1<<(CypherParserCONSTRAINT-112))|(1<<(CypherParserDO-112))|(1<<(CypherParserFOR-112))|(1<<(CypherParserREQUIRE-112))|(1<<(CypherParserUNIQUE-112))|(1<<(CypherParserMANDATORY-112))|(1<<(CypherParserSCALAR-112))|(1<<(CypherParserOF-112))|(1<<(CypherParserADD-112))|(1<<(CypherParserDROP-112))|(1<<(CypherParserFILTER-112))|(1<<(CypherParserEXTRACT-112))|(1<<(CypherParserUnescapedSymbolicName-112))|(1<<(CypherParserEscapedSymbolicName-112)))) != 0) {
 		{
-			p.SetState(1469)
+			p.SetState(1508)
 			p.OC_PropertyKeyName()
 		}
-		p.SetState(1471)
+		p.SetState(1510)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1470)
+				p.SetState(1509)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1473)
+			p.SetState(1512)
 			p.Match(CypherParserT__9)
 		}
-		p.SetState(1475)
+		p.SetState(1514)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1474)
+				p.SetState(1513)
 				p.Match(CypherParserSP)
 			}
 
 		}
 		{
-			p.SetState(1477)
+			p.SetState(1516)
 			p.OC_Expression()
 		}
-		p.SetState(1479)
+		p.SetState(1518)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == CypherParserSP {
 			{
-				p.SetState(1478)
+				p.SetState(1517)
 				p.Match(CypherParserSP)
 			}
 
 		}
-		p.SetState(1499)
+		p.SetState(1538)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == CypherParserT__1 {
 			{
-				p.SetState(1481)
+				p.SetState(1520)
 				p.Match(CypherParserT__1)
 			}
-			p.SetState(1483)
+			p.SetState(1522)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1482)
+					p.SetState(1521)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1485)
+				p.SetState(1524)
 				p.OC_PropertyKeyName()
 			}
-			p.SetState(1487)
+			p.SetState(1526)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1486)
+					p.SetState(1525)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1489)
+				p.SetState(1528)
 				p.Match(CypherParserT__9)
 			}
-			p.SetState(1491)
+			p.SetState(1530)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1490)
+					p.SetState(1529)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1493)
+				p.SetState(1532)
 				p.OC_Expression()
 			}
-			p.SetState(1495)
+			p.SetState(1534)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1494)
+					p.SetState(1533)
 					p.Match(CypherParserSP)
 				}
 
 			}
 
-			p.SetState(1501)
+			p.SetState(1540)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 
 	}
 	{
-		p.SetState(1504)
-		p.Match(CypherParserT__24)
+		p.SetState(1543)
+		p.Match(CypherParserT__25)
 	}
 
 	return localctx
@@ -17072,7 +17374,7 @@ func (s *OC_ParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 func (p *CypherParser) OC_Parameter() (localctx IOC_ParameterContext) {
 	localctx = NewOC_ParameterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, CypherParserRULE_oC_Parameter)
+	p.EnterRule(localctx, 178, CypherParserRULE_oC_Parameter)
 
 	defer func() {
 		p.ExitRule()
@@ -17092,22 +17394,22 @@ func (p *CypherParser) OC_Parameter() (localctx IOC_ParameterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1506)
-		p.Match(CypherParserT__25)
+		p.SetState(1545)
+		p.Match(CypherParserT__26)
 	}
-	p.SetState(1509)
+	p.SetState(1548)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		{
-			p.SetState(1507)
+			p.SetState(1546)
 			p.OC_SymbolicName()
 		}
 
 	case CypherParserDecimalInteger:
 		{
-			p.SetState(1508)
+			p.SetState(1547)
 			p.Match(CypherParserDecimalInteger)
 		}
 
@@ -17229,7 +17531,7 @@ func (s *OC_PropertyExpressionContext) Accept(visitor antlr.ParseTreeVisitor) in
 
 func (p *CypherParser) OC_PropertyExpression() (localctx IOC_PropertyExpressionContext) {
 	localctx = NewOC_PropertyExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, CypherParserRULE_oC_PropertyExpression)
+	p.EnterRule(localctx, 180, CypherParserRULE_oC_PropertyExpression)
 	var _la int
 
 	defer func() {
@@ -17252,28 +17554,28 @@ func (p *CypherParser) OC_PropertyExpression() (localctx IOC_PropertyExpressionC
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1511)
+		p.SetState(1550)
 		p.OC_Atom()
 	}
-	p.SetState(1516)
+	p.SetState(1555)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(1513)
+			p.SetState(1552)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == CypherParserSP {
 				{
-					p.SetState(1512)
+					p.SetState(1551)
 					p.Match(CypherParserSP)
 				}
 
 			}
 			{
-				p.SetState(1515)
+				p.SetState(1554)
 				p.OC_PropertyLookup()
 			}
 
@@ -17281,9 +17583,9 @@ func (p *CypherParser) OC_PropertyExpression() (localctx IOC_PropertyExpressionC
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(1518)
+		p.SetState(1557)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 281, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 290, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -17369,7 +17671,7 @@ func (s *OC_PropertyKeyNameContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 func (p *CypherParser) OC_PropertyKeyName() (localctx IOC_PropertyKeyNameContext) {
 	localctx = NewOC_PropertyKeyNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, CypherParserRULE_oC_PropertyKeyName)
+	p.EnterRule(localctx, 182, CypherParserRULE_oC_PropertyKeyName)
 
 	defer func() {
 		p.ExitRule()
@@ -17389,7 +17691,7 @@ func (p *CypherParser) OC_PropertyKeyName() (localctx IOC_PropertyKeyNameContext
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1520)
+		p.SetState(1559)
 		p.OC_SchemaName()
 	}
 
@@ -17478,7 +17780,7 @@ func (s *OC_IntegerLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) OC_IntegerLiteral() (localctx IOC_IntegerLiteralContext) {
 	localctx = NewOC_IntegerLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, CypherParserRULE_oC_IntegerLiteral)
+	p.EnterRule(localctx, 184, CypherParserRULE_oC_IntegerLiteral)
 	var _la int
 
 	defer func() {
@@ -17499,10 +17801,10 @@ func (p *CypherParser) OC_IntegerLiteral() (localctx IOC_IntegerLiteralContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1522)
+		p.SetState(1561)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-97)&-(0x1f+1)) == 0 && ((1<<uint((_la-97)))&((1<<(CypherParserHexInteger-97))|(1<<(CypherParserDecimalInteger-97))|(1<<(CypherParserOctalInteger-97)))) != 0) {
+		if !(((_la-100)&-(0x1f+1)) == 0 && ((1<<uint((_la-100)))&((1<<(CypherParserHexInteger-100))|(1<<(CypherParserDecimalInteger-100))|(1<<(CypherParserOctalInteger-100)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -17591,7 +17893,7 @@ func (s *OC_DoubleLiteralContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_DoubleLiteral() (localctx IOC_DoubleLiteralContext) {
 	localctx = NewOC_DoubleLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, CypherParserRULE_oC_DoubleLiteral)
+	p.EnterRule(localctx, 186, CypherParserRULE_oC_DoubleLiteral)
 	var _la int
 
 	defer func() {
@@ -17612,7 +17914,7 @@ func (p *CypherParser) OC_DoubleLiteral() (localctx IOC_DoubleLiteralContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1524)
+		p.SetState(1563)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == CypherParserExponentDecimalReal || _la == CypherParserRegularDecimalReal) {
@@ -17716,7 +18018,7 @@ func (s *OC_SchemaNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 func (p *CypherParser) OC_SchemaName() (localctx IOC_SchemaNameContext) {
 	localctx = NewOC_SchemaNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, CypherParserRULE_oC_SchemaName)
+	p.EnterRule(localctx, 188, CypherParserRULE_oC_SchemaName)
 
 	defer func() {
 		p.ExitRule()
@@ -17734,21 +18036,21 @@ func (p *CypherParser) OC_SchemaName() (localctx IOC_SchemaNameContext) {
 		}
 	}()
 
-	p.SetState(1528)
+	p.SetState(1567)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
+	case CypherParserSHORTESTPATH, CypherParserALLSHORTESTPATHS, CypherParserCOUNT, CypherParserANY, CypherParserNONE, CypherParserSINGLE, CypherParserHexLetter, CypherParserFILTER, CypherParserEXTRACT, CypherParserUnescapedSymbolicName, CypherParserEscapedSymbolicName:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1526)
+			p.SetState(1565)
 			p.OC_SymbolicName()
 		}
 
 	case CypherParserUNION, CypherParserALL, CypherParserOPTIONAL, CypherParserMATCH, CypherParserUNWIND, CypherParserAS, CypherParserMERGE, CypherParserON, CypherParserCREATE, CypherParserSET, CypherParserDETACH, CypherParserDELETE, CypherParserREMOVE, CypherParserWITH, CypherParserRETURN, CypherParserDISTINCT, CypherParserORDER, CypherParserBY, CypherParserL_SKIP, CypherParserLIMIT, CypherParserASCENDING, CypherParserASC, CypherParserDESCENDING, CypherParserDESC, CypherParserWHERE, CypherParserOR, CypherParserXOR, CypherParserAND, CypherParserNOT, CypherParserIN, CypherParserSTARTS, CypherParserENDS, CypherParserCONTAINS, CypherParserIS, CypherParserNULL, CypherParserTRUE, CypherParserFALSE, CypherParserEXISTS, CypherParserCASE, CypherParserELSE, CypherParserEND, CypherParserWHEN, CypherParserTHEN, CypherParserCONSTRAINT, CypherParserDO, CypherParserFOR, CypherParserREQUIRE, CypherParserUNIQUE, CypherParserMANDATORY, CypherParserSCALAR, CypherParserOF, CypherParserADD, CypherParserDROP:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1527)
+			p.SetState(1566)
 			p.OC_ReservedWord()
 		}
 
@@ -18041,7 +18343,7 @@ func (s *OC_ReservedWordContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *CypherParser) OC_ReservedWord() (localctx IOC_ReservedWordContext) {
 	localctx = NewOC_ReservedWordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, CypherParserRULE_oC_ReservedWord)
+	p.EnterRule(localctx, 190, CypherParserRULE_oC_ReservedWord)
 	var _la int
 
 	defer func() {
@@ -18062,10 +18364,10 @@ func (p *CypherParser) OC_ReservedWord() (localctx IOC_ReservedWordContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1530)
+		p.SetState(1569)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(CypherParserUNION-46))|(1<<(CypherParserALL-46))|(1<<(CypherParserOPTIONAL-46))|(1<<(CypherParserMATCH-46))|(1<<(CypherParserUNWIND-46))|(1<<(CypherParserAS-46))|(1<<(CypherParserMERGE-46))|(1<<(CypherParserON-46))|(1<<(CypherParserCREATE-46))|(1<<(CypherParserSET-46))|(1<<(CypherParserDETACH-46))|(1<<(CypherParserDELETE-46))|(1<<(CypherParserREMOVE-46))|(1<<(CypherParserWITH-46))|(1<<(CypherParserRETURN-46))|(1<<(CypherParserDISTINCT-46))|(1<<(CypherParserORDER-46))|(1<<(CypherParserBY-46))|(1<<(CypherParserL_SKIP-46))|(1<<(CypherParserLIMIT-46))|(1<<(CypherParserASCENDING-46))|(1<<(CypherParserASC-46))|(1<<(CypherParserDESCENDING-46))|(1<<(CypherParserDESC-46))|(1<<(CypherParserWHERE-46))|(1<<(CypherParserOR-46))|(1<<(CypherParserXOR-46))|(1<<(CypherParserAND-46))|(1<<(CypherParserNOT-46))|(1<<(CypherParserIN-46)))) != 0) || (((_la-78)&-(0x1f+1)) == 0 && ((1<<uint((_la-78)))&((1<<(CypherParserSTARTS-78))|(1<<(CypherParserENDS-78))|(1<<(CypherParserCONTAINS-78))|(1<<(CypherParserIS-78))|(1<<(CypherParserNULL-78))|(1<<(CypherParserTRUE-78))|(1<<(CypherParserFALSE-78))|(1<<(CypherParserEXISTS-78))|(1<<(CypherParserCASE-78))|(1<<(CypherParserELSE-78))|(1<<(CypherParserEND-78))|(1<<(CypherParserWHEN-78))|(1<<(CypherParserTHEN-78))|(1<<(CypherParserCONSTRAINT-78)))) != 0) || (((_la-110)&-(0x1f+1)) == 0 && ((1<<uint((_la-110)))&((1<<(CypherParserDO-110))|(1<<(CypherParserFOR-110))|(1<<(CypherParserREQUIRE-110))|(1<<(CypherParserUNIQUE-110))|(1<<(CypherParserMANDATORY-110))|(1<<(CypherParserSCALAR-110))|(1<<(CypherParserOF-110))|(1<<(CypherParserADD-110))|(1<<(CypherParserDROP-110)))) != 0)) {
+		if !((((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(CypherParserUNION-47))|(1<<(CypherParserALL-47))|(1<<(CypherParserOPTIONAL-47))|(1<<(CypherParserMATCH-47))|(1<<(CypherParserUNWIND-47))|(1<<(CypherParserAS-47))|(1<<(CypherParserMERGE-47))|(1<<(CypherParserON-47))|(1<<(CypherParserCREATE-47))|(1<<(CypherParserSET-47))|(1<<(CypherParserDETACH-47))|(1<<(CypherParserDELETE-47))|(1<<(CypherParserREMOVE-47))|(1<<(CypherParserWITH-47))|(1<<(CypherParserRETURN-47))|(1<<(CypherParserDISTINCT-47))|(1<<(CypherParserORDER-47))|(1<<(CypherParserBY-47))|(1<<(CypherParserL_SKIP-47))|(1<<(CypherParserLIMIT-47))|(1<<(CypherParserASCENDING-47))|(1<<(CypherParserASC-47))|(1<<(CypherParserDESCENDING-47))|(1<<(CypherParserDESC-47))|(1<<(CypherParserWHERE-47))|(1<<(CypherParserOR-47))|(1<<(CypherParserXOR-47))|(1<<(CypherParserAND-47)))) != 0) || (((_la-79)&-(0x1f+1)) == 0 && ((1<<uint((_la-79)))&((1<<(CypherParserNOT-79))|(1<<(CypherParserIN-79))|(1<<(CypherParserSTARTS-79))|(1<<(CypherParserENDS-79))|(1<<(CypherParserCONTAINS-79))|(1<<(CypherParserIS-79))|(1<<(CypherParserNULL-79))|(1<<(CypherParserTRUE-79))|(1<<(CypherParserFALSE-79))|(1<<(CypherParserEXISTS-79))|(1<<(CypherParserCASE-79))|(1<<(CypherParserELSE-79))|(1<<(CypherParserEND-79))|(1<<(CypherParserWHEN-79))|(1<<(CypherParserTHEN-79)))) != 0) || (((_la-112)&-(0x1f+1)) == 0 && ((1<<uint((_la-112)))&((1<<(CypherParserCONSTRAINT-112))|(1<<(CypherParserDO-112))|(1<<(CypherParserFOR-112))|(1<<(CypherParserREQUIRE-112))|(1<<(CypherParserUNIQUE-112))|(1<<(CypherParserMANDATORY-112))|(1<<(CypherParserSCALAR-112))|(1<<(CypherParserOF-112))|(1<<(CypherParserADD-112))|(1<<(CypherParserDROP-112)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -18150,6 +18452,14 @@ func (s *OC_SymbolicNameContext) SINGLE() antlr.TerminalNode {
 	return s.GetToken(CypherParserSINGLE, 0)
 }
 
+func (s *OC_SymbolicNameContext) SHORTESTPATH() antlr.TerminalNode {
+	return s.GetToken(CypherParserSHORTESTPATH, 0)
+}
+
+func (s *OC_SymbolicNameContext) ALLSHORTESTPATHS() antlr.TerminalNode {
+	return s.GetToken(CypherParserALLSHORTESTPATHS, 0)
+}
+
 func (s *OC_SymbolicNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -18182,7 +18492,7 @@ func (s *OC_SymbolicNameContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 func (p *CypherParser) OC_SymbolicName() (localctx IOC_SymbolicNameContext) {
 	localctx = NewOC_SymbolicNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, CypherParserRULE_oC_SymbolicName)
+	p.EnterRule(localctx, 192, CypherParserRULE_oC_SymbolicName)
 	var _la int
 
 	defer func() {
@@ -18203,10 +18513,10 @@ func (p *CypherParser) OC_SymbolicName() (localctx IOC_SymbolicNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1532)
+		p.SetState(1571)
 		_la = p.GetTokenStream().LA(1)
 
-		if !((((_la-83)&-(0x1f+1)) == 0 && ((1<<uint((_la-83)))&((1<<(CypherParserCOUNT-83))|(1<<(CypherParserANY-83))|(1<<(CypherParserNONE-83))|(1<<(CypherParserSINGLE-83))|(1<<(CypherParserHexLetter-83)))) != 0) || (((_la-119)&-(0x1f+1)) == 0 && ((1<<uint((_la-119)))&((1<<(CypherParserFILTER-119))|(1<<(CypherParserEXTRACT-119))|(1<<(CypherParserUnescapedSymbolicName-119))|(1<<(CypherParserEscapedSymbolicName-119)))) != 0)) {
+		if !((((_la-74)&-(0x1f+1)) == 0 && ((1<<uint((_la-74)))&((1<<(CypherParserSHORTESTPATH-74))|(1<<(CypherParserALLSHORTESTPATHS-74))|(1<<(CypherParserCOUNT-74))|(1<<(CypherParserANY-74))|(1<<(CypherParserNONE-74))|(1<<(CypherParserSINGLE-74))|(1<<(CypherParserHexLetter-74)))) != 0) || (((_la-122)&-(0x1f+1)) == 0 && ((1<<uint((_la-122)))&((1<<(CypherParserFILTER-122))|(1<<(CypherParserEXTRACT-122))|(1<<(CypherParserUnescapedSymbolicName-122))|(1<<(CypherParserEscapedSymbolicName-122)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -18286,7 +18596,7 @@ func (s *OC_LeftArrowHeadContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 func (p *CypherParser) OC_LeftArrowHead() (localctx IOC_LeftArrowHeadContext) {
 	localctx = NewOC_LeftArrowHeadContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, CypherParserRULE_oC_LeftArrowHead)
+	p.EnterRule(localctx, 194, CypherParserRULE_oC_LeftArrowHead)
 	var _la int
 
 	defer func() {
@@ -18307,10 +18617,10 @@ func (p *CypherParser) OC_LeftArrowHead() (localctx IOC_LeftArrowHeadContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1534)
+		p.SetState(1573)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__18)|(1<<CypherParserT__26)|(1<<CypherParserT__27)|(1<<CypherParserT__28)|(1<<CypherParserT__29))) != 0) {
+		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<CypherParserT__18)|(1<<CypherParserT__27)|(1<<CypherParserT__28)|(1<<CypherParserT__29)|(1<<CypherParserT__30))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -18390,7 +18700,7 @@ func (s *OC_RightArrowHeadContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 func (p *CypherParser) OC_RightArrowHead() (localctx IOC_RightArrowHeadContext) {
 	localctx = NewOC_RightArrowHeadContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, CypherParserRULE_oC_RightArrowHead)
+	p.EnterRule(localctx, 196, CypherParserRULE_oC_RightArrowHead)
 	var _la int
 
 	defer func() {
@@ -18411,10 +18721,10 @@ func (p *CypherParser) OC_RightArrowHead() (localctx IOC_RightArrowHeadContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1536)
+		p.SetState(1575)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-20)&-(0x1f+1)) == 0 && ((1<<uint((_la-20)))&((1<<(CypherParserT__19-20))|(1<<(CypherParserT__30-20))|(1<<(CypherParserT__31-20))|(1<<(CypherParserT__32-20))|(1<<(CypherParserT__33-20)))) != 0) {
+		if !(((_la-20)&-(0x1f+1)) == 0 && ((1<<uint((_la-20)))&((1<<(CypherParserT__19-20))|(1<<(CypherParserT__31-20))|(1<<(CypherParserT__32-20))|(1<<(CypherParserT__33-20))|(1<<(CypherParserT__34-20)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -18494,7 +18804,7 @@ func (s *OC_DashContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *CypherParser) OC_Dash() (localctx IOC_DashContext) {
 	localctx = NewOC_DashContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, CypherParserRULE_oC_Dash)
+	p.EnterRule(localctx, 198, CypherParserRULE_oC_Dash)
 	var _la int
 
 	defer func() {
@@ -18515,10 +18825,10 @@ func (p *CypherParser) OC_Dash() (localctx IOC_DashContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1538)
+		p.SetState(1577)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-14)&-(0x1f+1)) == 0 && ((1<<uint((_la-14)))&((1<<(CypherParserT__13-14))|(1<<(CypherParserT__34-14))|(1<<(CypherParserT__35-14))|(1<<(CypherParserT__36-14))|(1<<(CypherParserT__37-14))|(1<<(CypherParserT__38-14))|(1<<(CypherParserT__39-14))|(1<<(CypherParserT__40-14))|(1<<(CypherParserT__41-14))|(1<<(CypherParserT__42-14))|(1<<(CypherParserT__43-14))|(1<<(CypherParserT__44-14)))) != 0) {
+		if !(_la == CypherParserT__13 || (((_la-36)&-(0x1f+1)) == 0 && ((1<<uint((_la-36)))&((1<<(CypherParserT__35-36))|(1<<(CypherParserT__36-36))|(1<<(CypherParserT__37-36))|(1<<(CypherParserT__38-36))|(1<<(CypherParserT__39-36))|(1<<(CypherParserT__40-36))|(1<<(CypherParserT__41-36))|(1<<(CypherParserT__42-36))|(1<<(CypherParserT__43-36))|(1<<(CypherParserT__44-36))|(1<<(CypherParserT__45-36)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
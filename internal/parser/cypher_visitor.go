@@ -119,6 +119,9 @@ type CypherVisitor interface {
 	// Visit a parse tree produced by CypherParser#oC_AnonymousPatternPart.
 	VisitOC_AnonymousPatternPart(ctx *OC_AnonymousPatternPartContext) interface{}
 
+	// Visit a parse tree produced by CypherParser#oC_ShortestPathPattern.
+	VisitOC_ShortestPathPattern(ctx *OC_ShortestPathPatternContext) interface{}
+
 	// Visit a parse tree produced by CypherParser#oC_PatternElement.
 	VisitOC_PatternElement(ctx *OC_PatternElementContext) interface{}
 
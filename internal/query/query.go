@@ -36,11 +36,16 @@ func (pel *ParsingErrorListener) SyntaxError(recognizer antlr.Recognizer, offend
 	})
 }
 
-// TransformCypher transform an openCypher query into a QueryCypher.
-func TransformCypher(query string) (*QueryCypher, error) {
+// NewTokenStream create the stream of tokens of an openCypher query
+func NewTokenStream(query string) *antlr.CommonTokenStream {
 	is := antlr.NewInputStream(query)
 	lexer := parser.NewCypherLexer(is)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	return antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+}
+
+// TransformCypher transform an openCypher query into a QueryCypher.
+func TransformCypher(query string) (*QueryCypher, error) {
+	stream := NewTokenStream(query)
 	p := parser.NewCypherParser(stream)
 
 	pel := NewParsingErrorListener()
@@ -50,6 +55,9 @@ func TransformCypher(query string) (*QueryCypher, error) {
 	queryCypher := l.Visit(p.OC_Cypher())
 
 	if len(pel.Errors) > 0 {
+		if err := checkExistsSubquery(stream); err != nil {
+			return nil, err
+		}
 		errStr := []string{}
 		for _, e := range pel.Errors {
 			errStr = append(errStr, fmt.Sprintf("line %d:%d - %s", e.Line, e.Column, e.Message))
@@ -66,6 +74,29 @@ func TransformCypher(query string) (*QueryCypher, error) {
 	return nil, fmt.Errorf("Unable to detect type of IL")
 }
 
+// checkExistsSubquery return an error pointing to the supported syntax when the query uses an EXISTS subquery
+// like EXISTS { MATCH ... } since only the pattern form is supported by the grammar
+func checkExistsSubquery(stream *antlr.CommonTokenStream) error {
+	stream.Fill()
+	tokens := stream.GetAllTokens()
+	for i, t := range tokens {
+		if t.GetTokenType() != parser.CypherLexerEXISTS {
+			continue
+		}
+		for _, next := range tokens[i+1:] {
+			if next.GetTokenType() == parser.CypherLexerSP {
+				continue
+			}
+			if next.GetText() == "{" {
+				return fmt.Errorf("EXISTS subqueries are not supported at line %d:%d, use a pattern like "+
+					"(a)-->(b) or exists((a)-->(b)) instead", t.GetLine(), t.GetColumn())
+			}
+			break
+		}
+	}
+	return nil
+}
+
 // BaseCypherVisitor visitor for cypher
 type BaseCypherVisitor struct {
 	parser.BaseCypherVisitor
@@ -107,7 +138,11 @@ func (cl *BaseCypherVisitor) Visit(tree antlr.ParseTree) interface{} {
 
 // QueryCypher the representation of the query in IL
 type QueryCypher struct {
+	// QueryParts are the parts of a multi-part query preceding the final single part query
+	QueryParts []QueryPart
 	QuerySinglePartQuery
+	// Unions are the queries whose results are combined with the results of this query
+	Unions []QueryUnion
 }
 
 // VisitOC_Cypher visit cypher
@@ -117,6 +152,13 @@ func (cl *BaseCypherVisitor) VisitOC_Cypher(c *parser.OC_CypherContext) interfac
 		switch v := c.OC_Statement().Accept(cl).(type) {
 		case QuerySinglePartQuery:
 			q.QuerySinglePartQuery = v
+		case QueryMultiPartQuery:
+			q.QueryParts = v.QueryParts
+			q.QuerySinglePartQuery = v.QuerySinglePartQuery
+		case QueryRegularQuery:
+			q.QueryParts = v.QueryParts
+			q.QuerySinglePartQuery = v.QuerySinglePartQuery
+			q.Unions = v.Unions
 		case error:
 			return v
 		}
@@ -136,14 +178,76 @@ func (cl *BaseCypherVisitor) VisitOC_Query(c *parser.OC_QueryContext) interface{
 	if c.OC_RegularQuery() != nil {
 		return c.OC_RegularQuery().Accept(cl)
 	}
+	if c.OC_StandaloneCall() != nil {
+		return c.OC_StandaloneCall().Accept(cl)
+	}
 	return fmt.Errorf("Unable to parse Cypher query")
 }
 
+// QueryRegularQuery is a query whose results are combined with the results of other queries by UNION clauses
+type QueryRegularQuery struct {
+	QueryMultiPartQuery
+	Unions []QueryUnion
+}
+
+// QueryUnion is a query whose results are combined with the results of the previous queries
+type QueryUnion struct {
+	// All is true when duplicates are kept (UNION ALL)
+	All bool
+	QueryMultiPartQuery
+}
+
+// toMultiPartQuery convert the result of the visit of a single query into a multi-part query
+func toMultiPartQuery(v interface{}) (QueryMultiPartQuery, error) {
+	switch q := v.(type) {
+	case QuerySinglePartQuery:
+		return QueryMultiPartQuery{QueryParts: []QueryPart{}, QuerySinglePartQuery: q}, nil
+	case QueryMultiPartQuery:
+		return q, nil
+	case error:
+		return QueryMultiPartQuery{}, q
+	}
+	return QueryMultiPartQuery{}, fmt.Errorf("Unable to parse single query")
+}
+
 func (cl *BaseCypherVisitor) VisitOC_RegularQuery(c *parser.OC_RegularQueryContext) interface{} {
-	if c.OC_SingleQuery() != nil {
+	if c.OC_SingleQuery() == nil {
+		return fmt.Errorf("Unabel to parse regular query")
+	}
+
+	if len(c.AllOC_Union()) == 0 {
 		return c.OC_SingleQuery().Accept(cl)
 	}
-	return fmt.Errorf("Unabel to parse regular query")
+
+	q := QueryRegularQuery{}
+	var err error
+	q.QueryMultiPartQuery, err = toMultiPartQuery(c.OC_SingleQuery().Accept(cl))
+	if err != nil {
+		return err
+	}
+
+	q.Unions = make([]QueryUnion, 0)
+	for i := range c.AllOC_Union() {
+		switch v := c.OC_Union(i).Accept(cl).(type) {
+		case QueryUnion:
+			q.Unions = append(q.Unions, v)
+		case error:
+			return v
+		}
+	}
+	return q
+}
+
+func (cl *BaseCypherVisitor) VisitOC_Union(c *parser.OC_UnionContext) interface{} {
+	q := QueryUnion{}
+	q.All = c.ALL() != nil
+
+	var err error
+	q.QueryMultiPartQuery, err = toMultiPartQuery(c.OC_SingleQuery().Accept(cl))
+	if err != nil {
+		return err
+	}
+	return q
 }
 
 func (cl *BaseCypherVisitor) VisitOC_SingleQuery(c *parser.OC_SingleQueryContext) interface{} {
@@ -156,8 +260,94 @@ func (cl *BaseCypherVisitor) VisitOC_SingleQuery(c *parser.OC_SingleQueryContext
 	return fmt.Errorf("Unable to parse single query")
 }
 
+// QueryPart is a part of a multi-part query made of reading clauses followed by a WITH clause
+type QueryPart struct {
+	// Call is the CALL clause preceding the MATCH clauses, if any
+	Call         *QueryCall
+	QueryMatches []QueryMatch
+	With         QueryWith
+}
+
+// QueryMultiPartQuery is a query made of parts chained by WITH clauses
+type QueryMultiPartQuery struct {
+	QueryParts []QueryPart
+	QuerySinglePartQuery
+}
+
+func (cl *BaseCypherVisitor) VisitOC_MultiPartQuery(c *parser.OC_MultiPartQueryContext) interface{} {
+	q := QueryMultiPartQuery{}
+	q.QueryParts = make([]QueryPart, 0)
+
+	// Reading clauses and WITH clauses are interleaved so the children are visited in order to
+	// assign each reading clause to the part it belongs to.
+	part := QueryPart{QueryMatches: make([]QueryMatch, 0)}
+	for _, child := range c.GetChildren() {
+		switch ctx := child.(type) {
+		case *parser.OC_ReadingClauseContext:
+			switch v := ctx.Accept(cl).(type) {
+			case QueryMatch:
+				part.QueryMatches = append(part.QueryMatches, v)
+			case QueryCall:
+				if len(q.QueryParts) > 0 || len(part.QueryMatches) > 0 {
+					return fmt.Errorf("CALL clauses are only supported at the start of a query")
+				}
+				part.Call = &v
+			case error:
+				return v
+			}
+		case *parser.OC_UpdatingClauseContext:
+			return fmt.Errorf("Updating clauses are only supported in the last part of a query")
+		case *parser.OC_WithContext:
+			switch v := ctx.Accept(cl).(type) {
+			case QueryWith:
+				part.With = v
+			case error:
+				return v
+			}
+			q.QueryParts = append(q.QueryParts, part)
+			part = QueryPart{QueryMatches: make([]QueryMatch, 0)}
+		case *parser.OC_SinglePartQueryContext:
+			switch v := ctx.Accept(cl).(type) {
+			case QuerySinglePartQuery:
+				q.QuerySinglePartQuery = v
+			case error:
+				return v
+			}
+		}
+	}
+	return q
+}
+
+// QueryWith represent a WITH clause projecting the intermediate results of a multi-part query
+type QueryWith struct {
+	ProjectionBody QueryProjectionBody
+	Where          *QueryExpression
+}
+
+func (cl *BaseCypherVisitor) VisitOC_With(c *parser.OC_WithContext) interface{} {
+	q := QueryWith{}
+	switch v := c.OC_ProjectionBody().Accept(cl).(type) {
+	case QueryProjectionBody:
+		q.ProjectionBody = v
+	case error:
+		return v
+	}
+
+	if c.OC_Where() != nil {
+		q.Where = new(QueryExpression)
+		*q.Where = c.OC_Where().Accept(cl).(QueryExpression)
+	}
+	return q
+}
+
 type QuerySinglePartQuery struct {
-	QueryMatches   []QueryMatch
+	// Call is the CALL clause preceding the MATCH clauses, if any
+	Call         *QueryCall
+	QueryMatches []QueryMatch
+	// UpdatingClauses are the CREATE, MERGE and DELETE clauses following the reading clauses, if any
+	UpdatingClauses []QueryUpdatingClause
+	// ProjectionBody is empty when a query made of updating clauses has no RETURN clause and when the query is a
+	// standalone CALL clause
 	ProjectionBody QueryProjectionBody
 }
 
@@ -166,8 +356,28 @@ func (cl *BaseCypherVisitor) VisitOC_SinglePartQuery(c *parser.OC_SinglePartQuer
 	q.QueryMatches = make([]QueryMatch, 0)
 
 	for i := range c.AllOC_ReadingClause() {
-		q.QueryMatches = append(q.QueryMatches,
-			c.OC_ReadingClause(i).Accept(cl).(QueryMatch))
+		switch v := c.OC_ReadingClause(i).Accept(cl).(type) {
+		case QueryMatch:
+			q.QueryMatches = append(q.QueryMatches, v)
+		case QueryCall:
+			if i > 0 {
+				return fmt.Errorf("CALL clauses are only supported at the start of a query")
+			}
+			q.Call = &v
+		case error:
+			return v
+		}
+	}
+	for i := range c.AllOC_UpdatingClause() {
+		switch v := c.OC_UpdatingClause(i).Accept(cl).(type) {
+		case QueryUpdatingClause:
+			q.UpdatingClauses = append(q.UpdatingClauses, v)
+		case error:
+			return v
+		}
+	}
+	if c.OC_Return() == nil {
+		return q
 	}
 	switch v := c.OC_Return().Accept(cl).(type) {
 	case QueryProjectionBody:
@@ -178,6 +388,56 @@ func (cl *BaseCypherVisitor) VisitOC_SinglePartQuery(c *parser.OC_SinglePartQuer
 	return q
 }
 
+// QueryUpdatingClause is a clause creating or deleting assets and relations. Only one of Create, Merge and
+// Delete is set.
+type QueryUpdatingClause struct {
+	// Create are the patterns created by a CREATE clause
+	Create []QueryPatternElement
+	// Merge is the pattern created by a MERGE clause unless it already exists
+	Merge *QueryPatternElement
+	// Delete are the expressions of the assets and relations deleted by a DELETE clause
+	Delete []QueryExpression
+	// Detach is true when the relations of the deleted assets are deleted too (DETACH DELETE)
+	Detach bool
+}
+
+func (cl *BaseCypherVisitor) VisitOC_UpdatingClause(c *parser.OC_UpdatingClauseContext) interface{} {
+	switch {
+	case c.OC_Create() != nil:
+		return c.OC_Create().Accept(cl)
+	case c.OC_Merge() != nil:
+		return c.OC_Merge().Accept(cl)
+	case c.OC_Delete() != nil:
+		return c.OC_Delete().Accept(cl)
+	case c.OC_Set() != nil:
+		return fmt.Errorf("SET clauses are not supported since assets and relations have no properties")
+	case c.OC_Remove() != nil:
+		return fmt.Errorf("REMOVE clauses are not supported since assets and relations have no properties")
+	}
+	return fmt.Errorf("Unable to parse updating clause")
+}
+
+func (cl *BaseCypherVisitor) VisitOC_Create(c *parser.OC_CreateContext) interface{} {
+	return QueryUpdatingClause{Create: c.OC_Pattern().Accept(cl).([]QueryPatternElement)}
+}
+
+func (cl *BaseCypherVisitor) VisitOC_Merge(c *parser.OC_MergeContext) interface{} {
+	if len(c.AllOC_MergeAction()) > 0 {
+		return fmt.Errorf("ON CREATE and ON MATCH actions are not supported since assets and relations have no properties")
+	}
+	q := QueryUpdatingClause{Merge: new(QueryPatternElement)}
+	*q.Merge = c.OC_PatternPart().Accept(cl).(QueryPatternElement)
+	return q
+}
+
+func (cl *BaseCypherVisitor) VisitOC_Delete(c *parser.OC_DeleteContext) interface{} {
+	q := QueryUpdatingClause{Detach: c.DETACH() != nil}
+	for i := range c.AllOC_Expression() {
+		q.Delete = append(q.Delete, c.OC_Expression(i).Accept(cl).(QueryExpression))
+	}
+	return q
+}
+
 func (cl *BaseCypherVisitor) VisitOC_Return(c *parser.OC_ReturnContext) interface{} {
 	return c.OC_ProjectionBody().Accept(cl)
 }
@@ -188,8 +448,11 @@ type QueryProjectionItem struct {
 }
 
 type QueryProjectionBody struct {
-	Distinct        bool
+	Distinct bool
+	// Star is true when all the variables in scope are projected, like in WITH *
+	Star            bool
 	ProjectionItems []QueryProjectionItem
+	Order           []QuerySortItem
 	Limit           *QueryExpression
 	Skip            *QueryExpression
 }
@@ -199,6 +462,7 @@ func (cl *BaseCypherVisitor) VisitOC_ProjectionBody(c *parser.OC_ProjectionBodyC
 	q.Distinct = c.DISTINCT() != nil
 
 	if c.OC_ProjectionItems() != nil {
+		q.Star = c.OC_ProjectionItems().GetStart().GetText() == "*"
 		switch v := c.OC_ProjectionItems().Accept(cl).(type) {
 		case []QueryProjectionItem:
 			q.ProjectionItems = v
@@ -209,6 +473,15 @@ func (cl *BaseCypherVisitor) VisitOC_ProjectionBody(c *parser.OC_ProjectionBodyC
 		return fmt.Errorf("Unable to parse projection items")
 	}
 
+	if c.OC_Order() != nil {
+		switch v := c.OC_Order().Accept(cl).(type) {
+		case []QuerySortItem:
+			q.Order = v
+		case error:
+			return v
+		}
+	}
+
 	if c.OC_Limit() != nil {
 		q.Limit = new(QueryExpression)
 		*q.Limit = c.OC_Limit().Accept(cl).(QueryExpression)
@@ -220,6 +493,27 @@ func (cl *BaseCypherVisitor) VisitOC_ProjectionBody(c *parser.OC_ProjectionBodyC
 	return q
 }
 
+// QuerySortItem represent an item of the ORDER BY clause
+type QuerySortItem struct {
+	Expression QueryExpression
+	Descending bool
+}
+
+func (cl *BaseCypherVisitor) VisitOC_Order(c *parser.OC_OrderContext) interface{} {
+	items := make([]QuerySortItem, 0)
+	for i := range c.AllOC_SortItem() {
+		items = append(items, c.OC_SortItem(i).Accept(cl).(QuerySortItem))
+	}
+	return items
+}
+
+func (cl *BaseCypherVisitor) VisitOC_SortItem(c *parser.OC_SortItemContext) interface{} {
+	q := QuerySortItem{}
+	q.Expression = c.OC_Expression().Accept(cl).(QueryExpression)
+	q.Descending = c.DESC() != nil || c.DESCENDING() != nil
+	return q
+}
+
 func (cl *BaseCypherVisitor) VisitOC_Limit(c *parser.OC_LimitContext) interface{} {
 	return c.OC_Expression().Accept(cl)
 }
@@ -232,8 +526,13 @@ func (cl *BaseCypherVisitor) VisitOC_ProjectionItems(c *parser.OC_ProjectionItem
 	items := make([]QueryProjectionItem, 0)
 	for i := range c.AllOC_ProjectionItem() {
 		item := QueryProjectionItem{}
-		item.Expression = c.OC_ProjectionItem(i).Accept(cl).(QueryExpression)
-		item.Alias = c.OC_ProjectionItem(i).GetText()
+		itemCtx := c.OC_ProjectionItem(i).(*parser.OC_ProjectionItemContext)
+		item.Expression = itemCtx.Accept(cl).(QueryExpression)
+		if itemCtx.OC_Variable() != nil {
+			item.Alias = itemCtx.OC_Variable().GetText()
+		} else {
+			item.Alias = itemCtx.GetText()
+		}
 		items = append(items, item)
 	}
 	return items
@@ -244,16 +543,103 @@ func (cl *BaseCypherVisitor) VisitOC_ProjectionItem(c *parser.OC_ProjectionItemC
 }
 
 func (cl *BaseCypherVisitor) VisitOC_ReadingClause(c *parser.OC_ReadingClauseContext) interface{} {
+	if c.OC_InQueryCall() != nil {
+		return c.OC_InQueryCall().Accept(cl)
+	}
+	if c.OC_Unwind() != nil {
+		return fmt.Errorf("UNWIND clauses are not supported")
+	}
 	return c.OC_Match().Accept(cl)
 }
 
+// QueryCall is a CALL clause calling a procedure whose results are bound to variables
+type QueryCall struct {
+	// Procedure is the name of the procedure including its namespace, like db.labels
+	Procedure string
+	Arguments []QueryExpression
+	// YieldItems are the fields of the results bound to variables, all the fields are yielded when there is none
+	YieldItems []QueryYieldItem
+	// Where filters the results of the procedure
+	Where *QueryExpression
+}
+
+// QueryYieldItem is a field of the results of a procedure bound to a variable
+type QueryYieldItem struct {
+	Field string
+	// Variable is the name of the field unless the field is renamed with AS
+	Variable string
+}
+
+func (cl *BaseCypherVisitor) VisitOC_InQueryCall(c *parser.OC_InQueryCallContext) interface{} {
+	q := QueryCall{}
+	cl.visitProcedureInvocation(c.OC_ExplicitProcedureInvocation(), &q)
+	if c.OC_YieldItems() != nil {
+		if err := cl.visitYieldItems(c.OC_YieldItems(), &q); err != nil {
+			return err
+		}
+	}
+	return q
+}
+
+// VisitOC_StandaloneCall visit a query made of a CALL clause only, all the yielded fields are returned
+func (cl *BaseCypherVisitor) VisitOC_StandaloneCall(c *parser.OC_StandaloneCallContext) interface{} {
+	q := QueryCall{}
+	if c.OC_ExplicitProcedureInvocation() != nil {
+		cl.visitProcedureInvocation(c.OC_ExplicitProcedureInvocation(), &q)
+	} else {
+		q.Procedure = c.OC_ImplicitProcedureInvocation().GetText()
+	}
+	if c.OC_YieldItems() != nil {
+		if err := cl.visitYieldItems(c.OC_YieldItems(), &q); err != nil {
+			return err
+		}
+	}
+	return QuerySinglePartQuery{Call: &q, QueryMatches: make([]QueryMatch, 0)}
+}
+
+// visitProcedureInvocation read the name and the arguments of the procedure called
+func (cl *BaseCypherVisitor) visitProcedureInvocation(c parser.IOC_ExplicitProcedureInvocationContext, q *QueryCall) {
+	invocation := c.(*parser.OC_ExplicitProcedureInvocationContext)
+	q.Procedure = invocation.OC_ProcedureName().GetText()
+	for i := range invocation.AllOC_Expression() {
+		q.Arguments = append(q.Arguments, invocation.OC_Expression(i).Accept(cl).(QueryExpression))
+	}
+}
+
+// visitYieldItems read the fields yielded by the procedure and the filter of its results
+func (cl *BaseCypherVisitor) visitYieldItems(c parser.IOC_YieldItemsContext, q *QueryCall) error {
+	yieldItems := c.(*parser.OC_YieldItemsContext)
+	for i := range yieldItems.AllOC_YieldItem() {
+		item := yieldItems.OC_YieldItem(i).(*parser.OC_YieldItemContext)
+		yieldItem := QueryYieldItem{Variable: item.OC_Variable().GetText()}
+		yieldItem.Field = yieldItem.Variable
+		if item.OC_ProcedureResultField() != nil {
+			yieldItem.Field = item.OC_ProcedureResultField().GetText()
+		}
+		q.YieldItems = append(q.YieldItems, yieldItem)
+	}
+
+	if yieldItems.OC_Where() != nil {
+		switch v := yieldItems.OC_Where().Accept(cl).(type) {
+		case QueryExpression:
+			q.Where = &v
+		case error:
+			return v
+		}
+	}
+	return nil
+}
+
 type QueryMatch struct {
 	PatternElements []QueryPatternElement
 	Where           *QueryExpression
+	// Optional is true when the patterns are not required to match (OPTIONAL MATCH)
+	Optional bool
 }
 
 func (cl *BaseCypherVisitor) VisitOC_Match(c *parser.OC_MatchContext) interface{} {
 	q := QueryMatch{}
+	q.Optional = c.OPTIONAL() != nil
 	q.PatternElements = c.OC_Pattern().Accept(cl).([]QueryPatternElement)
 	if c.OC_Where() != nil {
 		switch v := c.OC_Where().Accept(cl).(type) {
@@ -350,6 +736,7 @@ const (
 	Greater           = iota
 	LessOrEqual       = iota
 	GreaterOrEqual    = iota
+	RegexMatch        = iota
 )
 
 func (cl *BaseCypherVisitor) VisitOC_ComparisonExpression(c *parser.OC_ComparisonExpressionContext) interface{} {
@@ -385,6 +772,8 @@ func (cl *BaseCypherVisitor) VisitOC_PartialComparisonExpression(c *parser.OC_Pa
 		q.ComparisonOperator = LessOrEqual
 	case ">=":
 		q.ComparisonOperator = GreaterOrEqual
+	case "=~":
+		q.ComparisonOperator = RegexMatch
 	}
 	q.AddOrSubtractExpression = c.OC_AddOrSubtractExpression().Accept(cl).(QueryAddOrSubtractExpression)
 	return q
@@ -404,11 +793,24 @@ func (cl *BaseCypherVisitor) VisitOC_AddOrSubtractExpression(c *parser.OC_AddOrS
 	q := QueryAddOrSubtractExpression{}
 	q.MultipleDivideModuloExpression = c.OC_MultiplyDivideModuloExpression(0).Accept(cl).(QueryMultipleDivideModuloExpression)
 	items := make([]QueryPartialAddOrSubtractExpression, 0)
-	for i := 1; i < len(c.AllOC_MultiplyDivideModuloExpression()); i++ {
-		qi := QueryPartialAddOrSubtractExpression{}
-		qi.AddOrSubtractOperator = Add
-		qi.MultipleDivideModuloExpression = c.OC_MultiplyDivideModuloExpression(i).Accept(cl).(QueryMultipleDivideModuloExpression)
-		items = append(items, qi)
+
+	// The operators are the tokens preceding each operand but the first one.
+	operator := Add
+	for _, child := range c.GetChildren()[1:] {
+		switch v := child.(type) {
+		case antlr.TerminalNode:
+			switch v.GetText() {
+			case "+":
+				operator = Add
+			case "-":
+				operator = Subtract
+			}
+		case *parser.OC_MultiplyDivideModuloExpressionContext:
+			qi := QueryPartialAddOrSubtractExpression{}
+			qi.AddOrSubtractOperator = operator
+			qi.MultipleDivideModuloExpression = v.Accept(cl).(QueryMultipleDivideModuloExpression)
+			items = append(items, qi)
+		}
 	}
 	q.PartialAddOrSubtractExpression = items
 	return q
@@ -437,16 +839,30 @@ func (cl *BaseCypherVisitor) VisitOC_MultiplyDivideModuloExpression(c *parser.OC
 	q.PowerOfExpression = c.OC_PowerOfExpression(0).Accept(cl).(QueryPowerOfExpression)
 
 	items := make([]QueryPartialMultipleDivideModuloExpression, 0)
-	for i := 1; i < len(c.AllOC_PowerOfExpression()); i++ {
-		qi := QueryPartialMultipleDivideModuloExpression{}
-		qi.MultiplyDivideOperator = Multiply
-		qi.QueryPowerOfExpression = c.OC_PowerOfExpression(i).Accept(cl).(QueryPowerOfExpression)
-		items = append(items, qi)
+	operator := Multiply
+	for _, child := range c.GetChildren()[1:] {
+		switch v := child.(type) {
+		case antlr.TerminalNode:
+			switch v.GetText() {
+			case "*":
+				operator = Multiply
+			case "/":
+				operator = Divide
+			case "%":
+				operator = Modulo
+			}
+		case *parser.OC_PowerOfExpressionContext:
+			qi := QueryPartialMultipleDivideModuloExpression{}
+			qi.MultiplyDivideOperator = operator
+			qi.QueryPowerOfExpression = v.Accept(cl).(QueryPowerOfExpression)
+			items = append(items, qi)
+		}
 	}
 	q.PartialMultipleDivideModuloExpressions = items
 	return q
 }
 
+// QueryPowerOfExpression is a chain of exponentiations like a ^ b ^ c, evaluated from left to right
 type QueryPowerOfExpression struct {
 	QueryUnaryAddOrSubtractExpressions []QueryUnaryAddOrSubtractExpression
 }
@@ -470,11 +886,17 @@ const (
 
 type QueryUnaryAddOrSubtractExpression struct {
 	StringListNullOperatorExpression QueryStringListNullOperatorExpression
-	Negation                         bool
+	// Negation is true when the expression is preceded by an odd number of unary minus
+	Negation bool
 }
 
 func (cl *BaseCypherVisitor) VisitOC_UnaryAddOrSubtractExpression(c *parser.OC_UnaryAddOrSubtractExpressionContext) interface{} {
 	q := QueryUnaryAddOrSubtractExpression{}
+	for _, child := range c.GetChildren() {
+		if t, ok := child.(antlr.TerminalNode); ok && t.GetText() == "-" {
+			q.Negation = !q.Negation
+		}
+	}
 	q.StringListNullOperatorExpression = c.OC_StringListNullOperatorExpression().Accept(cl).(QueryStringListNullOperatorExpression)
 	return q
 }
@@ -482,6 +904,8 @@ func (cl *BaseCypherVisitor) VisitOC_UnaryAddOrSubtractExpression(c *parser.OC_U
 type QueryStringListNullOperatorExpression struct {
 	PropertyOrLabelsExpression QueryPropertyOrLabelsExpression
 	StringOperatorExpression   []QueryStringOperatorExpression
+	ListOperatorExpression     []QueryListOperatorExpression
+	NullOperatorExpression     []QueryNullOperatorExpression
 }
 
 func (cl *BaseCypherVisitor) VisitOC_StringListNullOperatorExpression(c *parser.OC_StringListNullOperatorExpressionContext) interface{} {
@@ -493,9 +917,58 @@ func (cl *BaseCypherVisitor) VisitOC_StringListNullOperatorExpression(c *parser.
 		items = append(items, c.OC_StringOperatorExpression(i).Accept(cl).(QueryStringOperatorExpression))
 	}
 	q.StringOperatorExpression = items
+
+	q.ListOperatorExpression = make([]QueryListOperatorExpression, 0)
+	for i := range c.AllOC_ListOperatorExpression() {
+		q.ListOperatorExpression = append(q.ListOperatorExpression,
+			c.OC_ListOperatorExpression(i).Accept(cl).(QueryListOperatorExpression))
+	}
+
+	q.NullOperatorExpression = make([]QueryNullOperatorExpression, 0)
+	for i := range c.AllOC_NullOperatorExpression() {
+		q.NullOperatorExpression = append(q.NullOperatorExpression,
+			c.OC_NullOperatorExpression(i).Accept(cl).(QueryNullOperatorExpression))
+	}
 	return q
 }
 
+type ListOperator int
+
+const (
+	InOperator    ListOperator = 0
+	IndexOperator ListOperator = 1
+	RangeOperator ListOperator = 2
+)
+
+// QueryListOperatorExpression is an operator applied to a list like x IN [1, 2]. Only the IN operator has
+// a right operand in the representation.
+type QueryListOperatorExpression struct {
+	PropertyOrLabelsExpression QueryPropertyOrLabelsExpression
+	Operator                   ListOperator
+}
+
+func (cl *BaseCypherVisitor) VisitOC_ListOperatorExpression(c *parser.OC_ListOperatorExpressionContext) interface{} {
+	q := QueryListOperatorExpression{}
+	if c.IN() != nil {
+		q.Operator = InOperator
+		q.PropertyOrLabelsExpression = c.OC_PropertyOrLabelsExpression().Accept(cl).(QueryPropertyOrLabelsExpression)
+	} else if c.GetToken(parser.CypherParserT__11, 0) != nil {
+		q.Operator = RangeOperator
+	} else {
+		q.Operator = IndexOperator
+	}
+	return q
+}
+
+// QueryNullOperatorExpression is a null check like x IS NULL or x IS NOT NULL
+type QueryNullOperatorExpression struct {
+	Not bool
+}
+
+func (cl *BaseCypherVisitor) VisitOC_NullOperatorExpression(c *parser.OC_NullOperatorExpressionContext) interface{} {
+	return QueryNullOperatorExpression{Not: c.NOT() != nil}
+}
+
 type StringOperator int
 
 const (
@@ -528,6 +1001,8 @@ func (cl *BaseCypherVisitor) VisitOC_StringOperatorExpression(c *parser.OC_Strin
 type QueryPropertyOrLabelsExpression struct {
 	Atom         QueryAtom
 	PropertyKeys []string
+	// Labels are the labels the atom is tested against like h:host
+	Labels []string
 }
 
 func (cl *BaseCypherVisitor) VisitOC_PropertyOrLabelsExpression(c *parser.OC_PropertyOrLabelsExpressionContext) interface{} {
@@ -539,6 +1014,10 @@ func (cl *BaseCypherVisitor) VisitOC_PropertyOrLabelsExpression(c *parser.OC_Pro
 		propLookups = append(propLookups, c.OC_PropertyLookup(i).Accept(cl).(string))
 	}
 	q.PropertyKeys = propLookups
+
+	if c.OC_NodeLabels() != nil {
+		q.Labels = c.OC_NodeLabels().Accept(cl).([]string)
+	}
 	return q
 }
 
@@ -551,6 +1030,8 @@ type QueryAtom struct {
 	Literal                 *QueryLiteral
 	FunctionInvocation      *QueryFunctionInvocation
 	ParenthesizedExpression *QueryExpression
+	RelationshipsPattern    *QueryPatternElement
+	Parameter               *string
 }
 
 func (cl *BaseCypherVisitor) VisitOC_Atom(c *parser.OC_AtomContext) interface{} {
@@ -561,12 +1042,45 @@ func (cl *BaseCypherVisitor) VisitOC_Atom(c *parser.OC_AtomContext) interface{}
 	} else if c.OC_Literal() != nil {
 		q.Literal = new(QueryLiteral)
 		*q.Literal = c.OC_Literal().Accept(cl).(QueryLiteral)
+	} else if c.COUNT() != nil {
+		// count(*) is represented as a count without any argument
+		q.FunctionInvocation = &QueryFunctionInvocation{
+			FunctionName: c.COUNT().GetText(),
+			Expressions:  []QueryExpression{},
+		}
 	} else if c.OC_FunctionInvocation() != nil {
 		q.FunctionInvocation = new(QueryFunctionInvocation)
 		*q.FunctionInvocation = c.OC_FunctionInvocation().Accept(cl).(QueryFunctionInvocation)
 	} else if c.OC_ParenthesizedExpression() != nil {
 		q.ParenthesizedExpression = new(QueryExpression)
 		*q.ParenthesizedExpression = c.OC_ParenthesizedExpression().Accept(cl).(QueryExpression)
+	} else if c.OC_RelationshipsPattern() != nil {
+		q.RelationshipsPattern = new(QueryPatternElement)
+		*q.RelationshipsPattern = c.OC_RelationshipsPattern().Accept(cl).(QueryPatternElement)
+	} else if c.OC_Parameter() != nil {
+		q.Parameter = new(string)
+		*q.Parameter = c.OC_Parameter().Accept(cl).(string)
+	}
+	return q
+}
+
+// VisitOC_Parameter visit a parameter like $name and return its name
+func (cl *BaseCypherVisitor) VisitOC_Parameter(c *parser.OC_ParameterContext) interface{} {
+	if c.OC_SymbolicName() != nil {
+		return c.OC_SymbolicName().GetText()
+	}
+	return c.DecimalInteger().GetText()
+}
+
+// VisitOC_RelationshipsPattern visit a pattern used in an expression like (a)-[:r]->(b)
+func (cl *BaseCypherVisitor) VisitOC_RelationshipsPattern(c *parser.OC_RelationshipsPatternContext) interface{} {
+	q := QueryPatternElement{}
+	q.QueryPatternElementChains = make([]QueryPatternElementChain, 0)
+	q.QueryNodePattern = c.OC_NodePattern().Accept(cl).(QueryNodePattern)
+
+	for i := range c.AllOC_PatternElementChain() {
+		q.QueryPatternElementChains = append(q.QueryPatternElementChains,
+			c.OC_PatternElementChain(i).Accept(cl).(QueryPatternElementChain))
 	}
 	return q
 }
@@ -577,7 +1091,9 @@ func (cl *BaseCypherVisitor) VisitOC_ParenthesizedExpression(c *parser.OC_Parent
 
 type QueryFunctionInvocation struct {
 	FunctionName string
-	Expressions  []QueryExpression
+	// Distinct is true when the arguments are deduplicated before being aggregated like in count(DISTINCT x)
+	Distinct    bool
+	Expressions []QueryExpression
 }
 
 func (cl *BaseCypherVisitor) VisitOC_FunctionInvocation(c *parser.OC_FunctionInvocationContext) interface{} {
@@ -587,6 +1103,7 @@ func (cl *BaseCypherVisitor) VisitOC_FunctionInvocation(c *parser.OC_FunctionInv
 		expressions = append(expressions, c.OC_Expression(i).Accept(cl).(QueryExpression))
 	}
 	q.FunctionName = c.OC_FunctionName().GetText()
+	q.Distinct = c.DISTINCT() != nil
 	q.Expressions = expressions
 	return q
 }
@@ -596,6 +1113,55 @@ type QueryLiteral struct {
 	Integer *int64
 	Double  *float64
 	Boolean *bool
+	List    []QueryExpression
+	Null    bool
+}
+
+// unescapeStringLiteral replace the escaped characters of a string literal by the characters they represent
+func unescapeStringLiteral(literal string) string {
+	var b strings.Builder
+	runes := []rune(literal)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '\\' || i+1 == len(runes) {
+			b.WriteRune(runes[i])
+			continue
+		}
+		i++
+		switch runes[i] {
+		case 'b', 'B':
+			b.WriteRune('\b')
+		case 'f', 'F':
+			b.WriteRune('\f')
+		case 'n', 'N':
+			b.WriteRune('\n')
+		case 'r', 'R':
+			b.WriteRune('\r')
+		case 't', 'T':
+			b.WriteRune('\t')
+		case 'u', 'U':
+			// The grammar guarantees the code point is made of 4 or 8 hexadecimal digits.
+			size := 4
+			if i+9 <= len(runes) {
+				if _, err := strconv.ParseUint(string(runes[i+1:i+9]), 16, 32); err == nil {
+					size = 8
+				}
+			}
+			if i+1+size > len(runes) {
+				b.WriteRune(runes[i])
+				continue
+			}
+			codePoint, err := strconv.ParseUint(string(runes[i+1:i+1+size]), 16, 32)
+			if err != nil {
+				b.WriteRune(runes[i])
+				continue
+			}
+			b.WriteRune(rune(codePoint))
+			i += size
+		default:
+			b.WriteRune(runes[i])
+		}
+	}
+	return b.String()
 }
 
 func (cl *BaseCypherVisitor) VisitOC_Literal(c *parser.OC_LiteralContext) interface{} {
@@ -603,7 +1169,7 @@ func (cl *BaseCypherVisitor) VisitOC_Literal(c *parser.OC_LiteralContext) interf
 	if c.StringLiteral() != nil {
 		q.String = new(string)
 		token := c.StringLiteral().GetText()
-		*q.String = token[1 : len(token)-1]
+		*q.String = unescapeStringLiteral(token[1 : len(token)-1])
 	} else if c.OC_NumberLiteral() != nil {
 		switch v := c.OC_NumberLiteral().Accept(cl).(type) {
 		case int64:
@@ -616,10 +1182,22 @@ func (cl *BaseCypherVisitor) VisitOC_Literal(c *parser.OC_LiteralContext) interf
 	} else if c.OC_BooleanLiteral() != nil {
 		q.Boolean = new(bool)
 		*q.Boolean = c.OC_BooleanLiteral().Accept(cl).(bool)
+	} else if c.NULL() != nil {
+		q.Null = true
+	} else if c.OC_ListLiteral() != nil {
+		q.List = c.OC_ListLiteral().Accept(cl).([]QueryExpression)
 	}
 	return q
 }
 
+func (cl *BaseCypherVisitor) VisitOC_ListLiteral(c *parser.OC_ListLiteralContext) interface{} {
+	expressions := []QueryExpression{}
+	for i := range c.AllOC_Expression() {
+		expressions = append(expressions, c.OC_Expression(i).Accept(cl).(QueryExpression))
+	}
+	return expressions
+}
+
 func (cl *BaseCypherVisitor) VisitOC_NumberLiteral(c *parser.OC_NumberLiteralContext) interface{} {
 	if c.OC_IntegerLiteral() != nil {
 		return c.OC_IntegerLiteral().Accept(cl)
@@ -661,19 +1239,41 @@ func (cl *BaseCypherVisitor) VisitOC_Pattern(c *parser.OC_PatternContext) interf
 }
 
 func (cl *BaseCypherVisitor) VisitOC_PatternPart(c *parser.OC_PatternPartContext) interface{} {
-	return c.OC_AnonymousPatternPart().Accept(cl)
+	q := c.OC_AnonymousPatternPart().Accept(cl).(QueryPatternElement)
+	if c.OC_Variable() != nil {
+		q.PathVariable = c.OC_Variable().GetText()
+	}
+	return q
 }
 
 func (cl *BaseCypherVisitor) VisitOC_AnonymousPatternPart(c *parser.OC_AnonymousPatternPartContext) interface{} {
+	if c.OC_ShortestPathPattern() != nil {
+		return c.OC_ShortestPathPattern().Accept(cl)
+	}
 	return c.OC_PatternElement().Accept(cl)
 }
 
+// VisitOC_ShortestPathPattern visit a pattern given to shortestPath or allShortestPaths
+func (cl *BaseCypherVisitor) VisitOC_ShortestPathPattern(c *parser.OC_ShortestPathPatternContext) interface{} {
+	q := c.OC_PatternElement().Accept(cl).(QueryPatternElement)
+	q.ShortestPathFunction = c.GetStart().GetText()
+	return q
+}
+
 type QueryPatternElement struct {
 	QueryNodePattern
 	QueryPatternElementChains []QueryPatternElementChain
+	// ShortestPathFunction is the function, shortestPath or allShortestPaths, the pattern is given to, if any
+	ShortestPathFunction string
+	// PathVariable is the variable the path matched by the pattern is bound to, if any
+	PathVariable string
 }
 
 func (cl *BaseCypherVisitor) VisitOC_PatternElement(c *parser.OC_PatternElementContext) interface{} {
+	if c.OC_PatternElement() != nil {
+		return c.OC_PatternElement().Accept(cl)
+	}
+
 	q := QueryPatternElement{}
 	q.QueryPatternElementChains = make([]QueryPatternElementChain, 0)
 	q.QueryNodePattern = c.OC_NodePattern().Accept(cl).(QueryNodePattern)
@@ -714,10 +1314,14 @@ func (cl *BaseCypherVisitor) VisitOC_RelationshipPattern(c *parser.OC_Relationsh
 	return rp
 }
 
-// QueryRelationshipDetail object representing a relation [var:label]
+// QueryRelationshipDetail object representing a relation [var:label*min..max {key: value}]
 type QueryRelationshipDetail struct {
 	Variable string
 	Labels   []string
+	// Range is set when the relationship is a variable-length relationship
+	Range *QueryRangeLiteral
+	// Properties are the properties the relationship must have, if any
+	Properties *QueryProperties
 }
 
 func (cl *BaseCypherVisitor) VisitOC_RelationshipDetail(c *parser.OC_RelationshipDetailContext) interface{} {
@@ -728,9 +1332,91 @@ func (cl *BaseCypherVisitor) VisitOC_RelationshipDetail(c *parser.OC_Relationshi
 	if c.OC_RelationshipTypes() != nil {
 		rs.Labels = c.OC_RelationshipTypes().Accept(cl).([]string)
 	}
+	if c.OC_RangeLiteral() != nil {
+		rs.Range = new(QueryRangeLiteral)
+		*rs.Range = c.OC_RangeLiteral().Accept(cl).(QueryRangeLiteral)
+	}
+	if c.OC_Properties() != nil {
+		rs.Properties = new(QueryProperties)
+		*rs.Properties = c.OC_Properties().Accept(cl).(QueryProperties)
+	}
 	return rs
 }
 
+// QueryProperties are the properties a node or a relationship of a pattern must have. They are given either by
+// a map literal like {value: 'web-01'} or by a parameter holding a map.
+type QueryProperties struct {
+	Entries   []QueryMapEntry
+	Parameter *string
+}
+
+func (cl *BaseCypherVisitor) VisitOC_Properties(c *parser.OC_PropertiesContext) interface{} {
+	q := QueryProperties{}
+	if c.OC_MapLiteral() != nil {
+		q.Entries = c.OC_MapLiteral().Accept(cl).([]QueryMapEntry)
+	} else if c.OC_Parameter() != nil {
+		q.Parameter = new(string)
+		*q.Parameter = c.OC_Parameter().Accept(cl).(string)
+	}
+	return q
+}
+
+// QueryMapEntry is an entry of a map literal
+type QueryMapEntry struct {
+	Key        string
+	Expression QueryExpression
+}
+
+func (cl *BaseCypherVisitor) VisitOC_MapLiteral(c *parser.OC_MapLiteralContext) interface{} {
+	entries := make([]QueryMapEntry, 0)
+	for i := range c.AllOC_PropertyKeyName() {
+		entries = append(entries, QueryMapEntry{
+			Key:        c.OC_PropertyKeyName(i).GetText(),
+			Expression: c.OC_Expression(i).Accept(cl).(QueryExpression),
+		})
+	}
+	return entries
+}
+
+// QueryRangeLiteral represent the range of hops of a variable-length relationship *min..max.
+// A nil bound means the bound has not been provided in the query.
+type QueryRangeLiteral struct {
+	Min *int64
+	Max *int64
+}
+
+func (cl *BaseCypherVisitor) VisitOC_RangeLiteral(c *parser.OC_RangeLiteralContext) interface{} {
+	q := QueryRangeLiteral{}
+	rangeOperatorFound := false
+	for _, child := range c.GetChildren() {
+		switch v := child.(type) {
+		case antlr.TerminalNode:
+			if v.GetText() == ".." {
+				rangeOperatorFound = true
+			}
+		case *parser.OC_IntegerLiteralContext:
+			x, ok := v.Accept(cl).(int64)
+			if !ok {
+				continue
+			}
+			if rangeOperatorFound {
+				q.Max = new(int64)
+				*q.Max = x
+			} else {
+				q.Min = new(int64)
+				*q.Min = x
+			}
+		}
+	}
+
+	// *N means exactly N hops
+	if !rangeOperatorFound && q.Min != nil {
+		q.Max = new(int64)
+		*q.Max = *q.Min
+	}
+	return q
+}
+
 func (cl *BaseCypherVisitor) VisitOC_RelationshipTypes(c *parser.OC_RelationshipTypesContext) interface{} {
 	items := make([]string, 0)
 	for i := range c.AllOC_RelTypeName() {
@@ -742,6 +1428,8 @@ func (cl *BaseCypherVisitor) VisitOC_RelationshipTypes(c *parser.OC_Relationship
 type QueryNodePattern struct {
 	Variable string
 	Labels   []string
+	// Properties are the properties the node must have, if any
+	Properties *QueryProperties
 }
 
 func (cl *BaseCypherVisitor) VisitOC_NodePattern(c *parser.OC_NodePatternContext) interface{} {
@@ -753,6 +1441,11 @@ func (cl *BaseCypherVisitor) VisitOC_NodePattern(c *parser.OC_NodePatternContext
 	if c.OC_Variable() != nil {
 		q.Variable = c.OC_Variable().Accept(cl).(string)
 	}
+
+	if c.OC_Properties() != nil {
+		q.Properties = new(QueryProperties)
+		*q.Properties = c.OC_Properties().Accept(cl).(QueryProperties)
+	}
 	return q
 }
 
@@ -20,7 +20,7 @@ var testCases = []TestCase{
 	},
 	TestCase{
 		Query: "MATCH (n) RETURN c, n,",
-		Error: "Parsing errors detected: line 1:22 - mismatched input '<EOF>' expecting {'(', '[', '+', '-', '{', '$', ALL, NOT, NULL, COUNT, ANY, NONE, SINGLE, TRUE, FALSE, EXISTS, CASE, StringLiteral, HexInteger, DecimalInteger, OctalInteger, HexLetter, ExponentDecimalReal, RegularDecimalReal, FILTER, EXTRACT, UnescapedSymbolicName, EscapedSymbolicName, SP}",
+		Error: "Parsing errors detected: line 1:22 - mismatched input '<EOF>' expecting {'(', '[', '+', '-', '{', '$', ALL, SHORTESTPATH, ALLSHORTESTPATHS, NOT, NULL, COUNT, ANY, NONE, SINGLE, TRUE, FALSE, EXISTS, CASE, StringLiteral, HexInteger, DecimalInteger, OctalInteger, HexLetter, ExponentDecimalReal, RegularDecimalReal, FILTER, EXTRACT, UnescapedSymbolicName, EscapedSymbolicName, SP}",
 	},
 	TestCase{
 		Query: "MATCH (n)-[r]-> RETURN c",
@@ -42,3 +42,142 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestShouldParseShortestPathPatterns(t *testing.T) {
+	q, err := TransformCypher("MATCH (u:user), shortestPath((u)-[*..3]-(d:database)) RETURN d")
+	require.NoError(t, err)
+	require.Len(t, q.QueryMatches, 1)
+	elements := q.QueryMatches[0].PatternElements
+	require.Len(t, elements, 2)
+	require.Empty(t, elements[0].ShortestPathFunction)
+	require.Equal(t, "shortestPath", elements[1].ShortestPathFunction)
+	require.Equal(t, "u", elements[1].Variable)
+	require.Len(t, elements[1].QueryPatternElementChains, 1)
+	require.Equal(t, []string{"database"}, elements[1].QueryPatternElementChains[0].Labels)
+
+	q, err = TransformCypher("MATCH allShortestPaths ((u:user)-[*]->(g:group)) WHERE u.value = 'john' RETURN g")
+	require.NoError(t, err)
+	require.Equal(t, "allShortestPaths", q.QueryMatches[0].PatternElements[0].ShortestPathFunction)
+
+	// shortestPath is only a pattern function in the patterns of the MATCH clauses.
+	q, err = TransformCypher("MATCH (u:user) RETURN shortestPath((u)-[*..3]-(:database))")
+	require.NoError(t, err)
+	require.Empty(t, q.QueryMatches[0].PatternElements[0].ShortestPathFunction)
+	require.Len(t, q.ProjectionBody.ProjectionItems, 1)
+}
+
+func TestShouldParsePropertyMaps(t *testing.T) {
+	q, err := TransformCypher("MATCH (h:host {value: 'web-01', id: 3})-[r:owned_by {source: $source}]->(u $props) RETURN u")
+	require.NoError(t, err)
+	element := q.QueryMatches[0].PatternElements[0]
+
+	require.NotNil(t, element.Properties)
+	require.Len(t, element.Properties.Entries, 2)
+	require.Equal(t, "value", element.Properties.Entries[0].Key)
+	require.Equal(t, "id", element.Properties.Entries[1].Key)
+
+	detail := element.QueryPatternElementChains[0].RelationshipPattern.RelationshipDetail
+	require.NotNil(t, detail.Properties)
+	require.Len(t, detail.Properties.Entries, 1)
+	require.Equal(t, "source", detail.Properties.Entries[0].Key)
+
+	properties := element.QueryPatternElementChains[0].Properties
+	require.NotNil(t, properties)
+	require.Empty(t, properties.Entries)
+	require.Equal(t, "props", *properties.Parameter)
+}
+
+func TestShouldParseNamedPaths(t *testing.T) {
+	q, err := TransformCypher("MATCH p = (u:user)-->(g:group), (h:host), s = shortestPath((u)-[*]->(h)) RETURN p")
+	require.NoError(t, err)
+	elements := q.QueryMatches[0].PatternElements
+
+	require.Equal(t, "p", elements[0].PathVariable)
+	require.Equal(t, "u", elements[0].Variable)
+	require.Equal(t, "", elements[1].PathVariable)
+	require.Equal(t, "s", elements[2].PathVariable)
+	require.Equal(t, "shortestPath", elements[2].ShortestPathFunction)
+}
+
+func TestShouldParsePatternPredicates(t *testing.T) {
+	q, err := TransformCypher("MATCH (h:host) WHERE NOT (h)-[:owned_by]->() RETURN h")
+	require.NoError(t, err)
+
+	not := q.QueryMatches[0].Where.OrExpression.XorExpressions[0].AndExpressions[0].NotExpressions[0]
+	require.True(t, not.Not)
+
+	unary := not.ComparisonExpression.AddOrSubtractExpression.MultipleDivideModuloExpression.PowerOfExpression.QueryUnaryAddOrSubtractExpressions[0]
+	pattern := unary.StringListNullOperatorExpression.PropertyOrLabelsExpression.Atom.RelationshipsPattern
+	require.NotNil(t, pattern)
+	require.Equal(t, "h", pattern.Variable)
+	require.Len(t, pattern.QueryPatternElementChains, 1)
+	require.Equal(t, []string{"owned_by"}, pattern.QueryPatternElementChains[0].RelationshipPattern.RelationshipDetail.Labels)
+}
+
+func TestShouldParseUpdatingClauses(t *testing.T) {
+	q, err := TransformCypher("MATCH (h:host {value: 'web-01'}) CREATE (h)-[:owned_by]->(t:team {value: 'infra'}) " +
+		"MERGE (t)-[:tagged]->(:tag {value: 'critical'}) DETACH DELETE h")
+	require.NoError(t, err)
+	require.Len(t, q.QueryMatches, 1)
+	require.Len(t, q.UpdatingClauses, 3)
+	require.Empty(t, q.ProjectionBody.ProjectionItems)
+
+	create := q.UpdatingClauses[0].Create
+	require.Len(t, create, 1)
+	require.Equal(t, "h", create[0].Variable)
+	require.Equal(t, []string{"team"}, create[0].QueryPatternElementChains[0].Labels)
+
+	merge := q.UpdatingClauses[1].Merge
+	require.NotNil(t, merge)
+	require.Equal(t, "t", merge.Variable)
+	require.Equal(t, []string{"tagged"}, merge.QueryPatternElementChains[0].RelationshipPattern.RelationshipDetail.Labels)
+
+	require.Len(t, q.UpdatingClauses[2].Delete, 1)
+	require.True(t, q.UpdatingClauses[2].Detach)
+
+	q, err = TransformCypher("CREATE (:team {value: 'infra'}) RETURN 1")
+	require.NoError(t, err)
+	require.Len(t, q.UpdatingClauses, 1)
+	require.Len(t, q.ProjectionBody.ProjectionItems, 1)
+
+	_, err = TransformCypher("MATCH (h:host) SET h.value = 'web-02'")
+	require.EqualError(t, err, "SET clauses are not supported since assets and relations have no properties")
+
+	_, err = TransformCypher("MATCH (h:host) CREATE (h)-[:owned_by]->(:team {value: 'infra'}) WITH h RETURN h")
+	require.EqualError(t, err, "Updating clauses are only supported in the last part of a query")
+}
+
+func TestShouldParseProcedureCalls(t *testing.T) {
+	q, err := TransformCypher("CALL db.labels() YIELD label AS l WHERE l STARTS WITH 'h' RETURN count(l)")
+	require.NoError(t, err)
+	require.NotNil(t, q.Call)
+	require.Equal(t, "db.labels", q.Call.Procedure)
+	require.Empty(t, q.Call.Arguments)
+	require.Equal(t, []QueryYieldItem{{Field: "label", Variable: "l"}}, q.Call.YieldItems)
+	require.NotNil(t, q.Call.Where)
+	require.Len(t, q.ProjectionBody.ProjectionItems, 1)
+
+	q, err = TransformCypher("CALL db.schema")
+	require.NoError(t, err)
+	require.Equal(t, "db.schema", q.Call.Procedure)
+	require.Empty(t, q.Call.YieldItems)
+	require.Empty(t, q.ProjectionBody.ProjectionItems)
+
+	q, err = TransformCypher("CALL graphkb.count('host', $type) YIELD count WITH count RETURN count")
+	require.NoError(t, err)
+	require.Len(t, q.QueryParts, 1)
+	require.Equal(t, "graphkb.count", q.QueryParts[0].Call.Procedure)
+	require.Len(t, q.QueryParts[0].Call.Arguments, 2)
+
+	_, err = TransformCypher("MATCH (h:host) CALL db.labels() YIELD label RETURN label")
+	require.EqualError(t, err, "CALL clauses are only supported at the start of a query")
+}
+
+func TestShouldRejectExistsSubqueries(t *testing.T) {
+	_, err := TransformCypher("MATCH (h:host) WHERE EXISTS { MATCH (h)-[:owned_by]->(:team) } RETURN h")
+	require.EqualError(t, err, "EXISTS subqueries are not supported at line 1:21, use a pattern like "+
+		"(a)-->(b) or exists((a)-->(b)) instead")
+
+	_, err = TransformCypher("MATCH (h:host) WHERE exists((h)-[:owned_by]->(:team)) RETURN h")
+	require.NoError(t, err)
+}
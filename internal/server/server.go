@@ -42,6 +42,58 @@ func replyWithInternalError(w http.ResponseWriter, err error) {
 	}
 }
 
+func replyWithBadRequest(w http.ResponseWriter, err error) {
+	fmt.Println(err)
+	w.WriteHeader(http.StatusBadRequest)
+	_, werr := w.Write([]byte(err.Error()))
+	if werr != nil {
+		fmt.Println(werr)
+	}
+}
+
+// replyWithQueryCostError explain why the query has been rejected, it can be sent again once confirmed
+func replyWithQueryCostError(w http.ResponseWriter, err *knowledge.QueryCostError) {
+	type QueryCostErrorBody struct {
+		*knowledge.QueryCostError
+		Error                string `json:"error"`
+		ConfirmationRequired bool   `json:"confirmation_required"`
+	}
+
+	responseJSON, merr := json.Marshal(QueryCostErrorBody{
+		QueryCostError:       err,
+		Error:                err.Error(),
+		ConfirmationRequired: true,
+	})
+	if merr != nil {
+		replyWithInternalError(w, merr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnprocessableEntity)
+	if _, werr := w.Write(responseJSON); werr != nil {
+		fmt.Println(werr)
+	}
+}
+
+func replyWithNotFound(w http.ResponseWriter, err error) {
+	fmt.Println(err)
+	w.WriteHeader(http.StatusNotFound)
+	_, werr := w.Write([]byte(err.Error()))
+	if werr != nil {
+		fmt.Println(werr)
+	}
+}
+
+func replyWithForbidden(w http.ResponseWriter, err error) {
+	fmt.Println(err)
+	w.WriteHeader(http.StatusForbidden)
+	_, werr := w.Write([]byte(err.Error()))
+	if werr != nil {
+		fmt.Println(werr)
+	}
+}
+
 func replyWithUnauthorized(w http.ResponseWriter) {
 	w.WriteHeader(http.StatusUnauthorized)
 	_, werr := w.Write([]byte("Unauthorized"))
@@ -54,7 +106,8 @@ func getSourceGraph(registry importers.Registry, db schema.Persistor) http.Handl
 	return func(w http.ResponseWriter, r *http.Request) {
 		sourcesParams, ok := r.URL.Query()["sources"]
 		importers := []string{}
-		availableImporters := []string{}
+		// The schema of the assets and relations created by the queries is available like the ones of the importers
+		availableImporters := []string{knowledge.ManualSource}
 
 		importerToToken, err := registry.ListImporters(r.Context())
 		if err != nil {
@@ -141,10 +194,70 @@ func getDatabaseDetails(database knowledge.GraphDB) http.HandlerFunc {
 	}
 }
 
-func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) http.HandlerFunc {
+// ndjsonContentType is the content type of the results streamed one JSON document per line
+const ndjsonContentType = "application/x-ndjson"
+
+// streamFlushInterval is the number of rows written between two flushes of the stream
+const streamFlushInterval = 100
+
+// writeStreamLine write the document as one line of a NDJSON stream
+func writeStreamLine(w http.ResponseWriter, doc interface{}) error {
+	return json.NewEncoder(w).Encode(doc)
+}
+
+// streamQueryResults write the header and then each row read from the cursor as one line of JSON, flushing them
+// as they are read. An error is returned when the client disconnects so that the query stops.
+func streamQueryResults(ctx context.Context, w http.ResponseWriter, header interface{}, cursor knowledge.Cursor) error {
+	flusher, canFlush := w.(http.Flusher)
+	flush := func() {
+		if canFlush {
+			flusher.Flush()
+		}
+	}
+
+	w.Header().Set("Content-Type", ndjsonContentType)
+	if err := writeStreamLine(w, header); err != nil {
+		return err
+	}
+	flush()
+
+	rowsCount := 0
+	for cursor.HasMore() {
+		var d interface{}
+		if err := cursor.Read(ctx, &d); err != nil {
+			return err
+		}
+		if err := writeStreamLine(w, d); err != nil {
+			return err
+		}
+		rowsCount++
+		if rowsCount%streamFlushInterval == 0 {
+			flush()
+		}
+	}
+	flush()
+	// The cursor has no more rows when the context is canceled, the stream is then incomplete.
+	return ctx.Err()
+}
+
+func postQuery(database knowledge.GraphDB, schemaLoader knowledge.SchemaLoader,
+	queryHistorizer history.Historizer, queryRegistry *knowledge.QueryRegistry,
+	graphUpdater *knowledge.GraphUpdater, procedures *knowledge.ProcedureRegistry) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		type QueryRequestBody struct {
 			Query string `json:"q"`
+			// Params are the values of the parameters ($name) of the query
+			Params map[string]interface{} `json:"params"`
+			// Confirm runs the query even if it exceeds the cost budget
+			Confirm bool `json:"confirm"`
+			// Stream streams the results as NDJSON like with the Accept: application/x-ndjson header
+			Stream bool `json:"stream"`
+			// PageSize is the maximum number of rows returned, the results are not paginated when not set
+			PageSize int `json:"page_size"`
+			// Page is the token of the page to return, it is the next_page token of the previous page
+			Page string `json:"page"`
+			// Timeout is the number of seconds the query can run, it is capped by the query_max_timeout option
+			Timeout int `json:"timeout"`
 		}
 
 		type ColumnType struct {
@@ -152,10 +265,43 @@ func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) h
 			Type string `json:"type"`
 		}
 
+		// QueryProfile is the time spent in each phase of a profiled query and the number of results
+		type QueryProfile struct {
+			ParsingTimeMs     float64 `json:"parsing_time_ms"`
+			TranslationTimeMs float64 `json:"translation_time_ms"`
+			ExecutionTimeMs   float64 `json:"execution_time_ms"`
+			FetchingTimeMs    float64 `json:"fetching_time_ms"`
+			RowsCount         int     `json:"rows_count"`
+		}
+
 		type QueryResponseBody struct {
 			Items           [][]interface{} `json:"items"`
 			Columns         []ColumnType    `json:"columns"`
 			ExecutionTimeMs time.Duration   `json:"execution_time_ms"`
+			// Plan is set when the query is prefixed by EXPLAIN or PROFILE
+			Plan *knowledge.QueryPlan `json:"plan,omitempty"`
+			// Profile is set when the query is prefixed by PROFILE
+			Profile *QueryProfile `json:"profile,omitempty"`
+			// Warnings are the parts of the query not matching the schema
+			Warnings []knowledge.QueryWarning `json:"warnings,omitempty"`
+			// NextPage is the token of the next page, it is set when the results are paginated and there are more
+			NextPage string `json:"next_page,omitempty"`
+		}
+
+		// QueryStreamHeader is the first line of a stream of results, the rows follow one per line
+		type QueryStreamHeader struct {
+			Columns         []ColumnType             `json:"columns"`
+			ExecutionTimeMs time.Duration            `json:"execution_time_ms"`
+			Plan            *knowledge.QueryPlan     `json:"plan,omitempty"`
+			Warnings        []knowledge.QueryWarning `json:"warnings,omitempty"`
+		}
+
+		// QueryStreamTrailer is the last line of a stream of results when the query is profiled or fails while
+		// the rows are being streamed
+		type QueryStreamTrailer struct {
+			Profile  *QueryProfile `json:"profile,omitempty"`
+			NextPage string        `json:"next_page,omitempty"`
+			Error    string        `json:"error,omitempty"`
 		}
 
 		requestBody := QueryRequestBody{}
@@ -176,10 +322,48 @@ func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) h
 		}
 
 		querier := knowledge.NewQuerier(database, queryHistorizer)
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		querier.MaxHops = viper.GetInt("query_max_hops")
+		querier.CostBudget = viper.GetInt64("query_cost_budget")
+		querier.CostConfirmed = requestBody.Confirm
+		querier.SchemaLoader = schemaLoader
+		querier.StrictValidation = viper.GetBool("query_strict_validation")
+		querier.PageSize = requestBody.PageSize
+		querier.PageToken = requestBody.Page
+		timeout := knowledge.QueryTimeout(time.Duration(requestBody.Timeout)*time.Second,
+			time.Duration(viper.GetInt("query_timeout"))*time.Second,
+			time.Duration(viper.GetInt("query_max_timeout"))*time.Second)
+		// The query is bound to the request so that it is stopped when the client disconnects.
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
 		defer cancel()
 
-		res, err := querier.Query(ctx, requestBody.Query)
+		user := requestUser(r)
+		querier.Procedures = procedures
+		querier.Updater = graphUpdater
+		// Updates must be enabled and are reserved to the authenticated administrator. The user is not
+		// authenticated when there is no password.
+		querier.AllowUpdates = viper.GetBool("query_allow_updates") && viper.GetString("password") != "" &&
+			user == "admin"
+		queryID, ctx, unregister := queryRegistry.Register(ctx, user, requestBody.Query)
+		defer unregister()
+		w.Header().Set("X-Query-ID", queryID)
+
+		res, err := querier.Query(ctx, requestBody.Query, requestBody.Params)
+		if costErr, ok := err.(*knowledge.QueryCostError); ok {
+			replyWithQueryCostError(w, costErr)
+			return
+		}
+		if _, ok := err.(*knowledge.QueryValidationError); ok {
+			replyWithBadRequest(w, err)
+			return
+		}
+		if err == knowledge.ErrUpdatesNotAllowed {
+			replyWithForbidden(w, err)
+			return
+		}
+		if err == knowledge.ErrInvalidPageToken || err == knowledge.ErrPageTokenMismatch {
+			replyWithBadRequest(w, err)
+			return
+		}
 		if err != nil {
 			replyWithInternalError(w, err)
 			return
@@ -194,6 +378,10 @@ func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) h
 				colType = "asset"
 			case knowledge.EdgeExprType:
 				colType = "relation"
+			case knowledge.PathExprType:
+				colType = "path"
+			case knowledge.ListExprType:
+				colType = "list"
 			default:
 				colType = "property"
 			}
@@ -203,10 +391,51 @@ func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) h
 			})
 		}
 
+		var profile *QueryProfile
+		buildProfile := func() {
+			if res.Mode != knowledge.ProfileMode {
+				return
+			}
+			profile = &QueryProfile{
+				ParsingTimeMs:     float64(res.Statistics.Parsing.Microseconds()) / 1000.0,
+				TranslationTimeMs: float64(res.Statistics.Translation.Microseconds()) / 1000.0,
+				ExecutionTimeMs:   float64(res.Statistics.Execution.Microseconds()) / 1000.0,
+				FetchingTimeMs:    float64(res.Statistics.Fetching.Microseconds()) / 1000.0,
+				RowsCount:         res.Statistics.RowsCount,
+			}
+		}
+
+		if requestBody.Stream || strings.Contains(r.Header.Get("Accept"), ndjsonContentType) {
+			header := QueryStreamHeader{
+				Columns:         columns,
+				ExecutionTimeMs: res.Statistics.Execution / time.Millisecond,
+				Plan:            res.Plan,
+				Warnings:        res.Warnings,
+			}
+			trailer := QueryStreamTrailer{}
+			if err := streamQueryResults(ctx, w, header, res.Cursor); err != nil {
+				// The status has already been sent, the error is therefore reported in the last line.
+				fmt.Println(err)
+				trailer.Error = err.Error()
+			} else if trailer.NextPage, err = res.NextPage(); err != nil {
+				fmt.Println(err)
+				trailer.Error = err.Error()
+			} else {
+				buildProfile()
+				trailer.Profile = profile
+			}
+			if trailer.Profile != nil || trailer.NextPage != "" || trailer.Error != "" {
+				if err := writeStreamLine(w, trailer); err != nil {
+					fmt.Println(err)
+				}
+			}
+			return
+		}
+
 		items := make([][]interface{}, 0)
 		for res.Cursor.HasMore() {
 			var d interface{}
-			err := res.Cursor.Read(context.Background(), &d)
+			err := res.Cursor.Read(ctx, &d)
 			if err != nil {
 				replyWithInternalError(w, err)
 				return
@@ -222,6 +451,8 @@ func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) h
 					rowDocs = append(rowDocs, v)
 				case knowledge.RelationWithID:
 					rowDocs = append(rowDocs, v)
+				case knowledge.PathWithID:
+					rowDocs = append(rowDocs, v)
 				default:
 					rowDocs = append(rowDocs, v)
 				}
@@ -233,6 +464,16 @@ func postQuery(database knowledge.GraphDB, queryHistorizer history.Historizer) h
 			Items:           items,
 			Columns:         columns,
 			ExecutionTimeMs: res.Statistics.Execution / time.Millisecond,
+			Plan:            res.Plan,
+			Warnings:        res.Warnings,
+		}
+		buildProfile()
+		response.Profile = profile
+
+		response.NextPage, err = res.NextPage()
+		if err != nil {
+			replyWithInternalError(w, err)
+			return
 		}
 
 		err = json.NewEncoder(w).Encode(response)
@@ -330,6 +571,38 @@ func postGraphUpdates(registry importers.Registry, graphUpdatesC chan knowledge.
 	}
 }
 
+// requestUser return the user authenticated by the request, anonymous when authentication is disabled
+func requestUser(r *http.Request) string {
+	user, _, ok := r.BasicAuth()
+	if !ok {
+		return "anonymous"
+	}
+	return user
+}
+
+func getRunningQueries(queryRegistry *knowledge.QueryRegistry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(queryRegistry.List(requestUser(r))); err != nil {
+			replyWithInternalError(w, err)
+		}
+	}
+}
+
+func cancelQuery(queryRegistry *knowledge.QueryRegistry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := mux.Vars(r)["id"]
+		err := queryRegistry.Cancel(id, requestUser(r))
+		if err == knowledge.ErrQueryNotRunning {
+			replyWithNotFound(w, fmt.Errorf("Query %s is not running", id))
+			return
+		} else if err != nil {
+			replyWithForbidden(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 func flushDatabase(graphDB knowledge.GraphDB) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if err := graphDB.FlushAll(); err != nil {
@@ -358,6 +631,7 @@ func StartServer(listenInterface string,
 	schemaPersistor schema.Persistor,
 	importersRegistry importers.Registry,
 	queryHistorizer history.Historizer,
+	graphUpdater *knowledge.GraphUpdater,
 	graphUpdatesC chan knowledge.SourceSubGraphUpdates) {
 
 	r := mux.NewRouter()
@@ -365,7 +639,13 @@ func StartServer(listenInterface string,
 	listImportersHandler := listImporters(importersRegistry)
 	getSourceGraphHandler := getSourceGraph(importersRegistry, schemaPersistor)
 	getDatabaseDetailsHandler := getDatabaseDetails(database)
-	postQueryHandler := postQuery(database, queryHistorizer)
+	queryRegistry := knowledge.NewQueryRegistry("admin")
+	procedures := knowledge.NewProcedureRegistry()
+	procedures.RegisterBuiltins(database, schemaPersistor, importersRegistry)
+	postQueryHandler := postQuery(database, importers.NewSchemaLoader(importersRegistry, schemaPersistor),
+		queryHistorizer, queryRegistry, graphUpdater, procedures)
+	getRunningQueriesHandler := getRunningQueries(queryRegistry)
+	cancelQueryHandler := cancelQuery(queryRegistry)
 	flushDatabaseHandler := flushDatabase(database)
 
 	if viper.GetString("password") != "" {
@@ -381,6 +661,8 @@ func StartServer(listenInterface string,
 		getSourceGraphHandler = AuthMiddleware(getSourceGraphHandler)
 		getDatabaseDetailsHandler = AuthMiddleware(getDatabaseDetailsHandler)
 		postQueryHandler = AuthMiddleware(postQueryHandler)
+		getRunningQueriesHandler = AuthMiddleware(getRunningQueriesHandler)
+		cancelQueryHandler = AuthMiddleware(cancelQueryHandler)
 		flushDatabaseHandler = AuthMiddleware(flushDatabaseHandler)
 	}
 
@@ -394,6 +676,8 @@ func StartServer(listenInterface string,
 	r.HandleFunc("/api/graph/update", postGraphUpdates(importersRegistry, graphUpdatesC)).Methods("POST")
 
 	r.HandleFunc("/api/query", postQueryHandler).Methods("POST")
+	r.HandleFunc("/api/queries/running", getRunningQueriesHandler).Methods("GET")
+	r.HandleFunc("/api/queries/{id}", cancelQueryHandler).Methods("DELETE")
 	r.PathPrefix("/").Handler(http.FileServer(http.Dir("./web/build/")))
 
 	var err error
@@ -0,0 +1,31 @@
+package utils
+
+// LevenshteinDistance compute the minimum number of single character insertions, deletions or substitutions
+// required to change a string into the other
+func LevenshteinDistance(s1 string, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+	previous := make([]int, len(r2)+1)
+	current := make([]int, len(r2)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(r1); i++ {
+		current[0] = i
+		for j := 1; j <= len(r2); j++ {
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(r2)]
+}
@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 0, LevenshteinDistance("host", "host"))
+	assert.Equal(t, 2, LevenshteinDistance("hots", "host"))
+	assert.Equal(t, 1, LevenshteinDistance("users", "user"))
+	assert.Equal(t, 4, LevenshteinDistance("", "user"))
+	assert.Equal(t, 3, LevenshteinDistance("kitten", "sitting"))
+}
@@ -2,6 +2,7 @@ import React, { useEffect, useState, Fragment, useCallback } from "react";
 import { QueryResultSet } from "../models/QueryResultSet";
 import { Relation } from "../models/Relation";
 import { Asset } from "../models/Asset";
+import { Path } from "../models/Path";
 import D3Graph from "./D3Graph";
 import classnames from "classnames";
 import { makeStyles, useTheme } from "@material-ui/core";
@@ -64,12 +65,20 @@ export default function (props: Props) {
             for (const i in result.items) {
                 const row = result.items[i]
                 for (const j in row) {
+                    if (row[j] === null) {
+                        continue;
+                    }
                     const isAsset = result.columns[j].type === "asset";
                     const isRelation = result.columns[j].type === "relation";
+                    const isPath = result.columns[j].type === "path";
                     if (isAsset) {
                         assets.push(row[j] as Asset);
                     } else if (isRelation) {
                         relations.push(row[j] as Relation);
+                    } else if (isPath) {
+                        const path = row[j] as Path;
+                        assets.push(...path.assets);
+                        relations.push(...path.relations);
                     }
                 }
             }
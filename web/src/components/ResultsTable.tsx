@@ -1,8 +1,9 @@
 import React, { useState, useEffect, memo } from "react";
-import { QueryResultSet, TypedDoc, ColumnType } from "../models/QueryResultSet";
+import { QueryResultSet, RowResponse, ColumnType } from "../models/QueryResultSet";
 import MaterialTable from "material-table"
 import { Asset } from "../models/Asset";
 import { Relation } from "../models/Relation";
+import { Path } from "../models/Path";
 import { makeStyles } from "@material-ui/core";
 
 export interface Props {
@@ -14,9 +15,11 @@ function computeColumns(columns: ColumnType[]) {
     return columns.map((v, i) => ({ title: `${v.name} (${v.type})`, field: `col-${i}`, export: true }));
 }
 
-function cellToValue(row: TypedDoc[], colIdx: number, columns: ColumnType[]): string {
+function cellToValue(row: RowResponse, colIdx: number, columns: ColumnType[]): string {
     const v = row[colIdx];
-    if (columns[colIdx].type === "property") {
+    if (v === null) {
+        return "null";
+    } else if (columns[colIdx].type === "property") {
         return v as string;
     } else if (columns[colIdx].type === "asset") {
         const d = v as Asset;
@@ -24,6 +27,12 @@ function cellToValue(row: TypedDoc[], colIdx: number, columns: ColumnType[]): st
     } else if (columns[colIdx].type === "relation") {
         const d = v as Relation;
         return d.type;
+    } else if (columns[colIdx].type === "path") {
+        const d = v as Path;
+        return d.assets.map(a => a.key).join(" -> ");
+    } else if (columns[colIdx].type === "list") {
+        const d = v as unknown[];
+        return `[${d.map(e => typeof e === "object" ? JSON.stringify(e) : String(e)).join(", ")}]`;
     }
     return "unknown";
 }
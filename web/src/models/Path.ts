@@ -0,0 +1,7 @@
+import { Asset } from "./Asset";
+import { Relation } from "./Relation";
+
+export interface Path {
+    assets: Asset[];
+    relations: Relation[];
+}
\ No newline at end of file
@@ -1,17 +1,37 @@
 import { Asset } from "./Asset";
 import { Relation } from "./Relation";
+import { Path } from "./Path";
 
-export type TypedDoc = Asset | Relation | string;
+export type TypedDoc = Asset | Relation | Path | string | unknown[];
 
-export type RowResponse = TypedDoc[];
+export type RowResponse = (TypedDoc | null)[];
 
 export interface ColumnType {
     name: string
-    type: "asset" | "relation" | "property";
+    type: "asset" | "relation" | "path" | "list" | "property";
+}
+
+export interface QueryProfile {
+    parsing_time_ms: number;
+    translation_time_ms: number;
+    execution_time_ms: number;
+    fetching_time_ms: number;
+    rows_count: number;
+}
+
+export interface QueryWarning {
+    message: string;
+    suggestions?: string[];
 }
 
 export interface QueryResultSet {
     items: RowResponse[];
     columns: ColumnType[];
     execution_time_ms: number;
+    // Set when the query is prefixed by EXPLAIN or PROFILE
+    plan?: unknown;
+    // Set when the query is prefixed by PROFILE
+    profile?: QueryProfile;
+    // Parts of the query not matching the schema
+    warnings?: QueryWarning[];
 }
\ No newline at end of file
@@ -48,11 +48,26 @@ export async function searchAssets(query: string, from: number = 0, size: number
     return res.data;
 }
 
-export async function postQuery(query: string) {
+// QueryCostError is thrown when the query is estimated to be too expensive, it can be posted again once confirmed
+export class QueryCostError extends Error {
+    constructor(message: string) {
+        super(message);
+        this.name = "QueryCostError";
+        // Restore the prototype lost when extending Error with an es5 target so that instanceof works.
+        Object.setPrototypeOf(this, QueryCostError.prototype);
+    }
+}
+
+export async function postQuery(query: string, params: { [name: string]: string | number | boolean | null } = {}, confirm: boolean = false) {
     const res = await axios.post<QueryResultSet>("/api/query", {
         q: query,
-    }, { validateStatus: s => s === 200 || s === 500 || s === 400 });
+        params: params,
+        confirm: confirm,
+    }, { validateStatus: s => s === 200 || s === 500 || s === 400 || s === 422 });
 
+    if (res.status === 422) {
+        throw new QueryCostError((res.data as any).error);
+    }
     if (res.status !== 200) {
         throw new Error(`${res.data} (${res.status})`);
     }
@@ -2,7 +2,7 @@ import React, { useState, useEffect, useCallback } from 'react';
 import { makeStyles, Grid, Snackbar, SnackbarContent, Paper, useTheme, IconButton } from '@material-ui/core';
 import GraphExplorer from '../components/GraphExplorer';
 import QueryField from '../components/QueryField';
-import { postQuery, getSources, getDatabaseDetails } from "../services/SourceGraph";
+import { postQuery, getSources, getDatabaseDetails, QueryCostError } from "../services/SourceGraph";
 import { QueryResultSet } from '../models/QueryResultSet';
 import ResultsTable from '../components/ResultsTable';
 import { Asset } from '../models/Asset';
@@ -38,6 +38,16 @@ const ExplorerView = () => {
             const res = await postQuery(q);
             setQueryResult(res);
         } catch (err) {
+            if (err instanceof QueryCostError && window.confirm(err.message)) {
+                try {
+                    setQueryResult(await postQuery(q, {}, true));
+                } catch (err) {
+                    console.error(err);
+                    setError(err);
+                }
+                setIsQueryLoading(false);
+                return;
+            }
             console.error(err);
             setError(err);
         }
@@ -153,6 +163,9 @@ const ExplorerView = () => {
                                     <div className={styles.queryFieldContainerInner}>
                                         <div className={styles.resultsSummary}>
                                             {queryResult ? <span>{queryResult.items.length} results founds in {queryResult.execution_time_ms}ms</span> : null}
+                                            {queryResult && queryResult.warnings
+                                                ? queryResult.warnings.map((w, i) => <div key={i} className={styles.queryWarning}>{w.message}</div>)
+                                                : null}
                                         </div>
                                         <QueryField
                                             query={query}
@@ -238,6 +251,9 @@ const useStyles = makeStyles(theme => ({
         color: "grey",
         fontSize: 0.9 * theme.typography.fontSize,
     },
+    queryWarning: {
+        color: "orange",
+    },
     queryHint: {
         position: "absolute",
         right: theme.spacing(2),